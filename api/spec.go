@@ -0,0 +1,9 @@
+// Package api holds the OpenAPI contract of the HTTP API
+package api
+
+import _ "embed"
+
+// Spec is the OpenAPI document (openapi.yaml), embedded so the binary validates against the spec it was built with
+//
+//go:embed openapi.yaml
+var Spec []byte
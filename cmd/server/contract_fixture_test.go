@@ -0,0 +1,1125 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	app_composition "farohq-core-app/internal/app/composition"
+	auth_usecases "farohq-core-app/internal/domains/auth/app/usecases"
+	auth_domain "farohq-core-app/internal/domains/auth/domain"
+	auth_model "farohq-core-app/internal/domains/auth/domain/model"
+	auth_outbound "farohq-core-app/internal/domains/auth/domain/ports/outbound"
+	auth_http "farohq-core-app/internal/domains/auth/infra/http"
+	brand_domain "farohq-core-app/internal/domains/brand/domain"
+	brand_model "farohq-core-app/internal/domains/brand/domain/model"
+	brand_inbound "farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	brand_outbound "farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	brand_services "farohq-core-app/internal/domains/brand/domain/services"
+	brand_http "farohq-core-app/internal/domains/brand/infra/http"
+	files_model "farohq-core-app/internal/domains/files/domain/model"
+	files_inbound "farohq-core-app/internal/domains/files/domain/ports/inbound"
+	files_http "farohq-core-app/internal/domains/files/infra/http"
+	tenants_usecases "farohq-core-app/internal/domains/tenants/app/usecases"
+	tenants_domain "farohq-core-app/internal/domains/tenants/domain"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+	tenants_services "farohq-core-app/internal/domains/tenants/domain/services"
+	tenants_http "farohq-core-app/internal/domains/tenants/infra/http"
+	users_domain "farohq-core-app/internal/domains/users/domain"
+	users_model "farohq-core-app/internal/domains/users/domain/model"
+	users_inbound "farohq-core-app/internal/domains/users/domain/ports/inbound"
+	users_outbound "farohq-core-app/internal/domains/users/domain/ports/outbound"
+	users_http "farohq-core-app/internal/domains/users/infra/http"
+	"farohq-core-app/internal/platform/config"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/openapi"
+	"farohq-core-app/internal/platform/svix"
+	"farohq-core-app/internal/platform/tenant"
+)
+
+// stubUseCase is an inbound use case that answers every request with the same response
+type stubUseCase[Req, Resp any] struct {
+	resp Resp
+}
+
+func (s stubUseCase[Req, Resp]) Execute(ctx context.Context, req Req) (Resp, error) {
+	return s.resp, nil
+}
+
+// contractStore holds the records behind the in-memory repositories of the contract test
+// Tenant exports run in the background, so every access takes the mutex.
+type contractStore struct {
+	mu            sync.Mutex
+	tenants       map[uuid.UUID]tenants_model.Tenant
+	members       map[uuid.UUID]tenants_model.TenantMember
+	invites       map[uuid.UUID]tenants_model.Invite
+	clients       map[uuid.UUID]tenants_model.Client
+	clientMembers map[uuid.UUID]tenants_model.ClientMember
+	locations     map[uuid.UUID]tenants_model.Location
+	events        []tenants_model.SuspensionEvent
+	exports       map[uuid.UUID]tenants_model.TenantExport
+	users         map[uuid.UUID]users_model.User
+	sessions      map[uuid.UUID]auth_model.ImpersonationSession
+	brandings     map[uuid.UUID]brand_model.Branding
+}
+
+func newContractStore() *contractStore {
+	return &contractStore{
+		tenants:       map[uuid.UUID]tenants_model.Tenant{},
+		members:       map[uuid.UUID]tenants_model.TenantMember{},
+		invites:       map[uuid.UUID]tenants_model.Invite{},
+		clients:       map[uuid.UUID]tenants_model.Client{},
+		clientMembers: map[uuid.UUID]tenants_model.ClientMember{},
+		locations:     map[uuid.UUID]tenants_model.Location{},
+		exports:       map[uuid.UUID]tenants_model.TenantExport{},
+		users:         map[uuid.UUID]users_model.User{},
+		sessions:      map[uuid.UUID]auth_model.ImpersonationSession{},
+		brandings:     map[uuid.UUID]brand_model.Branding{},
+	}
+}
+
+// memoryTenantRepository is an in-memory outbound.TenantRepository that compares versions on update
+type memoryTenantRepository struct {
+	tenants_outbound.TenantRepository
+	store *contractStore
+}
+
+func (r *memoryTenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.Tenant, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	tenant, ok := r.store.tenants[id]
+	if !ok {
+		return nil, tenants_domain.ErrTenantNotFound
+	}
+	return &tenant, nil
+}
+
+func (r *memoryTenantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*tenants_model.Tenant, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var tenants []*tenants_model.Tenant
+	for _, id := range ids {
+		if tenant, ok := r.store.tenants[id]; ok {
+			tenants = append(tenants, &tenant)
+		}
+	}
+	return tenants, nil
+}
+
+func (r *memoryTenantRepository) FindBySlug(ctx context.Context, slug string) (*tenants_model.Tenant, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, tenant := range r.store.tenants {
+		if tenant.Slug() == slug {
+			return &tenant, nil
+		}
+	}
+	return nil, tenants_domain.ErrTenantNotFound
+}
+
+func (r *memoryTenantRepository) Save(ctx context.Context, tenant *tenants_model.Tenant) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.tenants[tenant.ID()] = *tenant
+	return nil
+}
+
+func (r *memoryTenantRepository) Update(ctx context.Context, tenant *tenants_model.Tenant) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	stored, ok := r.store.tenants[tenant.ID()]
+	if !ok {
+		return tenants_domain.ErrTenantNotFound
+	}
+	if stored.Version() != tenant.Version() {
+		return tenants_domain.ErrVersionConflict
+	}
+	tenant.SetVersion(tenant.Version() + 1)
+	r.store.tenants[tenant.ID()] = *tenant
+	return nil
+}
+
+// memoryTenantMemberRepository is an in-memory outbound.TenantMemberRepository
+type memoryTenantMemberRepository struct {
+	store *contractStore
+}
+
+func (r *memoryTenantMemberRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.TenantMember, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	member, ok := r.store.members[id]
+	if !ok {
+		return nil, tenants_domain.ErrMemberNotFound
+	}
+	return &member, nil
+}
+
+func (r *memoryTenantMemberRepository) FindByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*tenants_model.TenantMember, error) {
+	return r.find(func(m tenants_model.TenantMember) bool { return m.TenantID() == tenantID }), nil
+}
+
+func (r *memoryTenantMemberRepository) FindByTenantAndUserID(ctx context.Context, tenantID, userID uuid.UUID) (*tenants_model.TenantMember, error) {
+	members := r.find(func(m tenants_model.TenantMember) bool { return m.TenantID() == tenantID && m.UserID() == userID })
+	if len(members) == 0 {
+		return nil, tenants_domain.ErrMemberNotFound
+	}
+	return members[0], nil
+}
+
+func (r *memoryTenantMemberRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*tenants_model.TenantMember, error) {
+	return r.find(func(m tenants_model.TenantMember) bool { return m.UserID() == userID }), nil
+}
+
+func (r *memoryTenantMemberRepository) Save(ctx context.Context, member *tenants_model.TenantMember) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.members[member.ID()] = *member
+	return nil
+}
+
+func (r *memoryTenantMemberRepository) Update(ctx context.Context, member *tenants_model.TenantMember) error {
+	return r.Save(ctx, member)
+}
+
+func (r *memoryTenantMemberRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if _, ok := r.store.members[id]; !ok {
+		return tenants_domain.ErrMemberNotFound
+	}
+	delete(r.store.members, id)
+	return nil
+}
+
+func (r *memoryTenantMemberRepository) DeleteByTenantAndUserID(ctx context.Context, tenantID, userID uuid.UUID) error {
+	member, err := r.FindByTenantAndUserID(ctx, tenantID, userID)
+	if err != nil {
+		return err
+	}
+	return r.Delete(ctx, member.ID())
+}
+
+func (r *memoryTenantMemberRepository) CountByTenantID(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	members, _ := r.FindByTenantID(ctx, tenantID)
+	return len(members), nil
+}
+
+func (r *memoryTenantMemberRepository) find(match func(tenants_model.TenantMember) bool) []*tenants_model.TenantMember {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var members []*tenants_model.TenantMember
+	for _, member := range r.store.members {
+		if match(member) {
+			members = append(members, &member)
+		}
+	}
+	return members
+}
+
+// memoryInviteRepository is an in-memory outbound.InviteRepository
+type memoryInviteRepository struct {
+	store *contractStore
+}
+
+func (r *memoryInviteRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.Invite, error) {
+	return r.first(func(i tenants_model.Invite) bool { return i.ID() == id })
+}
+
+func (r *memoryInviteRepository) FindByToken(ctx context.Context, token string) (*tenants_model.Invite, error) {
+	return r.first(func(i tenants_model.Invite) bool { return i.Token() == token })
+}
+
+func (r *memoryInviteRepository) FindByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*tenants_model.Invite, error) {
+	return r.find(func(i tenants_model.Invite) bool { return i.TenantID() == tenantID }), nil
+}
+
+func (r *memoryInviteRepository) FindByEmail(ctx context.Context, email string, tenantID uuid.UUID) (*tenants_model.Invite, error) {
+	return r.first(func(i tenants_model.Invite) bool { return i.Email() == email && i.TenantID() == tenantID })
+}
+
+func (r *memoryInviteRepository) FindPendingInvitesByEmail(ctx context.Context, email string) ([]*tenants_model.Invite, error) {
+	return r.find(func(i tenants_model.Invite) bool { return i.Email() == email && isPendingInvite(i) }), nil
+}
+
+func (r *memoryInviteRepository) FindPendingByCreator(ctx context.Context, createdBy uuid.UUID) ([]*tenants_model.Invite, error) {
+	return r.find(func(i tenants_model.Invite) bool { return i.CreatedBy() == createdBy && isPendingInvite(i) }), nil
+}
+
+func (r *memoryInviteRepository) Save(ctx context.Context, invite *tenants_model.Invite) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.invites[invite.ID()] = *invite
+	return nil
+}
+
+func (r *memoryInviteRepository) Update(ctx context.Context, invite *tenants_model.Invite) error {
+	return r.Save(ctx, invite)
+}
+
+func (r *memoryInviteRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	delete(r.store.invites, id)
+	return nil
+}
+
+func (r *memoryInviteRepository) first(match func(tenants_model.Invite) bool) (*tenants_model.Invite, error) {
+	invites := r.find(match)
+	if len(invites) == 0 {
+		return nil, tenants_domain.ErrInviteNotFound
+	}
+	return invites[0], nil
+}
+
+func (r *memoryInviteRepository) find(match func(tenants_model.Invite) bool) []*tenants_model.Invite {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var invites []*tenants_model.Invite
+	for _, invite := range r.store.invites {
+		if match(invite) {
+			invites = append(invites, &invite)
+		}
+	}
+	return invites
+}
+
+func isPendingInvite(invite tenants_model.Invite) bool {
+	return !invite.IsAccepted() && !invite.IsRevoked() && !invite.IsExpired()
+}
+
+// memoryClientRepository is an in-memory outbound.ClientRepository that compares versions when saving a stored client
+type memoryClientRepository struct {
+	store *contractStore
+}
+
+func (r *memoryClientRepository) Save(ctx context.Context, client *tenants_model.Client) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if stored, ok := r.store.clients[client.ID()]; ok {
+		if stored.Version() != client.Version() {
+			return tenants_domain.ErrVersionConflict
+		}
+		client.SetVersion(client.Version() + 1)
+	}
+	r.store.clients[client.ID()] = *client
+	return nil
+}
+
+func (r *memoryClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.Client, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	client, ok := r.store.clients[id]
+	if !ok {
+		return nil, tenants_domain.ErrClientNotFound
+	}
+	return &client, nil
+}
+
+func (r *memoryClientRepository) FindBySlug(ctx context.Context, agencyID uuid.UUID, slug string) (*tenants_model.Client, error) {
+	clients := r.find(func(c tenants_model.Client) bool { return c.AgencyID() == agencyID && c.Slug() == slug })
+	if len(clients) == 0 {
+		return nil, tenants_domain.ErrClientNotFound
+	}
+	return clients[0], nil
+}
+
+func (r *memoryClientRepository) ListByAgency(ctx context.Context, agencyID uuid.UUID) ([]*tenants_model.Client, error) {
+	return r.find(func(c tenants_model.Client) bool { return c.AgencyID() == agencyID }), nil
+}
+
+func (r *memoryClientRepository) CountByAgency(ctx context.Context, agencyID uuid.UUID) (int, error) {
+	return len(r.find(func(c tenants_model.Client) bool { return c.AgencyID() == agencyID })), nil
+}
+
+func (r *memoryClientRepository) CountByAgencyAndTier(ctx context.Context, agencyID uuid.UUID, tier tenants_model.Tier) (int, error) {
+	return len(r.find(func(c tenants_model.Client) bool { return c.AgencyID() == agencyID && c.Tier() == tier })), nil
+}
+
+func (r *memoryClientRepository) find(match func(tenants_model.Client) bool) []*tenants_model.Client {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var clients []*tenants_model.Client
+	for _, client := range r.store.clients {
+		if match(client) {
+			clients = append(clients, &client)
+		}
+	}
+	return clients
+}
+
+// memoryClientMemberRepository is an in-memory outbound.ClientMemberRepository
+type memoryClientMemberRepository struct {
+	tenants_outbound.ClientMemberRepository
+	store *contractStore
+}
+
+func (r *memoryClientMemberRepository) Save(ctx context.Context, member *tenants_model.ClientMember) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.clientMembers[member.ID()] = *member
+	return nil
+}
+
+func (r *memoryClientMemberRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.ClientMember, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	member, ok := r.store.clientMembers[id]
+	if !ok {
+		return nil, tenants_domain.ErrClientMemberNotFound
+	}
+	return &member, nil
+}
+
+func (r *memoryClientMemberRepository) FindByClientAndUser(ctx context.Context, clientID, userID uuid.UUID, locationID *uuid.UUID) (*tenants_model.ClientMember, error) {
+	members := r.find(func(m tenants_model.ClientMember) bool {
+		return m.ClientID() == clientID && m.UserID() == userID && sameLocation(m.LocationID(), locationID)
+	})
+	if len(members) == 0 {
+		return nil, tenants_domain.ErrClientMemberNotFound
+	}
+	return members[0], nil
+}
+
+func (r *memoryClientMemberRepository) ListByClient(ctx context.Context, clientID uuid.UUID, locationID *uuid.UUID) ([]*tenants_model.ClientMember, error) {
+	return r.find(func(m tenants_model.ClientMember) bool {
+		return m.ClientID() == clientID && (locationID == nil || sameLocation(m.LocationID(), locationID))
+	}), nil
+}
+
+func (r *memoryClientMemberRepository) CountByClient(ctx context.Context, clientID uuid.UUID) (int, error) {
+	return len(r.find(func(m tenants_model.ClientMember) bool { return m.ClientID() == clientID })), nil
+}
+
+func (r *memoryClientMemberRepository) CountByClientAndLocation(ctx context.Context, clientID uuid.UUID, locationID uuid.UUID) (int, error) {
+	return len(r.find(func(m tenants_model.ClientMember) bool {
+		return m.ClientID() == clientID && sameLocation(m.LocationID(), &locationID)
+	})), nil
+}
+
+func (r *memoryClientMemberRepository) find(match func(tenants_model.ClientMember) bool) []*tenants_model.ClientMember {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var members []*tenants_model.ClientMember
+	for _, member := range r.store.clientMembers {
+		if !member.IsDeleted() && match(member) {
+			members = append(members, &member)
+		}
+	}
+	return members
+}
+
+func sameLocation(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// memoryLocationRepository is an in-memory outbound.LocationRepository that compares versions when saving a stored location
+type memoryLocationRepository struct {
+	store *contractStore
+}
+
+func (r *memoryLocationRepository) Save(ctx context.Context, location *tenants_model.Location) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if stored, ok := r.store.locations[location.ID()]; ok {
+		if stored.Version() != location.Version() {
+			return tenants_domain.ErrVersionConflict
+		}
+		location.SetVersion(location.Version() + 1)
+	}
+	r.store.locations[location.ID()] = *location
+	return nil
+}
+
+func (r *memoryLocationRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.Location, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	location, ok := r.store.locations[id]
+	if !ok {
+		return nil, tenants_domain.ErrLocationNotFound
+	}
+	return &location, nil
+}
+
+func (r *memoryLocationRepository) ListByClient(ctx context.Context, clientID uuid.UUID) ([]*tenants_model.Location, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var locations []*tenants_model.Location
+	for _, location := range r.store.locations {
+		if location.ClientID() == clientID {
+			locations = append(locations, &location)
+		}
+	}
+	return locations, nil
+}
+
+func (r *memoryLocationRepository) CountByClient(ctx context.Context, clientID uuid.UUID) (int, error) {
+	locations, _ := r.ListByClient(ctx, clientID)
+	return len(locations), nil
+}
+
+// memorySuspensionEventRepository is an in-memory outbound.SuspensionEventRepository
+type memorySuspensionEventRepository struct {
+	store *contractStore
+}
+
+func (r *memorySuspensionEventRepository) Save(ctx context.Context, event *tenants_model.SuspensionEvent) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.events = append(r.store.events, *event)
+	return nil
+}
+
+func (r *memorySuspensionEventRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*tenants_model.SuspensionEvent, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var events []*tenants_model.SuspensionEvent
+	for _, event := range r.store.events {
+		if event.TenantID() == tenantID {
+			events = append(events, &event)
+		}
+	}
+	return events, nil
+}
+
+// memoryTenantExportRepository is an in-memory outbound.TenantExportRepository
+type memoryTenantExportRepository struct {
+	store *contractStore
+}
+
+func (r *memoryTenantExportRepository) Save(ctx context.Context, export *tenants_model.TenantExport) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.exports[export.ID()] = *export
+	return nil
+}
+
+func (r *memoryTenantExportRepository) Update(ctx context.Context, export *tenants_model.TenantExport) error {
+	return r.Save(ctx, export)
+}
+
+func (r *memoryTenantExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.TenantExport, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	export, ok := r.store.exports[id]
+	if !ok {
+		return nil, tenants_domain.ErrExportNotFound
+	}
+	return &export, nil
+}
+
+func (r *memoryTenantExportRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*tenants_model.TenantExport, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var exports []*tenants_model.TenantExport
+	for _, export := range r.store.exports {
+		if export.TenantID() == tenantID {
+			exports = append(exports, &export)
+		}
+	}
+	return exports, nil
+}
+
+func (r *memoryTenantExportRepository) FindActiveByTenant(ctx context.Context, tenantID uuid.UUID) (*tenants_model.TenantExport, error) {
+	exports, _ := r.ListByTenant(ctx, tenantID)
+	for _, export := range exports {
+		if export.IsActive() {
+			return export, nil
+		}
+	}
+	return nil, tenants_domain.ErrExportNotFound
+}
+
+// memoryArchiveStore is an outbound.ExportArchiveStore that discards archives
+type memoryArchiveStore struct{}
+
+func (s *memoryArchiveStore) Upload(ctx context.Context, key string, content io.Reader, contentType string) error {
+	_, err := io.Copy(io.Discard, content)
+	return err
+}
+
+func (s *memoryArchiveStore) SignedDownloadURL(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	return "https://storage.example.com/" + key, nil
+}
+
+func (s *memoryArchiveStore) ListTenantFiles(ctx context.Context, tenantID uuid.UUID) ([]tenants_outbound.StoredFile, error) {
+	return nil, nil
+}
+
+// noopAccessRevoker is an outbound.AccessRevoker that revokes nothing
+type noopAccessRevoker struct{}
+
+func (noopAccessRevoker) RevokeAccess(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+// noopEmailService is an outbound.EmailService that sends nothing
+type noopEmailService struct{}
+
+func (noopEmailService) SendInviteEmail(ctx context.Context, emailCtx *tenants_outbound.InviteEmailContext) error {
+	return nil
+}
+
+func (noopEmailService) SendDomainNoticeEmail(ctx context.Context, emailCtx *tenants_outbound.DomainNoticeEmailContext) error {
+	return nil
+}
+
+// memoryUserRepository is an in-memory users outbound.UserRepository
+type memoryUserRepository struct {
+	store *contractStore
+}
+
+func (r *memoryUserRepository) FindByClerkUserID(ctx context.Context, clerkUserID string) (*users_model.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, user := range r.store.users {
+		if user.ClerkUserID() == clerkUserID {
+			return &user, nil
+		}
+	}
+	return nil, users_domain.ErrUserNotFound
+}
+
+func (r *memoryUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*users_model.User, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	user, ok := r.store.users[id]
+	if !ok {
+		return nil, users_domain.ErrUserNotFound
+	}
+	return &user, nil
+}
+
+func (r *memoryUserRepository) Save(ctx context.Context, user *users_model.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.users[user.ID()] = *user
+	return nil
+}
+
+func (r *memoryUserRepository) Update(ctx context.Context, user *users_model.User) error {
+	return r.Save(ctx, user)
+}
+
+// memoryBrandRepository is an in-memory brand outbound.BrandRepository that only reads brandings
+type memoryBrandRepository struct {
+	brand_outbound.BrandRepository
+	store *contractStore
+}
+
+func (r *memoryBrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*brand_model.Branding, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	branding, ok := r.store.brandings[agencyID]
+	if !ok {
+		return nil, brand_domain.ErrBrandingNotFound
+	}
+	return &branding, nil
+}
+
+// inviteBrandingAdapter adapts the brand repository to the branding of invite emails (ignoring client overrides)
+type inviteBrandingAdapter struct {
+	brandRepo *memoryBrandRepository
+}
+
+func (a *inviteBrandingAdapter) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (tenants_usecases.BrandingInfo, error) {
+	branding, err := a.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, err
+	}
+	return branding, nil
+}
+
+func (a *inviteBrandingAdapter) FindForClient(ctx context.Context, agencyID, clientID uuid.UUID) (tenants_usecases.BrandingInfo, error) {
+	return a.FindByAgencyID(ctx, agencyID)
+}
+
+// inviterAdapter adapts the user repository to the inviter of invite emails
+type inviterAdapter struct {
+	userRepo *memoryUserRepository
+}
+
+func (a *inviterAdapter) FindByID(ctx context.Context, userID uuid.UUID) (tenants_usecases.UserInfo, error) {
+	user, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// brandingExporter exports the agency's colors as the branding section of tenant exports
+type brandingExporter struct {
+	brandRepo *memoryBrandRepository
+}
+
+func (e *brandingExporter) ExportByAgencyID(ctx context.Context, agencyID uuid.UUID) (map[string]interface{}, error) {
+	branding, err := e.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		if err == brand_domain.ErrBrandingNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return map[string]interface{}{
+		"primary_color":   branding.PrimaryColor(),
+		"secondary_color": branding.SecondaryColor(),
+	}, nil
+}
+
+// memoryImpersonationSessionRepository is an in-memory auth outbound.ImpersonationSessionRepository
+type memoryImpersonationSessionRepository struct {
+	store *contractStore
+}
+
+func (r *memoryImpersonationSessionRepository) Save(ctx context.Context, session *auth_model.ImpersonationSession) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.sessions[session.ID()] = *session
+	return nil
+}
+
+func (r *memoryImpersonationSessionRepository) Update(ctx context.Context, session *auth_model.ImpersonationSession) error {
+	return r.Save(ctx, session)
+}
+
+func (r *memoryImpersonationSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*auth_model.ImpersonationSession, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	session, ok := r.store.sessions[id]
+	if !ok {
+		return nil, auth_domain.ErrImpersonationSessionNotFound
+	}
+	return &session, nil
+}
+
+func (r *memoryImpersonationSessionRepository) ListActiveByActor(ctx context.Context, actorUserID uuid.UUID) ([]*auth_model.ImpersonationSession, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	var sessions []*auth_model.ImpersonationSession
+	for _, session := range r.store.sessions {
+		if session.ActorUserID() == actorUserID && session.IsActive() {
+			sessions = append(sessions, &session)
+		}
+	}
+	return sessions, nil
+}
+
+// noopImpersonationAuditRepository is an auth outbound.ImpersonationAuditRepository that records nothing
+type noopImpersonationAuditRepository struct{}
+
+func (noopImpersonationAuditRepository) Save(ctx context.Context, entry *auth_model.ImpersonationAuditEntry) error {
+	return nil
+}
+
+// memoryDirectory adapts the in-memory users and members to the directories of the auth use cases
+type memoryDirectory struct {
+	userRepo   *memoryUserRepository
+	memberRepo *memoryTenantMemberRepository
+	tenantRepo *memoryTenantRepository
+	brandRepo  *memoryBrandRepository
+}
+
+func (d *memoryDirectory) FindByID(ctx context.Context, id uuid.UUID) (*auth_outbound.UserIdentity, error) {
+	user, err := d.userRepo.FindByID(ctx, id)
+	return toUserIdentity(user, err)
+}
+
+func (d *memoryDirectory) FindByClerkUserID(ctx context.Context, clerkUserID string) (*auth_outbound.UserIdentity, error) {
+	user, err := d.userRepo.FindByClerkUserID(ctx, clerkUserID)
+	return toUserIdentity(user, err)
+}
+
+func (d *memoryDirectory) MemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error) {
+	member, err := d.memberRepo.FindByTenantAndUserID(ctx, tenantID, userID)
+	if err != nil {
+		return "", auth_domain.ErrNotTenantMember
+	}
+	return string(member.Role()), nil
+}
+
+func (d *memoryDirectory) ListMemberships(ctx context.Context, userID uuid.UUID) ([]auth_model.SessionTenant, error) {
+	members, _ := d.memberRepo.FindByUserID(ctx, userID)
+	memberships := make([]auth_model.SessionTenant, 0, len(members))
+	for _, member := range members {
+		t, err := d.tenantRepo.FindByID(ctx, member.TenantID())
+		if err != nil {
+			return nil, err
+		}
+		entitlements := tenants_model.EntitlementsForTier(t.Tier())
+		memberships = append(memberships, auth_model.SessionTenant{
+			ID:          t.ID(),
+			Name:        t.Name(),
+			Slug:        t.Slug(),
+			Status:      string(t.Status()),
+			Tier:        t.Tier().String(),
+			Suspended:   t.IsSuspended(),
+			Role:        string(member.Role()),
+			Permissions: tenants_model.RolePermissions(member.Role()),
+			Entitlements: auth_model.SessionEntitlements{
+				ClientLimit:   entitlements.ClientLimit,
+				CustomDomain:  entitlements.CustomDomain,
+				HidePoweredBy: entitlements.HidePoweredBy,
+				SendingDomain: entitlements.SendingDomain,
+				UsesSubdomain: entitlements.UsesSubdomain,
+			},
+		})
+	}
+	return memberships, nil
+}
+
+func (d *memoryDirectory) FindByTenantID(ctx context.Context, tenantID uuid.UUID) (*auth_model.SessionBranding, error) {
+	branding, err := d.brandRepo.FindByAgencyID(ctx, tenantID)
+	if err != nil {
+		if err == brand_domain.ErrBrandingNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &auth_model.SessionBranding{
+		LogoURL:        branding.LogoURL(),
+		FaviconURL:     branding.FaviconURL(),
+		PrimaryColor:   branding.PrimaryColor(),
+		SecondaryColor: branding.SecondaryColor(),
+		Theme:          branding.ThemeJSON(),
+		HidePoweredBy:  branding.HidePoweredBy(),
+		Domain:         branding.Domain(),
+		Subdomain:      branding.Subdomain(),
+	}, nil
+}
+
+func toUserIdentity(user *users_model.User, err error) (*auth_outbound.UserIdentity, error) {
+	if err != nil {
+		return nil, auth_domain.ErrUserNotFound
+	}
+	return &auth_outbound.UserIdentity{
+		ID:          user.ID(),
+		ClerkUserID: user.ClerkUserID(),
+		Email:       user.Email(),
+		FirstName:   user.FirstName(),
+		LastName:    user.LastName(),
+		FullName:    user.FullName(),
+		ImageURL:    user.ImageURL(),
+	}, nil
+}
+
+// resolveFixtureTenant stands in for httpserver.TenantResolutionWithAuth, which needs Postgres:
+// authenticated requests are scoped to the given tenant as if it had been validated against the user's memberships.
+func resolveFixtureTenant(tenantID uuid.UUID) func(http.Handler) http.Handler {
+	resolver := tenant.NewResolver(nil, zerolog.Nop())
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if userID, _ := r.Context().Value("user_id").(string); userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx := resolver.SetTenantContext(r.Context(), tenantID.String())
+			ctx = resolver.SetResolutionContext(ctx, &tenant.TenantResolutionResult{
+				TenantID:  tenantID.String(),
+				Source:    tenant.TenantSourceHeader,
+				Validated: true,
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Credentials of the contract fixture
+const (
+	contractOwnerClerkID      = "user_owner"
+	contractNotificationToken = "storage-notification-token"
+	contractWebhookKey        = "clerk-webhook-signing-key"
+)
+
+// contractFixture serves newRouter over one agency (Acme) owned by the authenticated user
+// Tenants and impersonation run their real use cases over in-memory repositories; brand, files and user
+// use cases are stubbed with canned responses. Only tenant resolution is replaced, as it needs Postgres.
+type contractFixture struct {
+	router http.Handler
+	store  *contractStore
+	tenant *tenants_model.Tenant
+	owner  *users_model.User
+	client *tenants_model.Client
+	params *strings.Replacer
+}
+
+func newContractFixture(t *testing.T, auth *httpserver.RequireAuth, validator *openapi.Validator) *contractFixture {
+	t.Helper()
+	store := newContractStore()
+
+	tier := tenants_model.TierScale
+	acme := tenants_model.NewTenant("Acme", "acme", &tier, 10, nil)
+	owner := users_model.NewUser(contractOwnerClerkID, "owner@acme.example.com", "Olive", "Owner", "Olive Owner", "", nil)
+	staff := users_model.NewUser("user_staff", "staff@acme.example.com", "Sam", "Staff", "Sam Staff", "", nil)
+	ownerID := owner.ID()
+	client := tenants_model.NewClient(acme.ID(), "Bistro", "bistro", tenants_model.TierGrowth)
+	location := tenants_model.NewLocation(client.ID(), "Downtown")
+	clientMember := tenants_model.NewClientMember(client.ID(), staff.ID(), tenants_model.RoleClientViewer, nil)
+	invite := tenants_model.NewInvite(acme.ID(), "invitee@example.com", tenants_model.RoleStaff, "invite-token", owner.ID(), 7*24*time.Hour)
+	export := tenants_model.NewTenantExport(acme.ID(), &ownerID)
+	export.Start()
+	export.Complete("exports/"+acme.ID().String()+".zip", 2048, 7*24*time.Hour)
+	session := auth_model.NewImpersonationSession(owner.ID(), owner.ClerkUserID(), staff.ID(), staff.ClerkUserID(), acme.ID(), "Support ticket 42", 30*time.Minute)
+	customDomain := brand_model.DomainTypeCustom
+	branding := brand_model.NewBranding(acme.ID(), "portal.acme.example.com", "acme", &customDomain, "https://acme.example.com",
+		"https://cdn.example.com/logo.png", "https://cdn.example.com/favicon.ico", "#1a73e8", "#fbbc04", nil)
+
+	store.tenants[acme.ID()] = *acme
+	store.users[owner.ID()] = *owner
+	store.users[staff.ID()] = *staff
+	for _, member := range []*tenants_model.TenantMember{
+		tenants_model.NewTenantMember(acme.ID(), owner.ID(), tenants_model.RoleOwner),
+		tenants_model.NewTenantMember(acme.ID(), staff.ID(), tenants_model.RoleStaff),
+	} {
+		store.members[member.ID()] = *member
+	}
+	store.clients[client.ID()] = *client
+	store.locations[location.ID()] = *location
+	store.clientMembers[clientMember.ID()] = *clientMember
+	store.invites[invite.ID()] = *invite
+	store.exports[export.ID()] = *export
+	store.sessions[session.ID()] = *session
+	store.brandings[acme.ID()] = *branding
+
+	tenantRepo := &memoryTenantRepository{store: store}
+	memberRepo := &memoryTenantMemberRepository{store: store}
+	inviteRepo := &memoryInviteRepository{store: store}
+	clientRepo := &memoryClientRepository{store: store}
+	clientMemberRepo := &memoryClientMemberRepository{store: store}
+	locationRepo := &memoryLocationRepository{store: store}
+	suspensionEventRepo := &memorySuspensionEventRepository{store: store}
+	exportRepo := &memoryTenantExportRepository{store: store}
+	userRepo := &memoryUserRepository{store: store}
+	brandRepo := &memoryBrandRepository{store: store}
+	sessionRepo := &memoryImpersonationSessionRepository{store: store}
+	directory := &memoryDirectory{userRepo: userRepo, memberRepo: memberRepo, tenantRepo: tenantRepo, brandRepo: brandRepo}
+	archiveStore := &memoryArchiveStore{}
+	seatValidator := tenants_services.NewSeatValidator()
+	platformAdmins := []string{contractOwnerClerkID}
+
+	listTenantsByUser := tenants_usecases.NewListTenantsByUser(memberRepo, tenantRepo)
+	exportTenantData := tenants_usecases.NewExportTenantData(exportRepo, tenantRepo, memberRepo, inviteRepo, clientRepo, locationRepo,
+		clientMemberRepo, &brandingExporter{brandRepo: brandRepo}, &inviterAdapter{userRepo: userRepo}, archiveStore, 7*24*time.Hour)
+
+	tenantHandlers := tenants_http.NewHandlers(
+		zerolog.Nop(),
+		tenants_usecases.NewCreateTenant(tenantRepo),
+		tenants_usecases.NewOnboardTenant(tenantRepo, memberRepo),
+		tenants_usecases.NewGetTenant(tenantRepo),
+		tenants_usecases.NewUpdateTenant(tenantRepo),
+		tenants_usecases.NewInviteMember(inviteRepo, memberRepo, tenantRepo, &inviteBrandingAdapter{brandRepo: brandRepo},
+			&inviterAdapter{userRepo: userRepo}, noopEmailService{}, nil, seatValidator, 7*24*time.Hour, "https://app.example.com"),
+		tenants_usecases.NewAcceptInvite(inviteRepo, memberRepo),
+		tenants_usecases.NewListInvites(inviteRepo, tenantRepo),
+		tenants_usecases.NewFindInvitesByEmail(inviteRepo),
+		tenants_usecases.NewRevokeInvite(inviteRepo, tenantRepo),
+		tenants_usecases.NewDeleteInvite(inviteRepo, tenantRepo),
+		tenants_usecases.NewListMembers(memberRepo, tenantRepo),
+		tenants_usecases.NewRemoveMember(memberRepo, tenantRepo, noopAccessRevoker{}),
+		tenants_usecases.NewListRoles(tenantRepo),
+		tenants_usecases.NewCreateClient(clientRepo, tenantRepo, seatValidator),
+		tenants_usecases.NewListClients(clientRepo, tenantRepo),
+		tenants_usecases.NewGetClient(clientRepo),
+		tenants_usecases.NewUpdateClient(clientRepo),
+		tenants_usecases.NewAddClientMember(clientMemberRepo, locationRepo, seatValidator),
+		tenants_usecases.NewListClientMembers(clientMemberRepo),
+		tenants_usecases.NewRemoveClientMember(clientMemberRepo, noopAccessRevoker{}),
+		tenants_usecases.NewCreateLocation(locationRepo, clientRepo),
+		tenants_usecases.NewListLocations(locationRepo),
+		tenants_usecases.NewGetLocation(locationRepo),
+		tenants_usecases.NewUpdateLocation(locationRepo),
+		tenants_usecases.NewGetSeatUsage(tenantRepo, clientRepo, clientMemberRepo, locationRepo),
+		listTenantsByUser,
+		tenants_usecases.NewValidateSlug(tenantRepo),
+		tenants_usecases.NewSuspendTenant(tenantRepo, suspensionEventRepo),
+		tenants_usecases.NewReinstateTenant(tenantRepo, suspensionEventRepo),
+		tenants_usecases.NewSuspendClient(clientRepo, suspensionEventRepo),
+		tenants_usecases.NewReinstateClient(clientRepo, suspensionEventRepo),
+		tenants_usecases.NewListSuspensionEvents(suspensionEventRepo, tenantRepo),
+		tenants_usecases.NewStartTenantExport(exportRepo, tenantRepo, exportTenantData, time.Minute),
+		tenants_usecases.NewGetTenantExport(exportRepo, archiveStore, 15*time.Minute),
+		tenants_usecases.NewListTenantExports(exportRepo, tenantRepo),
+		tenants_usecases.NewRequestTenantClosure(tenantRepo, 30*24*time.Hour),
+		tenants_usecases.NewCancelTenantClosure(tenantRepo),
+		userRepo,
+		inviteRepo,
+		tenantRepo,
+		brandRepo,
+	)
+
+	authHandlers := auth_http.NewHandlers(
+		zerolog.Nop(),
+		auth_usecases.NewStartImpersonation(sessionRepo, noopImpersonationAuditRepository{}, directory, directory, platformAdmins),
+		auth_usecases.NewEndImpersonation(sessionRepo, noopImpersonationAuditRepository{}),
+		auth_usecases.NewGetSession(directory, directory, directory, nil),
+	)
+
+	brandHandlers := newStubBrandHandlers(acme.ID(), client.ID(), branding, tenantRepo)
+	filesHandlers := newStubFilesHandlers(acme.ID(), owner.ID(), userRepo)
+	userHandlers := newStubUserHandlers(t, acme.ID(), owner, staff)
+
+	composition := &app_composition.Composition{
+		TenantHandlers: tenantHandlers,
+		BrandHandlers:  brandHandlers,
+		FilesHandlers:  filesHandlers,
+		AuthHandlers:   authHandlers,
+		UserHandlers:   userHandlers,
+		UserRepo:       userRepo,
+		TenantRepo:     tenantRepo,
+		ClientRepo:     clientRepo,
+	}
+
+	router := newRouter(routerDeps{
+		cfg:              &config.Config{PlatformAdminUserIDs: platformAdmins},
+		logger:           zerolog.Nop(),
+		composition:      composition,
+		auth:             auth,
+		tenantResolution: resolveFixtureTenant(acme.ID()),
+		validator:        validator,
+	})
+
+	return &contractFixture{
+		router: router,
+		store:  store,
+		tenant: acme,
+		owner:  owner,
+		client: client,
+		params: strings.NewReplacer(
+			"{tenant}", acme.ID().String(),
+			"{client}", client.ID().String(),
+			"{location}", location.ID().String(),
+			"{client_member}", clientMember.ID().String(),
+			"{invite}", invite.ID().String(),
+			"{export}", export.ID().String(),
+			"{session}", session.ID().String(),
+			"{owner}", owner.ID().String(),
+			"{staff}", staff.ID().String(),
+		),
+	}
+}
+
+// newStubBrandHandlers serves the brand routes from canned responses built around the agency's branding
+func newStubBrandHandlers(agencyID, clientID uuid.UUID, branding *brand_model.Branding, tenantRepo tenants_outbound.TenantRepository) *brand_http.Handlers {
+	now := time.Now()
+	records := []brand_model.DNSRecord{
+		{Type: "TXT", Name: "_faro-verify.portal.acme.example.com", Value: "faro-verify=token"},
+		{Type: "CNAME", Name: "portal.acme.example.com", Value: "cname.example-dns.com"},
+	}
+	ownership := &brand_model.OwnershipCheck{Record: records[0], Verified: true}
+	changes := []brand_model.BrandingChange{{Field: "primary_color", From: "#000000", To: "#1a73e8"}}
+	draft := brand_model.NewBrandingDraft(branding)
+	clientBranding := brand_model.NewClientBranding(clientID, agencyID)
+	sendingDomain := brand_model.NewSendingDomain(agencyID, "mail.acme.example.com", "hello", "Acme")
+	sendingDomain.Register("provider-domain-1",
+		brand_model.DNSRecord{Type: "TXT", Name: "faro._domainkey.mail.acme.example.com", Value: "k=rsa;p=key"},
+		brand_model.DNSRecord{Type: "CNAME", Name: "pm-bounces.mail.acme.example.com", Value: "pm.mtasv.net"},
+		"spf.mtasv.net",
+	)
+	version := brand_model.NewBrandingVersionWithID(agencyID, 1, branding.Content(), now)
+
+	return brand_http.NewHandlers(
+		zerolog.Nop(),
+		stubUseCase[*brand_inbound.GetByDomainRequest, *brand_inbound.GetByDomainResponse]{resp: &brand_inbound.GetByDomainResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.GetByHostRequest, *brand_inbound.GetByHostResponse]{resp: &brand_inbound.GetByHostResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.ListBrandsRequest, *brand_inbound.ListBrandsResponse]{resp: &brand_inbound.ListBrandsResponse{Brands: []*brand_model.Branding{branding}}},
+		stubUseCase[*brand_inbound.CreateBrandRequest, *brand_inbound.CreateBrandResponse]{resp: &brand_inbound.CreateBrandResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.GetBrandRequest, *brand_inbound.GetBrandResponse]{resp: &brand_inbound.GetBrandResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.UpdateBrandRequest, *brand_inbound.UpdateBrandResponse]{resp: &brand_inbound.UpdateBrandResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.DeleteBrandRequest, *brand_inbound.DeleteBrandResponse]{resp: &brand_inbound.DeleteBrandResponse{Success: true}},
+		stubUseCase[*brand_inbound.VerifyDomainRequest, *brand_inbound.VerifyDomainResponse]{resp: &brand_inbound.VerifyDomainResponse{
+			Branding: branding, Verified: true, ExpectedCNAME: records[1].Value, CurrentCNAME: records[1].Value,
+			SSLStatus: "active", Records: records, Ownership: ownership,
+		}},
+		stubUseCase[*brand_inbound.GetDomainStatusRequest, *brand_inbound.GetDomainStatusResponse]{resp: &brand_inbound.GetDomainStatusResponse{
+			Branding: branding, Verified: true, ExpectedCNAME: records[1].Value, SSLStatus: "active", Records: records,
+			Check: brand_model.NewDomainCheck(agencyID, branding.Domain(), now), Ownership: ownership,
+		}},
+		stubUseCase[*brand_inbound.GetDomainInstructionsRequest, *brand_inbound.GetDomainInstructionsResponse]{resp: &brand_inbound.GetDomainInstructionsResponse{
+			Domain: branding.Domain(), CNAMETarget: records[1].Value, Records: records, Instructions: "Create the DNS records below.",
+		}},
+		stubUseCase[*brand_inbound.GetClientBrandingRequest, *brand_inbound.GetClientBrandingResponse]{resp: &brand_inbound.GetClientBrandingResponse{
+			ClientBranding: clientBranding, Branding: branding.WithClientBranding(clientBranding),
+		}},
+		stubUseCase[*brand_inbound.PutClientBrandingRequest, *brand_inbound.PutClientBrandingResponse]{resp: &brand_inbound.PutClientBrandingResponse{
+			ClientBranding: clientBranding, Branding: branding.WithClientBranding(clientBranding),
+		}},
+		stubUseCase[*brand_inbound.DeleteClientBrandingRequest, *brand_inbound.DeleteClientBrandingResponse]{resp: &brand_inbound.DeleteClientBrandingResponse{Success: true}},
+		stubUseCase[*brand_inbound.GetBrandingDraftRequest, *brand_inbound.GetBrandingDraftResponse]{resp: &brand_inbound.GetBrandingDraftResponse{
+			Draft: draft, Branding: branding, Changes: changes,
+		}},
+		stubUseCase[*brand_inbound.PutBrandingDraftRequest, *brand_inbound.PutBrandingDraftResponse]{resp: &brand_inbound.PutBrandingDraftResponse{
+			Draft: draft, Branding: branding, Changes: changes,
+		}},
+		stubUseCase[*brand_inbound.DeleteBrandingDraftRequest, *brand_inbound.DeleteBrandingDraftResponse]{resp: &brand_inbound.DeleteBrandingDraftResponse{Success: true}},
+		stubUseCase[*brand_inbound.PublishBrandingDraftRequest, *brand_inbound.PublishBrandingDraftResponse]{resp: &brand_inbound.PublishBrandingDraftResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.ListBrandingVersionsRequest, *brand_inbound.ListBrandingVersionsResponse]{resp: &brand_inbound.ListBrandingVersionsResponse{
+			Branding: branding, Versions: []brand_inbound.BrandingVersionEntry{{Version: version, Changes: changes}},
+		}},
+		stubUseCase[*brand_inbound.RollbackBrandingRequest, *brand_inbound.RollbackBrandingResponse]{resp: &brand_inbound.RollbackBrandingResponse{Branding: branding}},
+		stubUseCase[*brand_inbound.GetSendingDomainRequest, *brand_inbound.GetSendingDomainResponse]{resp: &brand_inbound.GetSendingDomainResponse{SendingDomain: sendingDomain}},
+		stubUseCase[*brand_inbound.PutSendingDomainRequest, *brand_inbound.PutSendingDomainResponse]{resp: &brand_inbound.PutSendingDomainResponse{SendingDomain: sendingDomain}},
+		stubUseCase[*brand_inbound.VerifySendingDomainRequest, *brand_inbound.VerifySendingDomainResponse]{resp: &brand_inbound.VerifySendingDomainResponse{SendingDomain: sendingDomain}},
+		stubUseCase[*brand_inbound.DeleteSendingDomainRequest, *brand_inbound.DeleteSendingDomainResponse]{resp: &brand_inbound.DeleteSendingDomainResponse{Success: true}},
+		stubUseCase[*brand_inbound.GetThemeRequest, *brand_inbound.GetThemeResponse]{resp: &brand_inbound.GetThemeResponse{Theme: brand_services.NewThemeCompiler().Compile(branding)}},
+		nil,
+		tenantRepo,
+	)
+}
+
+// newStubFilesHandlers serves the files routes from canned responses for an uploaded logo
+func newStubFilesHandlers(agencyID, uploadedBy uuid.UUID, userRepo users_outbound.UserRepository) *files_http.Handlers {
+	key := "tenants/" + agencyID.String() + "/logo/logo.png"
+	file := files_model.NewFile(agencyID, key, "logo", &uploadedBy, "image/png", 2048, "")
+
+	return files_http.NewHandlers(
+		zerolog.Nop(),
+		stubUseCase[*files_inbound.SignUploadRequest, *files_inbound.SignUploadResponse]{resp: &files_inbound.SignUploadResponse{
+			URL: "https://storage.example.com/" + key, Method: http.MethodPut,
+			Headers: map[string]string{"Content-Type": "image/png"}, Key: key, Expires: time.Now().Add(15 * time.Minute),
+		}},
+		stubUseCase[*files_inbound.FinalizeUploadRequest, *files_inbound.FinalizeUploadResponse]{resp: &files_inbound.FinalizeUploadResponse{
+			Key: key, AssetType: "logo", Width: 256, Height: 256,
+			URLs: map[string]string{"original": "https://cdn.example.com/" + key}, Removed: []string{},
+		}},
+		stubUseCase[*files_inbound.CompleteUploadRequest, *files_inbound.CompleteUploadResponse]{resp: &files_inbound.CompleteUploadResponse{File: file}},
+		stubUseCase[*files_inbound.ListFilesRequest, *files_inbound.ListFilesResponse]{resp: &files_inbound.ListFilesResponse{Files: []*files_model.File{file}}},
+		stubUseCase[*files_inbound.DeleteFileRequest, *files_inbound.DeleteFileResponse]{resp: &files_inbound.DeleteFileResponse{Success: true}},
+		userRepo,
+		contractNotificationToken,
+	)
+}
+
+// newStubUserHandlers serves the user routes from canned responses, verifying Clerk webhooks with contractWebhookKey
+func newStubUserHandlers(t *testing.T, tenantID uuid.UUID, owner, staff *users_model.User) *users_http.Handlers {
+	t.Helper()
+	verifier, err := svix.NewVerifier("whsec_" + base64.StdEncoding.EncodeToString([]byte(contractWebhookKey)))
+	require.NoError(t, err)
+	deleted := users_model.NewUser(staff.ClerkUserID(), staff.Email(), staff.FirstName(), staff.LastName(), staff.FullName(), "", nil)
+	deleted.Anonymize()
+
+	return users_http.NewHandlers(
+		zerolog.Nop(),
+		stubUseCase[*users_inbound.SyncUserRequest, *users_inbound.SyncUserResponse]{resp: &users_inbound.SyncUserResponse{User: owner}},
+		stubUseCase[*users_inbound.DeleteUserRequest, *users_inbound.DeleteUserResponse]{resp: &users_inbound.DeleteUserResponse{
+			User: deleted, RemovedTenantIDs: []uuid.UUID{tenantID}, RetainedTenantIDs: []uuid.UUID{},
+		}},
+		stubUseCase[*users_inbound.ReceiveClerkWebhookRequest, *users_inbound.ReceiveClerkWebhookResponse]{resp: &users_inbound.ReceiveClerkWebhookResponse{
+			Event: users_model.NewWebhookEvent("msg_1", "clerk", "user.updated", []byte(`{}`)),
+		}},
+		stubUseCase[*users_inbound.SwitchTenantRequest, *users_inbound.SwitchTenantResponse]{resp: &users_inbound.SwitchTenantResponse{
+			Preferences: users_model.NewTenantPreferencesWithID(owner.ID(), &tenantID, &tenantID, time.Now()),
+		}},
+		verifier,
+	)
+}
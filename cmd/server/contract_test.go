@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"farohq-core-app/api"
+	app_composition "farohq-core-app/internal/app/composition"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/platform/config"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/openapi"
+)
+
+// TestRoutesMatchOpenAPISpec fails when a route is served but not documented, or documented but not served
+func TestRoutesMatchOpenAPISpec(t *testing.T) {
+	validator, err := openapi.NewValidator(api.Spec, zerolog.Nop(), openapi.Options{})
+	require.NoError(t, err)
+
+	r := newRouter(routerDeps{
+		cfg:              &config.Config{},
+		logger:           zerolog.Nop(),
+		composition:      &app_composition.Composition{},
+		tenantResolution: func(next http.Handler) http.Handler { return next },
+		validator:        validator,
+	})
+
+	served := map[openapi.Operation]bool{}
+	err = chi.Walk(r, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		// Route("/x") with Post("/") registers "/x/"; it is served without the trailing slash too
+		if route != "/" {
+			route = strings.TrimSuffix(route, "/")
+		}
+		served[openapi.Operation{Method: method, Path: route}] = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, served)
+
+	documented := map[openapi.Operation]bool{}
+	for _, operation := range validator.Operations() {
+		documented[operation] = true
+	}
+
+	for operation := range served {
+		assert.True(t, documented[operation], "%s %s is served but not documented in api/openapi.yaml", operation.Method, operation.Path)
+	}
+	for operation := range documented {
+		assert.True(t, served[operation], "%s %s is documented in api/openapi.yaml but not served", operation.Method, operation.Path)
+	}
+}
+
+// contractCase is a valid request to a documented operation and the status it must be answered with
+type contractCase struct {
+	method  string
+	route   string // Documented path
+	target  string // Request URI; {tenant}, {client}, ... are replaced with the fixture's IDs
+	body    string
+	headers map[string]string
+	status  int
+	setup   func(f *contractFixture)
+}
+
+// TestOperationsMatchOpenAPISpec sends a valid request to every documented /api/v1 operation through the router
+// It fails when the request is rejected, answered with another status, or answered with a response the spec does
+// not describe. /, /healthz and /readyz are served outside the validated /api/v1 routes, so they are not covered.
+func TestOperationsMatchOpenAPISpec(t *testing.T) {
+	var warnings bytes.Buffer
+	validator, err := openapi.NewValidator(api.Spec, zerolog.New(&warnings), openapi.Options{ValidateResponses: true})
+	require.NoError(t, err)
+
+	keyPair, err := httpserver.GenerateTestKeyPair()
+	require.NoError(t, err)
+	auth, jwksServer, err := httpserver.CreateTestRequireAuth(keyPair)
+	require.NoError(t, err)
+	defer jwksServer.Close()
+	token, err := httpserver.CreateMockJWT(keyPair, map[string]interface{}{
+		"sub":      contractOwnerClerkID,
+		"email":    "owner@acme.example.com",
+		"org_role": "org:owner",
+	})
+	require.NoError(t, err)
+
+	ifMatch := map[string]string{"If-Match": `"1"`}
+	clerkEvent := `{"type":"user.updated","data":{"id":"user_owner"}}`
+
+	tests := []contractCase{
+		// Public
+		{method: http.MethodGet, route: "/api/v1/brand/by-domain", target: "/api/v1/brand/by-domain?domain=portal.acme.example.com", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brand/by-host", target: "/api/v1/brand/by-host?host=portal.acme.example.com", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brand/by-subdomain", target: "/api/v1/brand/by-subdomain?subdomain=acme", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brand/theme", target: "/api/v1/brand/theme?host=portal.acme.example.com", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brand/theme.css", target: "/api/v1/brand/theme.css?host=portal.acme.example.com", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/invites/{token}", target: "/api/v1/invites/invite-token", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/webhooks/clerk", target: "/api/v1/webhooks/clerk",
+			body: clerkEvent, headers: signClerkWebhook("msg_1", clerkEvent), status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/webhooks/storage", target: "/api/v1/webhooks/storage?token=" + contractNotificationToken,
+			body:   `{"message":{"attributes":{"eventType":"OBJECT_FINALIZE","objectId":"tenants/{tenant}/logo/logo.png"}}}`,
+			status: http.StatusNoContent,
+		},
+
+		// Authenticated, no tenant context required
+		{method: http.MethodGet, route: "/api/v1/invites/by-email", target: "/api/v1/invites/by-email?email=invitee@example.com", status: http.StatusOK},
+		{method: http.MethodPost, route: "/api/v1/tenants", target: "/api/v1/tenants", body: `{"name":"Globex","slug":"globex"}`, status: http.StatusCreated},
+		{
+			method: http.MethodPost, route: "/api/v1/tenants/onboard", target: "/api/v1/tenants/onboard",
+			body: `{"name":"Globex","slug":"globex","website":"https://globex.example.com","primary_color":"#336699"}`, status: http.StatusCreated,
+		},
+		{method: http.MethodGet, route: "/api/v1/tenants/my-orgs", target: "/api/v1/tenants/my-orgs", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/session", target: "/api/v1/session", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/auth/me", target: "/api/v1/auth/me", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/auth/impersonation", target: "/api/v1/auth/impersonation",
+			body: `{"tenant_id":"{tenant}","target_user_id":"{staff}","reason":"Support ticket 42","duration_minutes":15}`, status: http.StatusCreated,
+		},
+		{method: http.MethodDelete, route: "/api/v1/auth/impersonation/{id}", target: "/api/v1/auth/impersonation/{session}", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/users/sync", target: "/api/v1/users/sync",
+			body: `{"clerk_user_id":"user_owner","email":"owner@acme.example.com","first_name":"Olive","last_name":"Owner"}`, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/users/me/switch-tenant", target: "/api/v1/users/me/switch-tenant",
+			body: `{"tenant_id":"{tenant}","make_default":true}`, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/invites/accept", target: "/api/v1/invites/accept",
+			body: `{"token":"globex-invite-token"}`, status: http.StatusOK,
+			setup: func(f *contractFixture) {
+				globex := tenants_model.NewTenant("Globex", "globex", nil, 5, nil)
+				invite := tenants_model.NewInvite(globex.ID(), f.owner.Email(), tenants_model.RoleAdmin, "globex-invite-token", f.owner.ID(), time.Hour)
+				f.store.tenants[globex.ID()] = *globex
+				f.store.invites[invite.ID()] = *invite
+			},
+		},
+
+		// Platform admin
+		{
+			method: http.MethodPost, route: "/api/v1/admin/tenants/{id}/suspend", target: "/api/v1/admin/tenants/{tenant}/suspend",
+			body: `{"reason":"billing","note":"Invoice overdue"}`, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/admin/tenants/{id}/reinstate", target: "/api/v1/admin/tenants/{tenant}/reinstate",
+			body: `{"note":"Invoice paid"}`, status: http.StatusOK,
+			setup: func(f *contractFixture) {
+				tenant := f.store.tenants[f.tenant.ID()]
+				tenant.Suspend(tenants_model.SuspensionReasonBilling, "Invoice overdue", nil)
+				f.store.tenants[tenant.ID()] = tenant
+			},
+		},
+		{method: http.MethodGet, route: "/api/v1/admin/tenants/{id}/suspension-events", target: "/api/v1/admin/tenants/{tenant}/suspension-events", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/admin/clients/{id}/suspend", target: "/api/v1/admin/clients/{client}/suspend",
+			body: `{"reason":"policy_violation"}`, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/admin/clients/{id}/reinstate", target: "/api/v1/admin/clients/{client}/reinstate",
+			body: `{}`, status: http.StatusOK,
+			setup: func(f *contractFixture) {
+				client := f.store.clients[f.client.ID()]
+				client.Suspend(tenants_model.SuspensionReasonPolicyViolation, "", nil)
+				f.store.clients[client.ID()] = client
+			},
+		},
+		{method: http.MethodDelete, route: "/api/v1/admin/users/{clerk_user_id}", target: "/api/v1/admin/users/user_staff", status: http.StatusOK},
+
+		// Tenant
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}", target: "/api/v1/tenants/{tenant}", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/tenants/{id}", target: "/api/v1/tenants/{tenant}",
+			body: `{"name":"Acme Inc","slug":"acme"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPatch, route: "/api/v1/tenants/{id}", target: "/api/v1/tenants/{tenant}",
+			body: `{"name":"Acme Inc"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/tenants/{id}/invites", target: "/api/v1/tenants/{tenant}/invites",
+			body: `{"email":"new.hire@example.com","role":"staff"}`, status: http.StatusCreated,
+		},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/invites", target: "/api/v1/tenants/{tenant}/invites", status: http.StatusOK},
+		{method: http.MethodDelete, route: "/api/v1/tenants/{id}/invites/{invite_id}", target: "/api/v1/tenants/{tenant}/invites/{invite}", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/members", target: "/api/v1/tenants/{tenant}/members", status: http.StatusOK},
+		{method: http.MethodDelete, route: "/api/v1/tenants/{id}/members/{user_id}", target: "/api/v1/tenants/{tenant}/members/{staff}", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/roles", target: "/api/v1/tenants/{tenant}/roles", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/seat-usage", target: "/api/v1/tenants/{tenant}/seat-usage", status: http.StatusOK},
+		{method: http.MethodPost, route: "/api/v1/tenants/{id}/exports", target: "/api/v1/tenants/{tenant}/exports", status: http.StatusAccepted},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/exports", target: "/api/v1/tenants/{tenant}/exports", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/exports/{export_id}", target: "/api/v1/tenants/{tenant}/exports/{export}", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/tenants/{id}/closure", target: "/api/v1/tenants/{tenant}/closure",
+			body: `{"confirm_slug":"acme"}`, status: http.StatusAccepted,
+		},
+		{
+			method: http.MethodGet, route: "/api/v1/tenants/{id}/closure", target: "/api/v1/tenants/{tenant}/closure", status: http.StatusOK,
+			setup: requestFixtureClosure,
+		},
+		{
+			method: http.MethodDelete, route: "/api/v1/tenants/{id}/closure", target: "/api/v1/tenants/{tenant}/closure", status: http.StatusOK,
+			setup: requestFixtureClosure,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/tenants/{id}/clients", target: "/api/v1/tenants/{tenant}/clients",
+			body: `{"name":"Cafe","slug":"cafe","tier":"starter"}`, status: http.StatusCreated,
+		},
+		{method: http.MethodGet, route: "/api/v1/tenants/{id}/clients", target: "/api/v1/tenants/{tenant}/clients", status: http.StatusOK},
+
+		// Clients and locations
+		{method: http.MethodGet, route: "/api/v1/clients/{id}", target: "/api/v1/clients/{client}", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/clients/{id}", target: "/api/v1/clients/{client}",
+			body: `{"name":"Bistro Bar","slug":"bistro"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPatch, route: "/api/v1/clients/{id}", target: "/api/v1/clients/{client}",
+			body: `{"name":"Bistro Bar"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/clients/{id}/members", target: "/api/v1/clients/{client}/members",
+			body: `{"user_id":"{owner}","role":"client_viewer"}`, status: http.StatusCreated,
+		},
+		{method: http.MethodGet, route: "/api/v1/clients/{id}/members", target: "/api/v1/clients/{client}/members", status: http.StatusOK},
+		{method: http.MethodDelete, route: "/api/v1/clients/{id}/members/{memberId}", target: "/api/v1/clients/{client}/members/{client_member}", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/clients/{id}/locations", target: "/api/v1/clients/{client}/locations",
+			body: `{"name":"Uptown","phone":"+15550100","categories":["restaurant"]}`, status: http.StatusCreated,
+		},
+		{method: http.MethodGet, route: "/api/v1/clients/{id}/locations", target: "/api/v1/clients/{client}/locations", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/locations/{id}", target: "/api/v1/locations/{location}", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/locations/{id}", target: "/api/v1/locations/{location}",
+			body: `{"name":"Downtown East","phone":"+15550101"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPatch, route: "/api/v1/locations/{id}", target: "/api/v1/locations/{location}",
+			body: `{"is_active":false}`, headers: ifMatch, status: http.StatusOK,
+		},
+
+		// Brands
+		{method: http.MethodGet, route: "/api/v1/brands", target: "/api/v1/brands", status: http.StatusOK},
+		{method: http.MethodPost, route: "/api/v1/brands", target: "/api/v1/brands", body: `{"primary_color":"#1a73e8"}`, status: http.StatusCreated},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}", target: "/api/v1/brands/{tenant}", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/brands/{brandId}", target: "/api/v1/brands/{tenant}",
+			body: `{"primary_color":"#1a73e8"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPatch, route: "/api/v1/brands/{brandId}", target: "/api/v1/brands/{tenant}",
+			body: `{"secondary_color":"#fbbc04"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{method: http.MethodDelete, route: "/api/v1/brands/{brandId}", target: "/api/v1/brands/{tenant}", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/brands/{brandId}/verify-domain", target: "/api/v1/brands/{tenant}/verify-domain",
+			body: `{"domain":"portal.acme.example.com"}`, status: http.StatusOK,
+		},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/domain-status", target: "/api/v1/brands/{tenant}/domain-status", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/domain-instructions", target: "/api/v1/brands/{tenant}/domain-instructions", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/ssl-status", target: "/api/v1/brands/{tenant}/ssl-status", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/clients/{clientId}", target: "/api/v1/brands/{tenant}/clients/{client}", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/brands/{brandId}/clients/{clientId}", target: "/api/v1/brands/{tenant}/clients/{client}",
+			body: `{"primary_color":"#0b8043"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{method: http.MethodDelete, route: "/api/v1/brands/{brandId}/clients/{clientId}", target: "/api/v1/brands/{tenant}/clients/{client}", status: http.StatusOK},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/draft", target: "/api/v1/brands/{tenant}/draft", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/brands/{brandId}/draft", target: "/api/v1/brands/{tenant}/draft",
+			body: `{"primary_color":"#1a73e8"}`, headers: ifMatch, status: http.StatusOK,
+		},
+		{method: http.MethodDelete, route: "/api/v1/brands/{brandId}/draft", target: "/api/v1/brands/{tenant}/draft", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/brands/{brandId}/draft/publish", target: "/api/v1/brands/{tenant}/draft/publish",
+			headers: ifMatch, status: http.StatusOK,
+		},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/versions", target: "/api/v1/brands/{tenant}/versions?limit=10", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/brands/{brandId}/versions/{version}/rollback", target: "/api/v1/brands/{tenant}/versions/1/rollback",
+			headers: ifMatch, status: http.StatusOK,
+		},
+		{method: http.MethodGet, route: "/api/v1/brands/{brandId}/sending-domain", target: "/api/v1/brands/{tenant}/sending-domain", status: http.StatusOK},
+		{
+			method: http.MethodPut, route: "/api/v1/brands/{brandId}/sending-domain", target: "/api/v1/brands/{tenant}/sending-domain",
+			body: `{"domain":"mail.acme.example.com","local_part":"hello","from_name":"Acme"}`, status: http.StatusOK,
+		},
+		{method: http.MethodDelete, route: "/api/v1/brands/{brandId}/sending-domain", target: "/api/v1/brands/{tenant}/sending-domain", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/brands/{brandId}/sending-domain/verify", target: "/api/v1/brands/{tenant}/sending-domain/verify",
+			status: http.StatusOK,
+		},
+
+		// Files
+		{method: http.MethodGet, route: "/api/v1/files", target: "/api/v1/files?asset_type=logo&limit=10", status: http.StatusOK},
+		{
+			method: http.MethodPost, route: "/api/v1/files/sign", target: "/api/v1/files/sign",
+			body: `{"asset":"logo","content_type":"image/png","size":2048}`, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/files/complete", target: "/api/v1/files/complete",
+			body: `{"key":"tenants/{tenant}/logo/logo.png"}`, status: http.StatusOK,
+		},
+		{
+			method: http.MethodPost, route: "/api/v1/files/finalize", target: "/api/v1/files/finalize",
+			body: `{"key":"tenants/{tenant}/logo/logo.png"}`, status: http.StatusOK,
+		},
+		{method: http.MethodDelete, route: "/api/v1/files/{key}", target: "/api/v1/files/logo.png", status: http.StatusOK},
+	}
+
+	covered := map[openapi.Operation]bool{}
+	for _, tt := range tests {
+		covered[openapi.Operation{Method: tt.method, Path: tt.route}] = true
+
+		t.Run(tt.method+" "+tt.route, func(t *testing.T) {
+			f := newContractFixture(t, auth, validator)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			var body *strings.Reader
+			if tt.body != "" {
+				body = strings.NewReader(f.params.Replace(tt.body))
+			} else {
+				body = strings.NewReader("")
+			}
+			req := httptest.NewRequest(tt.method, f.params.Replace(tt.target), body)
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.body != "" {
+				if tt.method == http.MethodPatch {
+					req.Header.Set("Content-Type", httpserver.MergePatchContentType)
+				} else {
+					req.Header.Set("Content-Type", "application/json")
+				}
+			}
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			warnings.Reset()
+			rec := httptest.NewRecorder()
+			f.router.ServeHTTP(rec, req)
+
+			assert.NotEqual(t, http.StatusBadRequest, rec.Code, "valid request rejected: %s", rec.Body.String())
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+			assert.Empty(t, warnings.String(), "response does not match the spec")
+		})
+	}
+
+	for _, operation := range validator.Operations() {
+		if !strings.HasPrefix(operation.Path, "/api/v1/") {
+			continue
+		}
+		assert.True(t, covered[operation], "%s %s has no contract case", operation.Method, operation.Path)
+	}
+}
+
+// requestFixtureClosure schedules the fixture's tenant for closure
+func requestFixtureClosure(f *contractFixture) {
+	tenant := f.store.tenants[f.tenant.ID()]
+	ownerID := f.owner.ID()
+	tenant.RequestClosure(&ownerID, 30*24*time.Hour)
+	f.store.tenants[tenant.ID()] = tenant
+}
+
+// signClerkWebhook returns the Svix headers Clerk sends with a webhook payload signed with contractWebhookKey
+func signClerkWebhook(id, payload string) map[string]string {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(contractWebhookKey))
+	mac.Write([]byte(id + "." + timestamp + "." + payload))
+	return map[string]string{
+		"svix-id":        id,
+		"svix-timestamp": timestamp,
+		"svix-signature": "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+	}
+}
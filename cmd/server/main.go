@@ -2,25 +2,23 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
-	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/redis/go-redis/v9"
 
+	"farohq-core-app/api"
 	app_composition "farohq-core-app/internal/app/composition"
-	"farohq-core-app/internal/app/health"
 	"farohq-core-app/internal/platform/cache"
 	"farohq-core-app/internal/platform/config"
 	"farohq-core-app/internal/platform/db"
 	"farohq-core-app/internal/platform/httpserver"
 	"farohq-core-app/internal/platform/logging"
+	"farohq-core-app/internal/platform/openapi"
+	"farohq-core-app/internal/platform/revocation"
 	"farohq-core-app/internal/platform/tenant"
 )
 
@@ -70,133 +68,59 @@ func main() {
 		tenantResolver.SetCache(tenantCache)
 	}
 
+	// Initialize token revocation watermarks (shared through Redis when available, in memory otherwise)
+	revocations := revocation.NewStore(redisClient, 24*time.Hour, logger)
+
 	// Initialize authentication middleware
 	authMiddleware, err := httpserver.NewRequireAuth(cfg.ClerkJWKSURL, logger)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("Failed to initialize authentication middleware")
 	}
+	authMiddleware.SetRevocationChecker(revocations)
 
-	// Setup router
-	r := chi.NewRouter()
-
-	// Apply common middleware
-	for _, mw := range httpserver.CommonMiddleware(logger) {
-		r.Use(mw)
+	// Load the OpenAPI contract; responses are also checked against it outside production
+	specValidator, err := openapi.NewValidator(api.Spec, logger, openapi.Options{
+		ValidateResponses: cfg.Environment != "production",
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to load OpenAPI spec")
 	}
 
 	// Initialize composition (wires all domains together) - needed for user repo
-	appComposition := app_composition.NewComposition(pool, cfg, logger)
-
-	// Initialize health handlers
-	healthHandlers := health.NewHandlers(pool)
-
-	// Health check endpoints
-	r.Get("/healthz", healthHandlers.Healthz)
-	r.Get("/readyz", healthHandlers.Readyz)
-
-	// API info endpoint
-	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, `{
-			"name": "FaroHQ Core App",
-			"version": "1.0.0",
-			"status": "running",
-		"timestamp": "%s"
-	}`, time.Now().Format(time.RFC3339))
-	})
+	appComposition := app_composition.NewComposition(pool, redisClient, tenantCache, revocations, cfg, logger)
+
+	// Start background jobs (tenant purge); stopped on shutdown
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	appComposition.StartBackgroundJobs(jobsCtx)
+
+	// Apply cache invalidations and token revocations published by other instances
+	if tenantCache != nil {
+		go tenantCache.Subscribe(jobsCtx)
+	}
+	go revocations.Subscribe(jobsCtx)
+	go appComposition.BrandingCache.Subscribe(jobsCtx)
+
+	// Only proxies in front of the server may report the client IP
+	trustedProxies, err := httpserver.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid TRUSTED_PROXIES")
+	}
+
+	// Resolve the tenant of authenticated requests from the host, X-Tenant-ID or URL, validated against memberships
+	tenantResolution := httpserver.TenantResolutionWithAuth(tenantResolver, tenantCache, appComposition.UserRepo, pool, logger)
 
-	// API v1 routes
-	r.Route("/api/v1", func(r chi.Router) {
-		// CRITICAL: Register /invites/by-email FIRST (before public routes) to avoid route conflict with /invites/{token}
-		// This specific route must be registered before the parameterized public route
-		// NOTE: This route does NOT require tenant resolution because it's used by users who don't have a tenant yet
-		r.Group(func(r chi.Router) {
-			r.Use(authMiddleware.RequireAuth)
-			// #region agent log
-			r.Get("/invites/by-email", func(w http.ResponseWriter, r *http.Request) {
-				logFile, _ := os.OpenFile("/Users/bperez/Projects/farohq-core-app/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-				json.NewEncoder(logFile).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "main.go:150", "message": "invites/by-email route matched - BEFORE handler", "hypothesisId": "ROUTE", "sessionId": "debug-session", "runId": "run1", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method, "query": r.URL.RawQuery}})
-				logFile.Close()
-				// #endregion
-				appComposition.TenantHandlers.FindInvitesByEmailHandler(w, r)
-			})
-			// #endregion
-		})
-
-		// Public routes (no auth required) - MUST be registered AFTER specific routes to avoid conflicts
-		appComposition.RegisterPublicRoutes(r)
-
-		// Protected routes (auth required) - Register specific routes FIRST to avoid conflicts with parameterized public routes
-		r.Group(func(r chi.Router) {
-			// 1. Authenticate first
-			r.Use(authMiddleware.RequireAuth)
-
-			// 2. Resolve tenant with validation (runs AFTER authentication)
-			r.Use(httpserver.TenantResolutionWithAuth(
-				tenantResolver,
-				tenantCache,
-				appComposition.UserRepo,
-				pool,
-				logger,
-			))
-
-			// Routes that don't require tenant context
-			r.Route("/tenants", func(r chi.Router) {
-				r.Post("/", appComposition.TenantHandlers.CreateTenantHandler)
-				r.Post("/onboard", appComposition.TenantHandlers.OnboardTenantHandler)
-			})
-			// Register /tenants/my-orgs at top level (outside Route) to ensure it matches before Group middleware
-			// #region agent log
-			r.Get("/tenants/my-orgs", func(w http.ResponseWriter, r *http.Request) {
-				logFile, _ := os.OpenFile("/Users/bperez/Projects/farohq-core-app/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-				json.NewEncoder(logFile).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "main.go:100", "message": "my-orgs route matched - BEFORE RequireTenantContext", "hypothesisId": "H1,H2,H5", "sessionId": "debug-session", "runId": "run3", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method}})
-				logFile.Close()
-				appComposition.TenantHandlers.ListTenantsByUserHandler(w, r)
-			})
-			// #endregion
-			r.Route("/auth", func(r chi.Router) {
-				r.Get("/me", appComposition.AuthHandlers.MeHandler)
-			})
-			r.Route("/users", func(r chi.Router) {
-				r.Post("/sync", appComposition.UserHandlers.SyncUserHandler)
-			})
-			r.Route("/invites", func(r chi.Router) {
-				r.Post("/accept", appComposition.TenantHandlers.AcceptInviteHandler)
-			})
-		})
-
-		// All other protected routes require tenant context
-		// #region agent log
-		r.Group(func(r chi.Router) {
-			// 1. Authenticate first
-			r.Use(authMiddleware.RequireAuth)
-
-			// 2. Resolve tenant with validation (runs AFTER authentication)
-			r.Use(httpserver.TenantResolutionWithAuth(
-				tenantResolver,
-				tenantCache,
-				appComposition.UserRepo,
-				pool,
-				logger,
-			))
-
-			// #region agent log
-			mw := httpserver.RequireTenantContext
-			wrappedMw := func(next http.Handler) http.Handler {
-				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-					logFile, _ := os.OpenFile("/Users/bperez/Projects/farohq-core-app/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-					json.NewEncoder(logFile).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "main.go:116", "message": "Group middleware wrapper: about to apply RequireTenantContext", "hypothesisId": "H4", "sessionId": "debug-session", "runId": "run2", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method}})
-					logFile.Close()
-					// #endregion
-					mw(next).ServeHTTP(w, r)
-				})
-			}
-			r.Use(wrappedMw)
-			// #endregion
-			appComposition.RegisterProtectedRoutesWithTenant(r)
-		})
-		// #endregion
+	// Setup router
+	r := newRouter(routerDeps{
+		cfg:              cfg,
+		logger:           logger,
+		trustedProxies:   trustedProxies,
+		composition:      appComposition,
+		auth:             authMiddleware,
+		tenantResolution: tenantResolution,
+		pool:             pool,
+		redisClient:      redisClient,
+		validator:        specValidator,
 	})
 
 	// Start server
@@ -216,6 +140,8 @@ func main() {
 
 	<-stop
 
+	stopJobs()
+
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+
+	app_composition "farohq-core-app/internal/app/composition"
+	"farohq-core-app/internal/app/health"
+	"farohq-core-app/internal/platform/config"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/openapi"
+	"farohq-core-app/internal/platform/ratelimit"
+)
+
+// routerDeps holds everything the HTTP router is assembled from
+type routerDeps struct {
+	cfg            *config.Config
+	logger         zerolog.Logger
+	trustedProxies httpserver.TrustedProxies
+	composition    *app_composition.Composition
+	auth           *httpserver.RequireAuth
+	// tenantResolution resolves and validates the request's tenant (httpserver.TenantResolutionWithAuth)
+	tenantResolution func(http.Handler) http.Handler
+	pool             *pgxpool.Pool
+	redisClient      *redis.Client      // optional
+	validator        *openapi.Validator // optional
+}
+
+// newRouter assembles the HTTP router: common middleware, public, protected, admin and tenant-scoped routes
+func newRouter(deps routerDeps) *chi.Mux {
+	// Setup router
+	r := chi.NewRouter()
+
+	// Apply common middleware
+	for _, mw := range httpserver.CommonMiddleware(deps.logger, deps.trustedProxies) {
+		r.Use(mw)
+	}
+
+	// Unmatched routes and methods get problem responses like every other error
+	r.NotFound(httpserver.NotFoundHandler)
+	r.MethodNotAllowed(httpserver.MethodNotAllowedHandler)
+
+	// Initialize health handlers
+	healthHandlers := health.NewHandlers(deps.pool)
+
+	// Health check endpoints
+	r.Get("/healthz", healthHandlers.Healthz)
+	r.Get("/readyz", healthHandlers.Readyz)
+
+	// API info endpoint
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"name": "FaroHQ Core App",
+			"version": "1.0.0",
+			"status": "running",
+		"timestamp": "%s"
+	}`, time.Now().Format(time.RFC3339))
+	})
+
+	// Impersonation middleware (audited support/agency sessions acting as another user)
+	impersonate := httpserver.Impersonate(
+		deps.composition.ResolveImpersonation,
+		deps.composition.RecordImpersonationActivity,
+		deps.logger,
+	)
+
+	// Rate limits per route class (token buckets shared through Redis, per instance without it)
+	rateLimiter := httpserver.NewRateLimiter(ratelimit.NewLimiter(deps.redisClient, deps.logger), deps.composition.TenantRepo, deps.logger)
+	publicRateLimit := rateLimiter.Limit(httpserver.RateLimitPolicy{
+		Name:              "public",
+		Key:               httpserver.RateLimitByIP,
+		RequestsPerMinute: deps.cfg.RateLimitPublicPerMinute,
+	})
+	userRateLimit := rateLimiter.Limit(httpserver.RateLimitPolicy{
+		Name:              "authenticated",
+		Key:               httpserver.RateLimitByUser,
+		RequestsPerMinute: deps.cfg.RateLimitUserPerMinute,
+	})
+	writeRateLimit := rateLimiter.Limit(httpserver.RateLimitPolicy{
+		Name:              "write",
+		Key:               httpserver.RateLimitByUser,
+		RequestsPerMinute: deps.cfg.RateLimitWritePerMinute,
+		WritesOnly:        true,
+	})
+	tenantRateLimit := rateLimiter.Limit(httpserver.RateLimitPolicy{
+		Name:              "tenant",
+		Key:               httpserver.RateLimitByTenant,
+		RequestsPerMinute: deps.cfg.RateLimitTenantPerMinute,
+		TierScaled:        true,
+	})
+
+	// API v1 routes
+	r.Route("/api/v1", func(r chi.Router) {
+		// Reject requests that do not match the OpenAPI spec before any other work is done
+		if deps.validator != nil {
+			r.Use(deps.validator.Middleware)
+		}
+
+		// CRITICAL: Register /invites/by-email FIRST (before public routes) to avoid route conflict with /invites/{token}
+		// This specific route must be registered before the parameterized public route
+		// NOTE: This route does NOT require tenant resolution because it's used by users who don't have a tenant yet
+		r.Group(func(r chi.Router) {
+			r.Use(deps.auth.RequireAuth)
+			r.Use(userRateLimit)
+			r.Use(impersonate)
+			// #region agent log
+			r.Get("/invites/by-email", func(w http.ResponseWriter, r *http.Request) {
+				logFile, _ := os.OpenFile("/Users/bperez/Projects/farohq-core-app/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				json.NewEncoder(logFile).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "main.go:150", "message": "invites/by-email route matched - BEFORE handler", "hypothesisId": "ROUTE", "sessionId": "debug-session", "runId": "run1", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method, "query": r.URL.RawQuery}})
+				logFile.Close()
+				// #endregion
+				deps.composition.TenantHandlers.FindInvitesByEmailHandler(w, r)
+			})
+			// #endregion
+		})
+
+		// Public routes (no auth required) - MUST be registered AFTER specific routes to avoid conflicts
+		r.Group(func(r chi.Router) {
+			r.Use(publicRateLimit)
+			deps.composition.RegisterPublicRoutes(r)
+		})
+
+		// Protected routes (auth required) - Register specific routes FIRST to avoid conflicts with parameterized public routes
+		r.Group(func(r chi.Router) {
+			// 1. Authenticate first
+			r.Use(deps.auth.RequireAuth)
+
+			// 2. Rate limit per authenticated user (the actor, before any impersonation swap)
+			r.Use(userRateLimit)
+			r.Use(writeRateLimit)
+
+			// 3. Swap to the impersonated user when an impersonation session is sent (runs BEFORE tenant resolution)
+			r.Use(impersonate)
+
+			// 4. Resolve tenant with validation (runs AFTER authentication)
+			r.Use(deps.tenantResolution)
+
+			// Routes that don't require tenant context
+			r.Route("/tenants", func(r chi.Router) {
+				r.Post("/", deps.composition.TenantHandlers.CreateTenantHandler)
+				r.Post("/onboard", deps.composition.TenantHandlers.OnboardTenantHandler)
+			})
+			// Register /tenants/my-orgs at top level (outside Route) to ensure it matches before Group middleware
+			// #region agent log
+			r.Get("/tenants/my-orgs", func(w http.ResponseWriter, r *http.Request) {
+				logFile, _ := os.OpenFile("/Users/bperez/Projects/farohq-core-app/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				json.NewEncoder(logFile).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "main.go:100", "message": "my-orgs route matched - BEFORE RequireTenantContext", "hypothesisId": "H1,H2,H5", "sessionId": "debug-session", "runId": "run3", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method}})
+				logFile.Close()
+				deps.composition.TenantHandlers.ListTenantsByUserHandler(w, r)
+			})
+			// #endregion
+			r.Get("/session", deps.composition.AuthHandlers.SessionHandler)
+			r.Route("/auth", func(r chi.Router) {
+				r.Get("/me", deps.composition.AuthHandlers.MeHandler)
+				r.Post("/impersonation", deps.composition.AuthHandlers.StartImpersonationHandler)
+				r.Delete("/impersonation/{id}", deps.composition.AuthHandlers.EndImpersonationHandler)
+			})
+			r.Route("/users", func(r chi.Router) {
+				r.Post("/sync", deps.composition.UserHandlers.SyncUserHandler)
+				r.Post("/me/switch-tenant", deps.composition.UserHandlers.SwitchTenantHandler)
+			})
+			r.Route("/invites", func(r chi.Router) {
+				r.Post("/accept", deps.composition.TenantHandlers.AcceptInviteHandler)
+			})
+		})
+
+		// Platform admin routes (auth + platform admin required, no tenant context)
+		r.Group(func(r chi.Router) {
+			r.Use(deps.auth.RequireAuth)
+			r.Use(userRateLimit)
+			r.Use(writeRateLimit)
+			r.Use(httpserver.RequirePlatformAdmin(deps.cfg.PlatformAdminUserIDs))
+			deps.composition.RegisterAdminRoutes(r)
+		})
+
+		// All other protected routes require tenant context
+		// #region agent log
+		r.Group(func(r chi.Router) {
+			// 1. Authenticate first
+			r.Use(deps.auth.RequireAuth)
+
+			// 2. Rate limit per authenticated user (the actor, before any impersonation swap)
+			r.Use(userRateLimit)
+			r.Use(writeRateLimit)
+
+			// 3. Swap to the impersonated user when an impersonation session is sent (runs BEFORE tenant resolution)
+			r.Use(impersonate)
+
+			// 4. Resolve tenant with validation (runs AFTER authentication)
+			r.Use(deps.tenantResolution)
+
+			// #region agent log
+			mw := httpserver.RequireTenantContext
+			wrappedMw := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					logFile, _ := os.OpenFile("/Users/bperez/Projects/farohq-core-app/.cursor/debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+					json.NewEncoder(logFile).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "main.go:116", "message": "Group middleware wrapper: about to apply RequireTenantContext", "hypothesisId": "H4", "sessionId": "debug-session", "runId": "run2", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method}})
+					logFile.Close()
+					// #endregion
+					mw(next).ServeHTTP(w, r)
+				})
+			}
+			r.Use(wrappedMw)
+			// #endregion
+
+			// 5. Rate limit per tenant so one noisy agency cannot starve others (scaled by tier)
+			r.Use(tenantRateLimit)
+
+			// 6. Enforce tenant/client suspension (read-only or blocked depending on reason)
+			r.Use(httpserver.EnforceSuspension(deps.composition.TenantRepo, deps.composition.ClientRepo, deps.logger))
+
+			// 7. Replay retried POSTs that carry an Idempotency-Key (per tenant and user)
+			r.Use(httpserver.Idempotency(deps.composition.IdempotencyStore, deps.logger))
+
+			deps.composition.RegisterProtectedRoutesWithTenant(r)
+		})
+		// #endregion
+	})
+
+	return r
+}
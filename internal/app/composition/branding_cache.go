@@ -0,0 +1,120 @@
+package composition
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	brand_model "farohq-core-app/internal/domains/brand/domain/model"
+	brand_outbound "farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// The decorators below keep the cached public branding lookups fresh: every write that changes what
+// by-host, by-domain or by-subdomain return invalidates the agency, and the hosts its branding now claims.
+// Invalidation failures are logged; the cache TTL bounds staleness.
+
+func invalidateBrandingCache(ctx context.Context, cache brand_outbound.BrandingCache, agencyID uuid.UUID, hosts ...string) {
+	if err := cache.Invalidate(ctx, agencyID, hosts...); err != nil {
+		log.Warn().Err(err).Str("agency_id", agencyID.String()).Msg("Failed to invalidate cached branding of agency")
+	}
+}
+
+// brandingCacheInvalidatingBrandRepository invalidates an agency's cached lookups when its branding changes
+// Its domain and subdomain are invalidated too, so lookups cached as not found before it claimed them are dropped;
+// the cache matches them against lookup keys by brand_outbound.BrandingHost, whatever case or port was requested.
+type brandingCacheInvalidatingBrandRepository struct {
+	brand_outbound.BrandRepository
+	cache brand_outbound.BrandingCache
+}
+
+func (r *brandingCacheInvalidatingBrandRepository) Save(ctx context.Context, branding *brand_model.Branding) error {
+	if err := r.BrandRepository.Save(ctx, branding); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, branding.AgencyID(), branding.Domain(), branding.Subdomain())
+	return nil
+}
+
+func (r *brandingCacheInvalidatingBrandRepository) Update(ctx context.Context, branding *brand_model.Branding) error {
+	if err := r.BrandRepository.Update(ctx, branding); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, branding.AgencyID(), branding.Domain(), branding.Subdomain())
+	return nil
+}
+
+func (r *brandingCacheInvalidatingBrandRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	if err := r.BrandRepository.Delete(ctx, agencyID); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, agencyID)
+	return nil
+}
+
+// brandingCacheInvalidatingClientBrandingRepository invalidates an agency's cached lookups when a client override changes
+type brandingCacheInvalidatingClientBrandingRepository struct {
+	brand_outbound.ClientBrandingRepository
+	cache brand_outbound.BrandingCache
+}
+
+func (r *brandingCacheInvalidatingClientBrandingRepository) Save(ctx context.Context, clientBranding *brand_model.ClientBranding) error {
+	if err := r.ClientBrandingRepository.Save(ctx, clientBranding); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, clientBranding.AgencyID())
+	return nil
+}
+
+func (r *brandingCacheInvalidatingClientBrandingRepository) Update(ctx context.Context, clientBranding *brand_model.ClientBranding) error {
+	if err := r.ClientBrandingRepository.Update(ctx, clientBranding); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, clientBranding.AgencyID())
+	return nil
+}
+
+func (r *brandingCacheInvalidatingClientBrandingRepository) Delete(ctx context.Context, clientID uuid.UUID) error {
+	clientBranding, err := r.ClientBrandingRepository.FindByClientID(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if err := r.ClientBrandingRepository.Delete(ctx, clientID); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, clientBranding.AgencyID())
+	return nil
+}
+
+// brandingCacheInvalidatingTenantRepository invalidates an agency's cached lookups when its tier or status changes
+// (responses carry tier-based flags)
+type brandingCacheInvalidatingTenantRepository struct {
+	tenants_outbound.TenantRepository
+	cache brand_outbound.BrandingCache
+}
+
+func (r *brandingCacheInvalidatingTenantRepository) Update(ctx context.Context, tenant *tenants_model.Tenant) error {
+	if err := r.TenantRepository.Update(ctx, tenant); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, tenant.ID())
+	return nil
+}
+
+func (r *brandingCacheInvalidatingTenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.TenantRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, id)
+	return nil
+}
+
+func (r *brandingCacheInvalidatingTenantRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	if err := r.TenantRepository.Purge(ctx, id); err != nil {
+		return err
+	}
+	invalidateBrandingCache(ctx, r.cache, id)
+	return nil
+}
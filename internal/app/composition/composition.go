@@ -2,60 +2,569 @@ package composition
 
 import (
 	"context"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 
+	auth_usecases "farohq-core-app/internal/domains/auth/app/usecases"
+	auth_domain "farohq-core-app/internal/domains/auth/domain"
+	auth_model "farohq-core-app/internal/domains/auth/domain/model"
+	auth_outbound "farohq-core-app/internal/domains/auth/domain/ports/outbound"
+	auth_cache "farohq-core-app/internal/domains/auth/infra/cache"
+	auth_db "farohq-core-app/internal/domains/auth/infra/db"
 	auth_http "farohq-core-app/internal/domains/auth/infra/http"
 	brand_usecases "farohq-core-app/internal/domains/brand/app/usecases"
+	brand_domain "farohq-core-app/internal/domains/brand/domain"
+	brand_model "farohq-core-app/internal/domains/brand/domain/model"
 	brand_outbound "farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	brand_services "farohq-core-app/internal/domains/brand/domain/services"
+	brand_cache "farohq-core-app/internal/domains/brand/infra/cache"
+	brand_cloudflare "farohq-core-app/internal/domains/brand/infra/cloudflare"
 	brand_db "farohq-core-app/internal/domains/brand/infra/db"
 	brand_dns "farohq-core-app/internal/domains/brand/infra/dns"
+	brand_fake "farohq-core-app/internal/domains/brand/infra/fake"
 	brand_http "farohq-core-app/internal/domains/brand/infra/http"
+	brand_postmark "farohq-core-app/internal/domains/brand/infra/postmark"
 	brand_vercel "farohq-core-app/internal/domains/brand/infra/vercel"
 	files_usecases "farohq-core-app/internal/domains/files/app/usecases"
+	files_domain "farohq-core-app/internal/domains/files/domain"
 	files_outbound "farohq-core-app/internal/domains/files/domain/ports/outbound"
 	files_services "farohq-core-app/internal/domains/files/domain/services"
+	files_db "farohq-core-app/internal/domains/files/infra/db"
 	"farohq-core-app/internal/domains/files/infra/gcs"
 	files_http "farohq-core-app/internal/domains/files/infra/http"
 	"farohq-core-app/internal/domains/files/infra/s3"
 	tenants_usecases "farohq-core-app/internal/domains/tenants/app/usecases"
+	tenants_domain "farohq-core-app/internal/domains/tenants/domain"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
 	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 	tenants_services "farohq-core-app/internal/domains/tenants/domain/services"
 	tenants_db "farohq-core-app/internal/domains/tenants/infra/db"
 	tenants_email "farohq-core-app/internal/domains/tenants/infra/email"
 	tenants_http "farohq-core-app/internal/domains/tenants/infra/http"
 	users_usecases "farohq-core-app/internal/domains/users/app/usecases"
+	users_domain "farohq-core-app/internal/domains/users/domain"
+	users_model "farohq-core-app/internal/domains/users/domain/model"
+	users_inbound "farohq-core-app/internal/domains/users/domain/ports/inbound"
 	users_outbound "farohq-core-app/internal/domains/users/domain/ports/outbound"
 	users_db "farohq-core-app/internal/domains/users/infra/db"
 	users_http "farohq-core-app/internal/domains/users/infra/http"
 	"farohq-core-app/internal/platform/config"
+	"farohq-core-app/internal/platform/idempotency"
+	"farohq-core-app/internal/platform/revocation"
+	"farohq-core-app/internal/platform/svix"
+	"farohq-core-app/internal/platform/tenant"
 )
 
 // brandRepositoryAdapter adapts brand repository to the interface expected by invite use case
 type brandRepositoryAdapter struct {
-	brandRepo brand_outbound.BrandRepository
+	brandRepo          brand_outbound.BrandRepository
+	clientBrandingRepo brand_outbound.ClientBrandingRepository
 }
 
 func (a *brandRepositoryAdapter) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (tenants_usecases.BrandingInfo, error) {
 	return a.brandRepo.FindByAgencyID(ctx, agencyID)
 }
 
-// userRepositoryAdapter adapts user repository to the interface expected by invite use case
-// Note: Currently, UserRepository only supports FindByClerkUserID, not FindByID
-// For now, we'll return nil to make user lookup optional
+// FindForClient layers the client's overrides on the agency branding
+// Overrides of a client from another agency are ignored, so an unvalidated client context cannot borrow another agency's branding.
+func (a *brandRepositoryAdapter) FindForClient(ctx context.Context, agencyID, clientID uuid.UUID) (tenants_usecases.BrandingInfo, error) {
+	branding, err := a.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, err
+	}
+	clientBranding, err := a.clientBrandingRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if err == brand_domain.ErrClientBrandingNotFound {
+			return branding, nil
+		}
+		return nil, err
+	}
+	if clientBranding.AgencyID() != agencyID {
+		return branding, nil
+	}
+	return branding.WithClientBranding(clientBranding), nil
+}
+
+// brandingAssetsAdapter adapts brand repository to the branding assets written by upload finalization
+type brandingAssetsAdapter struct {
+	brandRepo brand_outbound.BrandRepository
+}
+
+// SetBrandingAssets stores an asset's variant URLs and points the logo (original) or favicon (favicon.ico) at them
+// A concurrent branding update is retried on the fresh branding rather than failing the upload.
+func (a *brandingAssetsAdapter) SetBrandingAssets(ctx context.Context, agencyID uuid.UUID, assetType string, urls map[string]string) error {
+	for attempt := 0; ; attempt++ {
+		branding, err := a.brandRepo.FindByAgencyID(ctx, agencyID)
+		if err != nil {
+			if err == brand_domain.ErrBrandingNotFound {
+				return files_domain.ErrBrandingNotFound
+			}
+			return err
+		}
+
+		branding.SetAssets(assetType, urls)
+		switch assetType {
+		case "logo":
+			branding.SetLogoURL(urls[files_services.VariantOriginal])
+		case "favicon":
+			branding.SetFaviconURL(urls[files_services.VariantFaviconICO])
+		}
+
+		err = a.brandRepo.Update(ctx, branding)
+		if err == brand_domain.ErrVersionConflict && attempt < 2 {
+			continue
+		}
+		return err
+	}
+}
+
+// userRepositoryAdapter adapts user repository to the interface expected by tenant use cases
 type userRepositoryAdapter struct {
 	userRepo users_outbound.UserRepository
 }
 
 func (a *userRepositoryAdapter) FindByID(ctx context.Context, userID uuid.UUID) (tenants_usecases.UserInfo, error) {
-	// TODO: Add FindByID to UserRepository interface and implementation
-	// For now, return nil to make user lookup optional (inviter info will be empty)
-	return nil, nil
+	user, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		// Avoid returning a typed nil inside the interface
+		return nil, err
+	}
+	return user, nil
+}
+
+// brandingExporterAdapter adapts brand repository to the branding section of tenant exports
+type brandingExporterAdapter struct {
+	brandRepo brand_outbound.BrandRepository
+}
+
+func (a *brandingExporterAdapter) ExportByAgencyID(ctx context.Context, agencyID uuid.UUID) (map[string]interface{}, error) {
+	branding, err := a.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		if err == brand_domain.ErrBrandingNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Domain verification tokens and provider zone IDs are internal and not exported
+	result := map[string]interface{}{
+		"agency_id":       branding.AgencyID().String(),
+		"domain":          branding.Domain(),
+		"subdomain":       branding.Subdomain(),
+		"domain_type":     nil,
+		"website":         branding.Website(),
+		"verified_at":     nil,
+		"logo_url":        branding.LogoURL(),
+		"favicon_url":     branding.FaviconURL(),
+		"primary_color":   branding.PrimaryColor(),
+		"secondary_color": branding.SecondaryColor(),
+		"theme_json":      branding.ThemeJSON(),
+		"assets":          branding.Assets(),
+		"hide_powered_by": branding.HidePoweredBy(),
+		"email_domain":    branding.EmailDomain(),
+		"ssl_status":      nil,
+		"updated_at":      branding.UpdatedAt().Format(time.RFC3339),
+	}
+	if branding.DomainType() != nil {
+		result["domain_type"] = string(*branding.DomainType())
+	}
+	if branding.VerifiedAt() != nil {
+		result["verified_at"] = branding.VerifiedAt().Format(time.RFC3339)
+	}
+	if branding.SSLStatus() != nil {
+		result["ssl_status"] = string(*branding.SSLStatus())
+	}
+	return result, nil
+}
+
+// tenantStorageAdapter adapts file storage to tenant export archives and tenant object purging
+type tenantStorageAdapter struct {
+	storage files_outbound.Storage
+	bucket  string
+}
+
+func (a *tenantStorageAdapter) Upload(ctx context.Context, key string, content io.Reader, contentType string) error {
+	return a.storage.UploadFile(ctx, a.bucket, key, content, contentType)
+}
+
+func (a *tenantStorageAdapter) SignedDownloadURL(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	return a.storage.GenerateDownloadURL(ctx, a.bucket, key, expiresIn)
+}
+
+func (a *tenantStorageAdapter) ListTenantFiles(ctx context.Context, tenantID uuid.UUID) ([]tenants_outbound.StoredFile, error) {
+	objects, err := a.storage.ListObjects(ctx, a.bucket, tenantID.String()+"/")
+	if err != nil {
+		return nil, err
+	}
+	files := make([]tenants_outbound.StoredFile, len(objects))
+	for i, object := range objects {
+		files[i] = tenants_outbound.StoredFile{
+			Key:         object.Key,
+			Size:        object.Size,
+			ContentType: object.ContentType,
+			UpdatedAt:   object.UpdatedAt,
+		}
+	}
+	return files, nil
+}
+
+func (a *tenantStorageAdapter) DeleteTenantObjects(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	objects, err := a.storage.ListObjects(ctx, a.bucket, tenantID.String()+"/")
+	if err != nil {
+		return 0, err
+	}
+	for i, object := range objects {
+		if err := a.storage.DeleteFile(ctx, a.bucket, object.Key); err != nil {
+			return i, err
+		}
+	}
+	return len(objects), nil
+}
+
+// brandingReleaserAdapter releases a purged tenant's custom domain (domain provider), sending domain
+// (email provider) and subdomain (branding row)
+type brandingReleaserAdapter struct {
+	brandRepo             brand_outbound.BrandRepository
+	domainProvider        brand_outbound.DomainProvider
+	sendingDomainRepo     brand_outbound.SendingDomainRepository
+	sendingDomainProvider brand_outbound.SendingDomainProvider
+}
+
+func (a *brandingReleaserAdapter) ReleaseByAgencyID(ctx context.Context, agencyID uuid.UUID) error {
+	branding, err := a.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		if err == brand_domain.ErrBrandingNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if branding.Domain() != "" {
+		if err := a.domainProvider.RemoveDomain(ctx, branding.Domain()); err != nil {
+			return err
+		}
+	}
+
+	sendingDomain, err := a.sendingDomainRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil && err != brand_domain.ErrSendingDomainNotFound {
+		return err
+	}
+	if sendingDomain != nil && sendingDomain.ProviderID() != "" {
+		if err := a.sendingDomainProvider.RemoveDomain(ctx, sendingDomain.ProviderID()); err != nil {
+			return err
+		}
+	}
+
+	// Deleting the branding row frees the subdomain for other tenants (and cascades to the sending domain)
+	if err := a.brandRepo.Delete(ctx, agencyID); err != nil && err != brand_domain.ErrBrandingNotFound {
+		return err
+	}
+	return nil
+}
+
+// senderRepositoryAdapter resolves the address an agency's emails are sent from: its verified sending domain
+type senderRepositoryAdapter struct {
+	sendingDomainRepo brand_outbound.SendingDomainRepository
+	tenantRepo        tenants_outbound.TenantRepository
+}
+
+func (a *senderRepositoryAdapter) FindSender(ctx context.Context, agencyID uuid.UUID) (*tenants_outbound.Sender, error) {
+	sendingDomain, err := a.sendingDomainRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		if err == brand_domain.ErrSendingDomainNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !sendingDomain.Verified() {
+		return nil, nil
+	}
+
+	// Downgraded agencies send from the platform address again, keeping their verified domain for an upgrade
+	tenant, err := a.tenantRepo.FindByID(ctx, agencyID)
+	if err != nil {
+		return nil, err
+	}
+	if !tenants_model.TierSupportsSendingDomain(tenant.Tier()) {
+		return nil, nil
+	}
+
+	name := sendingDomain.FromName()
+	if name == "" {
+		name = tenant.Name()
+	}
+	return &tenants_outbound.Sender{Name: name, Email: sendingDomain.Address()}, nil
+}
+
+// domainNotifierAdapter emails a tenant's owners about custom domain health changes
+type domainNotifierAdapter struct {
+	tenantRepo   tenants_outbound.TenantRepository
+	memberRepo   tenants_outbound.TenantMemberRepository
+	userRepo     users_outbound.UserRepository
+	emailService tenants_outbound.EmailService
+	webURL       string
+}
+
+func (a *domainNotifierAdapter) NotifyDomainChange(ctx context.Context, notice *brand_outbound.DomainNotice) error {
+	tenant, err := a.tenantRepo.FindByID(ctx, notice.AgencyID)
+	if err != nil {
+		return err
+	}
+	members, err := a.memberRepo.FindByTenantID(ctx, notice.AgencyID)
+	if err != nil {
+		return err
+	}
+
+	// Every owner is told; the first failure is returned after trying the rest
+	var sendErr error
+	for _, member := range members {
+		if member.Role() != tenants_model.RoleOwner || member.IsDeleted() {
+			continue
+		}
+		user, err := a.userRepo.FindByID(ctx, member.UserID())
+		if err != nil {
+			if sendErr == nil {
+				sendErr = err
+			}
+			continue
+		}
+		err = a.emailService.SendDomainNoticeEmail(ctx, &tenants_outbound.DomainNoticeEmailContext{
+			To:          user.Email(),
+			FirstName:   user.FirstName(),
+			AgencyName:  tenant.Name(),
+			Domain:      notice.Domain,
+			Live:        notice.Event == brand_model.DomainEventLive,
+			Problem:     notice.Problem,
+			SettingsURL: strings.TrimRight(a.webURL, "/") + "/agency/settings/branding",
+		})
+		if err != nil && sendErr == nil {
+			sendErr = err
+		}
+	}
+	return sendErr
+}
+
+// tenantMembershipRemoverAdapter adapts the tenants use case to the interface expected by user deletion
+type tenantMembershipRemoverAdapter struct {
+	removeUserFromTenants *tenants_usecases.RemoveUserFromTenants
+}
+
+func (a *tenantMembershipRemoverAdapter) RemoveUserFromTenants(ctx context.Context, userID uuid.UUID, keepLastOwnerships bool) (*users_outbound.TenantMembershipRemoval, error) {
+	resp, err := a.removeUserFromTenants.Execute(ctx, &tenants_usecases.RemoveUserFromTenantsRequest{
+		UserID:             userID,
+		KeepLastOwnerships: keepLastOwnerships,
+	})
+	if err != nil {
+		if err == tenants_domain.ErrLastOwner {
+			return nil, users_domain.ErrLastOwner
+		}
+		return nil, err
+	}
+	return &users_outbound.TenantMembershipRemoval{
+		RemovedTenantIDs:  resp.RemovedTenantIDs,
+		RetainedTenantIDs: resp.RetainedTenantIDs,
+		RevokedInvites:    resp.RevokedInvites,
+	}, nil
+}
+
+// tenantMembershipSyncAdapter adapts the tenants use case to the interface expected by Clerk event processing
+type tenantMembershipSyncAdapter struct {
+	syncTenantMembership *tenants_usecases.SyncTenantMembership
+}
+
+func (a *tenantMembershipSyncAdapter) SyncMembership(ctx context.Context, membership users_outbound.OrganizationMembership) error {
+	return a.execute(ctx, membership, false)
+}
+
+func (a *tenantMembershipSyncAdapter) RemoveMembership(ctx context.Context, membership users_outbound.OrganizationMembership) error {
+	return a.execute(ctx, membership, true)
+}
+
+func (a *tenantMembershipSyncAdapter) execute(ctx context.Context, membership users_outbound.OrganizationMembership, removed bool) error {
+	_, err := a.syncTenantMembership.Execute(ctx, &tenants_usecases.SyncTenantMembershipRequest{
+		TenantID: membership.TenantID,
+		UserID:   membership.UserID,
+		Role:     membership.Role,
+		Removed:  removed,
+	})
+	switch err {
+	case tenants_domain.ErrTenantNotFound:
+		return users_domain.ErrTenantNotLinked
+	case tenants_domain.ErrLastOwner:
+		return users_domain.ErrLastOwner
+	}
+	return err
+}
+
+// userDirectoryAdapter adapts user repository to the interface expected by impersonation
+type userDirectoryAdapter struct {
+	userRepo users_outbound.UserRepository
+}
+
+func (a *userDirectoryAdapter) FindByID(ctx context.Context, id uuid.UUID) (*auth_outbound.UserIdentity, error) {
+	user, err := a.userRepo.FindByID(ctx, id)
+	return a.toIdentity(user, err)
+}
+
+func (a *userDirectoryAdapter) FindByClerkUserID(ctx context.Context, clerkUserID string) (*auth_outbound.UserIdentity, error) {
+	user, err := a.userRepo.FindByClerkUserID(ctx, clerkUserID)
+	return a.toIdentity(user, err)
+}
+
+func (a *userDirectoryAdapter) toIdentity(user *users_model.User, err error) (*auth_outbound.UserIdentity, error) {
+	if err != nil {
+		if err == users_domain.ErrUserNotFound {
+			return nil, auth_domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	// Deleted users cannot be acted as
+	if user.IsDeleted() {
+		return nil, auth_domain.ErrUserNotFound
+	}
+	return &auth_outbound.UserIdentity{
+		ID:          user.ID(),
+		ClerkUserID: user.ClerkUserID(),
+		Email:       user.Email(),
+		FirstName:   user.FirstName(),
+		LastName:    user.LastName(),
+		FullName:    user.FullName(),
+		ImageURL:    user.ImageURL(),
+	}, nil
+}
+
+// tenantMembershipReaderAdapter adapts tenant member repository to the interface expected by impersonation
+type tenantMembershipReaderAdapter struct {
+	memberRepo tenants_outbound.TenantMemberRepository
+}
+
+func (a *tenantMembershipReaderAdapter) MemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error) {
+	member, err := a.memberRepo.FindByTenantAndUserID(ctx, tenantID, userID)
+	if err != nil {
+		if err == tenants_domain.ErrMemberNotFound {
+			return "", auth_domain.ErrNotTenantMember
+		}
+		return "", err
+	}
+	return string(member.Role()), nil
+}
+
+// memberAccessRevokerAdapter cuts off a user's existing access after a membership change
+// It drops the user's cached tenant list on every instance and rejects their tokens issued before now.
+type memberAccessRevokerAdapter struct {
+	userRepo    users_outbound.UserRepository
+	tenantCache *tenant.TenantCache // nil when Redis is not configured
+	revocations *revocation.Store
+}
+
+func (a *memberAccessRevokerAdapter) RevokeAccess(ctx context.Context, userID uuid.UUID) error {
+	if a.tenantCache != nil {
+		if err := a.tenantCache.Invalidate(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	user, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if err == users_domain.ErrUserNotFound {
+			// No account means no tokens to revoke
+			return nil
+		}
+		return err
+	}
+	return a.revocations.Revoke(ctx, user.ClerkUserID(), time.Now())
+}
+
+// tenantDirectoryAdapter adapts the tenants use case to the interface expected by the session bootstrap
+type tenantDirectoryAdapter struct {
+	listTenantsByUser *tenants_usecases.ListTenantsByUser
+}
+
+func (a *tenantDirectoryAdapter) ListMemberships(ctx context.Context, userID uuid.UUID) ([]auth_model.SessionTenant, error) {
+	resp, err := a.listTenantsByUser.Execute(ctx, &tenants_usecases.ListTenantsByUserRequest{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]auth_model.SessionTenant, len(resp.Tenants))
+	for i, tenantWithRole := range resp.Tenants {
+		tenant := tenantWithRole.Tenant
+		tier := ""
+		if tenant.Tier() != nil {
+			tier = tenant.Tier().String()
+		}
+		entitlements := tenants_model.EntitlementsForTier(tenant.Tier())
+
+		memberships[i] = auth_model.SessionTenant{
+			ID:          tenant.ID(),
+			Name:        tenant.Name(),
+			Slug:        tenant.Slug(),
+			Status:      string(tenant.Status()),
+			Tier:        tier,
+			Suspended:   tenant.IsSuspended(),
+			Role:        string(tenantWithRole.Role),
+			Permissions: tenants_model.RolePermissions(tenantWithRole.Role),
+			Entitlements: auth_model.SessionEntitlements{
+				ClientLimit:   entitlements.ClientLimit,
+				CustomDomain:  entitlements.CustomDomain,
+				HidePoweredBy: entitlements.HidePoweredBy,
+				SendingDomain: entitlements.SendingDomain,
+				UsesSubdomain: entitlements.UsesSubdomain,
+			},
+		}
+	}
+	return memberships, nil
+}
+
+// brandingReaderAdapter adapts brand repository to the interface expected by the session bootstrap
+type brandingReaderAdapter struct {
+	brandRepo brand_outbound.BrandRepository
+}
+
+func (a *brandingReaderAdapter) FindByTenantID(ctx context.Context, tenantID uuid.UUID) (*auth_model.SessionBranding, error) {
+	branding, err := a.brandRepo.FindByAgencyID(ctx, tenantID)
+	if err != nil {
+		if err == brand_domain.ErrBrandingNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &auth_model.SessionBranding{
+		LogoURL:        branding.LogoURL(),
+		FaviconURL:     branding.FaviconURL(),
+		PrimaryColor:   branding.PrimaryColor(),
+		SecondaryColor: branding.SecondaryColor(),
+		Theme:          branding.ThemeJSON(),
+		HidePoweredBy:  branding.HidePoweredBy(),
+		Domain:         branding.Domain(),
+		Subdomain:      branding.Subdomain(),
+	}, nil
+}
+
+// tenantAccessCheckerAdapter adapts tenant member repository to the interface expected by the organization switch
+type tenantAccessCheckerAdapter struct {
+	memberRepo tenants_outbound.TenantMemberRepository
+}
+
+func (a *tenantAccessCheckerAdapter) IsMember(ctx context.Context, tenantID, userID uuid.UUID) (bool, error) {
+	_, err := a.memberRepo.FindByTenantAndUserID(ctx, tenantID, userID)
+	if err != nil {
+		if err == tenants_domain.ErrMemberNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 // Composition wires all domains together
@@ -65,7 +574,21 @@ type Composition struct {
 	FilesHandlers  *files_http.Handlers
 	AuthHandlers   *auth_http.Handlers
 	UserHandlers   *users_http.Handlers
-	UserRepo       users_outbound.UserRepository // Expose user repo for tenant resolution middleware
+	UserRepo       users_outbound.UserRepository     // Expose user repo for tenant resolution middleware
+	TenantRepo     tenants_outbound.TenantRepository // Expose tenant repo for suspension middleware
+	ClientRepo     tenants_outbound.ClientRepository // Expose client repo for suspension middleware
+
+	// Expose impersonation use cases for the impersonation middleware
+	ResolveImpersonation        *auth_usecases.ResolveImpersonation
+	RecordImpersonationActivity *auth_usecases.RecordImpersonationActivity
+
+	IdempotencyStore idempotency.Store          // Expose idempotency store for the Idempotency-Key middleware
+	BrandingCache    *brand_cache.BrandingCache // Expose branding cache so invalidations from other instances are applied
+
+	logger             zerolog.Logger
+	purgeClosedTenants *tenants_usecases.PurgeClosedTenants
+	retryClerkEvents   users_inbound.RetryClerkEvents
+	monitorDomains     *brand_usecases.MonitorDomains
 }
 
 // RegisterPublicRoutes registers public routes (no auth required)
@@ -74,6 +597,10 @@ func (c *Composition) RegisterPublicRoutes(r chi.Router) {
 	c.BrandHandlers.RegisterPublicRoutes(r)
 	// Public tenant routes (invite details)
 	c.TenantHandlers.RegisterPublicRoutes(r)
+	// Identity provider webhooks (verified by signature)
+	c.UserHandlers.RegisterPublicRoutes(r)
+	// Storage upload notifications (verified by shared token)
+	c.FilesHandlers.RegisterPublicRoutes(r)
 }
 
 // RegisterProtectedRoutes registers protected routes (auth required)
@@ -93,6 +620,7 @@ func (c *Composition) RegisterProtectedRoutesWithTenant(r chi.Router) {
 	// Note: We register these directly since POST /tenants is already registered in main.go
 	r.Get("/tenants/{id}", c.TenantHandlers.GetTenantHandler)
 	r.Put("/tenants/{id}", c.TenantHandlers.UpdateTenantHandler)
+	r.Patch("/tenants/{id}", c.TenantHandlers.PatchTenantHandler)
 	r.Post("/tenants/{id}/invites", c.TenantHandlers.InviteMemberHandler)
 	r.Get("/tenants/{id}/invites", c.TenantHandlers.ListInvitesHandler)
 	r.Delete("/tenants/{id}/invites/{invite_id}", c.TenantHandlers.RevokeInviteHandler)
@@ -100,6 +628,12 @@ func (c *Composition) RegisterProtectedRoutesWithTenant(r chi.Router) {
 	r.Delete("/tenants/{id}/members/{user_id}", c.TenantHandlers.RemoveMemberHandler)
 	r.Get("/tenants/{id}/roles", c.TenantHandlers.ListRolesHandler)
 	r.Get("/tenants/{id}/seat-usage", c.TenantHandlers.GetSeatUsageHandler)
+	r.Post("/tenants/{id}/exports", c.TenantHandlers.StartTenantExportHandler)
+	r.Get("/tenants/{id}/exports", c.TenantHandlers.ListTenantExportsHandler)
+	r.Get("/tenants/{id}/exports/{export_id}", c.TenantHandlers.GetTenantExportHandler)
+	r.Post("/tenants/{id}/closure", c.TenantHandlers.RequestTenantClosureHandler)
+	r.Get("/tenants/{id}/closure", c.TenantHandlers.GetTenantClosureHandler)
+	r.Delete("/tenants/{id}/closure", c.TenantHandlers.CancelTenantClosureHandler)
 	r.Post("/tenants/{id}/clients", c.TenantHandlers.CreateClientHandler)
 	r.Get("/tenants/{id}/clients", c.TenantHandlers.ListClientsHandler)
 
@@ -107,6 +641,7 @@ func (c *Composition) RegisterProtectedRoutesWithTenant(r chi.Router) {
 	r.Route("/clients", func(r chi.Router) {
 		r.Get("/{id}", c.TenantHandlers.GetClientHandler)
 		r.Put("/{id}", c.TenantHandlers.UpdateClientHandler)
+		r.Patch("/{id}", c.TenantHandlers.PatchClientHandler)
 		r.Post("/{id}/members", c.TenantHandlers.AddClientMemberHandler)
 		r.Get("/{id}/members", c.TenantHandlers.ListClientMembersHandler)
 		r.Delete("/{id}/members/{memberId}", c.TenantHandlers.RemoveClientMemberHandler)
@@ -116,7 +651,9 @@ func (c *Composition) RegisterProtectedRoutesWithTenant(r chi.Router) {
 
 	// Register location routes (all require tenant context)
 	r.Route("/locations", func(r chi.Router) {
+		r.Get("/{id}", c.TenantHandlers.GetLocationHandler)
 		r.Put("/{id}", c.TenantHandlers.UpdateLocationHandler)
+		r.Patch("/{id}", c.TenantHandlers.PatchLocationHandler)
 	})
 
 	// Register brand routes (all require tenant context)
@@ -126,9 +663,19 @@ func (c *Composition) RegisterProtectedRoutesWithTenant(r chi.Router) {
 	c.FilesHandlers.RegisterRoutes(r)
 }
 
+// RegisterAdminRoutes registers platform admin routes (auth + platform admin required, no tenant context)
+func (c *Composition) RegisterAdminRoutes(r chi.Router) {
+	c.TenantHandlers.RegisterAdminRoutes(r)
+	c.UserHandlers.RegisterAdminRoutes(r)
+}
+
 // NewComposition creates a new composition with all dependencies wired
+// redisClient is optional - if nil, sessions are not cached
 func NewComposition(
 	db *pgxpool.Pool,
+	redisClient *redis.Client,
+	tenantCache *tenant.TenantCache,
+	revocations *revocation.Store,
 	cfg *config.Config,
 	logger zerolog.Logger,
 ) *Composition {
@@ -139,17 +686,55 @@ func NewComposition(
 	clientRepo := tenants_db.NewClientRepository(db)
 	locationRepo := tenants_db.NewLocationRepository(db)
 	clientMemberRepo := tenants_db.NewClientMemberRepository(db)
+	suspensionEventRepo := tenants_db.NewSuspensionEventRepository(db)
+	tenantExportRepo := tenants_db.NewTenantExportRepository(db)
 	brandRepo := brand_db.NewBrandRepository(db)
+	clientBrandingRepo := brand_db.NewClientBrandingRepository(db)
+	brandingDraftRepo := brand_db.NewBrandingDraftRepository(db)
+	brandingVersionRepo := brand_db.NewBrandingVersionRepository(db)
+	domainCheckRepo := brand_db.NewDomainCheckRepository(db)
+	sendingDomainRepo := brand_db.NewSendingDomainRepository(db)
 	userRepo := users_db.NewUserRepository(db)
+	webhookEventRepo := users_db.NewWebhookEventRepository(db)
+	tenantPreferencesRepo := users_db.NewTenantPreferencesRepository(db)
+	impersonationSessionRepo := auth_db.NewImpersonationSessionRepository(db)
+	impersonationAuditRepo := auth_db.NewImpersonationAuditRepository(db)
+	fileRepo := files_db.NewFileRepository(db)
+
+	// Cache the session bootstrap when Redis is available; decorated repositories invalidate it on writes
+	var sessionCache auth_outbound.SessionCache
+	if redisClient != nil {
+		sessionCache = auth_cache.NewSessionCache(redisClient, 5*time.Minute, logger)
+		userRepo = &sessionInvalidatingUserRepository{UserRepository: userRepo, cache: sessionCache}
+		tenantMemberRepo = &sessionInvalidatingTenantMemberRepository{TenantMemberRepository: tenantMemberRepo, cache: sessionCache}
+		tenantRepo = &sessionInvalidatingTenantRepository{TenantRepository: tenantRepo, cache: sessionCache}
+		brandRepo = &sessionInvalidatingBrandRepository{BrandRepository: brandRepo, cache: sessionCache}
+	}
+
+	// Cache public branding lookups in Redis when available, always behind an in-process LRU;
+	// decorated repositories invalidate them on writes
+	brandingCache := brand_cache.NewBrandingCache(redisClient, 5*time.Minute, logger)
+	brandRepo = &brandingCacheInvalidatingBrandRepository{BrandRepository: brandRepo, cache: brandingCache}
+	clientBrandingRepo = &brandingCacheInvalidatingClientBrandingRepository{ClientBrandingRepository: clientBrandingRepo, cache: brandingCache}
+	tenantRepo = &brandingCacheInvalidatingTenantRepository{TenantRepository: tenantRepo, cache: brandingCache}
+
+	// Membership changes drop cached tenant access and revoke existing tokens immediately
+	accessRevoker := &memberAccessRevokerAdapter{
+		userRepo:    userRepo,
+		tenantCache: tenantCache,
+		revocations: revocations,
+	}
 
 	// Initialize services
 	seatValidator := tenants_services.NewSeatValidator()
 	assetValidator := files_services.NewAssetValidator()
+	assetProcessor := files_services.NewAssetProcessor()
 	keyGenerator := files_services.NewKeyGenerator()
 
 	// Initialize storage (GCS for production, S3 for local dev/fallback)
 	var fileStorage files_outbound.Storage
 	var storageBucket string
+	storagePublicURL := cfg.StoragePublicURL
 	if cfg.GCSBucketName != "" {
 		// Use GCS for production
 		gcsStorage, err := gcs.NewStorage(cfg.GCSBucketName, cfg.GCSProjectID, os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"))
@@ -158,6 +743,9 @@ func NewComposition(
 		}
 		fileStorage = gcsStorage
 		storageBucket = cfg.GCSBucketName
+		if storagePublicURL == "" {
+			storagePublicURL = "https://storage.googleapis.com/" + cfg.GCSBucketName
+		}
 	} else {
 		// Fallback to S3 for local development
 		s3Storage, err := s3.NewStorage(cfg.AWSRegion, cfg.S3BucketName)
@@ -166,6 +754,9 @@ func NewComposition(
 		}
 		fileStorage = s3Storage
 		storageBucket = cfg.S3BucketName
+		if storagePublicURL == "" {
+			storagePublicURL = "https://" + cfg.S3BucketName + ".s3." + cfg.AWSRegion + ".amazonaws.com"
+		}
 	}
 
 	storage := fileStorage
@@ -192,10 +783,11 @@ func NewComposition(
 	getTenant := tenants_usecases.NewGetTenant(tenantRepo)
 	updateTenant := tenants_usecases.NewUpdateTenant(tenantRepo)
 	// Create adapters for brand and user repositories to match use case interfaces
-	brandRepoAdapter := &brandRepositoryAdapter{brandRepo: brandRepo}
+	brandRepoAdapter := &brandRepositoryAdapter{brandRepo: brandRepo, clientBrandingRepo: clientBrandingRepo}
 	userRepoAdapter := &userRepositoryAdapter{userRepo: userRepo}
+	senderRepoAdapter := &senderRepositoryAdapter{sendingDomainRepo: sendingDomainRepo, tenantRepo: tenantRepo}
 
-	inviteMember := tenants_usecases.NewInviteMember(inviteRepo, tenantMemberRepo, tenantRepo, brandRepoAdapter, userRepoAdapter, emailService, seatValidator, 7*24*time.Hour, cfg.WebURL)
+	inviteMember := tenants_usecases.NewInviteMember(inviteRepo, tenantMemberRepo, tenantRepo, brandRepoAdapter, userRepoAdapter, emailService, senderRepoAdapter, seatValidator, 7*24*time.Hour, cfg.WebURL)
 	acceptInvite := tenants_usecases.NewAcceptInvite(inviteRepo, tenantMemberRepo)
 	listInvites := tenants_usecases.NewListInvites(inviteRepo, tenantRepo)
 	findInvitesByEmail := tenants_usecases.NewFindInvitesByEmail(inviteRepo)
@@ -204,7 +796,7 @@ func NewComposition(
 	listMembers := tenants_usecases.NewListMembers(tenantMemberRepo, tenantRepo)
 	listTenantsByUser := tenants_usecases.NewListTenantsByUser(tenantMemberRepo, tenantRepo)
 	validateSlug := tenants_usecases.NewValidateSlug(tenantRepo)
-	removeMember := tenants_usecases.NewRemoveMember(tenantMemberRepo, tenantRepo)
+	removeMember := tenants_usecases.NewRemoveMember(tenantMemberRepo, tenantRepo, accessRevoker)
 	listRoles := tenants_usecases.NewListRoles(tenantRepo)
 	createClient := tenants_usecases.NewCreateClient(clientRepo, tenantRepo, seatValidator)
 	listClients := tenants_usecases.NewListClients(clientRepo, tenantRepo)
@@ -212,19 +804,70 @@ func NewComposition(
 	updateClient := tenants_usecases.NewUpdateClient(clientRepo)
 	addClientMember := tenants_usecases.NewAddClientMember(clientMemberRepo, locationRepo, seatValidator)
 	listClientMembers := tenants_usecases.NewListClientMembers(clientMemberRepo)
-	removeClientMember := tenants_usecases.NewRemoveClientMember(clientMemberRepo)
+	removeClientMember := tenants_usecases.NewRemoveClientMember(clientMemberRepo, accessRevoker)
 	createLocation := tenants_usecases.NewCreateLocation(locationRepo, clientRepo)
 	listLocations := tenants_usecases.NewListLocations(locationRepo)
+	getLocation := tenants_usecases.NewGetLocation(locationRepo)
 	updateLocation := tenants_usecases.NewUpdateLocation(locationRepo)
 	getSeatUsage := tenants_usecases.NewGetSeatUsage(tenantRepo, clientRepo, clientMemberRepo, locationRepo)
+	suspendTenant := tenants_usecases.NewSuspendTenant(tenantRepo, suspensionEventRepo)
+	reinstateTenant := tenants_usecases.NewReinstateTenant(tenantRepo, suspensionEventRepo)
+	suspendClient := tenants_usecases.NewSuspendClient(clientRepo, suspensionEventRepo)
+	reinstateClient := tenants_usecases.NewReinstateClient(clientRepo, suspensionEventRepo)
+	listSuspensionEvents := tenants_usecases.NewListSuspensionEvents(suspensionEventRepo, tenantRepo)
 
-	// Initialize Vercel service (required - source of truth for domain operations)
-	vercelService := brand_vercel.NewVercelService(
-		cfg.VercelAPIToken,
-		cfg.VercelProjectID,
-		cfg.VercelTeamID,
-		logger,
+	// Tenant exports: archives are kept for 7 days, download links are valid for 15 minutes
+	tenantStorage := &tenantStorageAdapter{storage: storage, bucket: storageBucket}
+	exportTenantData := tenants_usecases.NewExportTenantData(
+		tenantExportRepo,
+		tenantRepo,
+		tenantMemberRepo,
+		inviteRepo,
+		clientRepo,
+		locationRepo,
+		clientMemberRepo,
+		&brandingExporterAdapter{brandRepo: brandRepo},
+		userRepoAdapter,
+		tenantStorage,
+		7*24*time.Hour,
 	)
+	startTenantExport := tenants_usecases.NewStartTenantExport(tenantExportRepo, tenantRepo, exportTenantData, 30*time.Minute)
+	getTenantExport := tenants_usecases.NewGetTenantExport(tenantExportRepo, tenantStorage, 15*time.Minute)
+	listTenantExports := tenants_usecases.NewListTenantExports(tenantExportRepo, tenantRepo)
+
+	// Initialize the custom domain provider (source of truth for domain operations)
+	// The in-memory provider verifies every domain, so it is only used when chosen explicitly and never in production
+	providerName := cfg.DomainProvider
+	if providerName == "" {
+		if cfg.VercelAPIToken == "" {
+			logger.Fatal().Msg("DOMAIN_PROVIDER is required when VERCEL_API_TOKEN is not set")
+		}
+		providerName = "vercel"
+	}
+	if providerName == "fake" && cfg.Environment == "production" {
+		logger.Fatal().Msg("DOMAIN_PROVIDER=fake is not allowed in production")
+	}
+	var domainProvider brand_outbound.DomainProvider
+	switch providerName {
+	case "cloudflare":
+		domainProvider = brand_cloudflare.NewDomainProvider(cfg.CloudflareAPIToken, cfg.CloudflareZoneID, cfg.CloudflareCNAMETarget, logger)
+		logger.Info().Msg("Using Cloudflare for SaaS domain provider")
+	case "vercel":
+		vercelService := brand_vercel.NewVercelService(
+			cfg.VercelAPIToken,
+			cfg.VercelProjectID,
+			cfg.VercelTeamID,
+			logger,
+		)
+		domainProvider = brand_vercel.NewDomainProvider(vercelService)
+		logger.Info().Msg("Using Vercel domain provider")
+	case "fake":
+		// In-memory provider for local development: domains verify on the first attempt
+		domainProvider = brand_fake.NewDomainProvider("cname.localhost", true)
+		logger.Warn().Msg("Using in-memory domain provider (custom domains are not actually served)")
+	default:
+		logger.Fatal().Str("provider", providerName).Msg("Unknown DOMAIN_PROVIDER")
+	}
 
 	// Initialize DNS service (optional - UX feedback only)
 	var dnsService *brand_dns.DNSService
@@ -232,24 +875,171 @@ func NewComposition(
 		dnsService = brand_dns.NewDNSService(logger)
 	}
 
+	// Initialize ownership verifier (_faro-verify TXT records)
+	var ownershipVerifier brand_outbound.OwnershipVerifier
+	if cfg.DomainOwnershipCheck != "false" {
+		verifier, err := brand_dns.NewTXTVerifier(cfg.DNSResolvers, cfg.DNSAuthoritativeCheck, logger)
+		ownershipVerifier = verifier
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Invalid DNS_RESOLVERS")
+		}
+	} else {
+		logger.Warn().Msg("Domain ownership checks are disabled")
+	}
+
+	// Initialize the sending domain provider (agencies sending emails from their own domains)
+	// The in-memory provider confirms every record, so it is only used when chosen explicitly and never in production
+	sendingProviderName := cfg.SendingDomainProvider
+	if sendingProviderName == "" {
+		if cfg.PostmarkAccountToken == "" {
+			logger.Fatal().Msg("SENDING_DOMAIN_PROVIDER is required when POSTMARK_ACCOUNT_TOKEN is not set")
+		}
+		sendingProviderName = "postmark"
+	}
+	if sendingProviderName == "fake" && cfg.Environment == "production" {
+		logger.Fatal().Msg("SENDING_DOMAIN_PROVIDER=fake is not allowed in production")
+	}
+	var sendingDomainProvider brand_outbound.SendingDomainProvider
+	var recordVerifier brand_outbound.RecordVerifier
+	switch sendingProviderName {
+	case "postmark":
+		sendingDomainProvider = brand_postmark.NewSendingDomainProvider(cfg.PostmarkAccountToken, logger)
+		verifier, err := brand_dns.NewRecordVerifier(cfg.DNSResolvers, cfg.DNSAuthoritativeCheck, logger)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Invalid DNS_RESOLVERS")
+		}
+		recordVerifier = verifier
+		logger.Info().Msg("Using Postmark sending domain provider")
+	case "fake":
+		// In-memory provider for local development: records are treated as published and confirmed right away
+		sendingDomainProvider = brand_fake.NewSendingDomainProvider()
+		logger.Warn().Msg("Using in-memory sending domain provider (sending domains are not actually registered)")
+	default:
+		logger.Fatal().Str("provider", sendingProviderName).Msg("Unknown SENDING_DOMAIN_PROVIDER")
+	}
+
 	// Initialize brand use cases
 	getByDomain := brand_usecases.NewGetByDomain(brandRepo)
-	getByHost := brand_usecases.NewGetByHost(brandRepo, tenantRepo)
+	getByHost := brand_usecases.NewGetByHost(brandRepo, clientBrandingRepo, tenantRepo, brandingDraftRepo)
+	getTheme := brand_usecases.NewGetTheme(getByHost, brand_services.NewThemeCompiler())
 	listBrands := brand_usecases.NewListBrands(brandRepo)
 	createBrand := brand_usecases.NewCreateBrand(brandRepo, tenantRepo)
 	getBrand := brand_usecases.NewGetBrand(brandRepo, tenantRepo)
 	updateBrand := brand_usecases.NewUpdateBrand(brandRepo, tenantRepo)
 	deleteBrand := brand_usecases.NewDeleteBrand(brandRepo)
-	verifyDomain := brand_usecases.NewVerifyDomain(brandRepo, tenantRepo, vercelService, dnsService)
-	getDomainStatus := brand_usecases.NewGetDomainStatus(brandRepo, tenantRepo, vercelService)
-	getDomainInstructions := brand_usecases.NewGetDomainInstructions(brandRepo, tenantRepo, vercelService)
+	verifyDomain := brand_usecases.NewVerifyDomain(brandRepo, tenantRepo, domainProvider, ownershipVerifier, dnsService)
+	getDomainStatus := brand_usecases.NewGetDomainStatus(brandRepo, tenantRepo, domainProvider, domainCheckRepo, ownershipVerifier)
+	getDomainInstructions := brand_usecases.NewGetDomainInstructions(brandRepo, tenantRepo, domainProvider, ownershipVerifier)
+	monitorDomains := brand_usecases.NewMonitorDomains(brandRepo, domainCheckRepo, domainProvider, ownershipVerifier, &domainNotifierAdapter{
+		tenantRepo:   tenantRepo,
+		memberRepo:   tenantMemberRepo,
+		userRepo:     userRepo,
+		emailService: emailService,
+		webURL:       cfg.WebURL,
+	})
+	getClientBranding := brand_usecases.NewGetClientBranding(brandRepo, clientBrandingRepo, clientRepo)
+	putClientBranding := brand_usecases.NewPutClientBranding(brandRepo, clientBrandingRepo, clientRepo)
+	deleteClientBranding := brand_usecases.NewDeleteClientBranding(brandRepo, clientBrandingRepo, clientRepo)
+	getBrandingDraft := brand_usecases.NewGetBrandingDraft(brandRepo, brandingDraftRepo)
+	putBrandingDraft := brand_usecases.NewPutBrandingDraft(brandRepo, tenantRepo, brandingDraftRepo)
+	deleteBrandingDraft := brand_usecases.NewDeleteBrandingDraft(brandingDraftRepo)
+	publishBrandingDraft := brand_usecases.NewPublishBrandingDraft(brandRepo, tenantRepo, brandingDraftRepo)
+	listBrandingVersions := brand_usecases.NewListBrandingVersions(brandRepo, brandingVersionRepo)
+	rollbackBranding := brand_usecases.NewRollbackBranding(brandRepo, tenantRepo, brandingVersionRepo)
+	getSendingDomain := brand_usecases.NewGetSendingDomain(sendingDomainRepo)
+	putSendingDomain := brand_usecases.NewPutSendingDomain(brandRepo, tenantRepo, sendingDomainRepo, sendingDomainProvider)
+	verifySendingDomain := brand_usecases.NewVerifySendingDomain(brandRepo, tenantRepo, sendingDomainRepo, sendingDomainProvider, recordVerifier)
+	deleteSendingDomain := brand_usecases.NewDeleteSendingDomain(brandRepo, sendingDomainRepo, sendingDomainProvider)
+
+	// Initialize tenant closure use cases (depend on brand and storage for the purge)
+	closureRetention := time.Duration(cfg.TenantClosureRetentionDays) * 24 * time.Hour
+	requestTenantClosure := tenants_usecases.NewRequestTenantClosure(tenantRepo, closureRetention)
+	cancelTenantClosure := tenants_usecases.NewCancelTenantClosure(tenantRepo)
+	purgeClosedTenants := tenants_usecases.NewPurgeClosedTenants(
+		tenantRepo,
+		&brandingReleaserAdapter{
+			brandRepo:             brandRepo,
+			domainProvider:        domainProvider,
+			sendingDomainRepo:     sendingDomainRepo,
+			sendingDomainProvider: sendingDomainProvider,
+		},
+		tenantStorage,
+		closureRetention,
+	)
 
 	// Initialize files use cases
-	signUpload := files_usecases.NewSignUpload(storage, assetValidator, keyGenerator, storageBucket, 10*time.Minute)
-	deleteFile := files_usecases.NewDeleteFile(storage, keyGenerator, storageBucket)
+	signUpload := files_usecases.NewSignUpload(storage, fileRepo, assetValidator, keyGenerator, storageBucket, 10*time.Minute)
+	finalizeUpload := files_usecases.NewFinalizeUpload(
+		storage,
+		&brandingAssetsAdapter{brandRepo: brandRepo},
+		assetValidator,
+		assetProcessor,
+		files_services.NewSVGSanitizer(),
+		keyGenerator,
+		storageBucket,
+		storagePublicURL,
+	)
+	completeUpload := files_usecases.NewCompleteUpload(storage, fileRepo, assetValidator, storageBucket)
+	listFiles := files_usecases.NewListFiles(fileRepo)
+	deleteFile := files_usecases.NewDeleteFile(storage, fileRepo, keyGenerator, storageBucket)
 
 	// Initialize user use cases
 	syncUser := users_usecases.NewSyncUser(userRepo)
+	removeUserFromTenants := tenants_usecases.NewRemoveUserFromTenants(tenantMemberRepo, clientMemberRepo, inviteRepo, accessRevoker)
+	deleteUser := users_usecases.NewDeleteUser(userRepo, &tenantMembershipRemoverAdapter{removeUserFromTenants: removeUserFromTenants})
+	switchTenant := users_usecases.NewSwitchTenant(userRepo, tenantPreferencesRepo, &tenantAccessCheckerAdapter{memberRepo: tenantMemberRepo})
+
+	// Initialize Clerk webhook use cases (events map onto user sync/deletion and tenant memberships)
+	syncTenantMembership := tenants_usecases.NewSyncTenantMembership(tenantRepo, tenantMemberRepo, accessRevoker)
+	processClerkEvent := users_usecases.NewProcessClerkEvent(
+		webhookEventRepo,
+		userRepo,
+		syncUser,
+		deleteUser,
+		&tenantMembershipSyncAdapter{syncTenantMembership: syncTenantMembership},
+	)
+	receiveClerkWebhook := users_usecases.NewReceiveClerkWebhook(webhookEventRepo, processClerkEvent)
+	retryClerkEvents := users_usecases.NewRetryClerkEvents(webhookEventRepo, processClerkEvent)
+
+	var webhookVerifier *svix.Verifier
+	if cfg.ClerkWebhookSecret != "" {
+		verifier, err := svix.NewVerifier(cfg.ClerkWebhookSecret)
+		if err != nil {
+			logger.Error().Err(err).Msg("Invalid CLERK_WEBHOOK_SECRET, Clerk webhooks are disabled")
+		} else {
+			webhookVerifier = verifier
+		}
+	} else {
+		logger.Warn().Msg("CLERK_WEBHOOK_SECRET not set, Clerk webhooks are disabled")
+	}
+
+	// Initialize impersonation use cases (platform admins act as support staff)
+	userDirectory := &userDirectoryAdapter{userRepo: userRepo}
+	tenantMembershipReader := &tenantMembershipReaderAdapter{memberRepo: tenantMemberRepo}
+	startImpersonation := auth_usecases.NewStartImpersonation(
+		impersonationSessionRepo,
+		impersonationAuditRepo,
+		userDirectory,
+		tenantMembershipReader,
+		cfg.PlatformAdminUserIDs,
+	)
+	endImpersonation := auth_usecases.NewEndImpersonation(impersonationSessionRepo, impersonationAuditRepo)
+	resolveImpersonation := auth_usecases.NewResolveImpersonation(
+		impersonationSessionRepo,
+		impersonationAuditRepo,
+		userDirectory,
+		tenantMembershipReader,
+		cfg.PlatformAdminUserIDs,
+	)
+	recordImpersonationActivity := auth_usecases.NewRecordImpersonationActivity(impersonationAuditRepo)
+
+	// Initialize session bootstrap use case
+	getSession := auth_usecases.NewGetSession(
+		userDirectory,
+		&tenantDirectoryAdapter{listTenantsByUser: listTenantsByUser},
+		&brandingReaderAdapter{brandRepo: brandRepo},
+		sessionCache,
+	)
 
 	// Initialize handlers
 	tenantHandlers := tenants_http.NewHandlers(
@@ -276,10 +1066,21 @@ func NewComposition(
 		removeClientMember,
 		createLocation,
 		listLocations,
+		getLocation,
 		updateLocation,
 		getSeatUsage,
 		listTenantsByUser,
 		validateSlug,
+		suspendTenant,
+		reinstateTenant,
+		suspendClient,
+		reinstateClient,
+		listSuspensionEvents,
+		startTenantExport,
+		getTenantExport,
+		listTenantExports,
+		requestTenantClosure,
+		cancelTenantClosure,
 		userRepo,
 		inviteRepo,
 		tenantRepo,
@@ -298,20 +1099,49 @@ func NewComposition(
 		verifyDomain,
 		getDomainStatus,
 		getDomainInstructions,
+		getClientBranding,
+		putClientBranding,
+		deleteClientBranding,
+		getBrandingDraft,
+		putBrandingDraft,
+		deleteBrandingDraft,
+		publishBrandingDraft,
+		listBrandingVersions,
+		rollbackBranding,
+		getSendingDomain,
+		putSendingDomain,
+		verifySendingDomain,
+		deleteSendingDomain,
+		getTheme,
+		brandingCache,
 		tenantRepo,
 	)
 
 	filesHandlers := files_http.NewHandlers(
 		logger,
 		signUpload,
+		finalizeUpload,
+		completeUpload,
+		listFiles,
 		deleteFile,
+		userRepo,
+		cfg.StorageNotificationToken,
 	)
 
-	authHandlers := auth_http.NewHandlers(logger)
+	authHandlers := auth_http.NewHandlers(
+		logger,
+		startImpersonation,
+		endImpersonation,
+		getSession,
+	)
 
 	userHandlers := users_http.NewHandlers(
 		logger,
 		syncUser,
+		deleteUser,
+		receiveClerkWebhook,
+		switchTenant,
+		webhookVerifier,
 	)
 
 	return &Composition{
@@ -321,5 +1151,18 @@ func NewComposition(
 		AuthHandlers:   authHandlers,
 		UserHandlers:   userHandlers,
 		UserRepo:       userRepo,
+		TenantRepo:     tenantRepo,
+		ClientRepo:     clientRepo,
+
+		ResolveImpersonation:        resolveImpersonation,
+		RecordImpersonationActivity: recordImpersonationActivity,
+
+		IdempotencyStore: idempotency.NewPostgresStore(db),
+		BrandingCache:    brandingCache,
+
+		logger:             logger,
+		purgeClosedTenants: purgeClosedTenants,
+		retryClerkEvents:   retryClerkEvents,
+		monitorDomains:     monitorDomains,
 	}
 }
@@ -0,0 +1,107 @@
+package composition
+
+import (
+	"context"
+	"time"
+
+	brand_usecases "farohq-core-app/internal/domains/brand/app/usecases"
+	tenants_usecases "farohq-core-app/internal/domains/tenants/app/usecases"
+	users_inbound "farohq-core-app/internal/domains/users/domain/ports/inbound"
+)
+
+// tenantPurgeInterval is how often the purge job looks for tenants past their retention window
+const tenantPurgeInterval = time.Hour
+
+// idempotencyPurgeInterval is how often expired idempotency keys are deleted
+const idempotencyPurgeInterval = time.Hour
+
+// domainMonitorInterval is how often the domain monitor looks for custom domains due for a check
+// (each domain's own backoff decides when it is due)
+const domainMonitorInterval = 5 * time.Minute
+
+// Clerk event retry: events untouched for clerkEventRetryDelay are reprocessed, up to clerkEventMaxAttempts times
+const (
+	clerkEventRetryInterval = 5 * time.Minute
+	clerkEventRetryDelay    = 5 * time.Minute
+	clerkEventMaxAttempts   = 5
+)
+
+// StartBackgroundJobs starts periodic background jobs
+// Jobs stop when ctx is cancelled (on shutdown).
+func (c *Composition) StartBackgroundJobs(ctx context.Context) {
+	go c.runPeriodically(ctx, "purge_closed_tenants", tenantPurgeInterval, func(ctx context.Context) error {
+		resp, err := c.purgeClosedTenants.Execute(ctx, &tenants_usecases.PurgeClosedTenantsRequest{})
+		if err != nil {
+			return err
+		}
+		if len(resp.Purged) > 0 || len(resp.Failed) > 0 {
+			c.logger.Info().
+				Int("purged", len(resp.Purged)).
+				Int("failed", len(resp.Failed)).
+				Msg("Closed tenant purge finished")
+		}
+		return nil
+	})
+
+	go c.runPeriodically(ctx, "retry_clerk_events", clerkEventRetryInterval, func(ctx context.Context) error {
+		resp, err := c.retryClerkEvents.Execute(ctx, &users_inbound.RetryClerkEventsRequest{
+			Before:      time.Now().Add(-clerkEventRetryDelay),
+			MaxAttempts: clerkEventMaxAttempts,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Retried > 0 {
+			c.logger.Info().
+				Int("retried", resp.Retried).
+				Int("failed", resp.Failed).
+				Msg("Clerk event retry finished")
+		}
+		return nil
+	})
+
+	go c.runPeriodically(ctx, "monitor_domains", domainMonitorInterval, func(ctx context.Context) error {
+		resp, err := c.monitorDomains.Execute(ctx, &brand_usecases.MonitorDomainsRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.Live > 0 || resp.Broken > 0 || resp.Failed > 0 {
+			c.logger.Info().
+				Int("checked", resp.Checked).
+				Int("live", resp.Live).
+				Int("broken", resp.Broken).
+				Int("failed", resp.Failed).
+				Msg("Custom domain monitor finished")
+		}
+		return nil
+	})
+
+	go c.runPeriodically(ctx, "purge_idempotency_keys", idempotencyPurgeInterval, func(ctx context.Context) error {
+		purged, err := c.IdempotencyStore.PurgeExpired(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		if purged > 0 {
+			c.logger.Info().Int("purged", purged).Msg("Expired idempotency keys purged")
+		}
+		return nil
+	})
+}
+
+// runPeriodically runs job immediately and then every interval until ctx is cancelled
+func (c *Composition) runPeriodically(ctx context.Context, name string, interval time.Duration, job func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job(ctx); err != nil && ctx.Err() == nil {
+			c.logger.Error().Err(err).Str("job", name).Msg("Background job failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
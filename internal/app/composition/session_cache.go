@@ -0,0 +1,148 @@
+package composition
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	auth_outbound "farohq-core-app/internal/domains/auth/domain/ports/outbound"
+	brand_model "farohq-core-app/internal/domains/brand/domain/model"
+	brand_outbound "farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+	users_model "farohq-core-app/internal/domains/users/domain/model"
+	users_outbound "farohq-core-app/internal/domains/users/domain/ports/outbound"
+)
+
+// The decorators below keep the cached session bootstrap fresh: every write that changes what GET /session
+// returns invalidates the affected user or tenant, whichever use case or job performs it.
+// Invalidation failures are logged; the cache TTL bounds staleness.
+
+func invalidateSessionUser(ctx context.Context, cache auth_outbound.SessionCache, userID uuid.UUID) {
+	if err := cache.InvalidateUser(ctx, userID); err != nil {
+		log.Warn().Err(err).Str("user_id", userID.String()).Msg("Failed to invalidate cached sessions of user")
+	}
+}
+
+func invalidateSessionTenant(ctx context.Context, cache auth_outbound.SessionCache, tenantID uuid.UUID) {
+	if err := cache.InvalidateTenant(ctx, tenantID); err != nil {
+		log.Warn().Err(err).Str("tenant_id", tenantID.String()).Msg("Failed to invalidate cached sessions of tenant")
+	}
+}
+
+// sessionInvalidatingUserRepository invalidates a user's sessions when their profile changes
+type sessionInvalidatingUserRepository struct {
+	users_outbound.UserRepository
+	cache auth_outbound.SessionCache
+}
+
+func (r *sessionInvalidatingUserRepository) Update(ctx context.Context, user *users_model.User) error {
+	if err := r.UserRepository.Update(ctx, user); err != nil {
+		return err
+	}
+	invalidateSessionUser(ctx, r.cache, user.ID())
+	return nil
+}
+
+// sessionInvalidatingTenantMemberRepository invalidates a user's sessions when their memberships change
+type sessionInvalidatingTenantMemberRepository struct {
+	tenants_outbound.TenantMemberRepository
+	cache auth_outbound.SessionCache
+}
+
+func (r *sessionInvalidatingTenantMemberRepository) Save(ctx context.Context, member *tenants_model.TenantMember) error {
+	if err := r.TenantMemberRepository.Save(ctx, member); err != nil {
+		return err
+	}
+	invalidateSessionUser(ctx, r.cache, member.UserID())
+	return nil
+}
+
+func (r *sessionInvalidatingTenantMemberRepository) Update(ctx context.Context, member *tenants_model.TenantMember) error {
+	if err := r.TenantMemberRepository.Update(ctx, member); err != nil {
+		return err
+	}
+	invalidateSessionUser(ctx, r.cache, member.UserID())
+	return nil
+}
+
+func (r *sessionInvalidatingTenantMemberRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	member, err := r.TenantMemberRepository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.TenantMemberRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	invalidateSessionUser(ctx, r.cache, member.UserID())
+	return nil
+}
+
+func (r *sessionInvalidatingTenantMemberRepository) DeleteByTenantAndUserID(ctx context.Context, tenantID, userID uuid.UUID) error {
+	if err := r.TenantMemberRepository.DeleteByTenantAndUserID(ctx, tenantID, userID); err != nil {
+		return err
+	}
+	invalidateSessionUser(ctx, r.cache, userID)
+	return nil
+}
+
+// sessionInvalidatingTenantRepository invalidates a tenant's sessions when its name, tier or status changes
+type sessionInvalidatingTenantRepository struct {
+	tenants_outbound.TenantRepository
+	cache auth_outbound.SessionCache
+}
+
+func (r *sessionInvalidatingTenantRepository) Update(ctx context.Context, tenant *tenants_model.Tenant) error {
+	if err := r.TenantRepository.Update(ctx, tenant); err != nil {
+		return err
+	}
+	invalidateSessionTenant(ctx, r.cache, tenant.ID())
+	return nil
+}
+
+func (r *sessionInvalidatingTenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.TenantRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	invalidateSessionTenant(ctx, r.cache, id)
+	return nil
+}
+
+func (r *sessionInvalidatingTenantRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	if err := r.TenantRepository.Purge(ctx, id); err != nil {
+		return err
+	}
+	invalidateSessionTenant(ctx, r.cache, id)
+	return nil
+}
+
+// sessionInvalidatingBrandRepository invalidates a tenant's sessions when its branding changes
+type sessionInvalidatingBrandRepository struct {
+	brand_outbound.BrandRepository
+	cache auth_outbound.SessionCache
+}
+
+func (r *sessionInvalidatingBrandRepository) Save(ctx context.Context, branding *brand_model.Branding) error {
+	if err := r.BrandRepository.Save(ctx, branding); err != nil {
+		return err
+	}
+	invalidateSessionTenant(ctx, r.cache, branding.AgencyID())
+	return nil
+}
+
+func (r *sessionInvalidatingBrandRepository) Update(ctx context.Context, branding *brand_model.Branding) error {
+	if err := r.BrandRepository.Update(ctx, branding); err != nil {
+		return err
+	}
+	invalidateSessionTenant(ctx, r.cache, branding.AgencyID())
+	return nil
+}
+
+func (r *sessionInvalidatingBrandRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	if err := r.BrandRepository.Delete(ctx, agencyID); err != nil {
+		return err
+	}
+	invalidateSessionTenant(ctx, r.cache, agencyID)
+	return nil
+}
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// EndImpersonation handles the use case of ending an impersonation session early
+type EndImpersonation struct {
+	sessionRepo outbound.ImpersonationSessionRepository
+	auditRepo   outbound.ImpersonationAuditRepository
+}
+
+// NewEndImpersonation creates a new EndImpersonation use case
+func NewEndImpersonation(sessionRepo outbound.ImpersonationSessionRepository, auditRepo outbound.ImpersonationAuditRepository) *EndImpersonation {
+	return &EndImpersonation{
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+	}
+}
+
+// EndImpersonationRequest represents the request to end an impersonation session
+type EndImpersonationRequest struct {
+	SessionID        uuid.UUID
+	ActorClerkUserID string
+}
+
+// EndImpersonationResponse represents the response from ending an impersonation session
+type EndImpersonationResponse struct {
+	Session *model.ImpersonationSession
+}
+
+// Execute executes the use case
+// Only the actor can end their session; ending an inactive session is a no-op.
+func (uc *EndImpersonation) Execute(ctx context.Context, req *EndImpersonationRequest) (*EndImpersonationResponse, error) {
+	session, err := uc.sessionRepo.FindByID(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.ActorClerkUserID() != req.ActorClerkUserID {
+		return nil, domain.ErrImpersonationSessionNotFound
+	}
+
+	if session.IsActive() {
+		session.End()
+		if err := uc.sessionRepo.Update(ctx, session); err != nil {
+			return nil, err
+		}
+		if err := uc.auditRepo.Save(ctx, model.NewImpersonationAuditEntry(session, model.ImpersonationActionEnd, "", "", 0)); err != nil {
+			log.Error().Err(err).Str("session_id", session.ID().String()).Msg("Failed to record impersonation audit entry")
+		}
+		log.Info().Str("session_id", session.ID().String()).Msg("Impersonation ended")
+	}
+
+	return &EndImpersonationResponse{
+		Session: session,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// GetSession handles the use case of assembling the session bootstrap
+type GetSession struct {
+	users    outbound.UserDirectory
+	tenants  outbound.TenantDirectory
+	branding outbound.BrandingReader
+	cache    outbound.SessionCache
+}
+
+// NewGetSession creates a new GetSession use case
+// cache is optional - if nil, every session is computed
+func NewGetSession(
+	users outbound.UserDirectory,
+	tenants outbound.TenantDirectory,
+	branding outbound.BrandingReader,
+	cache outbound.SessionCache,
+) *GetSession {
+	return &GetSession{
+		users:    users,
+		tenants:  tenants,
+		branding: branding,
+		cache:    cache,
+	}
+}
+
+// GetSessionRequest represents the request to get the session bootstrap
+type GetSessionRequest struct {
+	ClerkUserID string
+	TenantID    *uuid.UUID // resolved tenant; nil when the user has none yet
+}
+
+// GetSessionResponse represents the session bootstrap
+type GetSessionResponse struct {
+	Session *model.Session
+	Cached  bool
+}
+
+// Execute executes the use case
+// Memberships and branding are loaded concurrently, then the result is cached per user and tenant.
+func (uc *GetSession) Execute(ctx context.Context, req *GetSessionRequest) (*GetSessionResponse, error) {
+	user, err := uc.users.FindByClerkUserID(ctx, req.ClerkUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := uuid.Nil
+	if req.TenantID != nil {
+		tenantID = *req.TenantID
+	}
+
+	if uc.cache != nil {
+		if session, found := uc.cache.Get(ctx, user.ID, tenantID); found {
+			return &GetSessionResponse{Session: session, Cached: true}, nil
+		}
+	}
+
+	var (
+		wg            sync.WaitGroup
+		memberships   []model.SessionTenant
+		membershipErr error
+		branding      *model.SessionBranding
+		brandingErr   error
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		memberships, membershipErr = uc.tenants.ListMemberships(ctx, user.ID)
+	}()
+
+	if tenantID != uuid.Nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			branding, brandingErr = uc.branding.FindByTenantID(ctx, tenantID)
+		}()
+	}
+
+	wg.Wait()
+	if membershipErr != nil {
+		return nil, membershipErr
+	}
+	if brandingErr != nil {
+		return nil, brandingErr
+	}
+
+	session := &model.Session{
+		User: model.SessionUser{
+			ID:          user.ID,
+			ClerkUserID: user.ClerkUserID,
+			Email:       user.Email,
+			FirstName:   user.FirstName,
+			LastName:    user.LastName,
+			FullName:    user.FullName,
+			ImageURL:    user.ImageURL,
+		},
+		Organizations: make([]model.SessionOrganization, 0, len(memberships)),
+		ComputedAt:    time.Now(),
+	}
+
+	for i := range memberships {
+		membership := memberships[i]
+		session.Organizations = append(session.Organizations, model.SessionOrganization{
+			ID:   membership.ID,
+			Name: membership.Name,
+			Slug: membership.Slug,
+			Role: membership.Role,
+		})
+		if membership.ID == tenantID {
+			session.Tenant = &membership
+		}
+	}
+
+	if tenantID != uuid.Nil {
+		if session.Tenant == nil {
+			return nil, domain.ErrNotTenantMember
+		}
+		session.Branding = branding
+	}
+
+	if uc.cache != nil {
+		if err := uc.cache.Set(ctx, user.ID, tenantID, session); err != nil {
+			// The session is still valid, only the next request pays for recomputing it
+			log.Warn().Err(err).Str("user_id", user.ID.String()).Msg("Failed to cache session")
+		}
+	}
+
+	return &GetSessionResponse{
+		Session: session,
+	}, nil
+}
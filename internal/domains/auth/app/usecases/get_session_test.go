@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantDirectory is a mock implementation of TenantDirectory
+type MockTenantDirectory struct {
+	mock.Mock
+}
+
+func (m *MockTenantDirectory) ListMemberships(ctx context.Context, userID uuid.UUID) ([]model.SessionTenant, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]model.SessionTenant), args.Error(1)
+}
+
+// MockBrandingReader is a mock implementation of BrandingReader
+type MockBrandingReader struct {
+	mock.Mock
+}
+
+func (m *MockBrandingReader) FindByTenantID(ctx context.Context, tenantID uuid.UUID) (*model.SessionBranding, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SessionBranding), args.Error(1)
+}
+
+// MockSessionCache is a mock implementation of SessionCache
+type MockSessionCache struct {
+	mock.Mock
+}
+
+func (m *MockSessionCache) Get(ctx context.Context, userID, tenantID uuid.UUID) (*model.Session, bool) {
+	args := m.Called(ctx, userID, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1)
+	}
+	return args.Get(0).(*model.Session), args.Bool(1)
+}
+
+func (m *MockSessionCache) Set(ctx context.Context, userID, tenantID uuid.UUID, session *model.Session) error {
+	args := m.Called(ctx, userID, tenantID, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionCache) InvalidateUser(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockSessionCache) InvalidateTenant(ctx context.Context, tenantID uuid.UUID) error {
+	args := m.Called(ctx, tenantID)
+	return args.Error(0)
+}
+
+func TestGetSession_Execute(t *testing.T) {
+	user := &outbound.UserIdentity{ID: uuid.New(), ClerkUserID: "user_1", Email: "owner@example.com"}
+	current := model.SessionTenant{ID: uuid.New(), Name: "Acme", Slug: "acme", Role: "owner", Permissions: []string{"manage_tenant"}}
+	other := model.SessionTenant{ID: uuid.New(), Name: "Other", Slug: "other", Role: "viewer"}
+	branding := &model.SessionBranding{PrimaryColor: "#112233"}
+
+	t.Run("computes and caches the session", func(t *testing.T) {
+		users := new(MockUserDirectory)
+		tenants := new(MockTenantDirectory)
+		brandingReader := new(MockBrandingReader)
+		cache := new(MockSessionCache)
+
+		users.On("FindByClerkUserID", mock.Anything, "user_1").Return(user, nil)
+		cache.On("Get", mock.Anything, user.ID, current.ID).Return(nil, false)
+		tenants.On("ListMemberships", mock.Anything, user.ID).Return([]model.SessionTenant{current, other}, nil)
+		brandingReader.On("FindByTenantID", mock.Anything, current.ID).Return(branding, nil)
+		cache.On("Set", mock.Anything, user.ID, current.ID, mock.Anything).Return(nil)
+
+		uc := NewGetSession(users, tenants, brandingReader, cache)
+		resp, err := uc.Execute(context.Background(), &GetSessionRequest{ClerkUserID: "user_1", TenantID: &current.ID})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Cached)
+		assert.Equal(t, "owner@example.com", resp.Session.User.Email)
+		require.NotNil(t, resp.Session.Tenant)
+		assert.Equal(t, current.ID, resp.Session.Tenant.ID)
+		assert.Equal(t, branding, resp.Session.Branding)
+		assert.Len(t, resp.Session.Organizations, 2)
+		cache.AssertCalled(t, "Set", mock.Anything, user.ID, current.ID, resp.Session)
+	})
+
+	t.Run("returns the cached session", func(t *testing.T) {
+		users := new(MockUserDirectory)
+		tenants := new(MockTenantDirectory)
+		brandingReader := new(MockBrandingReader)
+		cache := new(MockSessionCache)
+		cached := &model.Session{User: model.SessionUser{ID: user.ID}}
+
+		users.On("FindByClerkUserID", mock.Anything, "user_1").Return(user, nil)
+		cache.On("Get", mock.Anything, user.ID, current.ID).Return(cached, true)
+
+		uc := NewGetSession(users, tenants, brandingReader, cache)
+		resp, err := uc.Execute(context.Background(), &GetSessionRequest{ClerkUserID: "user_1", TenantID: &current.ID})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Cached)
+		assert.Same(t, cached, resp.Session)
+		tenants.AssertNotCalled(t, "ListMemberships", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without tenant and cache", func(t *testing.T) {
+		users := new(MockUserDirectory)
+		tenants := new(MockTenantDirectory)
+		brandingReader := new(MockBrandingReader)
+
+		users.On("FindByClerkUserID", mock.Anything, "user_1").Return(user, nil)
+		tenants.On("ListMemberships", mock.Anything, user.ID).Return([]model.SessionTenant{}, nil)
+
+		uc := NewGetSession(users, tenants, brandingReader, nil)
+		resp, err := uc.Execute(context.Background(), &GetSessionRequest{ClerkUserID: "user_1"})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Session.Tenant)
+		assert.Nil(t, resp.Session.Branding)
+		assert.Empty(t, resp.Session.Organizations)
+		brandingReader.AssertNotCalled(t, "FindByTenantID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects a tenant the user does not belong to", func(t *testing.T) {
+		users := new(MockUserDirectory)
+		tenants := new(MockTenantDirectory)
+		brandingReader := new(MockBrandingReader)
+		stranger := uuid.New()
+
+		users.On("FindByClerkUserID", mock.Anything, "user_1").Return(user, nil)
+		tenants.On("ListMemberships", mock.Anything, user.ID).Return([]model.SessionTenant{current}, nil)
+		brandingReader.On("FindByTenantID", mock.Anything, stranger).Return(nil, nil)
+
+		uc := NewGetSession(users, tenants, brandingReader, nil)
+		_, err := uc.Execute(context.Background(), &GetSessionRequest{ClerkUserID: "user_1", TenantID: &stranger})
+
+		assert.Equal(t, domain.ErrNotTenantMember, err)
+	})
+}
@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+)
+
+// RecordImpersonationActivity handles the use case of audit-logging a request made while impersonating (allowed or blocked)
+type RecordImpersonationActivity struct {
+	auditRepo outbound.ImpersonationAuditRepository
+}
+
+// NewRecordImpersonationActivity creates a new RecordImpersonationActivity use case
+func NewRecordImpersonationActivity(auditRepo outbound.ImpersonationAuditRepository) *RecordImpersonationActivity {
+	return &RecordImpersonationActivity{
+		auditRepo: auditRepo,
+	}
+}
+
+// RecordImpersonationActivityRequest represents an impersonated request to audit
+type RecordImpersonationActivityRequest struct {
+	Session    *model.ImpersonationSession
+	Method     string
+	Path       string
+	StatusCode int
+	Blocked    bool
+}
+
+// Execute executes the use case
+func (uc *RecordImpersonationActivity) Execute(ctx context.Context, req *RecordImpersonationActivityRequest) error {
+	action := model.ImpersonationActionRequest
+	if req.Blocked {
+		action = model.ImpersonationActionBlocked
+	}
+
+	return uc.auditRepo.Save(ctx, model.NewImpersonationAuditEntry(req.Session, action, req.Method, req.Path, req.StatusCode))
+}
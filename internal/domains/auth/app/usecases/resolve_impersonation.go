@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ResolveImpersonation handles the use case of validating an impersonation session for a request
+type ResolveImpersonation struct {
+	sessionRepo    outbound.ImpersonationSessionRepository
+	auditRepo      outbound.ImpersonationAuditRepository
+	users          outbound.UserDirectory
+	memberships    outbound.TenantMembershipReader
+	platformAdmins map[string]struct{}
+}
+
+// NewResolveImpersonation creates a new ResolveImpersonation use case
+// platformAdminUserIDs are the Clerk user IDs of platform support staff, as for StartImpersonation.
+func NewResolveImpersonation(
+	sessionRepo outbound.ImpersonationSessionRepository,
+	auditRepo outbound.ImpersonationAuditRepository,
+	users outbound.UserDirectory,
+	memberships outbound.TenantMembershipReader,
+	platformAdminUserIDs []string,
+) *ResolveImpersonation {
+	return &ResolveImpersonation{
+		sessionRepo:    sessionRepo,
+		auditRepo:      auditRepo,
+		users:          users,
+		memberships:    memberships,
+		platformAdmins: newPlatformAdminSet(platformAdminUserIDs),
+	}
+}
+
+// ResolveImpersonationRequest represents the request to resolve an impersonation session
+type ResolveImpersonationRequest struct {
+	SessionID        uuid.UUID
+	ActorClerkUserID string
+}
+
+// ResolveImpersonationResponse represents the effective principal of an impersonated request
+type ResolveImpersonationResponse struct {
+	Session    *model.ImpersonationSession
+	Target     *outbound.UserIdentity
+	TargetRole string
+}
+
+// Execute executes the use case
+// The target's role and the actor's authority are read on every request so membership changes take effect immediately.
+// A session whose actor may no longer impersonate the target is ended and fails with ErrImpersonationNotAllowed.
+func (uc *ResolveImpersonation) Execute(ctx context.Context, req *ResolveImpersonationRequest) (*ResolveImpersonationResponse, error) {
+	session, err := uc.sessionRepo.FindByID(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.ActorClerkUserID() != req.ActorClerkUserID {
+		return nil, domain.ErrImpersonationSessionNotFound
+	}
+	if !session.IsActive() {
+		return nil, domain.ErrImpersonationSessionInactive
+	}
+
+	target, err := uc.users.FindByID(ctx, session.TargetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uc.memberships.MemberRole(ctx, session.TenantID(), session.TargetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkImpersonationAllowed(ctx, uc.memberships, uc.platformAdmins, session.ActorClerkUserID(), session.ActorUserID(), session.TenantID(), role)
+	if err == domain.ErrImpersonationNotAllowed {
+		uc.end(ctx, session)
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolveImpersonationResponse{
+		Session:    session,
+		Target:     target,
+		TargetRole: role,
+	}, nil
+}
+
+// end ends a session whose actor lost the right to impersonate; failures are logged since the request is rejected either way
+func (uc *ResolveImpersonation) end(ctx context.Context, session *model.ImpersonationSession) {
+	session.End()
+	if err := uc.sessionRepo.Update(ctx, session); err != nil {
+		log.Error().Err(err).Str("session_id", session.ID().String()).Msg("Failed to end impersonation session")
+		return
+	}
+	if err := uc.auditRepo.Save(ctx, model.NewImpersonationAuditEntry(session, model.ImpersonationActionEnd, "", "", 0)); err != nil {
+		log.Error().Err(err).Str("session_id", session.ID().String()).Msg("Failed to record impersonation audit entry")
+	}
+	log.Warn().Str("session_id", session.ID().String()).Msg("Impersonation ended: actor may no longer impersonate the target")
+}
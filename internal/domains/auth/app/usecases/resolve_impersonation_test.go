@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveImpersonation_RechecksActorAuthority(t *testing.T) {
+	tests := []struct {
+		name           string
+		platformAdmins []string
+		actorRole      string // empty when the actor is no longer a member
+		targetRole     string
+		wantErr        error
+	}{
+		{"owner still impersonating a client viewer", nil, roleOwner, roleClientViewer, nil},
+		{"owner demoted mid-session", nil, "admin", roleClientViewer, domain.ErrImpersonationNotAllowed},
+		{"owner removed from the tenant", nil, "", roleClientViewer, domain.ErrImpersonationNotAllowed},
+		{"platform admin impersonating an admin", []string{"user_actor"}, "", "admin", nil},
+		{"platform admin whose flag was revoked", nil, "", "admin", domain.ErrImpersonationNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID := uuid.New()
+			actorID := uuid.New()
+			target := &outbound.UserIdentity{ID: uuid.New(), ClerkUserID: "user_target"}
+			session := model.NewImpersonationSession(actorID, "user_actor", target.ID, target.ClerkUserID, tenantID, "support ticket 42", time.Hour)
+
+			sessionRepo := new(MockImpersonationSessionRepository)
+			auditRepo := new(MockImpersonationAuditRepository)
+			users := new(MockUserDirectory)
+			memberships := new(MockTenantMembershipReader)
+
+			sessionRepo.On("FindByID", mock.Anything, session.ID()).Return(session, nil)
+			sessionRepo.On("Update", mock.Anything, session).Return(nil).Maybe()
+			auditRepo.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+			users.On("FindByID", mock.Anything, target.ID).Return(target, nil)
+			memberships.On("MemberRole", mock.Anything, tenantID, target.ID).Return(tt.targetRole, nil)
+			if tt.actorRole != "" {
+				memberships.On("MemberRole", mock.Anything, tenantID, actorID).Return(tt.actorRole, nil)
+			} else {
+				memberships.On("MemberRole", mock.Anything, tenantID, actorID).Return("", domain.ErrNotTenantMember)
+			}
+
+			uc := NewResolveImpersonation(sessionRepo, auditRepo, users, memberships, tt.platformAdmins)
+			resp, err := uc.Execute(context.Background(), &ResolveImpersonationRequest{
+				SessionID:        session.ID(),
+				ActorClerkUserID: "user_actor",
+			})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, resp)
+				assert.False(t, session.IsActive(), "the session is ended")
+				sessionRepo.AssertCalled(t, "Update", mock.Anything, session)
+				auditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry *model.ImpersonationAuditEntry) bool {
+					return entry.Action() == model.ImpersonationActionEnd
+				}))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.targetRole, resp.TargetRole)
+			assert.True(t, session.IsActive())
+			sessionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Impersonation session durations
+const (
+	DefaultImpersonationDuration = 30 * time.Minute
+	MaxImpersonationDuration     = time.Hour
+)
+
+// Tenant roles relevant to impersonation rules
+const (
+	roleOwner        = "owner"
+	roleClientViewer = "client_viewer"
+)
+
+// StartImpersonation handles the use case of starting an impersonation session
+type StartImpersonation struct {
+	sessionRepo    outbound.ImpersonationSessionRepository
+	auditRepo      outbound.ImpersonationAuditRepository
+	users          outbound.UserDirectory
+	memberships    outbound.TenantMembershipReader
+	platformAdmins map[string]struct{}
+}
+
+// NewStartImpersonation creates a new StartImpersonation use case
+// platformAdminUserIDs are the Clerk user IDs of platform support staff.
+func NewStartImpersonation(
+	sessionRepo outbound.ImpersonationSessionRepository,
+	auditRepo outbound.ImpersonationAuditRepository,
+	users outbound.UserDirectory,
+	memberships outbound.TenantMembershipReader,
+	platformAdminUserIDs []string,
+) *StartImpersonation {
+	return &StartImpersonation{
+		sessionRepo:    sessionRepo,
+		auditRepo:      auditRepo,
+		users:          users,
+		memberships:    memberships,
+		platformAdmins: newPlatformAdminSet(platformAdminUserIDs),
+	}
+}
+
+// StartImpersonationRequest represents the request to start impersonating a user
+type StartImpersonationRequest struct {
+	ActorClerkUserID string
+	TenantID         uuid.UUID
+	TargetUserID     uuid.UUID
+	Reason           string
+	Duration         time.Duration // defaults to DefaultImpersonationDuration, capped at MaxImpersonationDuration
+}
+
+// StartImpersonationResponse represents the response from starting impersonation
+type StartImpersonationResponse struct {
+	Session *model.ImpersonationSession
+}
+
+// Execute executes the use case
+// Platform support may impersonate any member of the tenant; agency owners only the tenant's client members.
+// Any other active session of the actor is ended first.
+func (uc *StartImpersonation) Execute(ctx context.Context, req *StartImpersonationRequest) (*StartImpersonationResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, domain.ErrImpersonationReasonRequired
+	}
+
+	actor, err := uc.users.FindByClerkUserID(ctx, req.ActorClerkUserID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.ID == req.TargetUserID {
+		return nil, domain.ErrCannotImpersonateSelf
+	}
+
+	target, err := uc.users.FindByID(ctx, req.TargetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetRole, err := uc.memberships.MemberRole(ctx, req.TenantID, target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkImpersonationAllowed(ctx, uc.memberships, uc.platformAdmins, req.ActorClerkUserID, actor.ID, req.TenantID, targetRole); err != nil {
+		return nil, err
+	}
+
+	duration := req.Duration
+	if duration <= 0 {
+		duration = DefaultImpersonationDuration
+	}
+	if duration > MaxImpersonationDuration {
+		duration = MaxImpersonationDuration
+	}
+
+	active, err := uc.sessionRepo.ListActiveByActor(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, previous := range active {
+		previous.End()
+		if err := uc.sessionRepo.Update(ctx, previous); err != nil {
+			return nil, err
+		}
+		uc.audit(ctx, model.NewImpersonationAuditEntry(previous, model.ImpersonationActionEnd, "", "", 0))
+	}
+
+	session := model.NewImpersonationSession(actor.ID, actor.ClerkUserID, target.ID, target.ClerkUserID, req.TenantID, reason, duration)
+	if err := uc.sessionRepo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	uc.audit(ctx, model.NewImpersonationAuditEntry(session, model.ImpersonationActionStart, "", "", 0))
+
+	log.Info().
+		Str("session_id", session.ID().String()).
+		Str("actor_user_id", actor.ID.String()).
+		Str("target_user_id", target.ID.String()).
+		Str("tenant_id", req.TenantID.String()).
+		Str("reason", reason).
+		Time("expires_at", session.ExpiresAt()).
+		Msg("Impersonation started")
+
+	return &StartImpersonationResponse{
+		Session: session,
+	}, nil
+}
+
+// newPlatformAdminSet builds the set of platform admin Clerk user IDs
+func newPlatformAdminSet(platformAdminUserIDs []string) map[string]struct{} {
+	platformAdmins := make(map[string]struct{}, len(platformAdminUserIDs))
+	for _, id := range platformAdminUserIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			platformAdmins[id] = struct{}{}
+		}
+	}
+	return platformAdmins
+}
+
+// checkImpersonationAllowed returns ErrImpersonationNotAllowed unless the actor is a platform admin,
+// or an owner of the tenant impersonating one of its client viewers
+func checkImpersonationAllowed(
+	ctx context.Context,
+	memberships outbound.TenantMembershipReader,
+	platformAdmins map[string]struct{},
+	actorClerkUserID string,
+	actorUserID, tenantID uuid.UUID,
+	targetRole string,
+) error {
+	if _, isPlatformAdmin := platformAdmins[actorClerkUserID]; isPlatformAdmin {
+		return nil
+	}
+
+	actorRole, err := memberships.MemberRole(ctx, tenantID, actorUserID)
+	if err != nil && err != domain.ErrNotTenantMember {
+		return err
+	}
+	if actorRole != roleOwner || targetRole != roleClientViewer {
+		return domain.ErrImpersonationNotAllowed
+	}
+	return nil
+}
+
+// audit records an audit entry; failures are logged rather than failing the request
+func (uc *StartImpersonation) audit(ctx context.Context, entry *model.ImpersonationAuditEntry) {
+	if err := uc.auditRepo.Save(ctx, entry); err != nil {
+		log.Error().Err(err).Str("session_id", entry.SessionID().String()).Msg("Failed to record impersonation audit entry")
+	}
+}
@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockImpersonationSessionRepository is a mock implementation of ImpersonationSessionRepository
+type MockImpersonationSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockImpersonationSessionRepository) Save(ctx context.Context, session *model.ImpersonationSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockImpersonationSessionRepository) Update(ctx context.Context, session *model.ImpersonationSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockImpersonationSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ImpersonationSession, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ImpersonationSession), args.Error(1)
+}
+
+func (m *MockImpersonationSessionRepository) ListActiveByActor(ctx context.Context, actorUserID uuid.UUID) ([]*model.ImpersonationSession, error) {
+	args := m.Called(ctx, actorUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.ImpersonationSession), args.Error(1)
+}
+
+// MockImpersonationAuditRepository is a mock implementation of ImpersonationAuditRepository
+type MockImpersonationAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockImpersonationAuditRepository) Save(ctx context.Context, entry *model.ImpersonationAuditEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// MockUserDirectory is a mock implementation of UserDirectory
+type MockUserDirectory struct {
+	mock.Mock
+}
+
+func (m *MockUserDirectory) FindByID(ctx context.Context, id uuid.UUID) (*outbound.UserIdentity, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*outbound.UserIdentity), args.Error(1)
+}
+
+func (m *MockUserDirectory) FindByClerkUserID(ctx context.Context, clerkUserID string) (*outbound.UserIdentity, error) {
+	args := m.Called(ctx, clerkUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*outbound.UserIdentity), args.Error(1)
+}
+
+// MockTenantMembershipReader is a mock implementation of TenantMembershipReader
+type MockTenantMembershipReader struct {
+	mock.Mock
+}
+
+func (m *MockTenantMembershipReader) MemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error) {
+	args := m.Called(ctx, tenantID, userID)
+	return args.String(0), args.Error(1)
+}
+
+func TestStartImpersonation_Execute(t *testing.T) {
+	tenantID := uuid.New()
+	actor := &outbound.UserIdentity{ID: uuid.New(), ClerkUserID: "user_actor"}
+	target := &outbound.UserIdentity{ID: uuid.New(), ClerkUserID: "user_target"}
+
+	tests := []struct {
+		name           string
+		platformAdmins []string
+		actorRole      string
+		targetRole     string
+		wantErr        error
+	}{
+		{"owner impersonates client viewer", nil, roleOwner, roleClientViewer, nil},
+		{"owner cannot impersonate admin", nil, roleOwner, "admin", domain.ErrImpersonationNotAllowed},
+		{"admin cannot impersonate client viewer", nil, "admin", roleClientViewer, domain.ErrImpersonationNotAllowed},
+		{"platform admin impersonates admin", []string{"user_actor"}, "", "admin", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionRepo := new(MockImpersonationSessionRepository)
+			auditRepo := new(MockImpersonationAuditRepository)
+			users := new(MockUserDirectory)
+			memberships := new(MockTenantMembershipReader)
+
+			users.On("FindByClerkUserID", mock.Anything, "user_actor").Return(actor, nil)
+			users.On("FindByID", mock.Anything, target.ID).Return(target, nil)
+			memberships.On("MemberRole", mock.Anything, tenantID, target.ID).Return(tt.targetRole, nil)
+			if tt.actorRole != "" {
+				memberships.On("MemberRole", mock.Anything, tenantID, actor.ID).Return(tt.actorRole, nil)
+			}
+			sessionRepo.On("ListActiveByActor", mock.Anything, actor.ID).Return([]*model.ImpersonationSession{}, nil)
+			sessionRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+			auditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+			uc := NewStartImpersonation(sessionRepo, auditRepo, users, memberships, tt.platformAdmins)
+			resp, err := uc.Execute(context.Background(), &StartImpersonationRequest{
+				ActorClerkUserID: "user_actor",
+				TenantID:         tenantID,
+				TargetUserID:     target.ID,
+				Reason:           "support ticket 42",
+				Duration:         4 * time.Hour,
+			})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				sessionRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, resp.Session.IsActive())
+			assert.WithinDuration(t, time.Now().Add(MaxImpersonationDuration), resp.Session.ExpiresAt(), time.Minute)
+			auditRepo.AssertNumberOfCalls(t, "Save", 1)
+		})
+	}
+}
+
+func TestStartImpersonation_Execute_EndsPreviousSession(t *testing.T) {
+	tenantID := uuid.New()
+	actor := &outbound.UserIdentity{ID: uuid.New(), ClerkUserID: "user_actor"}
+	target := &outbound.UserIdentity{ID: uuid.New(), ClerkUserID: "user_target"}
+	previous := model.NewImpersonationSession(actor.ID, actor.ClerkUserID, uuid.New(), "user_other", tenantID, "earlier", time.Hour)
+
+	sessionRepo := new(MockImpersonationSessionRepository)
+	auditRepo := new(MockImpersonationAuditRepository)
+	users := new(MockUserDirectory)
+	memberships := new(MockTenantMembershipReader)
+
+	users.On("FindByClerkUserID", mock.Anything, "user_actor").Return(actor, nil)
+	users.On("FindByID", mock.Anything, target.ID).Return(target, nil)
+	memberships.On("MemberRole", mock.Anything, tenantID, target.ID).Return(roleClientViewer, nil)
+	memberships.On("MemberRole", mock.Anything, tenantID, actor.ID).Return(roleOwner, nil)
+	sessionRepo.On("ListActiveByActor", mock.Anything, actor.ID).Return([]*model.ImpersonationSession{previous}, nil)
+	sessionRepo.On("Update", mock.Anything, previous).Return(nil)
+	sessionRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	auditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	uc := NewStartImpersonation(sessionRepo, auditRepo, users, memberships, nil)
+	_, err := uc.Execute(context.Background(), &StartImpersonationRequest{
+		ActorClerkUserID: "user_actor",
+		TenantID:         tenantID,
+		TargetUserID:     target.ID,
+		Reason:           "support ticket 42",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, previous.IsActive())
+	auditRepo.AssertNumberOfCalls(t, "Save", 2)
+}
+
+func TestStartImpersonation_Execute_RequiresReason(t *testing.T) {
+	uc := NewStartImpersonation(nil, nil, nil, nil, nil)
+	_, err := uc.Execute(context.Background(), &StartImpersonationRequest{
+		ActorClerkUserID: "user_actor",
+		Reason:           "   ",
+	})
+	assert.Equal(t, domain.ErrImpersonationReasonRequired, err)
+}
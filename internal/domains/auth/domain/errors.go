@@ -0,0 +1,26 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrImpersonationNotAllowed is returned when the actor may not impersonate the target
+	ErrImpersonationNotAllowed = errors.New("impersonation not allowed")
+
+	// ErrImpersonationSessionNotFound is returned when an impersonation session is not found
+	ErrImpersonationSessionNotFound = errors.New("impersonation session not found")
+
+	// ErrImpersonationSessionInactive is returned when an impersonation session has ended or expired
+	ErrImpersonationSessionInactive = errors.New("impersonation session has ended or expired")
+
+	// ErrImpersonationReasonRequired is returned when starting impersonation without a reason
+	ErrImpersonationReasonRequired = errors.New("a reason is required to impersonate a user")
+
+	// ErrCannotImpersonateSelf is returned when the actor and target are the same user
+	ErrCannotImpersonateSelf = errors.New("cannot impersonate yourself")
+
+	// ErrUserNotFound is returned when the actor or target user is not found
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrNotTenantMember is returned when a user is not a member of the tenant
+	ErrNotTenantMember = errors.New("user is not a member of this tenant")
+)
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImpersonationAction represents what happened in an impersonation audit entry
+type ImpersonationAction string
+
+const (
+	ImpersonationActionStart   ImpersonationAction = "start"
+	ImpersonationActionEnd     ImpersonationAction = "end"
+	ImpersonationActionRequest ImpersonationAction = "request"
+	ImpersonationActionBlocked ImpersonationAction = "blocked"
+)
+
+// ImpersonationAuditEntry records an action taken within an impersonation session
+type ImpersonationAuditEntry struct {
+	id           uuid.UUID
+	sessionID    uuid.UUID
+	actorUserID  uuid.UUID
+	targetUserID uuid.UUID
+	tenantID     uuid.UUID
+	action       ImpersonationAction
+	method       string
+	path         string
+	statusCode   int
+	createdAt    time.Time
+}
+
+// NewImpersonationAuditEntry creates an audit entry for a session action (method, path and status are empty for start/end)
+func NewImpersonationAuditEntry(session *ImpersonationSession, action ImpersonationAction, method, path string, statusCode int) *ImpersonationAuditEntry {
+	return &ImpersonationAuditEntry{
+		id:           uuid.New(),
+		sessionID:    session.ID(),
+		actorUserID:  session.ActorUserID(),
+		targetUserID: session.TargetUserID(),
+		tenantID:     session.TenantID(),
+		action:       action,
+		method:       method,
+		path:         path,
+		statusCode:   statusCode,
+		createdAt:    time.Now(),
+	}
+}
+
+// ID returns the entry ID
+func (e *ImpersonationAuditEntry) ID() uuid.UUID {
+	return e.id
+}
+
+// SessionID returns the impersonation session ID
+func (e *ImpersonationAuditEntry) SessionID() uuid.UUID {
+	return e.sessionID
+}
+
+// ActorUserID returns the real user's ID
+func (e *ImpersonationAuditEntry) ActorUserID() uuid.UUID {
+	return e.actorUserID
+}
+
+// TargetUserID returns the impersonated user's ID
+func (e *ImpersonationAuditEntry) TargetUserID() uuid.UUID {
+	return e.targetUserID
+}
+
+// TenantID returns the tenant of the session
+func (e *ImpersonationAuditEntry) TenantID() uuid.UUID {
+	return e.tenantID
+}
+
+// Action returns the audited action
+func (e *ImpersonationAuditEntry) Action() ImpersonationAction {
+	return e.action
+}
+
+// Method returns the HTTP method of an audited request
+func (e *ImpersonationAuditEntry) Method() string {
+	return e.method
+}
+
+// Path returns the path of an audited request
+func (e *ImpersonationAuditEntry) Path() string {
+	return e.path
+}
+
+// StatusCode returns the response status of an audited request
+func (e *ImpersonationAuditEntry) StatusCode() int {
+	return e.statusCode
+}
+
+// CreatedAt returns when the entry was recorded
+func (e *ImpersonationAuditEntry) CreatedAt() time.Time {
+	return e.createdAt
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImpersonationSession represents a time-boxed period in which an actor acts as another user within one tenant
+type ImpersonationSession struct {
+	id                uuid.UUID
+	actorUserID       uuid.UUID
+	actorClerkUserID  string
+	targetUserID      uuid.UUID
+	targetClerkUserID string
+	tenantID          uuid.UUID
+	reason            string
+	startedAt         time.Time
+	expiresAt         time.Time
+	endedAt           *time.Time
+}
+
+// NewImpersonationSession creates a new impersonation session that expires after duration
+func NewImpersonationSession(actorUserID uuid.UUID, actorClerkUserID string, targetUserID uuid.UUID, targetClerkUserID string, tenantID uuid.UUID, reason string, duration time.Duration) *ImpersonationSession {
+	now := time.Now()
+	return &ImpersonationSession{
+		id:                uuid.New(),
+		actorUserID:       actorUserID,
+		actorClerkUserID:  actorClerkUserID,
+		targetUserID:      targetUserID,
+		targetClerkUserID: targetClerkUserID,
+		tenantID:          tenantID,
+		reason:            reason,
+		startedAt:         now,
+		expiresAt:         now.Add(duration),
+	}
+}
+
+// NewImpersonationSessionWithID creates an impersonation session with all fields (used for reconstruction from database)
+func NewImpersonationSessionWithID(id, actorUserID uuid.UUID, actorClerkUserID string, targetUserID uuid.UUID, targetClerkUserID string, tenantID uuid.UUID, reason string, startedAt, expiresAt time.Time, endedAt *time.Time) *ImpersonationSession {
+	return &ImpersonationSession{
+		id:                id,
+		actorUserID:       actorUserID,
+		actorClerkUserID:  actorClerkUserID,
+		targetUserID:      targetUserID,
+		targetClerkUserID: targetClerkUserID,
+		tenantID:          tenantID,
+		reason:            reason,
+		startedAt:         startedAt,
+		expiresAt:         expiresAt,
+		endedAt:           endedAt,
+	}
+}
+
+// ID returns the session ID
+func (s *ImpersonationSession) ID() uuid.UUID {
+	return s.id
+}
+
+// ActorUserID returns the real user's ID
+func (s *ImpersonationSession) ActorUserID() uuid.UUID {
+	return s.actorUserID
+}
+
+// ActorClerkUserID returns the real user's Clerk user ID
+func (s *ImpersonationSession) ActorClerkUserID() string {
+	return s.actorClerkUserID
+}
+
+// TargetUserID returns the impersonated user's ID
+func (s *ImpersonationSession) TargetUserID() uuid.UUID {
+	return s.targetUserID
+}
+
+// TargetClerkUserID returns the impersonated user's Clerk user ID
+func (s *ImpersonationSession) TargetClerkUserID() string {
+	return s.targetClerkUserID
+}
+
+// TenantID returns the tenant the session is limited to
+func (s *ImpersonationSession) TenantID() uuid.UUID {
+	return s.tenantID
+}
+
+// Reason returns why the session was started
+func (s *ImpersonationSession) Reason() string {
+	return s.reason
+}
+
+// StartedAt returns when the session started
+func (s *ImpersonationSession) StartedAt() time.Time {
+	return s.startedAt
+}
+
+// ExpiresAt returns when the session expires
+func (s *ImpersonationSession) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+// EndedAt returns when the session was ended early
+func (s *ImpersonationSession) EndedAt() *time.Time {
+	return s.endedAt
+}
+
+// IsActive returns true if the session has not been ended and has not expired
+func (s *ImpersonationSession) IsActive() bool {
+	return s.endedAt == nil && time.Now().Before(s.expiresAt)
+}
+
+// End ends the session
+func (s *ImpersonationSession) End() {
+	if s.endedAt != nil {
+		return
+	}
+	now := time.Now()
+	s.endedAt = &now
+}
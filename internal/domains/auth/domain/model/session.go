@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is the bootstrap snapshot the frontend loads on every page: user, tenant, permissions, branding and switchable orgs
+// It is a read model assembled from other domains and stored as-is in the session cache, hence the exported fields.
+type Session struct {
+	User          SessionUser           `json:"user"`
+	Tenant        *SessionTenant        `json:"tenant,omitempty"`
+	Branding      *SessionBranding      `json:"branding,omitempty"`
+	Organizations []SessionOrganization `json:"organizations"`
+	ComputedAt    time.Time             `json:"computed_at"`
+}
+
+// SessionUser is the synced user of a session
+type SessionUser struct {
+	ID          uuid.UUID `json:"id"`
+	ClerkUserID string    `json:"clerk_user_id"`
+	Email       string    `json:"email"`
+	FirstName   string    `json:"first_name"`
+	LastName    string    `json:"last_name"`
+	FullName    string    `json:"full_name"`
+	ImageURL    string    `json:"image_url"`
+}
+
+// SessionTenant is a tenant the user belongs to, with the user's role and what it grants
+type SessionTenant struct {
+	ID           uuid.UUID           `json:"id"`
+	Name         string              `json:"name"`
+	Slug         string              `json:"slug"`
+	Status       string              `json:"status"`
+	Tier         string              `json:"tier"`
+	Suspended    bool                `json:"suspended"`
+	Role         string              `json:"role"`
+	Permissions  []string            `json:"permissions"`
+	Entitlements SessionEntitlements `json:"entitlements"`
+}
+
+// SessionEntitlements are the features unlocked by the tenant's tier
+type SessionEntitlements struct {
+	ClientLimit   int  `json:"client_limit"`
+	CustomDomain  bool `json:"custom_domain"`
+	HidePoweredBy bool `json:"hide_powered_by"`
+	SendingDomain bool `json:"sending_domain"`
+	UsesSubdomain bool `json:"uses_subdomain"`
+}
+
+// SessionBranding are the branding tokens of the tenant
+type SessionBranding struct {
+	LogoURL        string                 `json:"logo_url"`
+	FaviconURL     string                 `json:"favicon_url"`
+	PrimaryColor   string                 `json:"primary_color"`
+	SecondaryColor string                 `json:"secondary_color"`
+	Theme          map[string]interface{} `json:"theme"`
+	HidePoweredBy  bool                   `json:"hide_powered_by"`
+	Domain         string                 `json:"domain"`
+	Subdomain      string                 `json:"subdomain"`
+}
+
+// SessionOrganization is an organization the user can switch to
+type SessionOrganization struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug"`
+	Role string    `json:"role"`
+}
@@ -0,0 +1,32 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity is the subset of a user needed to act as them
+type UserIdentity struct {
+	ID          uuid.UUID
+	ClerkUserID string
+	Email       string
+	FirstName   string
+	LastName    string
+	FullName    string
+	ImageURL    string
+}
+
+// UserDirectory defines the interface for looking up users (implemented by the users domain)
+type UserDirectory interface {
+	// FindByID returns domain.ErrUserNotFound when the user does not exist
+	FindByID(ctx context.Context, id uuid.UUID) (*UserIdentity, error)
+	// FindByClerkUserID returns domain.ErrUserNotFound when the user does not exist
+	FindByClerkUserID(ctx context.Context, clerkUserID string) (*UserIdentity, error)
+}
+
+// TenantMembershipReader defines the interface for reading tenant roles (implemented by the tenants domain)
+type TenantMembershipReader interface {
+	// MemberRole returns the user's role in the tenant, or domain.ErrNotTenantMember
+	MemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error)
+}
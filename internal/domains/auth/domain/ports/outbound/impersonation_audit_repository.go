@@ -0,0 +1,12 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain/model"
+)
+
+// ImpersonationAuditRepository defines the interface for persisting impersonation audit entries
+type ImpersonationAuditRepository interface {
+	Save(ctx context.Context, entry *model.ImpersonationAuditEntry) error
+}
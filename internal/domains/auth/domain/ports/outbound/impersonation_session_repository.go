@@ -0,0 +1,18 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// ImpersonationSessionRepository defines the interface for impersonation session data access
+type ImpersonationSessionRepository interface {
+	Save(ctx context.Context, session *model.ImpersonationSession) error
+	Update(ctx context.Context, session *model.ImpersonationSession) error
+	FindByID(ctx context.Context, id uuid.UUID) (*model.ImpersonationSession, error)
+	// ListActiveByActor lists sessions of the actor that have not ended or expired
+	ListActiveByActor(ctx context.Context, actorUserID uuid.UUID) ([]*model.ImpersonationSession, error)
+}
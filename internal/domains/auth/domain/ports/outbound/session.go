@@ -0,0 +1,32 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// TenantDirectory defines the interface for listing a user's tenants (implemented by the tenants domain)
+type TenantDirectory interface {
+	// ListMemberships lists every tenant the user belongs to with their role, permissions and entitlements
+	ListMemberships(ctx context.Context, userID uuid.UUID) ([]model.SessionTenant, error)
+}
+
+// BrandingReader defines the interface for reading branding tokens (implemented by the brand domain)
+type BrandingReader interface {
+	// FindByTenantID returns nil without error when the tenant has no branding
+	FindByTenantID(ctx context.Context, tenantID uuid.UUID) (*model.SessionBranding, error)
+}
+
+// SessionCache defines the interface for caching sessions per user and tenant
+// tenantID is uuid.Nil for sessions without a tenant.
+type SessionCache interface {
+	Get(ctx context.Context, userID, tenantID uuid.UUID) (*model.Session, bool)
+	Set(ctx context.Context, userID, tenantID uuid.UUID, session *model.Session) error
+	// InvalidateUser drops every cached session of the user
+	InvalidateUser(ctx context.Context, userID uuid.UUID) error
+	// InvalidateTenant drops every cached session for the tenant
+	InvalidateTenant(ctx context.Context, tenantID uuid.UUID) error
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+// versionTTL outlives any session entry so a version never resets while entries built on it exist
+const versionTTL = 24 * time.Hour
+
+// SessionCache caches sessions in Redis/Dragonfly
+// Entries are keyed by the user's and the tenant's current version; invalidating bumps a version,
+// which orphans every entry built on it without scanning keys.
+type SessionCache struct {
+	client *redis.Client
+	ttl    time.Duration
+	logger zerolog.Logger
+	prefix string
+}
+
+// NewSessionCache creates a new session cache using Redis/Dragonfly
+func NewSessionCache(client *redis.Client, ttl time.Duration, logger zerolog.Logger) outbound.SessionCache {
+	if ttl == 0 {
+		ttl = 5 * time.Minute // Default TTL
+	}
+	return &SessionCache{
+		client: client,
+		ttl:    ttl,
+		logger: logger,
+		prefix: "session_cache:",
+	}
+}
+
+func (c *SessionCache) userVersionKey(userID uuid.UUID) string {
+	return c.prefix + "user_version:" + userID.String()
+}
+
+func (c *SessionCache) tenantVersionKey(tenantID uuid.UUID) string {
+	return c.prefix + "tenant_version:" + tenantID.String()
+}
+
+// entryKey returns the key of the session entry for the current user and tenant versions
+func (c *SessionCache) entryKey(ctx context.Context, userID, tenantID uuid.UUID) (string, error) {
+	versions, err := c.client.MGet(ctx, c.userVersionKey(userID), c.tenantVersionKey(tenantID)).Result()
+	if err != nil {
+		return "", err
+	}
+
+	userVersion, tenantVersion := "0", "0"
+	if v, ok := versions[0].(string); ok {
+		userVersion = v
+	}
+	if v, ok := versions[1].(string); ok {
+		tenantVersion = v
+	}
+
+	return fmt.Sprintf("%s%s:%s:%s:%s", c.prefix, userID, userVersion, tenantID, tenantVersion), nil
+}
+
+// Get retrieves the cached session of a user in a tenant
+func (c *SessionCache) Get(ctx context.Context, userID, tenantID uuid.UUID) (*model.Session, bool) {
+	key, err := c.entryKey(ctx, userID, tenantID)
+	if err != nil {
+		c.logger.Debug().Err(err).Str("user_id", userID.String()).Msg("Failed to get session cache versions from Redis")
+		return nil, false
+	}
+
+	val, err := c.client.Get(ctx, key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			c.logger.Debug().Err(err).Str("user_id", userID.String()).Msg("Failed to get session cache from Redis")
+		}
+		return nil, false
+	}
+
+	var session model.Session
+	if err := json.Unmarshal([]byte(val), &session); err != nil {
+		c.logger.Warn().Err(err).Str("user_id", userID.String()).Msg("Failed to unmarshal session cache from Redis")
+		return nil, false
+	}
+
+	return &session, true
+}
+
+// Set stores the session of a user in a tenant with TTL
+func (c *SessionCache) Set(ctx context.Context, userID, tenantID uuid.UUID, session *model.Session) error {
+	key, err := c.entryKey(ctx, userID, tenantID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, key, data, c.ttl).Err()
+}
+
+// InvalidateUser drops every cached session of the user
+func (c *SessionCache) InvalidateUser(ctx context.Context, userID uuid.UUID) error {
+	return c.bump(ctx, c.userVersionKey(userID))
+}
+
+// InvalidateTenant drops every cached session for the tenant
+func (c *SessionCache) InvalidateTenant(ctx context.Context, tenantID uuid.UUID) error {
+	return c.bump(ctx, c.tenantVersionKey(tenantID))
+}
+
+func (c *SessionCache) bump(ctx context.Context, versionKey string) error {
+	pipe := c.client.TxPipeline()
+	pipe.Incr(ctx, versionKey)
+	pipe.Expire(ctx, versionKey, versionTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.logger.Error().Err(err).Str("key", versionKey).Msg("Failed to invalidate session cache in Redis")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ImpersonationAuditRepository implements the outbound.ImpersonationAuditRepository interface
+type ImpersonationAuditRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewImpersonationAuditRepository creates a new PostgreSQL impersonation audit repository
+func NewImpersonationAuditRepository(db *pgxpool.Pool) outbound.ImpersonationAuditRepository {
+	return &ImpersonationAuditRepository{
+		db: db,
+	}
+}
+
+// Save appends an audit entry
+func (r *ImpersonationAuditRepository) Save(ctx context.Context, entry *model.ImpersonationAuditEntry) error {
+	query := `
+		INSERT INTO impersonation_audit_log (id, session_id, actor_user_id, target_user_id, tenant_id, action, method, path, status_code, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	var statusCode *int
+	if entry.StatusCode() != 0 {
+		code := entry.StatusCode()
+		statusCode = &code
+	}
+
+	_, err := r.db.Exec(ctx, query,
+		entry.ID(),
+		entry.SessionID(),
+		entry.ActorUserID(),
+		entry.TargetUserID(),
+		entry.TenantID(),
+		string(entry.Action()),
+		nullableString(entry.Method()),
+		nullableString(entry.Path()),
+		statusCode,
+		entry.CreatedAt(),
+	)
+
+	return err
+}
+
+// nullableString converts an empty string to NULL
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/domains/auth/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const impersonationSessionColumns = `id, actor_user_id, actor_clerk_user_id, target_user_id, target_clerk_user_id, tenant_id,
+	reason, started_at, expires_at, ended_at`
+
+// ImpersonationSessionRepository implements the outbound.ImpersonationSessionRepository interface
+type ImpersonationSessionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewImpersonationSessionRepository creates a new PostgreSQL impersonation session repository
+func NewImpersonationSessionRepository(db *pgxpool.Pool) outbound.ImpersonationSessionRepository {
+	return &ImpersonationSessionRepository{
+		db: db,
+	}
+}
+
+// Save creates a new impersonation session
+func (r *ImpersonationSessionRepository) Save(ctx context.Context, session *model.ImpersonationSession) error {
+	query := `
+		INSERT INTO impersonation_sessions (` + impersonationSessionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		session.ID(),
+		session.ActorUserID(),
+		session.ActorClerkUserID(),
+		session.TargetUserID(),
+		session.TargetClerkUserID(),
+		session.TenantID(),
+		session.Reason(),
+		session.StartedAt(),
+		session.ExpiresAt(),
+		session.EndedAt(),
+	)
+
+	return err
+}
+
+// Update persists the end of a session
+func (r *ImpersonationSessionRepository) Update(ctx context.Context, session *model.ImpersonationSession) error {
+	query := `UPDATE impersonation_sessions SET ended_at = $2 WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, session.ID(), session.EndedAt())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrImpersonationSessionNotFound
+	}
+
+	return nil
+}
+
+// FindByID finds an impersonation session by ID
+func (r *ImpersonationSessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ImpersonationSession, error) {
+	query := `SELECT ` + impersonationSessionColumns + ` FROM impersonation_sessions WHERE id = $1`
+
+	return r.scanSession(r.db.QueryRow(ctx, query, id))
+}
+
+// ListActiveByActor lists sessions of the actor that have not ended or expired
+func (r *ImpersonationSessionRepository) ListActiveByActor(ctx context.Context, actorUserID uuid.UUID) ([]*model.ImpersonationSession, error) {
+	query := `
+		SELECT ` + impersonationSessionColumns + `
+		FROM impersonation_sessions
+		WHERE actor_user_id = $1 AND ended_at IS NULL AND expires_at > NOW()
+		ORDER BY started_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, actorUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*model.ImpersonationSession
+	for rows.Next() {
+		session, err := r.scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// scanSession scans a single impersonation session row into a domain session
+func (r *ImpersonationSessionRepository) scanSession(row pgx.Row) (*model.ImpersonationSession, error) {
+	var (
+		id                uuid.UUID
+		actorUserID       uuid.UUID
+		actorClerkUserID  string
+		targetUserID      uuid.UUID
+		targetClerkUserID string
+		tenantID          uuid.UUID
+		reason            string
+		startedAt         time.Time
+		expiresAt         time.Time
+		endedAt           *time.Time
+	)
+
+	err := row.Scan(&id, &actorUserID, &actorClerkUserID, &targetUserID, &targetClerkUserID, &tenantID,
+		&reason, &startedAt, &expiresAt, &endedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrImpersonationSessionNotFound
+		}
+		return nil, err
+	}
+
+	return model.NewImpersonationSessionWithID(id, actorUserID, actorClerkUserID, targetUserID, targetClerkUserID, tenantID,
+		reason, startedAt, expiresAt, endedAt), nil
+}
@@ -5,21 +5,36 @@ import (
 	"net/http"
 
 	"github.com/rs/zerolog"
+
+	"farohq-core-app/internal/domains/auth/app/usecases"
+	"farohq-core-app/internal/platform/httpserver"
 )
 
 // Handlers provides HTTP handlers for the auth domain
 type Handlers struct {
-	logger zerolog.Logger
+	logger             zerolog.Logger
+	startImpersonation *usecases.StartImpersonation
+	endImpersonation   *usecases.EndImpersonation
+	getSession         *usecases.GetSession
 }
 
 // NewHandlers creates new auth HTTP handlers
-func NewHandlers(logger zerolog.Logger) *Handlers {
+func NewHandlers(
+	logger zerolog.Logger,
+	startImpersonation *usecases.StartImpersonation,
+	endImpersonation *usecases.EndImpersonation,
+	getSession *usecases.GetSession,
+) *Handlers {
 	return &Handlers{
-		logger: logger,
+		logger:             logger,
+		startImpersonation: startImpersonation,
+		endImpersonation:   endImpersonation,
+		getSession:         getSession,
 	}
 }
 
 // MeHandler returns current user info from Clerk token in context
+// While impersonating, this is the impersonated user and the impersonation block identifies the real actor
 func (h *Handlers) MeHandler(w http.ResponseWriter, r *http.Request) {
 	// Get user info from context (set by RequireAuth middleware)
 	userID := r.Context().Value("user_id")
@@ -33,18 +48,27 @@ func (h *Handlers) MeHandler(w http.ResponseWriter, r *http.Request) {
 	orgRole := r.Context().Value("org_role")
 
 	response := map[string]interface{}{
-		"user_id":    userID,
-		"email":      email,
-		"first_name": firstName,
-		"last_name":  lastName,
-		"name":       name,
-		"created_at": createdAt,
-		"org_id":     orgID,
-		"org_slug":   orgSlug,
-		"org_role":   orgRole,
+		"user_id":       userID,
+		"email":         email,
+		"first_name":    firstName,
+		"last_name":     lastName,
+		"name":          name,
+		"created_at":    createdAt,
+		"org_id":        orgID,
+		"org_slug":      orgSlug,
+		"org_role":      orgRole,
+		"impersonation": nil,
+	}
+
+	if impersonation, ok := httpserver.GetImpersonationFromContext(r.Context()); ok {
+		response["impersonation"] = map[string]interface{}{
+			"session_id":    impersonation.SessionID,
+			"actor_user_id": impersonation.ActorClerkUserID,
+			"tenant_id":     impersonation.TenantID,
+			"expires_at":    impersonation.ExpiresAt,
+		}
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
-
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"farohq-core-app/internal/domains/auth/app/usecases"
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// StartImpersonationHandler handles POST /api/v1/auth/impersonation
+// The returned session ID is sent in the X-Impersonation-Session header to act as the target user
+func (h *Handlers) StartImpersonationHandler(w http.ResponseWriter, r *http.Request) {
+	actorClerkUserID, ok := r.Context().Value("user_id").(string)
+	if !ok || actorClerkUserID == "" {
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "Unauthorized")
+		return
+	}
+
+	var req struct {
+		TenantID        string `json:"tenant_id"`
+		TargetUserID    string `json:"target_user_id"`
+		Reason          string `json:"reason"`
+		DurationMinutes int    `json:"duration_minutes,omitempty"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	tenantID, err := uuid.Parse(req.TenantID)
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "Invalid tenant ID")
+		return
+	}
+
+	targetUserID, err := uuid.Parse(req.TargetUserID)
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "target_user_id", "Invalid target user ID")
+		return
+	}
+
+	if req.DurationMinutes < 0 {
+		httpserver.WriteInvalidField(w, r, "duration_minutes", "Invalid duration")
+		return
+	}
+
+	resp, err := h.startImpersonation.Execute(r.Context(), &usecases.StartImpersonationRequest{
+		ActorClerkUserID: actorClerkUserID,
+		TenantID:         tenantID,
+		TargetUserID:     targetUserID,
+		Reason:           req.Reason,
+		Duration:         time.Duration(req.DurationMinutes) * time.Minute,
+	})
+	if err != nil {
+		h.writeImpersonationError(w, r, err, "Failed to start impersonation")
+		return
+	}
+
+	h.logger.Info().
+		Str("session_id", resp.Session.ID().String()).
+		Str("actor_clerk_user_id", actorClerkUserID).
+		Str("target_clerk_user_id", resp.Session.TargetClerkUserID()).
+		Str("tenant_id", tenantID.String()).
+		Msg("Impersonation session started")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(buildImpersonationSessionResponse(resp.Session))
+}
+
+// EndImpersonationHandler handles DELETE /api/v1/auth/impersonation/{id}
+func (h *Handlers) EndImpersonationHandler(w http.ResponseWriter, r *http.Request) {
+	actorClerkUserID, ok := r.Context().Value("user_id").(string)
+	if !ok || actorClerkUserID == "" {
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "Unauthorized")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "id", "Invalid session ID")
+		return
+	}
+
+	resp, err := h.endImpersonation.Execute(r.Context(), &usecases.EndImpersonationRequest{
+		SessionID:        sessionID,
+		ActorClerkUserID: actorClerkUserID,
+	})
+	if err != nil {
+		h.writeImpersonationError(w, r, err, "Failed to end impersonation")
+		return
+	}
+
+	h.logger.Info().
+		Str("session_id", sessionID.String()).
+		Str("actor_clerk_user_id", actorClerkUserID).
+		Msg("Impersonation session ended")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildImpersonationSessionResponse(resp.Session))
+}
+
+func (h *Handlers) writeImpersonationError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	// A target user outside the tenant is reported the same way as a missing user
+	if err == domain.ErrNotTenantMember {
+		httpserver.WriteNotFound(w, r, "user_not_found", err.Error())
+		return
+	}
+	h.writeError(w, r, err, msg)
+}
+
+func buildImpersonationSessionResponse(session *model.ImpersonationSession) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   session.ID().String(),
+		"header":               httpserver.ImpersonationHeader,
+		"tenant_id":            session.TenantID().String(),
+		"target_user_id":       session.TargetUserID().String(),
+		"target_clerk_user_id": session.TargetClerkUserID(),
+		"reason":               session.Reason(),
+		"started_at":           session.StartedAt(),
+		"expires_at":           session.ExpiresAt(),
+		"ended_at":             session.EndedAt(),
+		"active":               session.IsActive(),
+	}
+}
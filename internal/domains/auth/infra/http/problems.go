@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// problems maps auth domain errors to problem responses
+var problems = httpserver.NewErrorMapper(
+	httpserver.ErrorMapping{Err: domain.ErrImpersonationNotAllowed, Status: http.StatusForbidden, Code: "impersonation_not_allowed"},
+	httpserver.ErrorMapping{Err: domain.ErrImpersonationSessionNotFound, Status: http.StatusNotFound, Code: "impersonation_session_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrImpersonationSessionInactive, Status: http.StatusConflict, Code: "impersonation_session_inactive"},
+	httpserver.ErrorMapping{Err: domain.ErrImpersonationReasonRequired, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "reason"},
+	httpserver.ErrorMapping{Err: domain.ErrCannotImpersonateSelf, Status: http.StatusBadRequest, Code: "cannot_impersonate_self"},
+	httpserver.ErrorMapping{Err: domain.ErrUserNotFound, Status: http.StatusNotFound, Code: "user_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrNotTenantMember, Status: http.StatusForbidden, Code: "not_tenant_member"},
+)
+
+// writeError writes the problem mapped from a domain error
+// Unmapped errors are logged with msg and reported as a 500 without exposing the cause.
+func (h *Handlers) writeError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	if problem := problems.Problem(err); problem != nil {
+		httpserver.WriteProblem(w, r, problem)
+		return
+	}
+	h.logger.Error().Err(err).Msg(msg)
+	httpserver.WriteInternalError(w, r)
+}
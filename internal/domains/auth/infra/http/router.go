@@ -6,8 +6,10 @@ import (
 
 // RegisterRoutes registers all auth domain routes
 func (h *Handlers) RegisterRoutes(r chi.Router) {
+	r.Get("/session", h.SessionHandler)
 	r.Route("/auth", func(r chi.Router) {
 		r.Get("/me", h.MeHandler)
+		r.Post("/impersonation", h.StartImpersonationHandler)
+		r.Delete("/impersonation/{id}", h.EndImpersonationHandler)
 	})
 }
-
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"farohq-core-app/internal/domains/auth/app/usecases"
+	"farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/tenant"
+)
+
+// SessionHandler handles GET /api/v1/session
+// Returns everything the frontend needs on page load: user, resolved tenant, role, permissions, entitlements,
+// branding and switchable organizations
+func (h *Handlers) SessionHandler(w http.ResponseWriter, r *http.Request) {
+	clerkUserID, ok := r.Context().Value("user_id").(string)
+	if !ok || clerkUserID == "" {
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "Unauthorized")
+		return
+	}
+
+	req := &usecases.GetSessionRequest{
+		ClerkUserID: clerkUserID,
+	}
+	if tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context()); ok {
+		req.TenantID = &tenantID
+	}
+
+	resp, err := h.getSession.Execute(r.Context(), req)
+	if err != nil {
+		switch err {
+		case domain.ErrUserNotFound:
+			httpserver.WriteNotFound(w, r, "user_not_found", "User not synced")
+		case domain.ErrNotTenantMember:
+			httpserver.WriteForbidden(w, r, httpserver.TenantCodeAccessDenied, "You don't have access to this organization.")
+		default:
+			h.logger.Error().Err(err).Str("clerk_user_id", clerkUserID).Msg("Failed to get session")
+			httpserver.WriteInternalError(w, r)
+		}
+		return
+	}
+
+	session := resp.Session
+	response := map[string]interface{}{
+		"user":          session.User,
+		"tenant":        nil,
+		"resolution":    nil,
+		"role":          nil,
+		"permissions":   []string{},
+		"entitlements":  nil,
+		"branding":      session.Branding,
+		"organizations": session.Organizations,
+		"impersonation": nil,
+		"computed_at":   session.ComputedAt,
+	}
+
+	if session.Tenant != nil {
+		response["tenant"] = map[string]interface{}{
+			"id":        session.Tenant.ID,
+			"name":      session.Tenant.Name,
+			"slug":      session.Tenant.Slug,
+			"status":    session.Tenant.Status,
+			"tier":      session.Tenant.Tier,
+			"suspended": session.Tenant.Suspended,
+		}
+		response["role"] = session.Tenant.Role
+		response["permissions"] = session.Tenant.Permissions
+		response["entitlements"] = session.Tenant.Entitlements
+	}
+
+	if resolution, ok := tenant.GetResolutionFromContext(r.Context()); ok {
+		response["resolution"] = map[string]interface{}{
+			"source":        resolution.Source,
+			"fallback_used": resolution.FallbackUsed,
+		}
+	}
+
+	if impersonation, ok := httpserver.GetImpersonationFromContext(r.Context()); ok {
+		response["impersonation"] = map[string]interface{}{
+			"session_id":    impersonation.SessionID,
+			"actor_user_id": impersonation.ActorClerkUserID,
+			"tenant_id":     impersonation.TenantID,
+			"expires_at":    impersonation.ExpiresAt,
+		}
+	}
+
+	cacheStatus := "MISS"
+	if resp.Cached {
+		cacheStatus = "HIT"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("X-Session-Cache", cacheStatus)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// findBrandingWithTier loads a brand's live branding and its agency's tier
+func findBrandingWithTier(ctx context.Context, brandRepo outbound.BrandRepository, tenantRepo tenants_outbound.TenantRepository, brandID string) (*model.Branding, *tenants_model.Tier, error) {
+	agencyID, err := uuid.Parse(brandID)
+	if err != nil {
+		return nil, nil, domain.ErrBrandingNotFound
+	}
+
+	branding, err := brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, nil, domain.ErrBrandingNotFound
+	}
+
+	tenant, err := tenantRepo.FindByID(ctx, agencyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	return branding, tenant.Tier(), nil
+}
+
+// checkContentTier checks that a presentation about to go live only uses settings the tier includes
+// Content is drafted or archived under the tier of the time, which may have been downgraded since.
+func checkContentTier(content model.BrandingContent, tier *tenants_model.Tier) error {
+	if content.HidePoweredBy && !tenants_model.TierCanHidePoweredBy(tier) {
+		return domain.ErrHidePoweredByNotAllowed
+	}
+	return nil
+}
+
+// previewDraft shows the agency's branding draft in place of its live branding
+// The preview token must match the agency's current draft; publishing or discarding the draft invalidates it.
+func previewDraft(ctx context.Context, draftRepo outbound.BrandingDraftRepository, branding *model.Branding, previewToken string) (*model.Branding, error) {
+	if draftRepo == nil {
+		return nil, domain.ErrInvalidPreviewToken
+	}
+	draft, err := draftRepo.FindByAgencyID(ctx, branding.AgencyID())
+	if err != nil {
+		if err == domain.ErrBrandingDraftNotFound {
+			return nil, domain.ErrInvalidPreviewToken
+		}
+		return nil, err
+	}
+	if !draft.MatchesPreviewToken(previewToken) {
+		return nil, domain.ErrInvalidPreviewToken
+	}
+	return branding.WithContent(draft.Content()), nil
+}
@@ -0,0 +1,204 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBrandingDraftRepository is a mock implementation of outbound.BrandingDraftRepository
+type MockBrandingDraftRepository struct {
+	mock.Mock
+}
+
+func (m *MockBrandingDraftRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.BrandingDraft, error) {
+	args := m.Called(ctx, agencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BrandingDraft), args.Error(1)
+}
+
+func (m *MockBrandingDraftRepository) Save(ctx context.Context, draft *model.BrandingDraft) error {
+	return m.Called(ctx, draft).Error(0)
+}
+
+func (m *MockBrandingDraftRepository) Update(ctx context.Context, draft *model.BrandingDraft) error {
+	return m.Called(ctx, draft).Error(0)
+}
+
+func (m *MockBrandingDraftRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	return m.Called(ctx, agencyID).Error(0)
+}
+
+// MockBrandingVersionRepository is a mock implementation of outbound.BrandingVersionRepository
+type MockBrandingVersionRepository struct {
+	mock.Mock
+}
+
+func (m *MockBrandingVersionRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID, limit int) ([]*model.BrandingVersion, error) {
+	args := m.Called(ctx, agencyID, limit)
+	return args.Get(0).([]*model.BrandingVersion), args.Error(1)
+}
+
+func (m *MockBrandingVersionRepository) FindByNumber(ctx context.Context, agencyID uuid.UUID, number int64) (*model.BrandingVersion, error) {
+	args := m.Called(ctx, agencyID, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BrandingVersion), args.Error(1)
+}
+
+func TestBrandingDraft_PutAndPublish(t *testing.T) {
+	agencyID := uuid.New()
+	brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierGrowth)
+	draftRepo := new(MockBrandingDraftRepository)
+	draftRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(nil, domain.ErrBrandingDraftNotFound).Once()
+	draftRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	primary := "#abcdef"
+	theme := map[string]interface{}{"layout": "wide"}
+	resp, err := NewPutBrandingDraft(brandRepo, tenantRepo, draftRepo).Execute(context.Background(), &inbound.PutBrandingDraftRequest{
+		BrandID:      agencyID.String(),
+		PrimaryColor: &primary,
+		ThemeJSON:    &theme,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Created)
+	assert.NotEmpty(t, resp.Draft.PreviewToken())
+	assert.Equal(t, "#abcdef", resp.Draft.Content().PrimaryColor)
+	assert.Equal(t, "#445566", resp.Draft.Content().SecondaryColor, "a new draft starts from the live branding")
+	assert.Equal(t, []model.BrandingChange{
+		{Field: "primary_color", From: "#112233", To: "#abcdef"},
+		{Field: "theme_json.layout", From: nil, To: "wide"},
+	}, resp.Changes)
+	assert.Equal(t, "#112233", branding.PrimaryColor(), "the live branding is untouched")
+	brandRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	t.Run("stale draft versions conflict", func(t *testing.T) {
+		draftRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(resp.Draft, nil).Once()
+		stale := resp.Draft.Version() + 1
+
+		_, err := NewPutBrandingDraft(brandRepo, tenantRepo, draftRepo).Execute(context.Background(), &inbound.PutBrandingDraftRequest{
+			BrandID:         agencyID.String(),
+			PrimaryColor:    &primary,
+			ExpectedVersion: &stale,
+		})
+
+		assert.Equal(t, domain.ErrVersionConflict, err)
+	})
+
+	t.Run("publishing makes the draft live and discards it", func(t *testing.T) {
+		draftRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(resp.Draft, nil).Once()
+		draftRepo.On("Delete", mock.Anything, agencyID).Return(nil)
+
+		published, err := NewPublishBrandingDraft(brandRepo, tenantRepo, draftRepo).Execute(context.Background(),
+			&inbound.PublishBrandingDraftRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, "#abcdef", published.Branding.PrimaryColor())
+		assert.Equal(t, "wide", published.Branding.ThemeJSON()["layout"])
+		brandRepo.AssertCalled(t, "Update", mock.Anything, branding)
+		draftRepo.AssertCalled(t, "Delete", mock.Anything, agencyID)
+	})
+}
+
+func TestBrandingDraft_PublishRevalidatesTier(t *testing.T) {
+	agencyID := uuid.New()
+	brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierStarter)
+	content := branding.Content()
+	content.HidePoweredBy = true // Drafted before a downgrade
+	draftRepo := new(MockBrandingDraftRepository)
+	draftRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(model.NewBrandingDraftWithID(agencyID, content, "token", time.Now(), 2), nil)
+
+	_, err := NewPublishBrandingDraft(brandRepo, tenantRepo, draftRepo).Execute(context.Background(),
+		&inbound.PublishBrandingDraftRequest{BrandID: agencyID.String()})
+
+	assert.Equal(t, domain.ErrHidePoweredByNotAllowed, err)
+	assert.False(t, branding.HidePoweredBy())
+	brandRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBrandingVersions_ListAndRollback(t *testing.T) {
+	agencyID := uuid.New()
+	brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierScale)
+
+	first := branding.Content()
+	first.PrimaryColor = "#000000"
+	second := branding.Content()
+	second.PrimaryColor = "#ffffff"
+	second.ThemeJSON = map[string]interface{}{"colors": map[string]interface{}{"accent": "#ff0000"}}
+	v1 := model.NewBrandingVersionWithID(agencyID, 1, first, time.Now().Add(-2*time.Hour))
+	v2 := model.NewBrandingVersionWithID(agencyID, 2, second, time.Now().Add(-time.Hour))
+
+	versionRepo := new(MockBrandingVersionRepository)
+	versionRepo.On("ListByAgencyID", mock.Anything, agencyID, defaultBrandingVersionsLimit).Return([]*model.BrandingVersion{v2, v1}, nil)
+	versionRepo.On("FindByNumber", mock.Anything, agencyID, int64(1)).Return(v1, nil)
+	versionRepo.On("FindByNumber", mock.Anything, agencyID, int64(9)).Return(nil, domain.ErrBrandingVersionNotFound)
+
+	t.Run("versions are diffed against what replaced them", func(t *testing.T) {
+		resp, err := NewListBrandingVersions(brandRepo, versionRepo).Execute(context.Background(),
+			&inbound.ListBrandingVersionsRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Versions, 2)
+		assert.Equal(t, []model.BrandingChange{
+			{Field: "primary_color", From: "#ffffff", To: "#112233"},
+			{Field: "theme_json.colors", From: map[string]interface{}{"accent": "#ff0000"}, To: nil},
+		}, resp.Versions[0].Changes)
+		assert.Equal(t, []model.BrandingChange{
+			{Field: "primary_color", From: "#000000", To: "#ffffff"},
+			{Field: "theme_json.colors", From: nil, To: map[string]interface{}{"accent": "#ff0000"}},
+		}, resp.Versions[1].Changes)
+	})
+
+	t.Run("rollback restores a version's presentation", func(t *testing.T) {
+		version := branding.Version()
+		resp, err := NewRollbackBranding(brandRepo, tenantRepo, versionRepo).Execute(context.Background(),
+			&inbound.RollbackBrandingRequest{BrandID: agencyID.String(), Version: 1, ExpectedVersion: &version})
+
+		require.NoError(t, err)
+		assert.Equal(t, "#000000", resp.Branding.PrimaryColor())
+		assert.Equal(t, "portal.acme.com", resp.Branding.Domain(), "domain settings are not versioned")
+		brandRepo.AssertCalled(t, "Update", mock.Anything, branding)
+	})
+
+	t.Run("unknown versions are not found", func(t *testing.T) {
+		_, err := NewRollbackBranding(brandRepo, tenantRepo, versionRepo).Execute(context.Background(),
+			&inbound.RollbackBrandingRequest{BrandID: agencyID.String(), Version: 9})
+
+		assert.Equal(t, domain.ErrBrandingVersionNotFound, err)
+	})
+}
+
+func TestGetByHost_Execute_Preview(t *testing.T) {
+	agencyID := uuid.New()
+	agencyBranding := newAgencyBranding(agencyID)
+	brandRepo := new(MockBrandRepository)
+	brandRepo.On("FindBySubdomain", mock.Anything, "acme.portal.farohq.com").Return(agencyBranding, nil)
+
+	content := agencyBranding.Content()
+	content.PrimaryColor = "#abcdef"
+	draftRepo := new(MockBrandingDraftRepository)
+	draftRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(model.NewBrandingDraftWithID(agencyID, content, "secret-token", time.Now(), 1), nil)
+	uc := NewGetByHost(brandRepo, new(MockClientBrandingRepository), nil, draftRepo)
+
+	resp, err := uc.Execute(context.Background(), &inbound.GetByHostRequest{Host: "acme.portal.farohq.com", PreviewToken: "secret-token"})
+	require.NoError(t, err)
+	assert.Equal(t, "#abcdef", resp.Branding.PrimaryColor())
+	assert.Equal(t, "#112233", agencyBranding.PrimaryColor())
+
+	_, err = uc.Execute(context.Background(), &inbound.GetByHostRequest{Host: "acme.portal.farohq.com", PreviewToken: "guess"})
+	assert.Equal(t, domain.ErrInvalidPreviewToken, err)
+}
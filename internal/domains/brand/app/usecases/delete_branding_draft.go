@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// DeleteBrandingDraft implements the DeleteBrandingDraft inbound port
+type DeleteBrandingDraft struct {
+	draftRepo outbound.BrandingDraftRepository
+}
+
+// NewDeleteBrandingDraft creates a new DeleteBrandingDraft use case
+func NewDeleteBrandingDraft(draftRepo outbound.BrandingDraftRepository) inbound.DeleteBrandingDraft {
+	return &DeleteBrandingDraft{
+		draftRepo: draftRepo,
+	}
+}
+
+// Execute executes the use case
+// Discarding the draft also invalidates its preview token.
+func (uc *DeleteBrandingDraft) Execute(ctx context.Context, req *inbound.DeleteBrandingDraftRequest) (*inbound.DeleteBrandingDraftResponse, error) {
+	agencyID, err := uuid.Parse(req.BrandID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	if err := uc.draftRepo.Delete(ctx, agencyID); err != nil {
+		return nil, err
+	}
+
+	return &inbound.DeleteBrandingDraftResponse{
+		Success: true,
+	}, nil
+}
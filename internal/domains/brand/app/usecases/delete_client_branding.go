@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// DeleteClientBranding implements the DeleteClientBranding inbound port
+type DeleteClientBranding struct {
+	brandRepo          outbound.BrandRepository
+	clientBrandingRepo outbound.ClientBrandingRepository
+	clientRepo         tenants_outbound.ClientRepository
+}
+
+// NewDeleteClientBranding creates a new DeleteClientBranding use case
+func NewDeleteClientBranding(brandRepo outbound.BrandRepository, clientBrandingRepo outbound.ClientBrandingRepository, clientRepo tenants_outbound.ClientRepository) inbound.DeleteClientBranding {
+	return &DeleteClientBranding{
+		brandRepo:          brandRepo,
+		clientBrandingRepo: clientBrandingRepo,
+		clientRepo:         clientRepo,
+	}
+}
+
+// Execute executes the use case
+// Removing the overrides makes the client inherit the agency branding again.
+func (uc *DeleteClientBranding) Execute(ctx context.Context, req *inbound.DeleteClientBrandingRequest) (*inbound.DeleteClientBrandingResponse, error) {
+	_, clientID, err := findAgencyBrandingForClient(ctx, uc.brandRepo, uc.clientRepo, req.BrandID, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.clientBrandingRepo.Delete(ctx, clientID); err != nil {
+		return nil, err
+	}
+
+	return &inbound.DeleteClientBrandingResponse{
+		Success: true,
+	}, nil
+}
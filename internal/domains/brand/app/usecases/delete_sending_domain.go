@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// DeleteSendingDomain implements the DeleteSendingDomain inbound port
+type DeleteSendingDomain struct {
+	brandRepo         outbound.BrandRepository
+	sendingDomainRepo outbound.SendingDomainRepository
+	provider          outbound.SendingDomainProvider
+}
+
+// NewDeleteSendingDomain creates a new DeleteSendingDomain use case
+func NewDeleteSendingDomain(
+	brandRepo outbound.BrandRepository,
+	sendingDomainRepo outbound.SendingDomainRepository,
+	provider outbound.SendingDomainProvider,
+) inbound.DeleteSendingDomain {
+	return &DeleteSendingDomain{
+		brandRepo:         brandRepo,
+		sendingDomainRepo: sendingDomainRepo,
+		provider:          provider,
+	}
+}
+
+// Execute executes the use case
+// Not tier-gated, so downgraded agencies can still clean up; emails go back to the platform sender.
+func (uc *DeleteSendingDomain) Execute(ctx context.Context, req *inbound.DeleteSendingDomainRequest) (*inbound.DeleteSendingDomainResponse, error) {
+	agencyID, err := uuid.Parse(req.BrandID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	branding, err := uc.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	sendingDomain, err := uc.sendingDomainRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sendingDomain.ProviderID() != "" {
+		if err := uc.provider.RemoveDomain(ctx, sendingDomain.ProviderID()); err != nil {
+			return nil, fmt.Errorf("failed to remove sending domain from the provider: %w", err)
+		}
+	}
+
+	if err := uc.sendingDomainRepo.Delete(ctx, agencyID); err != nil {
+		return nil, err
+	}
+
+	if err := syncEmailDomain(ctx, uc.brandRepo, branding, nil); err != nil {
+		return nil, err
+	}
+
+	return &inbound.DeleteSendingDomainResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+)
+
+// ensureVerificationToken gives the branding a domain ownership token
+// Returns whether a token was generated (and the branding needs saving).
+func ensureVerificationToken(branding *model.Branding) bool {
+	if branding.DomainVerificationToken() != "" {
+		return false
+	}
+	branding.SetDomainVerificationToken(model.GenerateDomainVerificationToken())
+	return true
+}
+
+// checkOwnership checks that domainName publishes the branding's ownership record
+// Returns nil when ownership checks are disabled (no verifier); a branding without a token is never proven.
+func checkOwnership(ctx context.Context, verifier outbound.OwnershipVerifier, branding *model.Branding, domainName string) (*model.OwnershipCheck, error) {
+	if verifier == nil {
+		return nil, nil
+	}
+	record := model.OwnershipRecord(domainName, branding.DomainVerificationToken())
+	if branding.DomainVerificationToken() == "" {
+		return &model.OwnershipCheck{Record: record}, nil
+	}
+	check, err := verifier.VerifyTXT(ctx, record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check domain ownership: %w", err)
+	}
+	return check, nil
+}
+
+// ownershipProven reports whether an ownership check (nil when checks are disabled) proves ownership
+func ownershipProven(check *model.OwnershipCheck) bool {
+	return check == nil || check.Verified
+}
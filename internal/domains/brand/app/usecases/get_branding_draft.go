@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// GetBrandingDraft implements the GetBrandingDraft inbound port
+type GetBrandingDraft struct {
+	brandRepo outbound.BrandRepository
+	draftRepo outbound.BrandingDraftRepository
+}
+
+// NewGetBrandingDraft creates a new GetBrandingDraft use case
+func NewGetBrandingDraft(brandRepo outbound.BrandRepository, draftRepo outbound.BrandingDraftRepository) inbound.GetBrandingDraft {
+	return &GetBrandingDraft{
+		brandRepo: brandRepo,
+		draftRepo: draftRepo,
+	}
+}
+
+// Execute executes the use case
+func (uc *GetBrandingDraft) Execute(ctx context.Context, req *inbound.GetBrandingDraftRequest) (*inbound.GetBrandingDraftResponse, error) {
+	agencyID, err := uuid.Parse(req.BrandID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	branding, err := uc.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	draft, err := uc.draftRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inbound.GetBrandingDraftResponse{
+		Draft:    draft,
+		Branding: branding,
+		Changes:  model.DiffBrandingContent(branding.Content(), draft.Content()),
+	}, nil
+}
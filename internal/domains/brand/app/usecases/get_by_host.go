@@ -9,19 +9,25 @@ import (
 	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
 	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
 	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
 )
 
 // GetByHost implements the GetByHost inbound port
 type GetByHost struct {
-	brandRepo  outbound.BrandRepository
-	tenantRepo tenants_outbound.TenantRepository
+	brandRepo          outbound.BrandRepository
+	clientBrandingRepo outbound.ClientBrandingRepository
+	tenantRepo         tenants_outbound.TenantRepository
+	draftRepo          outbound.BrandingDraftRepository // Optional, previews are rejected when nil
 }
 
 // NewGetByHost creates a new GetByHost use case
-func NewGetByHost(brandRepo outbound.BrandRepository, tenantRepo tenants_outbound.TenantRepository) inbound.GetByHost {
+func NewGetByHost(brandRepo outbound.BrandRepository, clientBrandingRepo outbound.ClientBrandingRepository, tenantRepo tenants_outbound.TenantRepository, draftRepo outbound.BrandingDraftRepository) inbound.GetByHost {
 	return &GetByHost{
-		brandRepo:  brandRepo,
-		tenantRepo: tenantRepo,
+		brandRepo:          brandRepo,
+		clientBrandingRepo: clientBrandingRepo,
+		tenantRepo:         tenantRepo,
+		draftRepo:          draftRepo,
 	}
 }
 
@@ -53,6 +59,24 @@ func (uc *GetByHost) Execute(ctx context.Context, req *inbound.GetByHostRequest)
 		}
 	}
 
+	// A preview shows the agency's draft; client overrides still apply on top of it
+	if req.PreviewToken != "" {
+		branding, err = previewDraft(ctx, uc.draftRepo, branding, req.PreviewToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Client portals keep the agency's domain but show the client's branding overrides
+	if req.ClientID != "" {
+		if clientID, err := uuid.Parse(req.ClientID); err == nil {
+			branding, err = applyClientBranding(ctx, uc.clientBrandingRepo, branding, clientID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return &inbound.GetByHostResponse{
 		Branding: branding,
 	}, nil
@@ -0,0 +1,202 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockBrandRepository is a mock implementation of outbound.BrandRepository
+type MockBrandRepository struct {
+	mock.Mock
+}
+
+func (m *MockBrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.Branding, error) {
+	args := m.Called(ctx, agencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Branding), args.Error(1)
+}
+
+func (m *MockBrandRepository) FindByDomain(ctx context.Context, domain string) (*model.Branding, error) {
+	args := m.Called(ctx, domain)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Branding), args.Error(1)
+}
+
+func (m *MockBrandRepository) FindBySubdomain(ctx context.Context, subdomain string) (*model.Branding, error) {
+	args := m.Called(ctx, subdomain)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Branding), args.Error(1)
+}
+
+func (m *MockBrandRepository) CheckSubdomainExists(ctx context.Context, subdomain string) (bool, error) {
+	args := m.Called(ctx, subdomain)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBrandRepository) Save(ctx context.Context, branding *model.Branding) error {
+	args := m.Called(ctx, branding)
+	return args.Error(0)
+}
+
+func (m *MockBrandRepository) Update(ctx context.Context, branding *model.Branding) error {
+	args := m.Called(ctx, branding)
+	return args.Error(0)
+}
+
+func (m *MockBrandRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	args := m.Called(ctx, agencyID)
+	return args.Error(0)
+}
+
+func (m *MockBrandRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID) ([]*model.Branding, error) {
+	args := m.Called(ctx, agencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Branding), args.Error(1)
+}
+
+// MockClientBrandingRepository is a mock implementation of outbound.ClientBrandingRepository
+type MockClientBrandingRepository struct {
+	mock.Mock
+}
+
+func (m *MockClientBrandingRepository) FindByClientID(ctx context.Context, clientID uuid.UUID) (*model.ClientBranding, error) {
+	args := m.Called(ctx, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ClientBranding), args.Error(1)
+}
+
+func (m *MockClientBrandingRepository) Save(ctx context.Context, clientBranding *model.ClientBranding) error {
+	args := m.Called(ctx, clientBranding)
+	return args.Error(0)
+}
+
+func (m *MockClientBrandingRepository) Update(ctx context.Context, clientBranding *model.ClientBranding) error {
+	args := m.Called(ctx, clientBranding)
+	return args.Error(0)
+}
+
+func (m *MockClientBrandingRepository) Delete(ctx context.Context, clientID uuid.UUID) error {
+	args := m.Called(ctx, clientID)
+	return args.Error(0)
+}
+
+func newAgencyBranding(agencyID uuid.UUID) *model.Branding {
+	dt := model.DomainTypeSubdomain
+	return model.NewBranding(agencyID, "", "acme.portal.farohq.com", &dt, "", "https://cdn.example.com/acme.png", "https://cdn.example.com/acme.ico", "#112233", "#445566", map[string]interface{}{
+		"typography": map[string]interface{}{"font_family": "Inter", "font_size": "16px"},
+		"layout":     "wide",
+	})
+}
+
+func TestGetByHost_Execute_ClientBranding(t *testing.T) {
+	agencyID := uuid.New()
+	clientID := uuid.New()
+	logoURL := "https://cdn.example.com/client.png"
+	primaryColor := "#abcdef"
+	overrides := model.NewClientBrandingWithID(clientID, agencyID, &logoURL, nil, &primaryColor, nil, map[string]interface{}{
+		"typography": map[string]interface{}{"font_family": "Lora"},
+	}, time.Now(), 3)
+
+	tests := []struct {
+		name      string
+		clientID  string
+		mockSetup func(*MockClientBrandingRepository)
+		assertFn  func(*testing.T, *model.Branding)
+	}{
+		{
+			name:     "without client context returns the agency branding",
+			clientID: "",
+			assertFn: func(t *testing.T, branding *model.Branding) {
+				assert.Nil(t, branding.ClientID())
+				assert.Equal(t, "https://cdn.example.com/acme.png", branding.LogoURL())
+			},
+		},
+		{
+			name:     "applies the client's overrides and inherits the rest",
+			clientID: clientID.String(),
+			mockSetup: func(repo *MockClientBrandingRepository) {
+				repo.On("FindByClientID", mock.Anything, clientID).Return(overrides, nil)
+			},
+			assertFn: func(t *testing.T, branding *model.Branding) {
+				require.NotNil(t, branding.ClientID())
+				assert.Equal(t, clientID, *branding.ClientID())
+				assert.Equal(t, logoURL, branding.LogoURL())
+				assert.Equal(t, primaryColor, branding.PrimaryColor())
+				assert.Equal(t, "https://cdn.example.com/acme.ico", branding.FaviconURL())
+				assert.Equal(t, "#445566", branding.SecondaryColor())
+				assert.Equal(t, "acme.portal.farohq.com", branding.Subdomain())
+				assert.Equal(t, map[string]interface{}{
+					"typography": map[string]interface{}{"font_family": "Lora", "font_size": "16px"},
+					"layout":     "wide",
+				}, branding.ThemeJSON())
+			},
+		},
+		{
+			name:     "client without overrides inherits the agency branding",
+			clientID: clientID.String(),
+			mockSetup: func(repo *MockClientBrandingRepository) {
+				repo.On("FindByClientID", mock.Anything, clientID).Return(nil, domain.ErrClientBrandingNotFound)
+			},
+			assertFn: func(t *testing.T, branding *model.Branding) {
+				assert.Equal(t, "https://cdn.example.com/acme.png", branding.LogoURL())
+			},
+		},
+		{
+			name:     "ignores overrides of another agency's client",
+			clientID: clientID.String(),
+			mockSetup: func(repo *MockClientBrandingRepository) {
+				foreign := model.NewClientBrandingWithID(clientID, uuid.New(), &logoURL, nil, nil, nil, nil, time.Now(), 1)
+				repo.On("FindByClientID", mock.Anything, clientID).Return(foreign, nil)
+			},
+			assertFn: func(t *testing.T, branding *model.Branding) {
+				assert.Nil(t, branding.ClientID())
+				assert.Equal(t, "https://cdn.example.com/acme.png", branding.LogoURL())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agencyBranding := newAgencyBranding(agencyID)
+			brandRepo := new(MockBrandRepository)
+			brandRepo.On("FindBySubdomain", mock.Anything, "acme.portal.farohq.com").Return(agencyBranding, nil)
+			clientBrandingRepo := new(MockClientBrandingRepository)
+			if tt.mockSetup != nil {
+				tt.mockSetup(clientBrandingRepo)
+			}
+
+			uc := NewGetByHost(brandRepo, clientBrandingRepo, nil, nil)
+			resp, err := uc.Execute(context.Background(), &inbound.GetByHostRequest{
+				Host:     "Acme.portal.farohq.com:443",
+				ClientID: tt.clientID,
+			})
+
+			require.NoError(t, err)
+			tt.assertFn(t, resp.Branding)
+			// The agency branding itself is never modified by client overrides
+			assert.Equal(t, "https://cdn.example.com/acme.png", agencyBranding.LogoURL())
+			assert.Equal(t, "Inter", agencyBranding.ThemeJSON()["typography"].(map[string]interface{})["font_family"])
+			clientBrandingRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// GetClientBranding implements the GetClientBranding inbound port
+type GetClientBranding struct {
+	brandRepo          outbound.BrandRepository
+	clientBrandingRepo outbound.ClientBrandingRepository
+	clientRepo         tenants_outbound.ClientRepository
+}
+
+// NewGetClientBranding creates a new GetClientBranding use case
+func NewGetClientBranding(brandRepo outbound.BrandRepository, clientBrandingRepo outbound.ClientBrandingRepository, clientRepo tenants_outbound.ClientRepository) inbound.GetClientBranding {
+	return &GetClientBranding{
+		brandRepo:          brandRepo,
+		clientBrandingRepo: clientBrandingRepo,
+		clientRepo:         clientRepo,
+	}
+}
+
+// Execute executes the use case
+func (uc *GetClientBranding) Execute(ctx context.Context, req *inbound.GetClientBrandingRequest) (*inbound.GetClientBrandingResponse, error) {
+	branding, clientID, err := findAgencyBrandingForClient(ctx, uc.brandRepo, uc.clientRepo, req.BrandID, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientBranding, err := uc.clientBrandingRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if err == domain.ErrClientBrandingNotFound {
+			// No overrides: the client inherits the agency branding unchanged
+			return &inbound.GetClientBrandingResponse{
+				Branding: branding.WithClientBranding(model.NewClientBranding(clientID, branding.AgencyID())),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &inbound.GetClientBrandingResponse{
+		ClientBranding: clientBranding,
+		Branding:       branding.WithClientBranding(clientBranding),
+	}, nil
+}
+
+// findAgencyBrandingForClient loads the agency branding a client's overrides are layered on
+// Returns ErrClientNotFound unless the client belongs to the brand's agency.
+func findAgencyBrandingForClient(ctx context.Context, brandRepo outbound.BrandRepository, clientRepo tenants_outbound.ClientRepository, brandID, clientID string) (*model.Branding, uuid.UUID, error) {
+	agencyID, err := uuid.Parse(brandID)
+	if err != nil {
+		return nil, uuid.Nil, domain.ErrBrandingNotFound
+	}
+	clientUUID, err := uuid.Parse(clientID)
+	if err != nil {
+		return nil, uuid.Nil, domain.ErrClientNotFound
+	}
+
+	branding, err := brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, uuid.Nil, domain.ErrBrandingNotFound
+	}
+
+	client, err := clientRepo.FindByID(ctx, clientUUID)
+	if err != nil || client.AgencyID() != agencyID || client.IsDeleted() {
+		return nil, uuid.Nil, domain.ErrClientNotFound
+	}
+
+	return branding, clientUUID, nil
+}
+
+// applyClientBranding layers a client's overrides on the agency branding
+// Overrides are only applied if they belong to the branding's agency; a client without overrides inherits the agency branding.
+func applyClientBranding(ctx context.Context, clientBrandingRepo outbound.ClientBrandingRepository, branding *model.Branding, clientID uuid.UUID) (*model.Branding, error) {
+	clientBranding, err := clientBrandingRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if err == domain.ErrClientBrandingNotFound {
+			return branding, nil
+		}
+		return nil, err
+	}
+	if clientBranding.AgencyID() != branding.AgencyID() {
+		return branding, nil
+	}
+	return branding.WithClientBranding(clientBranding), nil
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
 	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
 	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
-	"farohq-core-app/internal/domains/brand/infra/vercel"
 	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
 
@@ -17,21 +18,24 @@ import (
 
 // GetDomainInstructions implements the GetDomainInstructions inbound port
 type GetDomainInstructions struct {
-	brandRepo     outbound.BrandRepository
-	tenantRepo    tenants_outbound.TenantRepository
-	vercelService *vercel.VercelService
+	brandRepo      outbound.BrandRepository
+	tenantRepo     tenants_outbound.TenantRepository
+	domainProvider outbound.DomainProvider
+	verifier       outbound.OwnershipVerifier // Optional, the ownership record is only listed when set
 }
 
 // NewGetDomainInstructions creates a new GetDomainInstructions use case
 func NewGetDomainInstructions(
 	brandRepo outbound.BrandRepository,
 	tenantRepo tenants_outbound.TenantRepository,
-	vercelService *vercel.VercelService,
+	domainProvider outbound.DomainProvider,
+	verifier outbound.OwnershipVerifier, // Optional, can be nil
 ) inbound.GetDomainInstructions {
 	return &GetDomainInstructions{
-		brandRepo:     brandRepo,
-		tenantRepo:    tenantRepo,
-		vercelService: vercelService,
+		brandRepo:      brandRepo,
+		tenantRepo:     tenantRepo,
+		domainProvider: domainProvider,
+		verifier:       verifier,
 	}
 }
 
@@ -50,7 +54,7 @@ func (uc *GetDomainInstructions) Execute(ctx context.Context, req *inbound.GetDo
 
 	tier := tenant.Tier()
 	if !tenants_model.TierSupportsCustomDomain(tier) {
-		return nil, errors.New("Custom domain support is only available for Scale tier")
+		return nil, domain.ErrCustomDomainNotAllowed
 	}
 
 	branding, err := uc.brandRepo.FindByAgencyID(ctx, agencyID)
@@ -58,52 +62,76 @@ func (uc *GetDomainInstructions) Execute(ctx context.Context, req *inbound.GetDo
 		return nil, domain.ErrBrandingNotFound
 	}
 
-	domain := branding.Domain()
-	if domain == "" {
+	customDomain := branding.Domain()
+	if customDomain == "" {
 		// Try to use website if available
 		website := branding.Website()
 		if website == "" {
 			return nil, errors.New("no custom domain configured and no website available")
 		}
 		// Extract domain from website URL
-		domain = website
+		customDomain = website
 	}
 
-	// Fetch expected DNS configuration from Vercel API (CNAME target and other records)
-	// Do NOT hardcode CNAME target - always fetch from Vercel API (value may vary)
-	dnsConfig, err := uc.vercelService.GetExpectedDNSConfig(ctx, domain)
-	if err != nil {
-		// If domain not added yet, try to add it to get expected config
-		dnsConfig, err = uc.vercelService.AddDomainToProject(ctx, domain)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get DNS configuration from Vercel API: %w", err)
+	// Fetch the expected DNS records from the domain provider (values may vary, don't hardcode)
+	records, err := uc.domainProvider.GetExpectedRecords(ctx, customDomain)
+	if err == domain.ErrDomainNotRegistered {
+		// Domain not added yet: adding it returns the records to create
+		var added *outbound.CustomDomain
+		added, err = uc.domainProvider.AddDomain(ctx, customDomain)
+		if added != nil {
+			records = added.Records
 		}
 	}
-
-	cnameTarget := ""
-	if dnsConfig != nil {
-		cnameTarget = dnsConfig.CNAMETarget
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DNS configuration from the domain provider: %w", err)
 	}
 
-	if cnameTarget == "" {
-		return nil, errors.New("no CNAME target found in Vercel API response")
+	target := cnameTarget(records)
+	if target == "" {
+		return nil, errors.New("no CNAME target found in the domain provider's records")
 	}
 
-	// Generate human-readable instructions
-	instructions := fmt.Sprintf(
-		"Create a CNAME record in your DNS provider:\n\n"+
-			"Record Type: CNAME\n"+
-			"Name/Host: %s (or @ for root domain)\n"+
-			"Value/Target: %s\n\n"+
-			"After creating the CNAME record, DNS propagation can take up to 48 hours globally. "+
-			"Click 'Verify Domain' once you've created the record.",
-		domain,
-		cnameTarget,
-	)
+	// The ownership record comes first: the domain is only verified once it is published
+	if uc.verifier != nil {
+		if ensureVerificationToken(branding) {
+			if err := uc.brandRepo.Update(ctx, branding); err != nil {
+				return nil, fmt.Errorf("failed to update branding: %w", err)
+			}
+		}
+		records = append([]model.DNSRecord{model.OwnershipRecord(customDomain, branding.DomainVerificationToken())}, records...)
+	}
 
 	return &inbound.GetDomainInstructionsResponse{
-		Domain:       domain,
-		CNAMETarget:  cnameTarget,
-		Instructions: instructions,
+		Domain:       customDomain,
+		CNAMETarget:  target,
+		Records:      records,
+		Instructions: domainInstructions(records),
 	}, nil
 }
+
+// cnameTarget returns the target of the first CNAME record, or ""
+func cnameTarget(records []model.DNSRecord) string {
+	for _, record := range records {
+		if record.Type == "CNAME" {
+			return record.Value
+		}
+	}
+	return ""
+}
+
+// domainInstructions generates human-readable instructions for creating the records
+func domainInstructions(records []model.DNSRecord) string {
+	var b strings.Builder
+	b.WriteString("Create the following records in your DNS provider:\n")
+	for _, record := range records {
+		name := record.Name
+		if record.Type == "CNAME" {
+			name += " (or @ for root domain)"
+		}
+		fmt.Fprintf(&b, "\nRecord Type: %s\nName/Host: %s\nValue/Target: %s\n", record.Type, name, record.Value)
+	}
+	b.WriteString("\nDNS propagation can take up to 48 hours globally. " +
+		"Click 'Verify Domain' once you've created the records.")
+	return b.String()
+}
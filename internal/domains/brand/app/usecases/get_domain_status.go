@@ -2,14 +2,12 @@ package usecases
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"farohq-core-app/internal/domains/brand/domain"
 	"farohq-core-app/internal/domains/brand/domain/model"
 	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
 	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
-	"farohq-core-app/internal/domains/brand/infra/vercel"
 	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
 
@@ -18,21 +16,27 @@ import (
 
 // GetDomainStatus implements the GetDomainStatus inbound port
 type GetDomainStatus struct {
-	brandRepo     outbound.BrandRepository
-	tenantRepo    tenants_outbound.TenantRepository
-	vercelService *vercel.VercelService
+	brandRepo      outbound.BrandRepository
+	tenantRepo     tenants_outbound.TenantRepository
+	domainProvider outbound.DomainProvider
+	checkRepo      outbound.DomainCheckRepository // Optional, can be nil
+	verifier       outbound.OwnershipVerifier     // Optional, nil disables ownership checks
 }
 
 // NewGetDomainStatus creates a new GetDomainStatus use case
 func NewGetDomainStatus(
 	brandRepo outbound.BrandRepository,
 	tenantRepo tenants_outbound.TenantRepository,
-	vercelService *vercel.VercelService,
+	domainProvider outbound.DomainProvider,
+	checkRepo outbound.DomainCheckRepository, // Optional, can be nil
+	verifier outbound.OwnershipVerifier, // Optional, can be nil
 ) inbound.GetDomainStatus {
 	return &GetDomainStatus{
-		brandRepo:     brandRepo,
-		tenantRepo:    tenantRepo,
-		vercelService: vercelService,
+		brandRepo:      brandRepo,
+		tenantRepo:     tenantRepo,
+		domainProvider: domainProvider,
+		checkRepo:      checkRepo,
+		verifier:       verifier,
 	}
 }
 
@@ -51,7 +55,7 @@ func (uc *GetDomainStatus) Execute(ctx context.Context, req *inbound.GetDomainSt
 
 	tier := tenant.Tier()
 	if !tenants_model.TierSupportsCustomDomain(tier) {
-		return nil, errors.New("Custom domain support is only available for Scale tier")
+		return nil, domain.ErrCustomDomainNotAllowed
 	}
 
 	branding, err := uc.brandRepo.FindByAgencyID(ctx, agencyID)
@@ -59,25 +63,39 @@ func (uc *GetDomainStatus) Execute(ctx context.Context, req *inbound.GetDomainSt
 		return nil, domain.ErrBrandingNotFound
 	}
 
-	domain := branding.Domain()
-	if domain == "" {
-		return nil, errors.New("no custom domain configured")
+	customDomain := branding.Domain()
+	if customDomain == "" {
+		return nil, domain.ErrDomainRequired
 	}
 
-	// Poll Vercel API for full domain status (verification, SSL readiness)
-	domainStatus, err := uc.vercelService.GetDomainStatus(ctx, domain)
+	// Poll the domain provider for full domain status (verification, SSL readiness, expected records)
+	domainStatus, err := uc.domainProvider.GetDomainStatus(ctx, customDomain)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get domain status from Vercel API: %w", err)
+		return nil, fmt.Errorf("failed to get domain status: %w", err)
 	}
 
-	// Update brand record with latest status from Vercel API
+	// Update brand record with latest status from the provider; a first verification also needs proven ownership
+	var ownership *model.OwnershipCheck
 	if domainStatus.Verified && branding.VerifiedAt() == nil {
-		branding.Verify()
+		ownership, err = checkOwnership(ctx, uc.verifier, branding, customDomain)
+		if err != nil {
+			return nil, err
+		}
+		if ownershipProven(ownership) {
+			branding.Verify()
+		}
+	}
+
+	// With ownership checks enabled the ownership record is listed first
+	records := domainStatus.Records
+	if uc.verifier != nil {
+		ensureVerificationToken(branding)
+		records = append([]model.DNSRecord{model.OwnershipRecord(customDomain, branding.DomainVerificationToken())}, records...)
 	}
 
 	var sslStatus *model.SSLStatus
-	if domainStatus.SSL.Status != "" {
-		ss := model.SSLStatus(domainStatus.SSL.Status)
+	if domainStatus.SSLStatus != "" {
+		ss := domainStatus.SSLStatus
 		sslStatus = &ss
 		branding.SetSSLStatus(sslStatus)
 	}
@@ -87,22 +105,30 @@ func (uc *GetDomainStatus) Execute(ctx context.Context, req *inbound.GetDomainSt
 		return nil, fmt.Errorf("failed to update branding: %w", err)
 	}
 
-	// Get expected CNAME target from Vercel API
-	expectedCNAME := ""
-	dnsConfig, err := uc.vercelService.GetExpectedDNSConfig(ctx, domain)
-	if err == nil && dnsConfig != nil {
-		expectedCNAME = dnsConfig.CNAMETarget
-	}
-
 	sslStatusStr := ""
 	if sslStatus != nil {
 		sslStatusStr = string(*sslStatus)
 	}
 
+	// The monitor's view flags domains that were live and broke since
+	var check *model.DomainCheck
+	if uc.checkRepo != nil {
+		check, err = uc.checkRepo.FindByAgencyID(ctx, agencyID)
+		if err != nil && err != domain.ErrDomainCheckNotFound {
+			return nil, fmt.Errorf("failed to get domain check: %w", err)
+		}
+		if check != nil && check.Domain() != customDomain {
+			check = nil
+		}
+	}
+
 	return &inbound.GetDomainStatusResponse{
 		Branding:      branding,
-		Verified:      domainStatus.Verified,
-		ExpectedCNAME: expectedCNAME,
+		Verified:      domainStatus.Verified && branding.VerifiedAt() != nil,
+		ExpectedCNAME: cnameTarget(domainStatus.Records),
 		SSLStatus:     sslStatusStr,
+		Records:       records,
+		Check:         check,
+		Ownership:     ownership,
 	}, nil
 }
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// GetSendingDomain implements the GetSendingDomain inbound port
+type GetSendingDomain struct {
+	sendingDomainRepo outbound.SendingDomainRepository
+}
+
+// NewGetSendingDomain creates a new GetSendingDomain use case
+func NewGetSendingDomain(sendingDomainRepo outbound.SendingDomainRepository) inbound.GetSendingDomain {
+	return &GetSendingDomain{
+		sendingDomainRepo: sendingDomainRepo,
+	}
+}
+
+// Execute executes the use case
+func (uc *GetSendingDomain) Execute(ctx context.Context, req *inbound.GetSendingDomainRequest) (*inbound.GetSendingDomainResponse, error) {
+	agencyID, err := uuid.Parse(req.BrandID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	sendingDomain, err := uc.sendingDomainRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inbound.GetSendingDomainResponse{
+		SendingDomain: sendingDomain,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/services"
+)
+
+// GetTheme implements the GetTheme inbound port
+type GetTheme struct {
+	getByHost     inbound.GetByHost
+	themeCompiler *services.ThemeCompiler
+}
+
+// NewGetTheme creates a new GetTheme use case
+func NewGetTheme(getByHost inbound.GetByHost, themeCompiler *services.ThemeCompiler) inbound.GetTheme {
+	return &GetTheme{
+		getByHost:     getByHost,
+		themeCompiler: themeCompiler,
+	}
+}
+
+// Execute executes the use case
+// The branding is resolved like GetByHost (including client overrides) and compiled into design tokens.
+func (uc *GetTheme) Execute(ctx context.Context, req *inbound.GetThemeRequest) (*inbound.GetThemeResponse, error) {
+	resp, err := uc.getByHost.Execute(ctx, &inbound.GetByHostRequest{
+		Host:         req.Host,
+		ClientID:     req.ClientID,
+		PreviewToken: req.PreviewToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &inbound.GetThemeResponse{
+		Theme: uc.themeCompiler.Compile(resp.Branding),
+	}, nil
+}
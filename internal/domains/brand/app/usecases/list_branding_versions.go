@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// Branding history page size: versions listed by default and at most
+const (
+	defaultBrandingVersionsLimit = 20
+	maxBrandingVersionsLimit     = 100
+)
+
+// ListBrandingVersions implements the ListBrandingVersions inbound port
+type ListBrandingVersions struct {
+	brandRepo   outbound.BrandRepository
+	versionRepo outbound.BrandingVersionRepository
+}
+
+// NewListBrandingVersions creates a new ListBrandingVersions use case
+func NewListBrandingVersions(brandRepo outbound.BrandRepository, versionRepo outbound.BrandingVersionRepository) inbound.ListBrandingVersions {
+	return &ListBrandingVersions{
+		brandRepo:   brandRepo,
+		versionRepo: versionRepo,
+	}
+}
+
+// Execute executes the use case
+// Each version is diffed against the presentation that replaced it: the next version, or the live branding for the newest.
+func (uc *ListBrandingVersions) Execute(ctx context.Context, req *inbound.ListBrandingVersionsRequest) (*inbound.ListBrandingVersionsResponse, error) {
+	agencyID, err := uuid.Parse(req.BrandID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	branding, err := uc.brandRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil {
+		return nil, domain.ErrBrandingNotFound
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultBrandingVersionsLimit
+	}
+	if limit > maxBrandingVersionsLimit {
+		limit = maxBrandingVersionsLimit
+	}
+
+	versions, err := uc.versionRepo.ListByAgencyID(ctx, agencyID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branding versions: %w", err)
+	}
+
+	entries := make([]inbound.BrandingVersionEntry, 0, len(versions))
+	next := branding.Content()
+	for _, version := range versions {
+		entries = append(entries, inbound.BrandingVersionEntry{
+			Version: version,
+			Changes: model.DiffBrandingContent(version.Content(), next),
+		})
+		next = version.Content()
+	}
+
+	return &inbound.ListBrandingVersionsResponse{
+		Branding: branding,
+		Versions: entries,
+	}, nil
+}
@@ -0,0 +1,229 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Domain monitor batching: a run checks at most domainMonitorBatchSize domains; a claimed check that is not
+// saved (the instance died mid-run) becomes due again after domainCheckLease.
+// A check the provider could not answer is retried after domainCheckRetryDelay without counting as a failure.
+const (
+	domainMonitorBatchSize = 50
+	domainCheckLease       = 10 * time.Minute
+	domainCheckRetryDelay  = 15 * time.Minute
+)
+
+// MonitorDomains handles the background job that re-checks custom domains with the domain provider
+// Pending domains are retried with backoff until they go live, live domains are periodically re-validated,
+// and the agency is notified when its domain goes live or breaks.
+type MonitorDomains struct {
+	brandRepo      outbound.BrandRepository
+	checkRepo      outbound.DomainCheckRepository
+	domainProvider outbound.DomainProvider
+	verifier       outbound.OwnershipVerifier // Optional, nil disables ownership checks
+	notifier       outbound.DomainNotifier    // Optional, can be nil
+}
+
+// NewMonitorDomains creates a new MonitorDomains use case
+func NewMonitorDomains(
+	brandRepo outbound.BrandRepository,
+	checkRepo outbound.DomainCheckRepository,
+	domainProvider outbound.DomainProvider,
+	verifier outbound.OwnershipVerifier, // Optional, can be nil
+	notifier outbound.DomainNotifier, // Optional, can be nil
+) *MonitorDomains {
+	return &MonitorDomains{
+		brandRepo:      brandRepo,
+		checkRepo:      checkRepo,
+		domainProvider: domainProvider,
+		verifier:       verifier,
+		notifier:       notifier,
+	}
+}
+
+// MonitorDomainsRequest represents the request to run the domain monitor
+type MonitorDomainsRequest struct {
+	Now time.Time
+}
+
+// MonitorDomainsResponse represents the response from running the domain monitor
+type MonitorDomainsResponse struct {
+	Checked int
+	Live    int // Domains that went live (or recovered) in this run
+	Broken  int // Live domains that broke in this run
+	Failed  int // Checks that could not be completed and were postponed
+}
+
+// Execute executes the use case
+func (uc *MonitorDomains) Execute(ctx context.Context, req *MonitorDomainsRequest) (*MonitorDomainsResponse, error) {
+	now := req.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	checks, err := uc.checkRepo.ClaimDue(ctx, now, domainCheckLease, domainMonitorBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &MonitorDomainsResponse{}
+	for _, check := range checks {
+		event, err := uc.check(ctx, check, now)
+		if err != nil {
+			log.Error().Err(err).Str("agency_id", check.AgencyID().String()).Str("domain", check.Domain()).Msg("Failed to check custom domain")
+			resp.Failed++
+			continue
+		}
+		resp.Checked++
+
+		switch event {
+		case model.DomainEventLive:
+			resp.Live++
+		case model.DomainEventBroken:
+			resp.Broken++
+		}
+		if event != model.DomainEventNone {
+			uc.notify(ctx, check, event)
+		}
+	}
+
+	return resp, nil
+}
+
+// check re-checks one domain, updates its branding and saves the check
+// Returns the health event to notify the agency about.
+func (uc *MonitorDomains) check(ctx context.Context, check *model.DomainCheck, now time.Time) (model.DomainEvent, error) {
+	branding, err := uc.brandRepo.FindByAgencyID(ctx, check.AgencyID())
+	if err != nil && err != domain.ErrBrandingNotFound {
+		return model.DomainEventNone, err
+	}
+	// The custom domain was removed: stop monitoring it
+	if err == domain.ErrBrandingNotFound || branding.Domain() == "" {
+		if err := uc.checkRepo.Delete(ctx, check.AgencyID()); err != nil && err != domain.ErrDomainCheckNotFound {
+			return model.DomainEventNone, err
+		}
+		return model.DomainEventNone, nil
+	}
+	if branding.Domain() != check.Domain() {
+		check.Reset(branding.Domain(), now)
+	}
+
+	verified, sslStatus, err := uc.providerStatus(ctx, check.Domain())
+	if err != nil {
+		check.Postpone(now, domainCheckRetryDelay)
+		if saveErr := uc.checkRepo.Save(ctx, check); saveErr != nil {
+			return model.DomainEventNone, saveErr
+		}
+		return model.DomainEventNone, err
+	}
+
+	// A domain is verified for the first time only once the agency proved it owns it
+	problem := domainProblem(verified, sslStatus)
+	if verified && branding.VerifiedAt() == nil {
+		ownership, err := checkOwnership(ctx, uc.verifier, branding, check.Domain())
+		if err != nil {
+			check.Postpone(now, domainCheckRetryDelay)
+			if saveErr := uc.checkRepo.Save(ctx, check); saveErr != nil {
+				return model.DomainEventNone, saveErr
+			}
+			return model.DomainEventNone, err
+		}
+		if !ownershipProven(ownership) {
+			verified = false
+			problem = "ownership TXT record " + ownership.Record.Name + " is not published"
+		}
+	}
+
+	if err := uc.updateBranding(ctx, branding, verified, sslStatus); err != nil {
+		// The branding changed since it was read (e.g. a new domain was set); re-check it on the next run
+		if err == domain.ErrVersionConflict {
+			check.Postpone(now, 0)
+			return model.DomainEventNone, uc.checkRepo.Save(ctx, check)
+		}
+		return model.DomainEventNone, err
+	}
+
+	live := verified && sslStatus == model.SSLStatusActive
+	event := check.RecordResult(live, problem, now)
+	if err := uc.checkRepo.Save(ctx, check); err != nil {
+		return model.DomainEventNone, err
+	}
+	return event, nil
+}
+
+// providerStatus asks the domain provider whether the domain is routed and for its certificate status
+// A domain the provider does not know (never added through verification, or removed) is not routed.
+func (uc *MonitorDomains) providerStatus(ctx context.Context, domainName string) (bool, model.SSLStatus, error) {
+	verified, err := uc.domainProvider.VerifyDomain(ctx, domainName)
+	if err == domain.ErrDomainNotRegistered {
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", fmt.Errorf("failed to verify domain: %w", err)
+	}
+
+	sslStatus, err := uc.domainProvider.GetSSLStatus(ctx, domainName)
+	if err == domain.ErrDomainNotRegistered {
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get SSL status: %w", err)
+	}
+
+	return verified, sslStatus, nil
+}
+
+// updateBranding records the provider's view on the branding, saving it only if something changed
+// The verification timestamp is kept when a domain breaks: it records when the domain was first verified.
+func (uc *MonitorDomains) updateBranding(ctx context.Context, branding *model.Branding, verified bool, sslStatus model.SSLStatus) error {
+	changed := false
+	if verified && branding.VerifiedAt() == nil {
+		branding.Verify()
+		changed = true
+	}
+	if sslStatus != "" && (branding.SSLStatus() == nil || *branding.SSLStatus() != sslStatus) {
+		branding.SetSSLStatus(&sslStatus)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return uc.brandRepo.Update(ctx, branding)
+}
+
+// notify tells the agency about a health change; failures are logged, the check result stands
+func (uc *MonitorDomains) notify(ctx context.Context, check *model.DomainCheck, event model.DomainEvent) {
+	if uc.notifier == nil {
+		return
+	}
+	notice := &outbound.DomainNotice{
+		AgencyID: check.AgencyID(),
+		Domain:   check.Domain(),
+		Event:    event,
+		Problem:  check.LastError(),
+	}
+	if err := uc.notifier.NotifyDomainChange(ctx, notice); err != nil {
+		log.Error().Err(err).Str("agency_id", check.AgencyID().String()).Str("event", string(event)).Msg("Failed to send custom domain notification")
+	}
+}
+
+// domainProblem describes why a domain is not live, or returns "" if it is
+func domainProblem(verified bool, sslStatus model.SSLStatus) string {
+	switch {
+	case !verified:
+		return "DNS records do not point the domain at the portal"
+	case sslStatus == model.SSLStatusFailed:
+		return "SSL certificate could not be issued"
+	case sslStatus != model.SSLStatusActive:
+		return "SSL certificate is not issued yet"
+	}
+	return ""
+}
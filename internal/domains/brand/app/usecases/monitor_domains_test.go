@@ -0,0 +1,158 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	"farohq-core-app/internal/domains/brand/infra/fake"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDomainCheckRepository is a mock implementation of outbound.DomainCheckRepository
+type MockDomainCheckRepository struct {
+	mock.Mock
+}
+
+func (m *MockDomainCheckRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.DomainCheck, error) {
+	args := m.Called(ctx, agencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.DomainCheck), args.Error(1)
+}
+
+func (m *MockDomainCheckRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.DomainCheck, error) {
+	args := m.Called(ctx, now, lease, limit)
+	return args.Get(0).([]*model.DomainCheck), args.Error(1)
+}
+
+func (m *MockDomainCheckRepository) Save(ctx context.Context, check *model.DomainCheck) error {
+	return m.Called(ctx, check).Error(0)
+}
+
+func (m *MockDomainCheckRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	return m.Called(ctx, agencyID).Error(0)
+}
+
+// MockDomainNotifier is a mock implementation of outbound.DomainNotifier
+type MockDomainNotifier struct {
+	mock.Mock
+}
+
+func (m *MockDomainNotifier) NotifyDomainChange(ctx context.Context, notice *outbound.DomainNotice) error {
+	return m.Called(ctx, notice).Error(0)
+}
+
+func TestMonitorDomains_Execute(t *testing.T) {
+	agencyID := uuid.New()
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	dt := model.DomainTypeCustom
+	branding := model.NewBranding(agencyID, "portal.acme.com", "", &dt, "", "", "", "#112233", "#445566", nil)
+	brandRepo := new(MockBrandRepository)
+	brandRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(branding, nil)
+	brandRepo.On("Update", mock.Anything, branding).Return(nil)
+
+	check := model.NewDomainCheck(agencyID, "portal.acme.com", start)
+	checkRepo := new(MockDomainCheckRepository)
+	checkRepo.On("ClaimDue", mock.Anything, mock.Anything, domainCheckLease, domainMonitorBatchSize).Return([]*model.DomainCheck{check}, nil)
+	checkRepo.On("Save", mock.Anything, check).Return(nil)
+
+	notifier := new(MockDomainNotifier)
+	notifier.On("NotifyDomainChange", mock.Anything, mock.Anything).Return(nil)
+
+	provider := fake.NewDomainProvider("cname.example.net", false)
+	uc := NewMonitorDomains(brandRepo, checkRepo, provider, nil, notifier)
+	run := func(now time.Time) *MonitorDomainsResponse {
+		resp, err := uc.Execute(context.Background(), &MonitorDomainsRequest{Now: now})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("pending domains are retried with backoff", func(t *testing.T) {
+		now := start
+		for _, delay := range []time.Duration{5 * time.Minute, 10 * time.Minute, 20 * time.Minute} {
+			resp := run(now)
+			assert.Equal(t, 1, resp.Checked)
+			assert.Equal(t, model.DomainHealthPending, check.Health())
+			assert.Equal(t, now.Add(delay), check.NextCheckAt())
+			now = check.NextCheckAt()
+		}
+		assert.NotEmpty(t, check.LastError())
+		assert.Nil(t, branding.VerifiedAt())
+		notifier.AssertNotCalled(t, "NotifyDomainChange", mock.Anything, mock.Anything)
+	})
+
+	t.Run("domains going live update the branding and notify the agency", func(t *testing.T) {
+		_, err := provider.AddDomain(context.Background(), "portal.acme.com")
+		require.NoError(t, err)
+		provider.SetVerified("portal.acme.com", true)
+		provider.SetSSLStatus("portal.acme.com", model.SSLStatusActive)
+
+		now := start.Add(time.Hour)
+		resp := run(now)
+
+		assert.Equal(t, 1, resp.Live)
+		assert.Equal(t, model.DomainHealthLive, check.Health())
+		assert.Equal(t, now.Add(6*time.Hour), check.NextCheckAt())
+		assert.NotNil(t, branding.VerifiedAt())
+		require.NotNil(t, branding.SSLStatus())
+		assert.Equal(t, model.SSLStatusActive, *branding.SSLStatus())
+		notifier.AssertCalled(t, "NotifyDomainChange", mock.Anything, &outbound.DomainNotice{
+			AgencyID: agencyID,
+			Domain:   "portal.acme.com",
+			Event:    model.DomainEventLive,
+		})
+
+		// Re-validating a live domain notifies no one
+		run(now.Add(6 * time.Hour))
+		notifier.AssertNumberOfCalls(t, "NotifyDomainChange", 1)
+	})
+
+	t.Run("live domains that lose their DNS are flagged as degraded", func(t *testing.T) {
+		provider.SetVerified("portal.acme.com", false)
+
+		now := start.Add(24 * time.Hour)
+		resp := run(now)
+
+		assert.Equal(t, 1, resp.Broken)
+		assert.Equal(t, model.DomainHealthDegraded, check.Health())
+		assert.Equal(t, now.Add(5*time.Minute), check.NextCheckAt())
+		assert.NotNil(t, branding.VerifiedAt(), "the first verification is kept")
+		notifier.AssertCalled(t, "NotifyDomainChange", mock.Anything, &outbound.DomainNotice{
+			AgencyID: agencyID,
+			Domain:   "portal.acme.com",
+			Event:    model.DomainEventBroken,
+			Problem:  check.LastError(),
+		})
+	})
+
+	t.Run("a changed domain starts over as pending", func(t *testing.T) {
+		branding.SetDomain("app.acme.com")
+
+		run(start.Add(48 * time.Hour))
+
+		assert.Equal(t, "app.acme.com", check.Domain())
+		assert.Equal(t, model.DomainHealthPending, check.Health())
+		assert.Equal(t, 1, check.Failures())
+		notifier.AssertNumberOfCalls(t, "NotifyDomainChange", 2)
+	})
+
+	t.Run("removed domains are no longer monitored", func(t *testing.T) {
+		branding.SetDomain("")
+		checkRepo.On("Delete", mock.Anything, agencyID).Return(domain.ErrDomainCheckNotFound)
+
+		resp := run(start.Add(72 * time.Hour))
+
+		assert.Equal(t, 1, resp.Checked)
+		checkRepo.AssertCalled(t, "Delete", mock.Anything, agencyID)
+	})
+}
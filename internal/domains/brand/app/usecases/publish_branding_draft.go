@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// PublishBrandingDraft implements the PublishBrandingDraft inbound port
+type PublishBrandingDraft struct {
+	brandRepo  outbound.BrandRepository
+	tenantRepo tenants_outbound.TenantRepository
+	draftRepo  outbound.BrandingDraftRepository
+}
+
+// NewPublishBrandingDraft creates a new PublishBrandingDraft use case
+func NewPublishBrandingDraft(brandRepo outbound.BrandRepository, tenantRepo tenants_outbound.TenantRepository, draftRepo outbound.BrandingDraftRepository) inbound.PublishBrandingDraft {
+	return &PublishBrandingDraft{
+		brandRepo:  brandRepo,
+		tenantRepo: tenantRepo,
+		draftRepo:  draftRepo,
+	}
+}
+
+// Execute executes the use case
+// The live presentation the draft replaces is archived as a new version when the branding is updated.
+func (uc *PublishBrandingDraft) Execute(ctx context.Context, req *inbound.PublishBrandingDraftRequest) (*inbound.PublishBrandingDraftResponse, error) {
+	branding, tier, err := findBrandingWithTier(ctx, uc.brandRepo, uc.tenantRepo, req.BrandID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := uc.draftRepo.FindByAgencyID(ctx, branding.AgencyID())
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != draft.Version() {
+		return nil, domain.ErrVersionConflict
+	}
+
+	if err := checkContentTier(draft.Content(), tier); err != nil {
+		return nil, err
+	}
+
+	branding.SetContent(draft.Content())
+	if err := uc.brandRepo.Update(ctx, branding); err != nil {
+		return nil, err
+	}
+
+	// The draft is live now; discarding it also invalidates its preview token
+	if err := uc.draftRepo.Delete(ctx, branding.AgencyID()); err != nil && err != domain.ErrBrandingDraftNotFound {
+		return nil, fmt.Errorf("failed to delete published draft: %w", err)
+	}
+
+	return &inbound.PublishBrandingDraftResponse{
+		Branding: branding,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// PutBrandingDraft implements the PutBrandingDraft inbound port
+type PutBrandingDraft struct {
+	brandRepo  outbound.BrandRepository
+	tenantRepo tenants_outbound.TenantRepository
+	draftRepo  outbound.BrandingDraftRepository
+}
+
+// NewPutBrandingDraft creates a new PutBrandingDraft use case
+func NewPutBrandingDraft(brandRepo outbound.BrandRepository, tenantRepo tenants_outbound.TenantRepository, draftRepo outbound.BrandingDraftRepository) inbound.PutBrandingDraft {
+	return &PutBrandingDraft{
+		brandRepo:  brandRepo,
+		tenantRepo: tenantRepo,
+		draftRepo:  draftRepo,
+	}
+}
+
+// Execute executes the use case
+// The live branding is not modified; the draft goes live when it is published.
+func (uc *PutBrandingDraft) Execute(ctx context.Context, req *inbound.PutBrandingDraftRequest) (*inbound.PutBrandingDraftResponse, error) {
+	branding, tier, err := findBrandingWithTier(ctx, uc.brandRepo, uc.tenantRepo, req.BrandID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := uc.draftRepo.FindByAgencyID(ctx, branding.AgencyID())
+	created := false
+	switch {
+	case err == domain.ErrBrandingDraftNotFound:
+		if req.ExpectedVersion != nil && *req.ExpectedVersion != 0 {
+			return nil, domain.ErrBrandingDraftNotFound
+		}
+		draft = model.NewBrandingDraft(branding)
+		created = true
+	case err != nil:
+		return nil, err
+	case req.ExpectedVersion != nil && *req.ExpectedVersion != draft.Version():
+		return nil, domain.ErrVersionConflict
+	}
+
+	if req.HidePoweredBy != nil && *req.HidePoweredBy && !tenants_model.TierCanHidePoweredBy(tier) {
+		return nil, domain.ErrHidePoweredByNotAllowed
+	}
+
+	// Edit the draft through a preview of the branding, so the setters' rules (e.g. dropping stale asset derivatives) apply
+	preview := branding.WithContent(draft.Content())
+	if req.Website != nil {
+		preview.SetWebsite(*req.Website)
+	}
+	if req.LogoURL != nil {
+		preview.SetLogoURL(*req.LogoURL)
+	}
+	if req.FaviconURL != nil {
+		preview.SetFaviconURL(*req.FaviconURL)
+	}
+	if req.PrimaryColor != nil {
+		preview.SetPrimaryColor(*req.PrimaryColor)
+	}
+	if req.SecondaryColor != nil {
+		preview.SetSecondaryColor(*req.SecondaryColor)
+	}
+	if req.ThemeJSON != nil {
+		preview.SetThemeJSON(*req.ThemeJSON)
+	}
+	if req.HidePoweredBy != nil {
+		preview.SetHidePoweredBy(*req.HidePoweredBy)
+	}
+	draft.SetContent(preview.Content())
+
+	if created {
+		err = uc.draftRepo.Save(ctx, draft)
+	} else {
+		err = uc.draftRepo.Update(ctx, draft)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &inbound.PutBrandingDraftResponse{
+		Draft:    draft,
+		Branding: branding,
+		Changes:  model.DiffBrandingContent(branding.Content(), draft.Content()),
+		Created:  created,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// PutClientBranding implements the PutClientBranding inbound port
+type PutClientBranding struct {
+	brandRepo          outbound.BrandRepository
+	clientBrandingRepo outbound.ClientBrandingRepository
+	clientRepo         tenants_outbound.ClientRepository
+}
+
+// NewPutClientBranding creates a new PutClientBranding use case
+func NewPutClientBranding(brandRepo outbound.BrandRepository, clientBrandingRepo outbound.ClientBrandingRepository, clientRepo tenants_outbound.ClientRepository) inbound.PutClientBranding {
+	return &PutClientBranding{
+		brandRepo:          brandRepo,
+		clientBrandingRepo: clientBrandingRepo,
+		clientRepo:         clientRepo,
+	}
+}
+
+// Execute executes the use case
+func (uc *PutClientBranding) Execute(ctx context.Context, req *inbound.PutClientBrandingRequest) (*inbound.PutClientBrandingResponse, error) {
+	branding, clientID, err := findAgencyBrandingForClient(ctx, uc.brandRepo, uc.clientRepo, req.BrandID, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientBranding, err := uc.clientBrandingRepo.FindByClientID(ctx, clientID)
+	created := false
+	switch {
+	case err == domain.ErrClientBrandingNotFound:
+		if req.ExpectedVersion != nil && *req.ExpectedVersion != 0 {
+			return nil, domain.ErrClientBrandingNotFound
+		}
+		clientBranding = model.NewClientBranding(clientID, branding.AgencyID())
+		created = true
+	case err != nil:
+		return nil, err
+	case req.ExpectedVersion != nil && *req.ExpectedVersion != clientBranding.Version():
+		return nil, domain.ErrVersionConflict
+	}
+
+	// PUT replaces all overrides; anything not provided is inherited again
+	clientBranding.SetLogoURL(req.LogoURL)
+	clientBranding.SetFaviconURL(req.FaviconURL)
+	clientBranding.SetPrimaryColor(req.PrimaryColor)
+	clientBranding.SetSecondaryColor(req.SecondaryColor)
+	clientBranding.SetThemeJSON(req.ThemeJSON)
+
+	if created {
+		err = uc.clientBrandingRepo.Save(ctx, clientBranding)
+	} else {
+		err = uc.clientBrandingRepo.Update(ctx, clientBranding)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &inbound.PutClientBrandingResponse{
+		ClientBranding: clientBranding,
+		Branding:       branding.WithClientBranding(clientBranding),
+		Created:        created,
+	}, nil
+}
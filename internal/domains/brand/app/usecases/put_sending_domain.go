@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// PutSendingDomain implements the PutSendingDomain inbound port
+type PutSendingDomain struct {
+	brandRepo         outbound.BrandRepository
+	tenantRepo        tenants_outbound.TenantRepository
+	sendingDomainRepo outbound.SendingDomainRepository
+	provider          outbound.SendingDomainProvider
+}
+
+// NewPutSendingDomain creates a new PutSendingDomain use case
+func NewPutSendingDomain(
+	brandRepo outbound.BrandRepository,
+	tenantRepo tenants_outbound.TenantRepository,
+	sendingDomainRepo outbound.SendingDomainRepository,
+	provider outbound.SendingDomainProvider,
+) inbound.PutSendingDomain {
+	return &PutSendingDomain{
+		brandRepo:         brandRepo,
+		tenantRepo:        tenantRepo,
+		sendingDomainRepo: sendingDomainRepo,
+		provider:          provider,
+	}
+}
+
+// Execute executes the use case
+// Keeping the domain only updates the sender address and name; a new domain is registered with the provider
+// and emails go back to the platform sender until its records are verified.
+func (uc *PutSendingDomain) Execute(ctx context.Context, req *inbound.PutSendingDomainRequest) (*inbound.PutSendingDomainResponse, error) {
+	branding, tier, err := findBrandingWithTier(ctx, uc.brandRepo, uc.tenantRepo, req.BrandID)
+	if err != nil {
+		return nil, err
+	}
+	if !tenants_model.TierSupportsSendingDomain(tier) {
+		return nil, domain.ErrSendingDomainNotAllowed
+	}
+
+	domainName, ok := model.NormalizeHostname(req.Domain)
+	if !ok {
+		return nil, domain.ErrInvalidDomain
+	}
+	localPart, ok := model.NormalizeLocalPart(req.LocalPart)
+	if !ok {
+		return nil, domain.ErrInvalidSenderAddress
+	}
+	if !model.ValidSenderName(req.FromName) {
+		return nil, domain.ErrInvalidSenderName
+	}
+
+	agencyID := branding.AgencyID()
+	owner, err := uc.sendingDomainRepo.FindByDomain(ctx, domainName)
+	if err != nil && err != domain.ErrSendingDomainNotFound {
+		return nil, err
+	}
+	if err == nil && owner.AgencyID() != agencyID {
+		return nil, domain.ErrSendingDomainTaken
+	}
+
+	existing, err := uc.sendingDomainRepo.FindByAgencyID(ctx, agencyID)
+	if err != nil && err != domain.ErrSendingDomainNotFound {
+		return nil, err
+	}
+
+	if existing != nil && existing.Domain() == domainName {
+		existing.SetLocalPart(localPart)
+		existing.SetFromName(req.FromName)
+		if err := uc.sendingDomainRepo.Save(ctx, existing); err != nil {
+			return nil, err
+		}
+		return &inbound.PutSendingDomainResponse{
+			SendingDomain: existing,
+			Created:       false,
+		}, nil
+	}
+
+	registration, err := uc.provider.RegisterDomain(ctx, domainName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register sending domain: %w", err)
+	}
+
+	sendingDomain := model.NewSendingDomain(agencyID, domainName, localPart, req.FromName)
+	sendingDomain.Register(registration.ProviderID, registration.DKIM, registration.ReturnPath, registration.SPFInclude)
+	if err := uc.sendingDomainRepo.Save(ctx, sendingDomain); err != nil {
+		return nil, err
+	}
+
+	// Best effort: a leftover registration at the provider sends nothing on its own
+	if existing != nil && existing.ProviderID() != "" && existing.ProviderID() != registration.ProviderID {
+		_ = uc.provider.RemoveDomain(ctx, existing.ProviderID())
+	}
+
+	if err := syncEmailDomain(ctx, uc.brandRepo, branding, sendingDomain); err != nil {
+		return nil, err
+	}
+
+	return &inbound.PutSendingDomainResponse{
+		SendingDomain: sendingDomain,
+		Created:       existing == nil,
+	}, nil
+}
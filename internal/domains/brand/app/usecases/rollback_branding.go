@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// RollbackBranding implements the RollbackBranding inbound port
+type RollbackBranding struct {
+	brandRepo   outbound.BrandRepository
+	tenantRepo  tenants_outbound.TenantRepository
+	versionRepo outbound.BrandingVersionRepository
+}
+
+// NewRollbackBranding creates a new RollbackBranding use case
+func NewRollbackBranding(brandRepo outbound.BrandRepository, tenantRepo tenants_outbound.TenantRepository, versionRepo outbound.BrandingVersionRepository) inbound.RollbackBranding {
+	return &RollbackBranding{
+		brandRepo:   brandRepo,
+		tenantRepo:  tenantRepo,
+		versionRepo: versionRepo,
+	}
+}
+
+// Execute executes the use case
+// The presentation being replaced is archived as a new version, so a rollback can itself be rolled back.
+// The agency's draft, if any, is left untouched.
+func (uc *RollbackBranding) Execute(ctx context.Context, req *inbound.RollbackBrandingRequest) (*inbound.RollbackBrandingResponse, error) {
+	branding, tier, err := findBrandingWithTier(ctx, uc.brandRepo, uc.tenantRepo, req.BrandID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != branding.Version() {
+		return nil, domain.ErrVersionConflict
+	}
+
+	version, err := uc.versionRepo.FindByNumber(ctx, branding.AgencyID(), req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkContentTier(version.Content(), tier); err != nil {
+		return nil, err
+	}
+
+	branding.SetContent(version.Content())
+	if err := uc.brandRepo.Update(ctx, branding); err != nil {
+		return nil, err
+	}
+
+	return &inbound.RollbackBrandingResponse{
+		Branding: branding,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+)
+
+// syncEmailDomain points the branding's email domain at the sending domain while it is verified
+// Pass nil when the agency no longer has a sending domain; the branding is only saved when it changes.
+func syncEmailDomain(ctx context.Context, brandRepo outbound.BrandRepository, branding *model.Branding, sendingDomain *model.SendingDomain) error {
+	emailDomain := ""
+	if sendingDomain != nil && sendingDomain.Verified() {
+		emailDomain = sendingDomain.Domain()
+	}
+	if branding.EmailDomain() == emailDomain {
+		return nil
+	}
+
+	branding.SetEmailDomain(emailDomain)
+	if err := brandRepo.Update(ctx, branding); err != nil {
+		return fmt.Errorf("failed to update branding: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/infra/fake"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSendingDomainRepository is a mock implementation of outbound.SendingDomainRepository
+type MockSendingDomainRepository struct {
+	mock.Mock
+}
+
+func (m *MockSendingDomainRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.SendingDomain, error) {
+	args := m.Called(ctx, agencyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SendingDomain), args.Error(1)
+}
+
+func (m *MockSendingDomainRepository) FindByDomain(ctx context.Context, domainName string) (*model.SendingDomain, error) {
+	args := m.Called(ctx, domainName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SendingDomain), args.Error(1)
+}
+
+func (m *MockSendingDomainRepository) Save(ctx context.Context, sendingDomain *model.SendingDomain) error {
+	return m.Called(ctx, sendingDomain).Error(0)
+}
+
+func (m *MockSendingDomainRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	return m.Called(ctx, agencyID).Error(0)
+}
+
+// MockRecordVerifier is a mock implementation of outbound.RecordVerifier
+type MockRecordVerifier struct {
+	mock.Mock
+}
+
+func (m *MockRecordVerifier) VerifyRecord(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error) {
+	args := m.Called(ctx, record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.OwnershipCheck), args.Error(1)
+}
+
+func TestSendingDomain_PutVerifyDelete(t *testing.T) {
+	agencyID := uuid.New()
+	brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierGrowth)
+	provider := fake.NewSendingDomainProvider()
+	sendingDomainRepo := new(MockSendingDomainRepository)
+	sendingDomainRepo.On("FindByDomain", mock.Anything, "mail.acme.com").Return(nil, domain.ErrSendingDomainNotFound)
+	sendingDomainRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(nil, domain.ErrSendingDomainNotFound).Once()
+	sendingDomainRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	resp, err := NewPutSendingDomain(brandRepo, tenantRepo, sendingDomainRepo, provider).Execute(context.Background(), &inbound.PutSendingDomainRequest{
+		BrandID:  agencyID.String(),
+		Domain:   "Mail.Acme.com.",
+		FromName: "Acme Marketing",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Created)
+	sendingDomain := resp.SendingDomain
+	assert.Equal(t, "noreply@mail.acme.com", sendingDomain.Address())
+	assert.False(t, sendingDomain.Verified())
+	records := sendingDomain.Records()
+	require.Len(t, records, 3)
+	assert.Equal(t, model.DNSRecord{Type: "TXT", Name: "mail.acme.com", Value: "v=spf1 include:spf.localhost ~all"}, records[1].Record)
+	assert.Equal(t, model.DNSRecord{Type: "CNAME", Name: "bounces.mail.acme.com", Value: "bounces.localhost"}, records[2].Record)
+	brandRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	sendingDomainRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(sendingDomain, nil)
+
+	t.Run("missing records keep emails on the platform sender", func(t *testing.T) {
+		verifier := new(MockRecordVerifier)
+		verifier.On("VerifyRecord", mock.Anything, records[0].Record).Return(&model.OwnershipCheck{Record: records[0].Record, Verified: true}, nil)
+		verifier.On("VerifyRecord", mock.Anything, records[1].Record).Return(&model.OwnershipCheck{Record: records[1].Record, Verified: true}, nil)
+		verifier.On("VerifyRecord", mock.Anything, records[2].Record).Return(&model.OwnershipCheck{Record: records[2].Record}, nil)
+
+		verified, err := NewVerifySendingDomain(brandRepo, tenantRepo, sendingDomainRepo, provider, verifier).Execute(context.Background(),
+			&inbound.VerifySendingDomainRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.False(t, verified.ProviderConfirmed, "the provider is not asked before DNS propagated")
+		assert.False(t, verified.SendingDomain.Verified())
+		assert.False(t, verified.Checks[model.SendingRecordReturnPath].Verified)
+		assert.NotNil(t, verified.SendingDomain.CheckedAt())
+		assert.Empty(t, branding.EmailDomain())
+	})
+
+	t.Run("published records switch the agency to its domain", func(t *testing.T) {
+		verified, err := NewVerifySendingDomain(brandRepo, tenantRepo, sendingDomainRepo, provider, nil).Execute(context.Background(),
+			&inbound.VerifySendingDomainRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.True(t, verified.ProviderConfirmed)
+		assert.True(t, verified.SendingDomain.Verified())
+		assert.Nil(t, verified.Checks)
+		assert.Equal(t, "mail.acme.com", branding.EmailDomain())
+	})
+
+	t.Run("changing the sender name keeps the verification", func(t *testing.T) {
+		updated, err := NewPutSendingDomain(brandRepo, tenantRepo, sendingDomainRepo, provider).Execute(context.Background(), &inbound.PutSendingDomainRequest{
+			BrandID:   agencyID.String(),
+			Domain:    "mail.acme.com",
+			LocalPart: "Hello",
+			FromName:  "Acme",
+		})
+
+		require.NoError(t, err)
+		assert.False(t, updated.Created)
+		assert.Equal(t, "hello@mail.acme.com", updated.SendingDomain.Address())
+		assert.True(t, updated.SendingDomain.Verified())
+	})
+
+	t.Run("deleting unregisters the domain and restores the platform sender", func(t *testing.T) {
+		sendingDomainRepo.On("Delete", mock.Anything, agencyID).Return(nil)
+
+		_, err := NewDeleteSendingDomain(brandRepo, sendingDomainRepo, provider).Execute(context.Background(),
+			&inbound.DeleteSendingDomainRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.Empty(t, branding.EmailDomain())
+		confirmed, _ := provider.ConfirmDomain(context.Background(), sendingDomain.ProviderID())
+		assert.False(t, confirmed)
+	})
+}
+
+func TestPutSendingDomain_Rejections(t *testing.T) {
+	agencyID := uuid.New()
+
+	t.Run("starter agencies send from the platform", func(t *testing.T) {
+		brandRepo, tenantRepo, _ := newDomainTestRepos(agencyID, tenants_model.TierStarter)
+
+		_, err := NewPutSendingDomain(brandRepo, tenantRepo, new(MockSendingDomainRepository), fake.NewSendingDomainProvider()).Execute(context.Background(),
+			&inbound.PutSendingDomainRequest{BrandID: agencyID.String(), Domain: "mail.acme.com"})
+
+		assert.Equal(t, domain.ErrSendingDomainNotAllowed, err)
+	})
+
+	t.Run("invalid senders", func(t *testing.T) {
+		brandRepo, tenantRepo, _ := newDomainTestRepos(agencyID, tenants_model.TierGrowth)
+		uc := NewPutSendingDomain(brandRepo, tenantRepo, new(MockSendingDomainRepository), fake.NewSendingDomainProvider())
+
+		_, err := uc.Execute(context.Background(), &inbound.PutSendingDomainRequest{BrandID: agencyID.String(), Domain: "localhost"})
+		assert.Equal(t, domain.ErrInvalidDomain, err)
+		_, err = uc.Execute(context.Background(), &inbound.PutSendingDomainRequest{BrandID: agencyID.String(), Domain: "mail.acme.com", LocalPart: "a b"})
+		assert.Equal(t, domain.ErrInvalidSenderAddress, err)
+		_, err = uc.Execute(context.Background(), &inbound.PutSendingDomainRequest{BrandID: agencyID.String(), Domain: "mail.acme.com", FromName: "Acme <spoof@evil.com>"})
+		assert.Equal(t, domain.ErrInvalidSenderName, err)
+	})
+
+	t.Run("domains of other agencies are taken", func(t *testing.T) {
+		brandRepo, tenantRepo, _ := newDomainTestRepos(agencyID, tenants_model.TierGrowth)
+		sendingDomainRepo := new(MockSendingDomainRepository)
+		sendingDomainRepo.On("FindByDomain", mock.Anything, "mail.acme.com").Return(model.NewSendingDomain(uuid.New(), "mail.acme.com", "noreply", ""), nil)
+
+		_, err := NewPutSendingDomain(brandRepo, tenantRepo, sendingDomainRepo, fake.NewSendingDomainProvider()).Execute(context.Background(),
+			&inbound.PutSendingDomainRequest{BrandID: agencyID.String(), Domain: "mail.acme.com"})
+
+		assert.Equal(t, domain.ErrSendingDomainTaken, err)
+	})
+}
@@ -2,7 +2,6 @@ package usecases
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"farohq-core-app/internal/domains/brand/domain"
@@ -41,6 +40,10 @@ func (uc *UpdateBrand) Execute(ctx context.Context, req *inbound.UpdateBrandRequ
 		return nil, domain.ErrBrandingNotFound
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != branding.Version() {
+		return nil, domain.ErrVersionConflict
+	}
+
 	// Get tenant/agency to check tier for validation
 	tenant, err := uc.tenantRepo.FindByID(ctx, branding.AgencyID())
 	if err != nil {
@@ -57,7 +60,7 @@ func (uc *UpdateBrand) Execute(ctx context.Context, req *inbound.UpdateBrandRequ
 	// Update hide_powered_by with tier validation (Growth+ tiers only)
 	if req.HidePoweredBy != nil {
 		if !tenants_model.TierCanHidePoweredBy(tier) {
-			return nil, errors.New("Hide 'Powered by Faro' badge is only available for Growth+ tiers")
+			return nil, domain.ErrHidePoweredByNotAllowed
 		}
 		branding.SetHidePoweredBy(*req.HidePoweredBy)
 	}
@@ -65,7 +68,7 @@ func (uc *UpdateBrand) Execute(ctx context.Context, req *inbound.UpdateBrandRequ
 	// Update domain with tier validation (Scale tier only)
 	if req.Domain != nil {
 		if !tenants_model.TierSupportsCustomDomain(tier) {
-			return nil, errors.New("Custom domain support is only available for Scale tier")
+			return nil, domain.ErrCustomDomainNotAllowed
 		}
 
 		// Scale tier: Allow custom domain configuration
@@ -2,7 +2,6 @@ package usecases
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"farohq-core-app/internal/domains/brand/domain"
@@ -10,7 +9,6 @@ import (
 	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
 	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
 	"farohq-core-app/internal/domains/brand/infra/dns"
-	"farohq-core-app/internal/domains/brand/infra/vercel"
 	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
 
@@ -19,24 +17,27 @@ import (
 
 // VerifyDomain implements the VerifyDomain inbound port
 type VerifyDomain struct {
-	brandRepo     outbound.BrandRepository
-	tenantRepo    tenants_outbound.TenantRepository
-	vercelService *vercel.VercelService
-	dnsService    *dns.DNSService // Optional, for UX feedback only
+	brandRepo      outbound.BrandRepository
+	tenantRepo     tenants_outbound.TenantRepository
+	domainProvider outbound.DomainProvider
+	verifier       outbound.OwnershipVerifier // Optional, nil disables ownership checks
+	dnsService     *dns.DNSService            // Optional, for UX feedback only
 }
 
 // NewVerifyDomain creates a new VerifyDomain use case
 func NewVerifyDomain(
 	brandRepo outbound.BrandRepository,
 	tenantRepo tenants_outbound.TenantRepository,
-	vercelService *vercel.VercelService,
+	domainProvider outbound.DomainProvider,
+	verifier outbound.OwnershipVerifier, // Optional, can be nil
 	dnsService *dns.DNSService, // Optional, can be nil
 ) inbound.VerifyDomain {
 	return &VerifyDomain{
-		brandRepo:     brandRepo,
-		tenantRepo:    tenantRepo,
-		vercelService: vercelService,
-		dnsService:    dnsService,
+		brandRepo:      brandRepo,
+		tenantRepo:     tenantRepo,
+		domainProvider: domainProvider,
+		verifier:       verifier,
+		dnsService:     dnsService,
 	}
 }
 
@@ -55,7 +56,7 @@ func (uc *VerifyDomain) Execute(ctx context.Context, req *inbound.VerifyDomainRe
 
 	tier := tenant.Tier()
 	if !tenants_model.TierSupportsCustomDomain(tier) {
-		return nil, errors.New("Custom domain support is only available for Scale tier")
+		return nil, domain.ErrCustomDomainNotAllowed
 	}
 
 	// Only proceed with domain verification if tier is Scale
@@ -70,51 +71,62 @@ func (uc *VerifyDomain) Execute(ctx context.Context, req *inbound.VerifyDomainRe
 		domainToVerify = branding.Domain()
 	}
 	if domainToVerify == "" {
-		return nil, errors.New("domain is required for verification")
-	}
-
-	// Primary flow (required): Use VercelService as source of truth (Scale tier only)
-	// Step 1: Add domain to Vercel project (if not already added)
-	dnsConfig, err := uc.vercelService.AddDomainToProject(ctx, domainToVerify)
-	if err != nil {
-		// Check if domain already exists (might be 409 conflict or similar)
-		// Try to get existing domain status
-		status, statusErr := uc.vercelService.GetDomainStatus(ctx, domainToVerify)
-		if statusErr != nil {
-			return nil, fmt.Errorf("failed to add domain to Vercel: %w", err)
+		return nil, domain.ErrDomainRequired
+	}
+
+	// Step 0: the agency proves it controls the domain with a _faro-verify TXT record (when checks are enabled)
+	var ownershipRecords []model.DNSRecord
+	var ownership *model.OwnershipCheck
+	if uc.verifier != nil {
+		tokenGenerated := ensureVerificationToken(branding)
+		ownershipRecords = []model.DNSRecord{model.OwnershipRecord(domainToVerify, branding.DomainVerificationToken())}
+		ownership, err = checkOwnership(ctx, uc.verifier, branding, domainToVerify)
+		if err != nil {
+			return nil, err
 		}
-		// Domain already exists, use existing status
-		dnsConfig, _ = uc.vercelService.GetExpectedDNSConfig(ctx, domainToVerify)
-		if dnsConfig == nil && len(status.Config) > 0 {
-			dnsConfig = &vercel.DomainConfig{
-				CNAMETarget: status.Config[0].RecordValue,
-				RecordType:  "CNAME",
-				RecordValue: status.Config[0].RecordValue,
+		if !ownershipProven(ownership) {
+			// The domain is not handed to the provider until ownership is proven
+			if tokenGenerated {
+				if err := uc.brandRepo.Update(ctx, branding); err != nil {
+					return nil, fmt.Errorf("failed to update branding: %w", err)
+				}
 			}
+			return &inbound.VerifyDomainResponse{
+				Branding:  branding,
+				Verified:  false,
+				Records:   ownershipRecords,
+				Ownership: ownership,
+			}, nil
 		}
 	}
 
-	// Step 2: Verify domain via Vercel API (source of truth)
-	verified, err := uc.vercelService.VerifyDomain(ctx, domainToVerify)
+	// Primary flow (required): the domain provider is the source of truth (Scale tier only)
+	// Step 1: Register the domain with the provider (returns the existing registration if already added)
+	if _, err := uc.domainProvider.AddDomain(ctx, domainToVerify); err != nil {
+		return nil, fmt.Errorf("failed to add domain to the domain provider: %w", err)
+	}
+
+	// Step 2: Verify domain via the provider
+	verified, err := uc.domainProvider.VerifyDomain(ctx, domainToVerify)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify domain via Vercel API: %w", err)
+		return nil, fmt.Errorf("failed to verify domain: %w", err)
 	}
 
-	// Step 3: Get full domain status from Vercel API
-	domainStatus, err := uc.vercelService.GetDomainStatus(ctx, domainToVerify)
+	// Step 3: Get full domain status (SSL and expected records) after verification
+	domainStatus, err := uc.domainProvider.GetDomainStatus(ctx, domainToVerify)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get domain status from Vercel API: %w", err)
+		return nil, fmt.Errorf("failed to get domain status: %w", err)
 	}
 
-	// Step 4: Update brand verification fields based on Vercel API response
+	// Step 4: Update brand verification fields based on the provider's response
 	if verified {
 		branding.Verify()
 	}
 
-	// Update SSL status from Vercel API
+	// Update SSL status from the provider
 	var sslStatus *model.SSLStatus
-	if domainStatus.SSL.Status != "" {
-		ss := model.SSLStatus(domainStatus.SSL.Status)
+	if domainStatus.SSLStatus != "" {
+		ss := domainStatus.SSLStatus
 		sslStatus = &ss
 		branding.SetSSLStatus(sslStatus)
 	}
@@ -138,11 +150,6 @@ func (uc *VerifyDomain) Execute(ctx context.Context, req *inbound.VerifyDomainRe
 		// Note: We don't use this result for verification decisions, only for UI feedback
 	}
 
-	expectedCNAME := ""
-	if dnsConfig != nil {
-		expectedCNAME = dnsConfig.CNAMETarget
-	}
-
 	sslStatusStr := ""
 	if sslStatus != nil {
 		sslStatusStr = string(*sslStatus)
@@ -151,8 +158,10 @@ func (uc *VerifyDomain) Execute(ctx context.Context, req *inbound.VerifyDomainRe
 	return &inbound.VerifyDomainResponse{
 		Branding:      branding,
 		Verified:      verified,
-		ExpectedCNAME: expectedCNAME,
+		ExpectedCNAME: cnameTarget(domainStatus.Records),
 		CurrentCNAME:  currentCNAME, // Optional, for UX feedback only
 		SSLStatus:     sslStatusStr,
+		Records:       append(ownershipRecords, domainStatus.Records...),
+		Ownership:     ownership,
 	}, nil
 }
@@ -0,0 +1,214 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/infra/fake"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantRepository is a mock implementation of tenants outbound.TenantRepository
+type MockTenantRepository struct {
+	mock.Mock
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenants_model.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*tenants_model.Tenant, error) {
+	args := m.Called(ctx, ids)
+	return nil, args.Error(1)
+}
+
+func (m *MockTenantRepository) FindBySlug(ctx context.Context, slug string) (*tenants_model.Tenant, error) {
+	args := m.Called(ctx, slug)
+	return nil, args.Error(1)
+}
+
+func (m *MockTenantRepository) Save(ctx context.Context, tenant *tenants_model.Tenant) error {
+	return m.Called(ctx, tenant).Error(0)
+}
+
+func (m *MockTenantRepository) Update(ctx context.Context, tenant *tenants_model.Tenant) error {
+	return m.Called(ctx, tenant).Error(0)
+}
+
+func (m *MockTenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockTenantRepository) ListDueForPurge(ctx context.Context, now, deletedBefore time.Time) ([]uuid.UUID, error) {
+	args := m.Called(ctx, now, deletedBefore)
+	return nil, args.Error(1)
+}
+
+func (m *MockTenantRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func newDomainTestRepos(agencyID uuid.UUID, tier tenants_model.Tier) (*MockBrandRepository, *MockTenantRepository, *model.Branding) {
+	dt := model.DomainTypeCustom
+	branding := model.NewBranding(agencyID, "portal.acme.com", "", &dt, "", "", "", "#112233", "#445566", nil)
+	brandRepo := new(MockBrandRepository)
+	brandRepo.On("FindByAgencyID", mock.Anything, agencyID).Return(branding, nil)
+	brandRepo.On("Update", mock.Anything, branding).Return(nil)
+	tenantRepo := new(MockTenantRepository)
+	tenantRepo.On("FindByID", mock.Anything, agencyID).Return(tenants_model.NewTenant("Acme", "acme", &tier, 10, nil), nil)
+	return brandRepo, tenantRepo, branding
+}
+
+func TestVerifyDomain_Execute(t *testing.T) {
+	agencyID := uuid.New()
+
+	t.Run("verified domains get the provider's SSL status", func(t *testing.T) {
+		brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierScale)
+		provider := fake.NewDomainProvider("cname.example.net", true)
+
+		resp, err := NewVerifyDomain(brandRepo, tenantRepo, provider, nil, nil).Execute(context.Background(),
+			&inbound.VerifyDomainRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Verified)
+		assert.Equal(t, "active", resp.SSLStatus)
+		assert.Equal(t, "cname.example.net", resp.ExpectedCNAME)
+		assert.Equal(t, []model.DNSRecord{{Type: "CNAME", Name: "portal.acme.com", Value: "cname.example.net"}}, resp.Records)
+		assert.NotNil(t, branding.VerifiedAt())
+		assert.Equal(t, []string{"portal.acme.com"}, provider.Domains())
+		brandRepo.AssertCalled(t, "Update", mock.Anything, branding)
+	})
+
+	t.Run("pending domains stay unverified", func(t *testing.T) {
+		brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierScale)
+		provider := fake.NewDomainProvider("cname.example.net", false)
+
+		resp, err := NewVerifyDomain(brandRepo, tenantRepo, provider, nil, nil).Execute(context.Background(),
+			&inbound.VerifyDomainRequest{BrandID: agencyID.String(), Domain: "app.acme.com"})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Verified)
+		assert.Empty(t, resp.SSLStatus)
+		assert.Nil(t, branding.VerifiedAt())
+		assert.Equal(t, "app.acme.com", branding.Domain())
+	})
+
+	t.Run("tiers without custom domains never reach the provider", func(t *testing.T) {
+		brandRepo, tenantRepo, _ := newDomainTestRepos(agencyID, tenants_model.TierGrowth)
+		provider := fake.NewDomainProvider("cname.example.net", true)
+
+		_, err := NewVerifyDomain(brandRepo, tenantRepo, provider, nil, nil).Execute(context.Background(),
+			&inbound.VerifyDomainRequest{BrandID: agencyID.String()})
+
+		assert.Equal(t, domain.ErrCustomDomainNotAllowed, err)
+		assert.Empty(t, provider.Domains())
+	})
+}
+
+// MockOwnershipVerifier is a mock implementation of outbound.OwnershipVerifier
+type MockOwnershipVerifier struct {
+	mock.Mock
+}
+
+func (m *MockOwnershipVerifier) VerifyTXT(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error) {
+	args := m.Called(ctx, record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.OwnershipCheck), args.Error(1)
+}
+
+func TestVerifyDomain_Ownership(t *testing.T) {
+	agencyID := uuid.New()
+
+	t.Run("domains without the ownership record are not handed to the provider", func(t *testing.T) {
+		brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierScale)
+		provider := fake.NewDomainProvider("cname.example.net", true)
+		verifier := new(MockOwnershipVerifier)
+		verifier.On("VerifyTXT", mock.Anything, mock.Anything).Return(&model.OwnershipCheck{Lookups: []model.TXTLookup{{Resolver: "1.1.1.1"}}}, nil)
+
+		resp, err := NewVerifyDomain(brandRepo, tenantRepo, provider, verifier, nil).Execute(context.Background(),
+			&inbound.VerifyDomainRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Verified)
+		require.NotEmpty(t, branding.DomainVerificationToken())
+		record := model.OwnershipRecord("portal.acme.com", branding.DomainVerificationToken())
+		assert.Equal(t, []model.DNSRecord{record}, resp.Records)
+		require.NotNil(t, resp.Ownership)
+		assert.False(t, resp.Ownership.Verified)
+		verifier.AssertCalled(t, "VerifyTXT", mock.Anything, record)
+		assert.Nil(t, branding.VerifiedAt())
+		assert.Empty(t, provider.Domains())
+		brandRepo.AssertCalled(t, "Update", mock.Anything, branding)
+	})
+
+	t.Run("proven ownership lets the provider verify the domain", func(t *testing.T) {
+		brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierScale)
+		branding.SetDomainVerificationToken("0123456789abcdef")
+		record := model.OwnershipRecord("portal.acme.com", "0123456789abcdef")
+		provider := fake.NewDomainProvider("cname.example.net", true)
+		verifier := new(MockOwnershipVerifier)
+		verifier.On("VerifyTXT", mock.Anything, record).Return(&model.OwnershipCheck{Record: record, Verified: true}, nil)
+
+		resp, err := NewVerifyDomain(brandRepo, tenantRepo, provider, verifier, nil).Execute(context.Background(),
+			&inbound.VerifyDomainRequest{BrandID: agencyID.String()})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Verified)
+		assert.Equal(t, []model.DNSRecord{record, {Type: "CNAME", Name: "portal.acme.com", Value: "cname.example.net"}}, resp.Records)
+		assert.NotNil(t, branding.VerifiedAt())
+		assert.Equal(t, "0123456789abcdef", branding.DomainVerificationToken())
+	})
+}
+
+func TestGetDomainStatus_Execute(t *testing.T) {
+	agencyID := uuid.New()
+	brandRepo, tenantRepo, branding := newDomainTestRepos(agencyID, tenants_model.TierScale)
+	provider := fake.NewDomainProvider("cname.example.net", false)
+	uc := NewGetDomainStatus(brandRepo, tenantRepo, provider, nil, nil)
+
+	_, err := uc.Execute(context.Background(), &inbound.GetDomainStatusRequest{BrandID: agencyID.String()})
+	assert.True(t, errors.Is(err, domain.ErrDomainNotRegistered))
+
+	_, err = provider.AddDomain(context.Background(), "portal.acme.com")
+	require.NoError(t, err)
+	provider.SetVerified("portal.acme.com", true)
+	provider.SetSSLStatus("portal.acme.com", model.SSLStatusPending)
+
+	resp, err := uc.Execute(context.Background(), &inbound.GetDomainStatusRequest{BrandID: agencyID.String()})
+	require.NoError(t, err)
+	assert.True(t, resp.Verified)
+	assert.Equal(t, "pending", resp.SSLStatus)
+	assert.Equal(t, "cname.example.net", resp.ExpectedCNAME)
+	assert.NotNil(t, branding.VerifiedAt())
+}
+
+func TestGetDomainInstructions_Execute(t *testing.T) {
+	agencyID := uuid.New()
+	brandRepo, tenantRepo, _ := newDomainTestRepos(agencyID, tenants_model.TierScale)
+	provider := fake.NewDomainProvider("cname.example.net", false)
+
+	resp, err := NewGetDomainInstructions(brandRepo, tenantRepo, provider, nil).Execute(context.Background(),
+		&inbound.GetDomainInstructionsRequest{BrandID: agencyID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cname.example.net", resp.CNAMETarget)
+	assert.Contains(t, resp.Instructions, "Value/Target: cname.example.net")
+	// Asking for instructions registers the domain so the provider can hand out its records
+	assert.Equal(t, []string{"portal.acme.com"}, provider.Domains())
+}
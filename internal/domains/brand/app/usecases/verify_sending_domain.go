@@ -0,0 +1,107 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// VerifySendingDomain implements the VerifySendingDomain inbound port
+type VerifySendingDomain struct {
+	brandRepo         outbound.BrandRepository
+	tenantRepo        tenants_outbound.TenantRepository
+	sendingDomainRepo outbound.SendingDomainRepository
+	provider          outbound.SendingDomainProvider
+	verifier          outbound.RecordVerifier // Optional, nil treats the records as published
+}
+
+// NewVerifySendingDomain creates a new VerifySendingDomain use case
+func NewVerifySendingDomain(
+	brandRepo outbound.BrandRepository,
+	tenantRepo tenants_outbound.TenantRepository,
+	sendingDomainRepo outbound.SendingDomainRepository,
+	provider outbound.SendingDomainProvider,
+	verifier outbound.RecordVerifier, // Optional, can be nil
+) inbound.VerifySendingDomain {
+	return &VerifySendingDomain{
+		brandRepo:         brandRepo,
+		tenantRepo:        tenantRepo,
+		sendingDomainRepo: sendingDomainRepo,
+		provider:          provider,
+		verifier:          verifier,
+	}
+}
+
+// Execute executes the use case
+// The provider is only asked to confirm the domain once DNS lookups find every record, so agencies
+// get per-record propagation feedback without hammering the provider's verification API.
+func (uc *VerifySendingDomain) Execute(ctx context.Context, req *inbound.VerifySendingDomainRequest) (*inbound.VerifySendingDomainResponse, error) {
+	branding, tier, err := findBrandingWithTier(ctx, uc.brandRepo, uc.tenantRepo, req.BrandID)
+	if err != nil {
+		return nil, err
+	}
+	if !tenants_model.TierSupportsSendingDomain(tier) {
+		return nil, domain.ErrSendingDomainNotAllowed
+	}
+
+	sendingDomain, err := uc.sendingDomainRepo.FindByAgencyID(ctx, branding.AgencyID())
+	if err != nil {
+		return nil, err
+	}
+
+	var checks map[model.SendingRecordPurpose]*model.OwnershipCheck
+	published := make(map[model.SendingRecordPurpose]bool)
+	allPublished := true
+	for _, record := range sendingDomain.Records() {
+		if uc.verifier == nil {
+			published[record.Purpose] = true
+			continue
+		}
+		check, err := uc.verifier.VerifyRecord(ctx, record.Record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s record: %w", record.Purpose, err)
+		}
+		if checks == nil {
+			checks = make(map[model.SendingRecordPurpose]*model.OwnershipCheck)
+		}
+		checks[record.Purpose] = check
+		published[record.Purpose] = check.Verified
+		allPublished = allPublished && check.Verified
+	}
+
+	confirmed := false
+	if allPublished {
+		confirmed, err = uc.provider.ConfirmDomain(ctx, sendingDomain.ProviderID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to confirm sending domain: %w", err)
+		}
+	}
+
+	sendingDomain.ApplyCheck(
+		published[model.SendingRecordDKIM],
+		published[model.SendingRecordSPF],
+		published[model.SendingRecordReturnPath],
+		confirmed,
+		time.Now(),
+	)
+	if err := uc.sendingDomainRepo.Save(ctx, sendingDomain); err != nil {
+		return nil, err
+	}
+
+	if err := syncEmailDomain(ctx, uc.brandRepo, branding, sendingDomain); err != nil {
+		return nil, err
+	}
+
+	return &inbound.VerifySendingDomainResponse{
+		SendingDomain:     sendingDomain,
+		Checks:            checks,
+		ProviderConfirmed: confirmed,
+	}, nil
+}
@@ -6,7 +6,55 @@ var (
 	// ErrBrandingNotFound is returned when branding is not found
 	ErrBrandingNotFound = errors.New("branding not found")
 
+	// ErrClientBrandingNotFound is returned when a client has no branding overrides
+	ErrClientBrandingNotFound = errors.New("client branding not found")
+
+	// ErrClientNotFound is returned when a client does not exist or does not belong to the agency
+	ErrClientNotFound = errors.New("client not found")
+
+	// ErrBrandingDraftNotFound is returned when the agency has no unpublished branding draft
+	ErrBrandingDraftNotFound = errors.New("branding draft not found")
+
+	// ErrBrandingVersionNotFound is returned when a branding version does not exist
+	ErrBrandingVersionNotFound = errors.New("branding version not found")
+
+	// ErrInvalidPreviewToken is returned when a preview token does not match the agency's branding draft
+	ErrInvalidPreviewToken = errors.New("invalid or expired preview token")
+
+	// ErrVersionConflict is returned when branding was modified since the version the caller last read
+	ErrVersionConflict = errors.New("branding was modified by another request")
+
 	// ErrInvalidDomain is returned when domain is invalid
 	ErrInvalidDomain = errors.New("invalid domain")
+
+	// ErrDomainRequired is returned when a domain operation has no domain to work on
+	ErrDomainRequired = errors.New("no custom domain configured")
+
+	// ErrDomainNotRegistered is returned when the domain provider does not know the custom domain
+	ErrDomainNotRegistered = errors.New("custom domain not registered with the domain provider")
+
+	// ErrDomainCheckNotFound is returned when a custom domain is not monitored yet
+	ErrDomainCheckNotFound = errors.New("custom domain check not found")
+
+	// ErrSendingDomainNotFound is returned when the agency has no sending domain
+	ErrSendingDomainNotFound = errors.New("sending domain not found")
+
+	// ErrSendingDomainTaken is returned when another agency already sends from the domain
+	ErrSendingDomainTaken = errors.New("sending domain is already registered by another agency")
+
+	// ErrInvalidSenderAddress is returned when the local part of the sender address is invalid
+	ErrInvalidSenderAddress = errors.New("invalid sender address")
+
+	// ErrInvalidSenderName is returned when the sender display name cannot be used in a From header
+	ErrInvalidSenderName = errors.New("invalid sender name")
+
+	// ErrSendingDomainNotAllowed is returned when the tenant's tier does not include white-label sending domains
+	ErrSendingDomainNotAllowed = errors.New("Sending domains are only available for Growth+ tiers")
+
+	// ErrCustomDomainNotAllowed is returned when the tenant's tier does not include custom domains
+	ErrCustomDomainNotAllowed = errors.New("Custom domain support is only available for Scale tier")
+
+	// ErrHidePoweredByNotAllowed is returned when the tenant's tier cannot hide the "Powered by Faro" badge
+	ErrHidePoweredByNotAllowed = errors.New("Hide 'Powered by Faro' badge is only available for Growth+ tiers")
 )
 
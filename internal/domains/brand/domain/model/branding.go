@@ -41,12 +41,15 @@ type Branding struct {
 	primaryColor            string
 	secondaryColor          string
 	themeJSON               map[string]interface{}
+	assets                  map[string]map[string]string // Asset type ("logo", "favicon") to variant URLs, set when uploads are finalized
 	hidePoweredBy           bool
 	emailDomain             string
 	cloudflareZoneID        string
 	domainVerificationToken string
 	sslStatus               *SSLStatus
 	updatedAt               time.Time
+	version                 int64
+	clientID                *uuid.UUID // Set on effective branding resolved for a client (see WithClientBranding)
 }
 
 // NewBranding creates a new branding entity
@@ -67,12 +70,14 @@ func NewBranding(agencyID uuid.UUID, domain, subdomain string, domainType *Domai
 		primaryColor:            primaryColor,
 		secondaryColor:          secondaryColor,
 		themeJSON:               themeJSON,
+		assets:                  make(map[string]map[string]string),
 		hidePoweredBy:           false,
 		emailDomain:             "",
 		cloudflareZoneID:        "",
 		domainVerificationToken: "",
 		sslStatus:               nil,
 		updatedAt:               now,
+		version:                 1,
 	}
 }
 
@@ -85,14 +90,19 @@ func NewBrandingWithID(
 	verifiedAt *time.Time,
 	logoURL, faviconURL, primaryColor, secondaryColor string,
 	themeJSON map[string]interface{},
+	assets map[string]map[string]string,
 	hidePoweredBy bool,
 	emailDomain, cloudflareZoneID, domainVerificationToken string,
 	sslStatus *SSLStatus,
 	updatedAt time.Time,
+	version int64,
 ) *Branding {
 	if themeJSON == nil {
 		themeJSON = make(map[string]interface{})
 	}
+	if assets == nil {
+		assets = make(map[string]map[string]string)
+	}
 	return &Branding{
 		agencyID:                agencyID,
 		domain:                  domain,
@@ -105,20 +115,37 @@ func NewBrandingWithID(
 		primaryColor:            primaryColor,
 		secondaryColor:          secondaryColor,
 		themeJSON:               themeJSON,
+		assets:                  assets,
 		hidePoweredBy:           hidePoweredBy,
 		emailDomain:             emailDomain,
 		cloudflareZoneID:        cloudflareZoneID,
 		domainVerificationToken: domainVerificationToken,
 		sslStatus:               sslStatus,
 		updatedAt:               updatedAt,
+		version:                 version,
 	}
 }
 
+// Version returns the branding version (bumped on every update; used for optimistic concurrency)
+func (b *Branding) Version() int64 {
+	return b.version
+}
+
+// SetVersion sets the branding version (called by the repository after a successful update)
+func (b *Branding) SetVersion(version int64) {
+	b.version = version
+}
+
 // AgencyID returns the agency ID
 func (b *Branding) AgencyID() uuid.UUID {
 	return b.agencyID
 }
 
+// ClientID returns the client this branding was resolved for, or nil for the agency's own branding
+func (b *Branding) ClientID() *uuid.UUID {
+	return b.clientID
+}
+
 // Domain returns the domain
 func (b *Branding) Domain() string {
 	return b.domain
@@ -154,6 +181,16 @@ func (b *Branding) ThemeJSON() map[string]interface{} {
 	return b.themeJSON
 }
 
+// Assets returns the URLs of the processed assets and their derivatives, by asset type and variant
+func (b *Branding) Assets() map[string]map[string]string {
+	return b.assets
+}
+
+// AssetURL returns the URL of an asset variant (e.g. "favicon", "ico"), or "" when it has not been generated
+func (b *Branding) AssetURL(assetType, variant string) string {
+	return b.assets[assetType][variant]
+}
+
 // UpdatedAt returns the update timestamp
 func (b *Branding) UpdatedAt() time.Time {
 	return b.updatedAt
@@ -166,17 +203,52 @@ func (b *Branding) SetDomain(domain string) {
 }
 
 // SetLogoURL sets the logo URL
+// Processed logo derivatives are dropped unless the URL is one of them.
 func (b *Branding) SetLogoURL(logoURL string) {
 	b.logoURL = logoURL
+	b.dropStaleAssets("logo", logoURL)
 	b.updatedAt = time.Now()
 }
 
 // SetFaviconURL sets the favicon URL
+// Processed favicon derivatives are dropped unless the URL is one of them.
 func (b *Branding) SetFaviconURL(faviconURL string) {
 	b.faviconURL = faviconURL
+	b.dropStaleAssets("favicon", faviconURL)
+	b.updatedAt = time.Now()
+}
+
+// SetAssets replaces the variant URLs of a processed asset type
+func (b *Branding) SetAssets(assetType string, urls map[string]string) {
+	assets := make(map[string]map[string]string, len(b.assets)+1)
+	for existingType, existingURLs := range b.assets {
+		assets[existingType] = existingURLs
+	}
+	assets[assetType] = urls
+	b.assets = assets
 	b.updatedAt = time.Now()
 }
 
+// dropStaleAssets removes the derivatives of an asset type that no longer belong to its URL
+func (b *Branding) dropStaleAssets(assetType, url string) {
+	variants, ok := b.assets[assetType]
+	if !ok {
+		return
+	}
+	for _, variantURL := range variants {
+		if variantURL == url {
+			return
+		}
+	}
+	assets := make(map[string]map[string]string, len(b.assets))
+	for existingType, existingURLs := range b.assets {
+		if existingType != assetType {
+			assets[existingType] = existingURLs
+		}
+	}
+	b.assets = assets
+}
+
 // SetPrimaryColor sets the primary color
 func (b *Branding) SetPrimaryColor(color string) {
 	b.primaryColor = color
@@ -451,6 +523,11 @@ func (b *Branding) GetComponentBorderRadius(component, style string) string {
 	return getDefaultBorderRadius(component, style)
 }
 
+// DefaultComponentBorderRadius returns the built-in border radius of a component and style
+func DefaultComponentBorderRadius(component, style string) string {
+	return getDefaultBorderRadius(component, style)
+}
+
 // getDefaultBorderRadius returns default border radius values
 func getDefaultBorderRadius(component, style string) string {
 	defaults := map[string]map[string]string{
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// BrandingContent is the presentation of a branding: the part that is drafted, published and versioned
+// Domain and verification settings are not part of it; they always apply to the live branding immediately.
+type BrandingContent struct {
+	Website        string
+	LogoURL        string
+	FaviconURL     string
+	Assets         map[string]map[string]string
+	PrimaryColor   string
+	SecondaryColor string
+	ThemeJSON      map[string]interface{}
+	HidePoweredBy  bool
+}
+
+// BrandingChange is a difference between two branding contents
+// Theme changes are reported per key ("theme_json.colors.primary"), asset changes per asset type ("assets.logo");
+// From or To is nil when the key is absent on that side.
+type BrandingChange struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
+
+// Content returns the branding's presentation
+func (b *Branding) Content() BrandingContent {
+	return BrandingContent{
+		Website:        b.website,
+		LogoURL:        b.logoURL,
+		FaviconURL:     b.faviconURL,
+		Assets:         b.assets,
+		PrimaryColor:   b.primaryColor,
+		SecondaryColor: b.secondaryColor,
+		ThemeJSON:      b.themeJSON,
+		HidePoweredBy:  b.hidePoweredBy,
+	}
+}
+
+// SetContent replaces the branding's presentation (publishing a draft or rolling back to a version)
+func (b *Branding) SetContent(content BrandingContent) {
+	b.applyContent(content)
+	b.updatedAt = time.Now()
+}
+
+// WithContent returns a copy of the branding showing content, e.g. to preview a draft
+// The branding itself is not modified.
+func (b *Branding) WithContent(content BrandingContent) *Branding {
+	preview := *b
+	preview.applyContent(content)
+	return &preview
+}
+
+func (b *Branding) applyContent(content BrandingContent) {
+	b.website = content.Website
+	b.logoURL = content.LogoURL
+	b.faviconURL = content.FaviconURL
+	b.assets = content.Assets
+	if b.assets == nil {
+		b.assets = make(map[string]map[string]string)
+	}
+	b.primaryColor = content.PrimaryColor
+	b.secondaryColor = content.SecondaryColor
+	b.themeJSON = content.ThemeJSON
+	if b.themeJSON == nil {
+		b.themeJSON = make(map[string]interface{})
+	}
+	b.hidePoweredBy = content.HidePoweredBy
+}
+
+// DiffBrandingContent lists what changes from one branding content to another, in a stable order
+func DiffBrandingContent(from, to BrandingContent) []BrandingChange {
+	var changes []BrandingChange
+	addChange := func(field string, a, b interface{}) {
+		if !reflect.DeepEqual(a, b) {
+			changes = append(changes, BrandingChange{Field: field, From: a, To: b})
+		}
+	}
+
+	addChange("website", from.Website, to.Website)
+	addChange("logo_url", from.LogoURL, to.LogoURL)
+	addChange("favicon_url", from.FaviconURL, to.FaviconURL)
+	for _, assetType := range unionKeys(from.Assets, to.Assets) {
+		addChange("assets."+assetType, assetsOrNil(from.Assets, assetType), assetsOrNil(to.Assets, assetType))
+	}
+	addChange("primary_color", from.PrimaryColor, to.PrimaryColor)
+	addChange("secondary_color", from.SecondaryColor, to.SecondaryColor)
+	changes = append(changes, diffTheme("theme_json", from.ThemeJSON, to.ThemeJSON)...)
+	addChange("hide_powered_by", from.HidePoweredBy, to.HidePoweredBy)
+
+	return changes
+}
+
+// diffTheme diffs two theme objects key by key, descending into objects present on both sides
+func diffTheme(prefix string, from, to map[string]interface{}) []BrandingChange {
+	var changes []BrandingChange
+	for _, key := range unionKeys(from, to) {
+		a, inFrom := from[key]
+		b, inTo := to[key]
+		aMap, aIsMap := a.(map[string]interface{})
+		bMap, bIsMap := b.(map[string]interface{})
+		switch {
+		case aIsMap && bIsMap:
+			changes = append(changes, diffTheme(prefix+"."+key, aMap, bMap)...)
+		case inFrom && inTo && reflect.DeepEqual(a, b):
+		default:
+			changes = append(changes, BrandingChange{Field: prefix + "." + key, From: a, To: b})
+		}
+	}
+	return changes
+}
+
+// assetsOrNil returns the variant URLs of an asset type, or nil (not an empty map) when there are none
+func assetsOrNil(assets map[string]map[string]string, assetType string) interface{} {
+	if urls, ok := assets[assetType]; ok {
+		return urls
+	}
+	return nil
+}
+
+// unionKeys returns the keys of both maps, sorted
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BrandingDraft is an agency's unpublished branding
+// Portals keep showing the live branding until the draft is published; anyone holding the preview token
+// can render the draft on the agency's hosts.
+type BrandingDraft struct {
+	agencyID     uuid.UUID
+	content      BrandingContent
+	previewToken string
+	updatedAt    time.Time
+	version      int64
+}
+
+// NewBrandingDraft creates a draft starting from the live branding's presentation
+func NewBrandingDraft(branding *Branding) *BrandingDraft {
+	return &BrandingDraft{
+		agencyID:     branding.AgencyID(),
+		content:      branding.Content(),
+		previewToken: GeneratePreviewToken(),
+		updatedAt:    time.Now(),
+		version:      1,
+	}
+}
+
+// NewBrandingDraftWithID recreates a draft from persistence
+func NewBrandingDraftWithID(agencyID uuid.UUID, content BrandingContent, previewToken string, updatedAt time.Time, version int64) *BrandingDraft {
+	return &BrandingDraft{
+		agencyID:     agencyID,
+		content:      content,
+		previewToken: previewToken,
+		updatedAt:    updatedAt,
+		version:      version,
+	}
+}
+
+// GeneratePreviewToken generates a random draft preview token
+func GeneratePreviewToken() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// AgencyID returns the agency ID
+func (d *BrandingDraft) AgencyID() uuid.UUID {
+	return d.agencyID
+}
+
+// Content returns the drafted presentation
+func (d *BrandingDraft) Content() BrandingContent {
+	return d.content
+}
+
+// SetContent replaces the drafted presentation
+func (d *BrandingDraft) SetContent(content BrandingContent) {
+	d.content = content
+	d.updatedAt = time.Now()
+}
+
+// PreviewToken returns the token that renders the draft on the agency's hosts
+func (d *BrandingDraft) PreviewToken() string {
+	return d.previewToken
+}
+
+// MatchesPreviewToken reports whether token is the draft's preview token (compared in constant time)
+func (d *BrandingDraft) MatchesPreviewToken(token string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(d.previewToken)) == 1
+}
+
+// UpdatedAt returns the update timestamp
+func (d *BrandingDraft) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// Version returns the draft version (bumped on every update; used for optimistic concurrency)
+func (d *BrandingDraft) Version() int64 {
+	return d.version
+}
+
+// SetVersion sets the draft version (called by the repository after a successful write)
+func (d *BrandingDraft) SetVersion(version int64) {
+	d.version = version
+}
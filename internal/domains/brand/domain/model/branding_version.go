@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BrandingVersion is a previously live branding presentation, archived when it was replaced
+// Versions are numbered per agency in the order they were replaced.
+type BrandingVersion struct {
+	agencyID   uuid.UUID
+	number     int64
+	content    BrandingContent
+	replacedAt time.Time
+}
+
+// NewBrandingVersionWithID recreates a branding version from persistence
+func NewBrandingVersionWithID(agencyID uuid.UUID, number int64, content BrandingContent, replacedAt time.Time) *BrandingVersion {
+	return &BrandingVersion{
+		agencyID:   agencyID,
+		number:     number,
+		content:    content,
+		replacedAt: replacedAt,
+	}
+}
+
+// AgencyID returns the agency ID
+func (v *BrandingVersion) AgencyID() uuid.UUID {
+	return v.agencyID
+}
+
+// Number returns the version number
+func (v *BrandingVersion) Number() int64 {
+	return v.number
+}
+
+// Content returns the archived presentation
+func (v *BrandingVersion) Content() BrandingContent {
+	return v.content
+}
+
+// ReplacedAt returns when the presentation stopped being live
+func (v *BrandingVersion) ReplacedAt() time.Time {
+	return v.replacedAt
+}
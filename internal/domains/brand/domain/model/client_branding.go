@@ -0,0 +1,186 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientBranding represents a client's branding overrides on top of its agency's branding
+// Unset fields (nil) and theme keys the client does not set are inherited from the agency branding;
+// the domain and tier-based settings always come from the agency.
+type ClientBranding struct {
+	clientID       uuid.UUID
+	agencyID       uuid.UUID
+	logoURL        *string
+	faviconURL     *string
+	primaryColor   *string
+	secondaryColor *string
+	themeJSON      map[string]interface{}
+	updatedAt      time.Time
+	version        int64
+}
+
+// NewClientBranding creates client branding that inherits everything from the agency
+func NewClientBranding(clientID, agencyID uuid.UUID) *ClientBranding {
+	return &ClientBranding{
+		clientID:  clientID,
+		agencyID:  agencyID,
+		themeJSON: make(map[string]interface{}),
+		updatedAt: time.Now(),
+		version:   1,
+	}
+}
+
+// NewClientBrandingWithID recreates client branding from persistence
+func NewClientBrandingWithID(
+	clientID, agencyID uuid.UUID,
+	logoURL, faviconURL, primaryColor, secondaryColor *string,
+	themeJSON map[string]interface{},
+	updatedAt time.Time,
+	version int64,
+) *ClientBranding {
+	if themeJSON == nil {
+		themeJSON = make(map[string]interface{})
+	}
+	return &ClientBranding{
+		clientID:       clientID,
+		agencyID:       agencyID,
+		logoURL:        logoURL,
+		faviconURL:     faviconURL,
+		primaryColor:   primaryColor,
+		secondaryColor: secondaryColor,
+		themeJSON:      themeJSON,
+		updatedAt:      updatedAt,
+		version:        version,
+	}
+}
+
+// ClientID returns the client ID
+func (c *ClientBranding) ClientID() uuid.UUID {
+	return c.clientID
+}
+
+// AgencyID returns the ID of the agency whose branding is overridden
+func (c *ClientBranding) AgencyID() uuid.UUID {
+	return c.agencyID
+}
+
+// LogoURL returns the logo URL override (nil inherits the agency logo)
+func (c *ClientBranding) LogoURL() *string {
+	return c.logoURL
+}
+
+// FaviconURL returns the favicon URL override (nil inherits the agency favicon)
+func (c *ClientBranding) FaviconURL() *string {
+	return c.faviconURL
+}
+
+// PrimaryColor returns the primary color override (nil inherits the agency color)
+func (c *ClientBranding) PrimaryColor() *string {
+	return c.primaryColor
+}
+
+// SecondaryColor returns the secondary color override (nil inherits the agency color)
+func (c *ClientBranding) SecondaryColor() *string {
+	return c.secondaryColor
+}
+
+// ThemeJSON returns the theme overrides, merged key by key into the agency theme
+func (c *ClientBranding) ThemeJSON() map[string]interface{} {
+	return c.themeJSON
+}
+
+// UpdatedAt returns the update timestamp
+func (c *ClientBranding) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// Version returns the client branding version (bumped on every update; used for optimistic concurrency)
+func (c *ClientBranding) Version() int64 {
+	return c.version
+}
+
+// SetVersion sets the client branding version (called by the repository after a successful update)
+func (c *ClientBranding) SetVersion(version int64) {
+	c.version = version
+}
+
+// SetLogoURL sets the logo URL override (nil inherits)
+func (c *ClientBranding) SetLogoURL(logoURL *string) {
+	c.logoURL = logoURL
+	c.updatedAt = time.Now()
+}
+
+// SetFaviconURL sets the favicon URL override (nil inherits)
+func (c *ClientBranding) SetFaviconURL(faviconURL *string) {
+	c.faviconURL = faviconURL
+	c.updatedAt = time.Now()
+}
+
+// SetPrimaryColor sets the primary color override (nil inherits)
+func (c *ClientBranding) SetPrimaryColor(color *string) {
+	c.primaryColor = color
+	c.updatedAt = time.Now()
+}
+
+// SetSecondaryColor sets the secondary color override (nil inherits)
+func (c *ClientBranding) SetSecondaryColor(color *string) {
+	c.secondaryColor = color
+	c.updatedAt = time.Now()
+}
+
+// SetThemeJSON sets the theme overrides (empty inherits the whole agency theme)
+func (c *ClientBranding) SetThemeJSON(themeJSON map[string]interface{}) {
+	if themeJSON == nil {
+		themeJSON = make(map[string]interface{})
+	}
+	c.themeJSON = themeJSON
+	c.updatedAt = time.Now()
+}
+
+// WithClientBranding returns the effective branding for a client: a copy of the agency branding
+// with the client's overrides applied. The agency branding itself is not modified.
+func (b *Branding) WithClientBranding(cb *ClientBranding) *Branding {
+	effective := *b
+	clientID := cb.ClientID()
+	effective.clientID = &clientID
+
+	// The agency's processed derivatives no longer match an overridden logo or favicon
+	if cb.LogoURL() != nil {
+		effective.logoURL = *cb.LogoURL()
+		effective.dropStaleAssets("logo", effective.logoURL)
+	}
+	if cb.FaviconURL() != nil {
+		effective.faviconURL = *cb.FaviconURL()
+		effective.dropStaleAssets("favicon", effective.faviconURL)
+	}
+	if cb.PrimaryColor() != nil {
+		effective.primaryColor = *cb.PrimaryColor()
+	}
+	if cb.SecondaryColor() != nil {
+		effective.secondaryColor = *cb.SecondaryColor()
+	}
+	effective.themeJSON = mergeTheme(b.themeJSON, cb.ThemeJSON())
+
+	return &effective
+}
+
+// mergeTheme deep-merges theme overrides into a base theme without modifying either
+// Nested objects are merged key by key; any other override value replaces the base value.
+func mergeTheme(base, overrides map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(overrides))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overrides {
+		overrideMap, overrideIsMap := value.(map[string]interface{})
+		baseMap, baseIsMap := merged[key].(map[string]interface{})
+		if overrideIsMap && baseIsMap {
+			merged[key] = mergeTheme(baseMap, overrideMap)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
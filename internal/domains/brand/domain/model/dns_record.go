@@ -0,0 +1,8 @@
+package model
+
+// DNSRecord is a DNS record the customer must create for a custom domain
+type DNSRecord struct {
+	Type  string // "CNAME", "TXT", "A", ...
+	Name  string // Fully qualified record name
+	Value string
+}
@@ -0,0 +1,183 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DomainHealth represents the monitored state of a custom domain
+type DomainHealth string
+
+const (
+	DomainHealthPending  DomainHealth = "pending"  // Never went live (DNS or certificate still missing)
+	DomainHealthLive     DomainHealth = "live"     // Routed to the portal with an active certificate
+	DomainHealthDegraded DomainHealth = "degraded" // Was live, but the provider no longer routes it or its certificate failed
+)
+
+// DomainEvent is a change of a custom domain's health worth telling the agency about
+type DomainEvent string
+
+const (
+	DomainEventNone   DomainEvent = ""
+	DomainEventLive   DomainEvent = "live"   // The domain went live (or recovered)
+	DomainEventBroken DomainEvent = "broken" // A live domain broke
+)
+
+// Check scheduling: failing checks back off exponentially from domainCheckBaseDelay,
+// live domains are re-validated every domainLiveRecheckInterval.
+const (
+	domainCheckBaseDelay      = 5 * time.Minute
+	domainPendingMaxDelay     = 6 * time.Hour
+	domainDegradedMaxDelay    = time.Hour
+	domainLiveRecheckInterval = 6 * time.Hour
+)
+
+// DomainCheck is the monitoring state of an agency's custom domain
+type DomainCheck struct {
+	agencyID    uuid.UUID
+	domain      string
+	health      DomainHealth
+	failures    int
+	lastError   string
+	checkedAt   *time.Time
+	nextCheckAt time.Time
+	updatedAt   time.Time
+}
+
+// NewDomainCheck creates a pending check for a domain, due immediately
+func NewDomainCheck(agencyID uuid.UUID, domain string, now time.Time) *DomainCheck {
+	return &DomainCheck{
+		agencyID:    agencyID,
+		domain:      domain,
+		health:      DomainHealthPending,
+		nextCheckAt: now,
+		updatedAt:   now,
+	}
+}
+
+// NewDomainCheckWithID recreates a domain check from persistence
+func NewDomainCheckWithID(
+	agencyID uuid.UUID,
+	domain string,
+	health DomainHealth,
+	failures int,
+	lastError string,
+	checkedAt *time.Time,
+	nextCheckAt, updatedAt time.Time,
+) *DomainCheck {
+	return &DomainCheck{
+		agencyID:    agencyID,
+		domain:      domain,
+		health:      health,
+		failures:    failures,
+		lastError:   lastError,
+		checkedAt:   checkedAt,
+		nextCheckAt: nextCheckAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// AgencyID returns the ID of the agency owning the domain
+func (c *DomainCheck) AgencyID() uuid.UUID {
+	return c.agencyID
+}
+
+// Domain returns the monitored domain
+func (c *DomainCheck) Domain() string {
+	return c.domain
+}
+
+// Health returns the domain's health as of the last check
+func (c *DomainCheck) Health() DomainHealth {
+	return c.health
+}
+
+// Failures returns the number of consecutive failed checks
+func (c *DomainCheck) Failures() int {
+	return c.failures
+}
+
+// LastError returns why the last failed check failed
+func (c *DomainCheck) LastError() string {
+	return c.lastError
+}
+
+// CheckedAt returns when the domain was last checked, or nil if it never was
+func (c *DomainCheck) CheckedAt() *time.Time {
+	return c.checkedAt
+}
+
+// NextCheckAt returns when the domain is due for its next check
+func (c *DomainCheck) NextCheckAt() time.Time {
+	return c.nextCheckAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (c *DomainCheck) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// RecordResult records the outcome of a check and schedules the next one
+// It returns the event to notify the agency about, if the domain's health changed in a way they care about.
+func (c *DomainCheck) RecordResult(live bool, problem string, now time.Time) DomainEvent {
+	c.checkedAt = &now
+	c.updatedAt = now
+
+	if live {
+		event := DomainEventNone
+		if c.health != DomainHealthLive {
+			event = DomainEventLive
+		}
+		c.health = DomainHealthLive
+		c.failures = 0
+		c.lastError = ""
+		c.nextCheckAt = now.Add(domainLiveRecheckInterval)
+		return event
+	}
+
+	event := DomainEventNone
+	if c.health == DomainHealthLive {
+		c.health = DomainHealthDegraded
+		c.failures = 0
+		event = DomainEventBroken
+	}
+	c.failures++
+	c.lastError = problem
+
+	maxDelay := domainPendingMaxDelay
+	if c.health == DomainHealthDegraded {
+		maxDelay = domainDegradedMaxDelay
+	}
+	c.nextCheckAt = now.Add(backoff(c.failures, maxDelay))
+	return event
+}
+
+// Postpone schedules the next check without recording a result (the provider could not be asked)
+func (c *DomainCheck) Postpone(now time.Time, delay time.Duration) {
+	c.nextCheckAt = now.Add(delay)
+	c.updatedAt = now
+}
+
+// Reset starts monitoring a new domain from scratch
+func (c *DomainCheck) Reset(domain string, now time.Time) {
+	c.domain = domain
+	c.health = DomainHealthPending
+	c.failures = 0
+	c.lastError = ""
+	c.checkedAt = nil
+	c.nextCheckAt = now
+	c.updatedAt = now
+}
+
+// backoff returns the delay after the given number of consecutive failures
+func backoff(failures int, maxDelay time.Duration) time.Duration {
+	delay := domainCheckBaseDelay
+	for i := 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// OwnershipRecordLabel is the label under which agencies publish their domain ownership challenge
+const OwnershipRecordLabel = "_faro-verify"
+
+// OwnershipRecord returns the TXT record proving the agency controls domain
+func OwnershipRecord(domain, token string) DNSRecord {
+	return DNSRecord{
+		Type:  "TXT",
+		Name:  OwnershipRecordLabel + "." + domain,
+		Value: "faro-verify=" + token,
+	}
+}
+
+// GenerateDomainVerificationToken generates a random domain ownership token
+func GenerateDomainVerificationToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// TXTLookup is the outcome of looking up the ownership record on one resolver
+type TXTLookup struct {
+	Resolver      string
+	Authoritative bool     // The resolver is one of the domain's authoritative nameservers
+	Found         bool     // The expected value is published
+	Values        []string // TXT values the resolver returned
+	Error         string   // Set when the resolver could not be queried
+}
+
+// OwnershipCheck is the result of checking a domain's ownership record
+type OwnershipCheck struct {
+	Record   DNSRecord
+	Verified bool
+	Lookups  []TXTLookup // Propagation per resolver
+}
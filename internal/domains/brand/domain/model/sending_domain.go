@@ -0,0 +1,236 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultSenderLocalPart is the local part agency emails are sent from when none is configured
+const DefaultSenderLocalPart = "noreply"
+
+var (
+	hostnameLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	localPartPattern     = regexp.MustCompile(`^[a-z0-9]([a-z0-9._+-]{0,62}[a-z0-9])?$`)
+)
+
+// SendingRecordPurpose identifies what a sending domain DNS record is for
+type SendingRecordPurpose string
+
+const (
+	SendingRecordDKIM       SendingRecordPurpose = "dkim"        // Public key the provider signs the agency's emails with
+	SendingRecordSPF        SendingRecordPurpose = "spf"         // Authorizes the provider to send for the domain
+	SendingRecordReturnPath SendingRecordPurpose = "return_path" // Bounce domain, aligning the envelope sender with the domain
+)
+
+// SendingRecord is a DNS record of a sending domain and whether its last check found it published
+type SendingRecord struct {
+	Purpose  SendingRecordPurpose
+	Record   DNSRecord
+	Verified bool
+}
+
+// SendingDomain is the domain an agency sends its emails from
+// Emails only use it while all of its records are verified; until then they are sent from the platform address.
+type SendingDomain struct {
+	agencyID           uuid.UUID
+	domain             string
+	localPart          string
+	fromName           string // Display name; empty uses the agency name
+	providerID         string
+	dkim               DNSRecord
+	returnPath         DNSRecord
+	spf                DNSRecord
+	dkimVerified       bool
+	spfVerified        bool
+	returnPathVerified bool
+	verifiedAt         *time.Time
+	checkedAt          *time.Time
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+// NewSendingDomain creates an unregistered sending domain
+func NewSendingDomain(agencyID uuid.UUID, domain, localPart, fromName string) *SendingDomain {
+	now := time.Now()
+	return &SendingDomain{
+		agencyID:  agencyID,
+		domain:    domain,
+		localPart: localPart,
+		fromName:  fromName,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+// NewSendingDomainWithID recreates a sending domain from persistence
+func NewSendingDomainWithID(
+	agencyID uuid.UUID,
+	domain, localPart, fromName, providerID string,
+	dkim, returnPath, spf DNSRecord,
+	dkimVerified, spfVerified, returnPathVerified bool,
+	verifiedAt, checkedAt *time.Time,
+	createdAt, updatedAt time.Time,
+) *SendingDomain {
+	return &SendingDomain{
+		agencyID:           agencyID,
+		domain:             domain,
+		localPart:          localPart,
+		fromName:           fromName,
+		providerID:         providerID,
+		dkim:               dkim,
+		returnPath:         returnPath,
+		spf:                spf,
+		dkimVerified:       dkimVerified,
+		spfVerified:        spfVerified,
+		returnPathVerified: returnPathVerified,
+		verifiedAt:         verifiedAt,
+		checkedAt:          checkedAt,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// AgencyID returns the agency ID
+func (d *SendingDomain) AgencyID() uuid.UUID {
+	return d.agencyID
+}
+
+// Domain returns the sending domain
+func (d *SendingDomain) Domain() string {
+	return d.domain
+}
+
+// LocalPart returns the local part of the sender address
+func (d *SendingDomain) LocalPart() string {
+	return d.localPart
+}
+
+// FromName returns the configured display name (empty uses the agency name)
+func (d *SendingDomain) FromName() string {
+	return d.fromName
+}
+
+// Address returns the sender address
+func (d *SendingDomain) Address() string {
+	return d.localPart + "@" + d.domain
+}
+
+// ProviderID returns the ID of the domain at the email provider
+func (d *SendingDomain) ProviderID() string {
+	return d.providerID
+}
+
+// Records returns the DNS records the agency must publish, with their last check results
+func (d *SendingDomain) Records() []SendingRecord {
+	return []SendingRecord{
+		{Purpose: SendingRecordDKIM, Record: d.dkim, Verified: d.dkimVerified},
+		{Purpose: SendingRecordSPF, Record: d.spf, Verified: d.spfVerified},
+		{Purpose: SendingRecordReturnPath, Record: d.returnPath, Verified: d.returnPathVerified},
+	}
+}
+
+// Verified returns whether all records were verified by the last check
+func (d *SendingDomain) Verified() bool {
+	return d.verifiedAt != nil
+}
+
+// VerifiedAt returns when all records were verified
+func (d *SendingDomain) VerifiedAt() *time.Time {
+	return d.verifiedAt
+}
+
+// CheckedAt returns when the records were last checked
+func (d *SendingDomain) CheckedAt() *time.Time {
+	return d.checkedAt
+}
+
+// CreatedAt returns the creation timestamp
+func (d *SendingDomain) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (d *SendingDomain) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// SetFromName sets the display name
+func (d *SendingDomain) SetFromName(fromName string) {
+	d.fromName = fromName
+	d.updatedAt = time.Now()
+}
+
+// SetLocalPart sets the local part of the sender address
+func (d *SendingDomain) SetLocalPart(localPart string) {
+	d.localPart = localPart
+	d.updatedAt = time.Now()
+}
+
+// Register records the provider's registration of the domain; the records must be verified again
+// The SPF record authorizes spfInclude alongside whatever else the domain already sends through.
+func (d *SendingDomain) Register(providerID string, dkim, returnPath DNSRecord, spfInclude string) {
+	d.providerID = providerID
+	d.dkim = dkim
+	d.returnPath = returnPath
+	d.spf = DNSRecord{Type: "TXT", Name: d.domain, Value: "v=spf1 include:" + spfInclude + " ~all"}
+	d.dkimVerified = false
+	d.spfVerified = false
+	d.returnPathVerified = false
+	d.verifiedAt = nil
+	d.checkedAt = nil
+	d.updatedAt = time.Now()
+}
+
+// ApplyCheck records the result of checking the records
+// The domain is verified while all of them are published and the provider confirmed them,
+// and unverified again as soon as one goes missing.
+func (d *SendingDomain) ApplyCheck(dkim, spf, returnPath, providerConfirmed bool, now time.Time) {
+	d.dkimVerified = dkim
+	d.spfVerified = spf
+	d.returnPathVerified = returnPath
+	d.checkedAt = &now
+	if dkim && spf && returnPath && providerConfirmed {
+		if d.verifiedAt == nil {
+			d.verifiedAt = &now
+		}
+	} else {
+		d.verifiedAt = nil
+	}
+	d.updatedAt = now
+}
+
+// NormalizeHostname lowercases a hostname and checks that it is a valid multi-label domain name
+func NormalizeHostname(name string) (string, bool) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	labels := strings.Split(name, ".")
+	if len(name) > 253 || len(labels) < 2 {
+		return "", false
+	}
+	for _, label := range labels {
+		if !hostnameLabelPattern.MatchString(label) {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+// NormalizeLocalPart lowercases the local part of a sender address and checks it
+func NormalizeLocalPart(localPart string) (string, bool) {
+	localPart = strings.ToLower(strings.TrimSpace(localPart))
+	if localPart == "" {
+		return DefaultSenderLocalPart, true
+	}
+	if !localPartPattern.MatchString(localPart) || strings.Contains(localPart, "..") {
+		return "", false
+	}
+	return localPart, true
+}
+
+// ValidSenderName checks that a display name can be used in a From header
+// Quotes, angle brackets and line breaks are rejected so the name cannot alter the header.
+func ValidSenderName(name string) bool {
+	return len(name) <= 100 && !strings.ContainsAny(name, "\"<>\r\n")
+}
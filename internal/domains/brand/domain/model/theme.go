@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// Theme is the compiled design-token set of a branding: tonal palettes, light and dark color tokens and component radii
+// It is a read model derived from Branding (see services.ThemeCompiler) and served as-is, hence the exported fields.
+type Theme struct {
+	AgencyID uuid.UUID                    `json:"agency_id"`
+	ClientID *uuid.UUID                   `json:"client_id"`
+	Palettes map[string]map[string]string `json:"palettes"` // Palette name -> step ("50".."900") -> #rrggbb
+	Light    map[string]string            `json:"light"`    // Token name -> color
+	Dark     map[string]string            `json:"dark"`     // Token name -> color
+	Radii    map[string]string            `json:"radii"`    // Component -> border radius
+	Contrast []ContrastCheck              `json:"contrast"` // Every foreground/background token pair and its contrast ratio
+}
+
+// ContrastCheck is the contrast of a foreground token against a background token in one color mode
+type ContrastCheck struct {
+	Mode       string  `json:"mode"`
+	Foreground string  `json:"foreground"`
+	Background string  `json:"background"`
+	Ratio      float64 `json:"ratio"`
+	Minimum    float64 `json:"minimum"`
+	Pass       bool    `json:"pass"`
+}
@@ -0,0 +1,20 @@
+package inbound
+
+import (
+	"context"
+)
+
+// DeleteBrandingDraft is the inbound port for discarding an agency's branding draft
+type DeleteBrandingDraft interface {
+	Execute(ctx context.Context, req *DeleteBrandingDraftRequest) (*DeleteBrandingDraftResponse, error)
+}
+
+// DeleteBrandingDraftRequest represents the request
+type DeleteBrandingDraftRequest struct {
+	BrandID string
+}
+
+// DeleteBrandingDraftResponse represents the response
+type DeleteBrandingDraftResponse struct {
+	Success bool
+}
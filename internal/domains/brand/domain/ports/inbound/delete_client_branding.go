@@ -0,0 +1,21 @@
+package inbound
+
+import (
+	"context"
+)
+
+// DeleteClientBranding is the inbound port for removing a client's branding overrides
+type DeleteClientBranding interface {
+	Execute(ctx context.Context, req *DeleteClientBrandingRequest) (*DeleteClientBrandingResponse, error)
+}
+
+// DeleteClientBrandingRequest represents the request
+type DeleteClientBrandingRequest struct {
+	BrandID  string
+	ClientID string
+}
+
+// DeleteClientBrandingResponse represents the response
+type DeleteClientBrandingResponse struct {
+	Success bool
+}
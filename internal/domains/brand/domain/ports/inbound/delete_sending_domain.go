@@ -0,0 +1,20 @@
+package inbound
+
+import (
+	"context"
+)
+
+// DeleteSendingDomain is the inbound port for removing an agency's sending domain
+type DeleteSendingDomain interface {
+	Execute(ctx context.Context, req *DeleteSendingDomainRequest) (*DeleteSendingDomainResponse, error)
+}
+
+// DeleteSendingDomainRequest represents the request
+type DeleteSendingDomainRequest struct {
+	BrandID string
+}
+
+// DeleteSendingDomainResponse represents the response
+type DeleteSendingDomainResponse struct {
+	Success bool
+}
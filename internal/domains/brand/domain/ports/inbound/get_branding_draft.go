@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// GetBrandingDraft is the inbound port for getting an agency's unpublished branding draft
+type GetBrandingDraft interface {
+	Execute(ctx context.Context, req *GetBrandingDraftRequest) (*GetBrandingDraftResponse, error)
+}
+
+// GetBrandingDraftRequest represents the request
+type GetBrandingDraftRequest struct {
+	BrandID string
+}
+
+// GetBrandingDraftResponse represents the response
+type GetBrandingDraftResponse struct {
+	Draft    *model.BrandingDraft
+	Branding *model.Branding        // Live branding
+	Changes  []model.BrandingChange // What publishing the draft would change
+}
@@ -13,7 +13,10 @@ type GetByHost interface {
 
 // GetByHostRequest represents the request
 type GetByHostRequest struct {
-	Host string
+	Host     string
+	ClientID string // Optional: client context; the client's branding overrides are applied when set
+	// PreviewToken, if set, renders the agency's branding draft instead of the live branding
+	PreviewToken string
 }
 
 // GetByHostResponse represents the response
@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// GetClientBranding is the inbound port for getting a client's branding
+type GetClientBranding interface {
+	Execute(ctx context.Context, req *GetClientBrandingRequest) (*GetClientBrandingResponse, error)
+}
+
+// GetClientBrandingRequest represents the request
+type GetClientBrandingRequest struct {
+	BrandID  string
+	ClientID string
+}
+
+// GetClientBrandingResponse represents the response
+type GetClientBrandingResponse struct {
+	ClientBranding *model.ClientBranding // nil when the client inherits the agency branding unchanged
+	Branding       *model.Branding       // Effective branding: the agency branding with the client's overrides applied
+}
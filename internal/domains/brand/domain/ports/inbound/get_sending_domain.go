@@ -0,0 +1,22 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// GetSendingDomain is the inbound port for getting an agency's sending domain and the records to publish
+type GetSendingDomain interface {
+	Execute(ctx context.Context, req *GetSendingDomainRequest) (*GetSendingDomainResponse, error)
+}
+
+// GetSendingDomainRequest represents the request
+type GetSendingDomainRequest struct {
+	BrandID string
+}
+
+// GetSendingDomainResponse represents the response
+type GetSendingDomainResponse struct {
+	SendingDomain *model.SendingDomain
+}
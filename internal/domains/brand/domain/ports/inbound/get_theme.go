@@ -0,0 +1,25 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// GetTheme is the inbound port for getting the compiled theme of the branding served on a host
+type GetTheme interface {
+	Execute(ctx context.Context, req *GetThemeRequest) (*GetThemeResponse, error)
+}
+
+// GetThemeRequest represents the request
+type GetThemeRequest struct {
+	Host     string
+	ClientID string // Optional: client context; the theme is compiled from the client's effective branding
+	// PreviewToken, if set, compiles the agency's branding draft instead of the live branding
+	PreviewToken string
+}
+
+// GetThemeResponse represents the response
+type GetThemeResponse struct {
+	Theme *model.Theme
+}
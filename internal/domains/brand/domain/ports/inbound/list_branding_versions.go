@@ -0,0 +1,30 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// ListBrandingVersions is the inbound port for listing an agency's branding version history
+type ListBrandingVersions interface {
+	Execute(ctx context.Context, req *ListBrandingVersionsRequest) (*ListBrandingVersionsResponse, error)
+}
+
+// ListBrandingVersionsRequest represents the request
+type ListBrandingVersionsRequest struct {
+	BrandID string
+	Limit   int // Most recent versions to return (default 20, at most 100)
+}
+
+// BrandingVersionEntry is a version with what replaced it
+type BrandingVersionEntry struct {
+	Version *model.BrandingVersion
+	Changes []model.BrandingChange // From this version to the next one (or the live branding for the newest)
+}
+
+// ListBrandingVersionsResponse represents the response
+type ListBrandingVersionsResponse struct {
+	Branding *model.Branding // Live branding
+	Versions []BrandingVersionEntry
+}
@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// PublishBrandingDraft is the inbound port for making an agency's branding draft live
+type PublishBrandingDraft interface {
+	Execute(ctx context.Context, req *PublishBrandingDraftRequest) (*PublishBrandingDraftResponse, error)
+}
+
+// PublishBrandingDraftRequest represents the request
+type PublishBrandingDraftRequest struct {
+	BrandID string
+	// ExpectedVersion, if set, makes publishing fail with ErrVersionConflict unless the draft is still at this version
+	ExpectedVersion *int64
+}
+
+// PublishBrandingDraftResponse represents the response
+type PublishBrandingDraftResponse struct {
+	Branding *model.Branding // Live branding, now showing the draft
+}
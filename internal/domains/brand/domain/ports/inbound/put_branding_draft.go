@@ -0,0 +1,36 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// PutBrandingDraft is the inbound port for creating or editing an agency's branding draft
+type PutBrandingDraft interface {
+	Execute(ctx context.Context, req *PutBrandingDraftRequest) (*PutBrandingDraftResponse, error)
+}
+
+// PutBrandingDraftRequest represents the request
+// A new draft starts from the live branding; nil fields keep their drafted value.
+type PutBrandingDraftRequest struct {
+	BrandID        string
+	Website        *string
+	LogoURL        *string
+	FaviconURL     *string
+	PrimaryColor   *string
+	SecondaryColor *string
+	ThemeJSON      *map[string]interface{}
+	HidePoweredBy  *bool // Growth+ tiers only
+	// ExpectedVersion, if set, makes the write fail unless the draft is still at this version
+	// (0 expects no draft yet, so the write only creates it)
+	ExpectedVersion *int64
+}
+
+// PutBrandingDraftResponse represents the response
+type PutBrandingDraftResponse struct {
+	Draft    *model.BrandingDraft
+	Branding *model.Branding        // Live branding
+	Changes  []model.BrandingChange // What publishing the draft would change
+	Created  bool
+}
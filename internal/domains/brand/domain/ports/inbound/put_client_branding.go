@@ -0,0 +1,34 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// PutClientBranding is the inbound port for creating or replacing a client's branding overrides
+type PutClientBranding interface {
+	Execute(ctx context.Context, req *PutClientBrandingRequest) (*PutClientBrandingResponse, error)
+}
+
+// PutClientBrandingRequest represents the request
+// Nil fields and theme keys that are not set are inherited from the agency branding.
+type PutClientBrandingRequest struct {
+	BrandID        string
+	ClientID       string
+	LogoURL        *string
+	FaviconURL     *string
+	PrimaryColor   *string
+	SecondaryColor *string
+	ThemeJSON      map[string]interface{}
+	// ExpectedVersion, if set, makes the write fail unless the overrides are still at this version
+	// (0 expects the client to have no overrides yet, so the write only creates them)
+	ExpectedVersion *int64
+}
+
+// PutClientBrandingResponse represents the response
+type PutClientBrandingResponse struct {
+	ClientBranding *model.ClientBranding
+	Branding       *model.Branding // Effective branding
+	Created        bool
+}
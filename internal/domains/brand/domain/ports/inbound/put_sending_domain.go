@@ -0,0 +1,27 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// PutSendingDomain is the inbound port for setting the domain an agency sends its emails from
+type PutSendingDomain interface {
+	Execute(ctx context.Context, req *PutSendingDomainRequest) (*PutSendingDomainResponse, error)
+}
+
+// PutSendingDomainRequest represents the request
+// Changing the domain registers the new one with the email provider; its records must be verified again.
+type PutSendingDomainRequest struct {
+	BrandID   string
+	Domain    string
+	LocalPart string // Optional: defaults to noreply
+	FromName  string // Optional: defaults to the agency name
+}
+
+// PutSendingDomainResponse represents the response
+type PutSendingDomainResponse struct {
+	SendingDomain *model.SendingDomain
+	Created       bool
+}
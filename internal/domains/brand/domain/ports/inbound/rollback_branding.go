@@ -0,0 +1,25 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// RollbackBranding is the inbound port for restoring a previous branding version
+type RollbackBranding interface {
+	Execute(ctx context.Context, req *RollbackBrandingRequest) (*RollbackBrandingResponse, error)
+}
+
+// RollbackBrandingRequest represents the request
+type RollbackBrandingRequest struct {
+	BrandID string
+	Version int64 // Number of the version to restore
+	// ExpectedVersion, if set, makes the rollback fail with ErrVersionConflict unless the branding is still at this version
+	ExpectedVersion *int64
+}
+
+// RollbackBrandingResponse represents the response
+type RollbackBrandingResponse struct {
+	Branding *model.Branding
+}
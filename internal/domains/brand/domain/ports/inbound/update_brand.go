@@ -22,6 +22,8 @@ type UpdateBrandRequest struct {
 	SecondaryColor *string
 	ThemeJSON      *map[string]interface{}
 	HidePoweredBy  *bool                   // Optional: Hide "Powered by Faro" badge (Growth+ tiers only)
+	// ExpectedVersion, if set, makes the update fail with ErrVersionConflict unless the branding is still at this version
+	ExpectedVersion *int64
 }
 
 // UpdateBrandResponse represents the response
@@ -21,9 +21,11 @@ type VerifyDomainRequest struct {
 type VerifyDomainResponse struct {
 	Branding      *model.Branding
 	Verified      bool
-	ExpectedCNAME string // CNAME target from Vercel API (value may vary, don't hardcode)
-	CurrentCNAME  string // Current DNS record (optional, for UX feedback only)
-	SSLStatus     string // SSL status from Vercel API: "pending", "active", "failed"
+	ExpectedCNAME string                // CNAME target from the domain provider (value may vary, don't hardcode)
+	CurrentCNAME  string                // Current DNS record (optional, for UX feedback only)
+	SSLStatus     string                // SSL status from the domain provider: "pending", "active", "failed"
+	Records       []model.DNSRecord     // DNS records to create: the ownership TXT record and the provider's records
+	Ownership     *model.OwnershipCheck // Ownership record propagation (nil when ownership checks are disabled)
 }
 
 // GetDomainStatus is the inbound port for getting full domain status
@@ -39,9 +41,12 @@ type GetDomainStatusRequest struct {
 // GetDomainStatusResponse represents the response
 type GetDomainStatusResponse struct {
 	Branding      *model.Branding
-	Verified      bool   // Domain verification status from Vercel API
-	ExpectedCNAME string // Expected CNAME target from Vercel API
-	SSLStatus     string // SSL status from Vercel API: "pending", "active", "failed"
+	Verified      bool                  // Domain verification status from the domain provider
+	ExpectedCNAME string                // Expected CNAME target from the domain provider
+	SSLStatus     string                // SSL status from the domain provider: "pending", "active", "failed"
+	Records       []model.DNSRecord     // DNS records the provider expects
+	Check         *model.DomainCheck    // Background monitoring state, nil until the monitor picks up the domain
+	Ownership     *model.OwnershipCheck // Set when the ownership record was checked to verify the domain
 }
 
 // GetDomainInstructions is the inbound port for getting DNS setup instructions
@@ -56,7 +61,8 @@ type GetDomainInstructionsRequest struct {
 
 // GetDomainInstructionsResponse represents the response
 type GetDomainInstructionsResponse struct {
-	Domain        string // Custom domain
-	CNAMETarget   string // CNAME target from Vercel API (value may vary, don't hardcode)
-	Instructions  string // Human-readable instructions for DNS setup
+	Domain       string            // Custom domain
+	CNAMETarget  string            // CNAME target from the domain provider (value may vary, don't hardcode)
+	Records      []model.DNSRecord // All DNS records to create (ownership TXT record, CNAME and any provider TXT records)
+	Instructions string            // Human-readable instructions for DNS setup
 }
@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// VerifySendingDomain is the inbound port for checking that an agency published its sending domain records
+type VerifySendingDomain interface {
+	Execute(ctx context.Context, req *VerifySendingDomainRequest) (*VerifySendingDomainResponse, error)
+}
+
+// VerifySendingDomainRequest represents the request
+type VerifySendingDomainRequest struct {
+	BrandID string
+}
+
+// VerifySendingDomainResponse represents the response
+type VerifySendingDomainResponse struct {
+	SendingDomain     *model.SendingDomain
+	Checks            map[model.SendingRecordPurpose]*model.OwnershipCheck // Propagation per record (nil without DNS checks)
+	ProviderConfirmed bool                                                 // The email provider verified the records too
+}
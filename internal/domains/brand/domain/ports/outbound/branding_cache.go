@@ -0,0 +1,52 @@
+package outbound
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CachedBranding is a resolved public branding response, or a negative entry for a lookup no branding answers
+type CachedBranding struct {
+	AgencyID uuid.UUID // uuid.Nil for negative entries
+	Host     string    // BrandingHost of the host or domain the lookup asked for, so claiming it drops negative entries
+	Body     []byte    // Encoded response; nil for negative entries
+}
+
+// Found reports whether the entry holds a branding rather than a cached not-found
+func (e *CachedBranding) Found() bool {
+	return e.Body != nil
+}
+
+// BrandingCache caches public branding lookups by request key
+type BrandingCache interface {
+	Get(ctx context.Context, key string) (*CachedBranding, bool)
+	Set(ctx context.Context, key string, entry *CachedBranding) error
+	// Invalidate drops every entry of the agency and every entry (negative ones included) for the hosts
+	Invalidate(ctx context.Context, agencyID uuid.UUID, hosts ...string) error
+}
+
+// BrandingHost returns the form a host is cached under: lowercased, without port or trailing dot
+// Lookup keys, cached entries and invalidations all use it, so invalidating a host drops every lookup of it.
+func BrandingHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// HostCacheKey returns the cache key of a by-host or by-subdomain lookup
+// Client portals resolve to different responses, so the client context is part of the key.
+func HostCacheKey(host, clientID string) string {
+	if id, err := uuid.Parse(clientID); err == nil {
+		return "host:" + BrandingHost(host) + "|" + id.String()
+	}
+	return "host:" + BrandingHost(host) + "|"
+}
+
+// DomainCacheKey returns the cache key of a by-domain lookup
+func DomainCacheKey(domain string) string {
+	return "domain:" + BrandingHost(domain)
+}
@@ -0,0 +1,17 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// BrandingDraftRepository defines the interface for branding draft data access
+type BrandingDraftRepository interface {
+	FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.BrandingDraft, error)
+	Save(ctx context.Context, draft *model.BrandingDraft) error
+	Update(ctx context.Context, draft *model.BrandingDraft) error
+	Delete(ctx context.Context, agencyID uuid.UUID) error
+}
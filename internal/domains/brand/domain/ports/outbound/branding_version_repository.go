@@ -0,0 +1,17 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// BrandingVersionRepository defines the interface for branding version history data access
+// Versions are archived by the database whenever the live branding's presentation changes.
+type BrandingVersionRepository interface {
+	// ListByAgencyID returns the agency's most recent versions, newest first
+	ListByAgencyID(ctx context.Context, agencyID uuid.UUID, limit int) ([]*model.BrandingVersion, error)
+	FindByNumber(ctx context.Context, agencyID uuid.UUID, number int64) (*model.BrandingVersion, error)
+}
@@ -0,0 +1,17 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// ClientBrandingRepository defines the interface for client branding override data access
+type ClientBrandingRepository interface {
+	FindByClientID(ctx context.Context, clientID uuid.UUID) (*model.ClientBranding, error)
+	Save(ctx context.Context, clientBranding *model.ClientBranding) error
+	Update(ctx context.Context, clientBranding *model.ClientBranding) error
+	Delete(ctx context.Context, clientID uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// DomainCheckRepository defines the interface for custom domain monitoring state
+type DomainCheckRepository interface {
+	// FindByAgencyID returns domain.ErrDomainCheckNotFound if the agency's domain is not monitored yet
+	FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.DomainCheck, error)
+	// ClaimDue starts monitoring custom domains that have no check yet and returns up to limit checks due at now.
+	// Claimed checks are pushed back by lease so concurrent instances skip them until they are saved.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.DomainCheck, error)
+	Save(ctx context.Context, check *model.DomainCheck) error
+	Delete(ctx context.Context, agencyID uuid.UUID) error
+}
@@ -0,0 +1,22 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// DomainNotice describes a custom domain health change
+type DomainNotice struct {
+	AgencyID uuid.UUID
+	Domain   string
+	Event    model.DomainEvent
+	Problem  string // Why the domain broke (empty when it went live)
+}
+
+// DomainNotifier tells an agency about changes to its custom domain's health
+type DomainNotifier interface {
+	NotifyDomainChange(ctx context.Context, notice *DomainNotice) error
+}
@@ -0,0 +1,32 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// CustomDomain is a custom domain as seen by the domain provider
+type CustomDomain struct {
+	Domain    string
+	Verified  bool              // The provider routes the domain to the portal
+	SSLStatus model.SSLStatus   // Empty when the provider has not started issuing a certificate
+	Records   []model.DNSRecord // Records the customer must create (routing and ownership)
+}
+
+// DomainProvider defines the interface for the platform serving white-label portals on custom domains
+// Implementations return domain.ErrDomainNotRegistered for domains that were never added (or were removed).
+type DomainProvider interface {
+	// AddDomain registers a custom domain; adding an already registered domain returns its current state
+	AddDomain(ctx context.Context, domain string) (*CustomDomain, error)
+	// GetExpectedRecords returns the DNS records the customer must create for a registered domain
+	GetExpectedRecords(ctx context.Context, domain string) ([]model.DNSRecord, error)
+	// VerifyDomain asks the provider to check the domain's DNS and returns whether it is verified
+	VerifyDomain(ctx context.Context, domain string) (bool, error)
+	// GetDomainStatus returns the provider's current view of a registered domain
+	GetDomainStatus(ctx context.Context, domain string) (*CustomDomain, error)
+	// GetSSLStatus returns the status of the domain's certificate
+	GetSSLStatus(ctx context.Context, domain string) (model.SSLStatus, error)
+	// RemoveDomain unregisters a domain; removing an unknown domain is not an error
+	RemoveDomain(ctx context.Context, domain string) error
+}
@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// OwnershipVerifier checks that a domain publishes its ownership TXT record
+// Resolver failures are reported in the check's lookups rather than returned as errors.
+type OwnershipVerifier interface {
+	VerifyTXT(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error)
+}
@@ -0,0 +1,14 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// RecordVerifier checks that a domain publishes a DNS record, with the same propagation rules as ownership checks
+// TXT records match exactly, except SPF records, which match when the published policy has the expected includes;
+// CNAME records match their target. Resolver failures are reported in the check's lookups rather than returned as errors.
+type RecordVerifier interface {
+	VerifyRecord(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error)
+}
@@ -0,0 +1,26 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+)
+
+// SendingDomainRegistration is a sending domain as registered with the email provider
+type SendingDomainRegistration struct {
+	ProviderID string
+	DKIM       model.DNSRecord // TXT record with the provider's DKIM public key
+	ReturnPath model.DNSRecord // CNAME record of the bounce domain
+	SPFInclude string          // Domain the agency's SPF record must include
+}
+
+// SendingDomainProvider defines the interface for the email provider sending agency emails from their own domains
+type SendingDomainProvider interface {
+	// RegisterDomain registers a sending domain; registering an already registered domain returns its registration
+	RegisterDomain(ctx context.Context, domain string) (*SendingDomainRegistration, error)
+	// ConfirmDomain asks the provider to check the published records so it starts signing with the domain's key
+	// Returns whether the provider considers the DKIM and return-path records verified.
+	ConfirmDomain(ctx context.Context, providerID string) (bool, error)
+	// RemoveDomain unregisters a domain; removing an unknown domain is not an error
+	RemoveDomain(ctx context.Context, providerID string) error
+}
@@ -0,0 +1,18 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// SendingDomainRepository defines the interface for sending domain persistence
+type SendingDomainRepository interface {
+	FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.SendingDomain, error)
+	FindByDomain(ctx context.Context, domain string) (*model.SendingDomain, error)
+	// Save creates or replaces the agency's sending domain; returns ErrSendingDomainTaken if another agency has the domain
+	Save(ctx context.Context, sendingDomain *model.SendingDomain) error
+	Delete(ctx context.Context, agencyID uuid.UUID) error
+}
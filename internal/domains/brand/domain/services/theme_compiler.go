@@ -0,0 +1,266 @@
+package services
+
+import (
+	"math"
+	"regexp"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/utils"
+)
+
+const (
+	// DefaultPrimaryColor is used when a branding has no (valid) primary color
+	DefaultPrimaryColor = "#2563eb"
+	// DefaultSecondaryColor is used when a branding has no (valid) secondary color
+	DefaultSecondaryColor = "#475569"
+
+	// minTextContrast is the WCAG AA minimum for normal text
+	minTextContrast = 4.5
+	// minUIContrast is the WCAG AA minimum for borders, focus indicators and other UI components
+	minUIContrast = 3.0
+)
+
+// paletteSteps are the tonal palette steps, as the share of white (lighter) or black (darker) mixed into the base color
+var paletteSteps = []struct {
+	name    string
+	mixWith string
+	weight  float64
+}{
+	{"50", "#ffffff", 0.95},
+	{"100", "#ffffff", 0.9},
+	{"200", "#ffffff", 0.75},
+	{"300", "#ffffff", 0.6},
+	{"400", "#ffffff", 0.3},
+	{"500", "#ffffff", 0},
+	{"600", "#000000", 0.15},
+	{"700", "#000000", 0.3},
+	{"800", "#000000", 0.45},
+	{"900", "#000000", 0.6},
+}
+
+// neutralPalette is the gray scale surfaces, text and borders are derived from
+var neutralPalette = map[string]string{
+	"50":  "#f9fafb",
+	"100": "#f3f4f6",
+	"200": "#e5e7eb",
+	"300": "#d1d5db",
+	"400": "#9ca3af",
+	"500": "#6b7280",
+	"600": "#4b5563",
+	"700": "#374151",
+	"800": "#1f2937",
+	"900": "#111827",
+}
+
+// cssLengthPattern matches the radius values accepted from theme_json (a plain CSS length or percentage)
+var cssLengthPattern = regexp.MustCompile(`^\d+(\.\d+)?(px|rem|em|%)$`)
+
+// themeComponents are the components that get a radius token (see Branding.GetComponentBorderRadius)
+var themeComponents = []string{"button", "card", "panel", "tile", "badge", "input"}
+
+// contrastPair is a foreground token that must stay readable on a background token
+type contrastPair struct {
+	foreground string
+	background string
+	text       bool // Text pairs need the text minimum, the others the UI component minimum
+}
+
+// contrastPairs are the token pairs whose contrast is enforced in both color modes
+var contrastPairs = []contrastPair{
+	{"text", "background", true},
+	{"text", "surface", true},
+	{"text", "surface-muted", true},
+	{"text-muted", "background", true},
+	{"text-muted", "surface", true},
+	{"text-muted", "surface-muted", true},
+	{"link", "background", true},
+	{"link", "surface", true},
+	{"link", "surface-muted", true},
+	{"on-primary", "primary", true},
+	{"on-primary", "primary-hover", true},
+	{"on-secondary", "secondary", true},
+	{"border", "background", false},
+	{"border", "surface", false},
+	{"focus-ring", "background", false},
+	{"focus-ring", "surface", false},
+	{"focus-ring", "surface-muted", false},
+}
+
+// ThemeCompiler turns a branding into a complete design-token set
+type ThemeCompiler struct{}
+
+// NewThemeCompiler creates a new theme compiler
+func NewThemeCompiler() *ThemeCompiler {
+	return &ThemeCompiler{}
+}
+
+// Compile derives the tonal palettes, light and dark color tokens and component radii of a branding
+// Foreground tokens are adjusted until every pair in contrastPairs meets WCAG AA (4.5:1 for text, 3:1 for UI
+// components), or the branding's stricter theme_json contrast.minimum_ratio for text.
+func (c *ThemeCompiler) Compile(branding *model.Branding) *model.Theme {
+	primary := colorOrDefault(branding.PrimaryColor(), DefaultPrimaryColor)
+	secondary := colorOrDefault(branding.SecondaryColor(), DefaultSecondaryColor)
+
+	textMin := math.Max(minTextContrast, branding.GetContrastMinimumRatio())
+
+	primaryPalette := TonalPalette(primary)
+	secondaryPalette := TonalPalette(secondary)
+
+	light := compileMode(modeColors{
+		background:   "#ffffff",
+		surface:      neutralPalette["50"],
+		surfaceMuted: neutralPalette["100"],
+		text:         neutralPalette["900"],
+		textMuted:    neutralPalette["600"],
+		border:       neutralPalette["400"],
+		primary:      primary,
+		primaryHover: primaryPalette["600"],
+		secondary:    secondary,
+		link:         primary,
+	}, textMin)
+
+	dark := compileMode(modeColors{
+		background:   neutralPalette["900"],
+		surface:      neutralPalette["800"],
+		surfaceMuted: neutralPalette["700"],
+		text:         neutralPalette["50"],
+		textMuted:    neutralPalette["400"],
+		border:       neutralPalette["500"],
+		primary:      primaryPalette["400"],
+		primaryHover: primaryPalette["300"],
+		secondary:    secondaryPalette["400"],
+		link:         primaryPalette["300"],
+	}, textMin)
+
+	radii := make(map[string]string, len(themeComponents))
+	for _, component := range themeComponents {
+		radius := branding.GetComponentBorderRadius(component, "default")
+		if !cssLengthPattern.MatchString(radius) {
+			radius = model.DefaultComponentBorderRadius(component, "default")
+		}
+		radii[component] = radius
+	}
+
+	theme := &model.Theme{
+		AgencyID: branding.AgencyID(),
+		ClientID: branding.ClientID(),
+		Palettes: map[string]map[string]string{
+			"primary":   primaryPalette,
+			"secondary": secondaryPalette,
+			"neutral":   neutralPalette,
+		},
+		Light: light,
+		Dark:  dark,
+		Radii: radii,
+	}
+	theme.Contrast = append(contrastChecks("light", light, textMin), contrastChecks("dark", dark, textMin)...)
+
+	return theme
+}
+
+// TonalPalette derives the 50-900 palette of a base color (500 is the base color itself)
+func TonalPalette(base string) map[string]string {
+	palette := make(map[string]string, len(paletteSteps))
+	for _, step := range paletteSteps {
+		palette[step.name] = utils.MixColors(base, step.mixWith, step.weight)
+	}
+	return palette
+}
+
+// modeColors are the starting colors of a color mode, before contrast is enforced
+type modeColors struct {
+	background   string
+	surface      string
+	surfaceMuted string
+	text         string
+	textMuted    string
+	border       string
+	primary      string
+	primaryHover string
+	secondary    string
+	link         string
+}
+
+// compileMode builds the color tokens of one mode, adjusting foregrounds until they meet their contrast minimum
+func compileMode(colors modeColors, textMin float64) map[string]string {
+	backgrounds := []string{colors.background, colors.surface, colors.surfaceMuted}
+	primary := utils.NormalizeHex(colors.primary)
+	secondary := utils.NormalizeHex(colors.secondary)
+	onPrimary := readableOn(labelColor(primary), []string{primary}, textMin)
+	// The hover shade is a background for the same label, so it is adjusted against the label rather than the other way round
+	primaryHover := readableOn(colors.primaryHover, []string{onPrimary}, textMin)
+
+	return map[string]string{
+		"background":    colors.background,
+		"surface":       colors.surface,
+		"surface-muted": colors.surfaceMuted,
+		"text":          readableOn(colors.text, backgrounds, textMin),
+		"text-muted":    readableOn(colors.textMuted, backgrounds, textMin),
+		"border":        readableOn(colors.border, backgrounds[:2], minUIContrast),
+		"primary":       primary,
+		"primary-hover": primaryHover,
+		"on-primary":    onPrimary,
+		"secondary":     secondary,
+		"on-secondary":  readableOn(labelColor(secondary), []string{secondary}, textMin),
+		"link":          readableOn(colors.link, backgrounds, textMin),
+		"focus-ring":    readableOn(colors.primary, backgrounds, minUIContrast),
+	}
+}
+
+// labelColor picks white or dark text for a filled background, whichever contrasts more
+// (utils.GetContrastingTextColor switches at 50% luminance, which puts white labels on mid-tone colors such as amber).
+func labelColor(background string) string {
+	if utils.CalculateContrastRatio("#ffffff", background) >= utils.CalculateContrastRatio(neutralPalette["800"], background) {
+		return "#ffffff"
+	}
+	return neutralPalette["800"]
+}
+
+// readableOn adjusts a foreground color until it meets minRatio against every background
+// The backgrounds of one mode are close in luminance, so adjusting against the weakest one converges in a few passes.
+func readableOn(color string, backgrounds []string, minRatio float64) string {
+	color = utils.NormalizeHex(color)
+	for pass := 0; pass < 2*len(backgrounds); pass++ {
+		weakest := ""
+		weakestRatio := math.Inf(1)
+		for _, background := range backgrounds {
+			if ratio := utils.CalculateContrastRatio(color, background); ratio < weakestRatio {
+				weakest, weakestRatio = background, ratio
+			}
+		}
+		if weakestRatio >= minRatio {
+			return color
+		}
+		color = utils.AdjustForContrast(color, weakest, minRatio)
+	}
+	return color
+}
+
+// contrastChecks reports the contrast of every enforced token pair in a mode
+func contrastChecks(mode string, tokens map[string]string, textMin float64) []model.ContrastCheck {
+	checks := make([]model.ContrastCheck, 0, len(contrastPairs))
+	for _, pair := range contrastPairs {
+		minimum := minUIContrast
+		if pair.text {
+			minimum = textMin
+		}
+		ratio := utils.CalculateContrastRatio(tokens[pair.foreground], tokens[pair.background])
+		checks = append(checks, model.ContrastCheck{
+			Mode:       mode,
+			Foreground: pair.foreground,
+			Background: pair.background,
+			Ratio:      math.Round(ratio*100) / 100,
+			Minimum:    minimum,
+			Pass:       ratio >= minimum,
+		})
+	}
+	return checks
+}
+
+// colorOrDefault returns color as #rrggbb, or fallback when it is not a hex color
+func colorOrDefault(color, fallback string) string {
+	if !utils.IsHexColor(color) {
+		return fallback
+	}
+	return utils.NormalizeHex(color)
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"testing"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBranding(primary, secondary string, themeJSON map[string]interface{}) *model.Branding {
+	return model.NewBranding(uuid.New(), "", "acme.portal.farohq.com", nil, "", "", "", primary, secondary, themeJSON)
+}
+
+func TestThemeCompiler_Compile_EnforcesContrastForEveryPair(t *testing.T) {
+	colors := []string{
+		"#ffffff", "#000000", "#777777", "#767676", "#ffff00", "#00ff00", "#00ffff", "#ff0000",
+		"#2563eb", "#f59e0b", "#10b981", "#ec4899", "#1f2937", "#fef3c7", "#6366f1", "#808080",
+	}
+	compiler := NewThemeCompiler()
+
+	for _, primary := range colors {
+		for _, secondary := range []string{"#ffffff", "#000000", "#777777", primary} {
+			theme := compiler.Compile(newTestBranding(primary, secondary, nil))
+
+			require.Len(t, theme.Contrast, 2*len(contrastPairs))
+			for _, check := range theme.Contrast {
+				assert.True(t, check.Pass, "%s: %s on %s is %.2f:1 for primary %s, secondary %s",
+					check.Mode, check.Foreground, check.Background, check.Ratio, primary, secondary)
+				tokens := theme.Light
+				if check.Mode == "dark" {
+					tokens = theme.Dark
+				}
+				assert.GreaterOrEqual(t, utils.CalculateContrastRatio(tokens[check.Foreground], tokens[check.Background]), check.Minimum)
+			}
+		}
+	}
+}
+
+func TestThemeCompiler_Compile_Tokens(t *testing.T) {
+	branding := newTestBranding("#2563EB", "", map[string]interface{}{
+		"spacing": map[string]interface{}{
+			"border_radius": map[string]interface{}{
+				"card": map[string]interface{}{"default": "20px"},
+			},
+		},
+	})
+
+	theme := NewThemeCompiler().Compile(branding)
+
+	assert.Equal(t, branding.AgencyID(), theme.AgencyID)
+	assert.Nil(t, theme.ClientID)
+
+	// The brand color is kept as-is in light mode; dark mode uses a lighter shade
+	assert.Equal(t, "#2563eb", theme.Palettes["primary"]["500"])
+	assert.Equal(t, "#2563eb", theme.Light["primary"])
+	assert.Equal(t, theme.Palettes["primary"]["400"], theme.Dark["primary"])
+	assert.Equal(t, "#ffffff", theme.Light["on-primary"])
+
+	// A missing secondary color falls back to the default
+	assert.Equal(t, DefaultSecondaryColor, theme.Palettes["secondary"]["500"])
+
+	assert.Equal(t, "#ffffff", theme.Light["background"])
+	assert.Equal(t, neutralPalette["900"], theme.Dark["background"])
+
+	assert.Equal(t, "20px", theme.Radii["card"])
+	assert.Equal(t, "999px", theme.Radii["button"])
+	assert.Len(t, theme.Radii, len(themeComponents))
+}
+
+func TestThemeCompiler_Compile_HonorsStricterMinimumRatio(t *testing.T) {
+	branding := newTestBranding("#2563eb", "#475569", map[string]interface{}{
+		"contrast": map[string]interface{}{"minimum_ratio": 7.0},
+	})
+
+	theme := NewThemeCompiler().Compile(branding)
+
+	for _, check := range theme.Contrast {
+		if check.Foreground == "text" || check.Foreground == "link" {
+			assert.Equal(t, 7.0, check.Minimum)
+			assert.True(t, check.Pass, "%s: %s on %s is %.2f:1", check.Mode, check.Foreground, check.Background, check.Ratio)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// IsHexColor reports whether color is a #RGB or #RRGGBB hex color
+func IsHexColor(color string) bool {
+	return hexColorPattern.MatchString(strings.TrimSpace(color))
+}
+
+// NormalizeHex returns a color (hex, rgb or rgba) as lowercase #rrggbb
+// Colors that cannot be parsed become black, matching how contrast is calculated for them.
+func NormalizeHex(color string) string {
+	r, g, b := parseColor(color)
+	return toHex(r, g, b)
+}
+
+// MixColors mixes color with other in sRGB and returns the result as #rrggbb
+// weight is the share of other: 0 returns color, 1 returns other.
+func MixColors(color, other string, weight float64) string {
+	weight = math.Max(0, math.Min(1, weight))
+	r1, g1, b1 := parseColor(color)
+	r2, g2, b2 := parseColor(other)
+	return toHex(
+		r1+(r2-r1)*weight,
+		g1+(g2-g1)*weight,
+		b1+(b2-b1)*weight,
+	)
+}
+
+// AdjustForContrast returns color, darkened or lightened as little as possible, so that it reaches
+// minRatio against background. If no shade of color gets there, the better of black and white is returned.
+func AdjustForContrast(color, background string, minRatio float64) string {
+	color = NormalizeHex(color)
+	if CalculateContrastRatio(color, background) >= minRatio {
+		return color
+	}
+
+	for step := 1; step <= 20; step++ {
+		weight := float64(step) / 20
+		darker := MixColors(color, "#000000", weight)
+		lighter := MixColors(color, "#ffffff", weight)
+		darkerRatio := CalculateContrastRatio(darker, background)
+		lighterRatio := CalculateContrastRatio(lighter, background)
+
+		switch {
+		case darkerRatio >= minRatio && lighterRatio >= minRatio:
+			if darkerRatio >= lighterRatio {
+				return darker
+			}
+			return lighter
+		case darkerRatio >= minRatio:
+			return darker
+		case lighterRatio >= minRatio:
+			return lighter
+		}
+	}
+
+	if CalculateContrastRatio("#000000", background) >= CalculateContrastRatio("#ffffff", background) {
+		return "#000000"
+	}
+	return "#ffffff"
+}
+
+// toHex formats RGB values (0-1 range) as #rrggbb
+func toHex(r, g, b float64) string {
+	return fmt.Sprintf("#%02x%02x%02x", toByte(r), toByte(g), toByte(b))
+}
+
+// toByte converts a 0-1 channel value to 0-255
+func toByte(value float64) int {
+	return int(math.Round(math.Max(0, math.Min(1, value)) * 255))
+}
@@ -0,0 +1,335 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// invalidationChannel is the Redis pub/sub channel used to drop local entries on every instance
+	invalidationChannel = "branding_cache:invalidate"
+
+	// localTTL bounds how long an instance serves an entry from memory
+	// Pub/sub normally drops stale entries immediately; the TTL covers missed messages and instances without Redis.
+	localTTL = 10 * time.Second
+
+	// negativeTTL bounds how long an unknown host stays unknown after a branding claims it on another path
+	negativeTTL = 30 * time.Second
+
+	// maxLocalEntries caps the in-process layer; the least recently used entries are evicted first
+	maxLocalEntries = 10000
+)
+
+// storedBranding is the Redis encoding of a cached branding
+type storedBranding struct {
+	AgencyID uuid.UUID `json:"agency_id"`
+	Host     string    `json:"host"`
+	Body     []byte    `json:"body"`
+}
+
+// localBrandingEntry is an instance-local copy of a cached branding
+type localBrandingEntry struct {
+	key       string
+	entry     *outbound.CachedBranding
+	expiresAt time.Time
+}
+
+// BrandingCache caches public branding lookups in Redis/Dragonfly behind an in-process LRU
+// Entries are indexed by agency and by host so invalidating a branding drops every lookup that resolved to it,
+// and every negative entry for the hosts it now claims. Without a Redis client only the in-process layer is used.
+type BrandingCache struct {
+	client *redis.Client
+	ttl    time.Duration
+	logger zerolog.Logger
+	prefix string
+
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // Most recently used first
+}
+
+// NewBrandingCache creates a new branding cache; client may be nil
+func NewBrandingCache(client *redis.Client, ttl time.Duration, logger zerolog.Logger) *BrandingCache {
+	if ttl == 0 {
+		ttl = 5 * time.Minute // Default TTL
+	}
+	return &BrandingCache{
+		client:   client,
+		ttl:      ttl,
+		logger:   logger,
+		prefix:   "branding_cache:",
+		capacity: maxLocalEntries,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *BrandingCache) entryKey(key string) string {
+	return c.prefix + "entry:" + key
+}
+
+func (c *BrandingCache) agencyKey(agencyID uuid.UUID) string {
+	return c.prefix + "agency:" + agencyID.String()
+}
+
+func (c *BrandingCache) hostKey(host string) string {
+	return c.prefix + "host:" + host
+}
+
+// Get retrieves a cached branding lookup
+func (c *BrandingCache) Get(ctx context.Context, key string) (*outbound.CachedBranding, bool) {
+	if entry, found := c.getLocal(key); found {
+		return entry, true
+	}
+	if c.client == nil {
+		return nil, false
+	}
+
+	val, err := c.client.Get(ctx, c.entryKey(key)).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			c.logger.Debug().
+				Str("key", key).
+				Err(err).
+				Msg("Failed to get branding cache from Redis")
+		}
+		return nil, false
+	}
+
+	var stored storedBranding
+	if err := json.Unmarshal(val, &stored); err != nil {
+		c.logger.Warn().
+			Str("key", key).
+			Err(err).
+			Msg("Failed to unmarshal branding cache from Redis")
+		return nil, false
+	}
+
+	entry := &outbound.CachedBranding{AgencyID: stored.AgencyID, Host: stored.Host, Body: stored.Body}
+	c.setLocal(key, entry)
+	return entry, true
+}
+
+// Set stores a branding lookup; negative entries expire sooner
+func (c *BrandingCache) Set(ctx context.Context, key string, entry *outbound.CachedBranding) error {
+	entry = &outbound.CachedBranding{AgencyID: entry.AgencyID, Host: outbound.BrandingHost(entry.Host), Body: entry.Body}
+	c.setLocal(key, entry)
+	if c.client == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(storedBranding{AgencyID: entry.AgencyID, Host: entry.Host, Body: entry.Body})
+	if err != nil {
+		return err
+	}
+
+	ttl := c.ttl
+	if !entry.Found() {
+		ttl = negativeTTL
+	}
+
+	// Index sets are refreshed on every write so they outlive the entries they point to
+	pipe := c.client.Pipeline()
+	pipe.Set(ctx, c.entryKey(key), data, ttl)
+	if entry.AgencyID != uuid.Nil {
+		pipe.SAdd(ctx, c.agencyKey(entry.AgencyID), key)
+		pipe.Expire(ctx, c.agencyKey(entry.AgencyID), c.ttl)
+	}
+	if entry.Host != "" {
+		pipe.SAdd(ctx, c.hostKey(entry.Host), key)
+		pipe.Expire(ctx, c.hostKey(entry.Host), c.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.logger.Error().
+			Str("key", key).
+			Err(err).
+			Msg("Failed to set branding cache in Redis")
+		return err
+	}
+	return nil
+}
+
+// Invalidate drops the agency's entries and the hosts' entries on every instance
+func (c *BrandingCache) Invalidate(ctx context.Context, agencyID uuid.UUID, hosts ...string) error {
+	normalized := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if host = outbound.BrandingHost(host); host != "" {
+			normalized = append(normalized, host)
+		}
+	}
+
+	c.dropLocal(agencyID, normalized)
+	if c.client == nil {
+		return nil
+	}
+
+	indexKeys := make([]string, 0, len(normalized)+1)
+	if agencyID != uuid.Nil {
+		indexKeys = append(indexKeys, c.agencyKey(agencyID))
+	}
+	for _, host := range normalized {
+		indexKeys = append(indexKeys, c.hostKey(host))
+	}
+	if len(indexKeys) == 0 {
+		return nil
+	}
+
+	pipe := c.client.Pipeline()
+	members := make([]*redis.StringSliceCmd, 0, len(indexKeys))
+	for _, indexKey := range indexKeys {
+		members = append(members, pipe.SMembers(ctx, indexKey))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.logger.Error().
+			Str("agency_id", agencyID.String()).
+			Err(err).
+			Msg("Failed to read branding cache index from Redis")
+		return err
+	}
+
+	keys := indexKeys
+	for _, cmd := range members {
+		for _, key := range cmd.Val() {
+			keys = append(keys, c.entryKey(key))
+		}
+	}
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		c.logger.Error().
+			Str("agency_id", agencyID.String()).
+			Err(err).
+			Msg("Failed to invalidate branding cache in Redis")
+		return err
+	}
+
+	c.publish(ctx, strings.Join(append([]string{agencyID.String()}, normalized...), " "))
+
+	c.logger.Debug().
+		Str("agency_id", agencyID.String()).
+		Strs("hosts", normalized).
+		Int("deleted_keys", len(keys)).
+		Msg("Invalidated branding cache")
+
+	return nil
+}
+
+// Subscribe drops local entries invalidated by other instances until ctx is cancelled
+func (c *BrandingCache) Subscribe(ctx context.Context) {
+	if c.client == nil {
+		return
+	}
+
+	pubsub := c.client.Subscribe(ctx, invalidationChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			// Payload is "<agency ID> <host>..."
+			fields := strings.Fields(msg.Payload)
+			if len(fields) == 0 {
+				continue
+			}
+			agencyID, err := uuid.Parse(fields[0])
+			if err != nil {
+				continue
+			}
+			c.dropLocal(agencyID, fields[1:])
+		}
+	}
+}
+
+// publish broadcasts an invalidation to every instance
+func (c *BrandingCache) publish(ctx context.Context, payload string) {
+	if err := c.client.Publish(ctx, invalidationChannel, payload).Err(); err != nil {
+		// Other instances still drop the entry once their local copy expires
+		c.logger.Warn().
+			Str("payload", payload).
+			Err(err).
+			Msg("Failed to publish branding cache invalidation")
+	}
+}
+
+// getLocal returns the instance-local copy of an entry if it has not expired, marking it recently used
+func (c *BrandingCache) getLocal(key string) (*outbound.CachedBranding, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, found := c.entries[key]
+	if !found {
+		return nil, false
+	}
+	local := element.Value.(*localBrandingEntry)
+	if time.Now().After(local.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return local.entry, true
+}
+
+// setLocal stores the instance-local copy of an entry, evicting the least recently used beyond capacity
+func (c *BrandingCache) setLocal(key string, entry *outbound.CachedBranding) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	local := &localBrandingEntry{key: key, entry: entry, expiresAt: time.Now().Add(localTTL)}
+	if element, found := c.entries[key]; found {
+		element.Value = local
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(local)
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*localBrandingEntry).key)
+	}
+}
+
+// dropLocal removes the instance-local entries of an agency and of the hosts
+func (c *BrandingCache) dropLocal(agencyID uuid.UUID, hosts []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		local := element.Value.(*localBrandingEntry)
+		if (agencyID != uuid.Nil && local.entry.AgencyID == agencyID) || containsHost(hosts, local.entry.Host) {
+			c.order.Remove(element)
+			delete(c.entries, local.key)
+		}
+		element = next
+	}
+}
+
+// containsHost reports whether host is one of hosts
+func containsHost(hosts []string, host string) bool {
+	if host == "" {
+		return false
+	}
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrandingCache_Local(t *testing.T) {
+	ctx := context.Background()
+	cache := NewBrandingCache(nil, 0, zerolog.Nop())
+	agencyID := uuid.New()
+
+	_, found := cache.Get(ctx, "host:acme.portal.farohq.com|")
+	assert.False(t, found)
+
+	require.NoError(t, cache.Set(ctx, "host:acme.portal.farohq.com|", &outbound.CachedBranding{AgencyID: agencyID, Host: "acme.portal.farohq.com", Body: []byte(`{}`)}))
+	require.NoError(t, cache.Set(ctx, "domain:portal.acme.com", &outbound.CachedBranding{AgencyID: agencyID, Host: "portal.acme.com", Body: []byte(`{}`)}))
+	require.NoError(t, cache.Set(ctx, "host:unknown.com|", &outbound.CachedBranding{Host: "Unknown.com:443"}))
+
+	entry, found := cache.Get(ctx, "host:acme.portal.farohq.com|")
+	require.True(t, found)
+	assert.True(t, entry.Found())
+
+	negative, found := cache.Get(ctx, "host:unknown.com|")
+	require.True(t, found)
+	assert.False(t, negative.Found(), "unknown hosts are cached as not found")
+
+	t.Run("claiming a host drops its negative entry", func(t *testing.T) {
+		require.NoError(t, cache.Invalidate(ctx, uuid.New(), "UNKNOWN.com"))
+		_, found := cache.Get(ctx, "host:unknown.com|")
+		assert.False(t, found)
+		_, found = cache.Get(ctx, "domain:portal.acme.com")
+		assert.True(t, found)
+	})
+
+	t.Run("invalidating an agency drops all of its lookups", func(t *testing.T) {
+		require.NoError(t, cache.Invalidate(ctx, agencyID))
+		_, found := cache.Get(ctx, "host:acme.portal.farohq.com|")
+		assert.False(t, found)
+		_, found = cache.Get(ctx, "domain:portal.acme.com")
+		assert.False(t, found)
+	})
+
+	t.Run("expired entries are misses", func(t *testing.T) {
+		require.NoError(t, cache.Set(ctx, "domain:portal.acme.com", &outbound.CachedBranding{AgencyID: agencyID, Body: []byte(`{}`)}))
+		cache.entries["domain:portal.acme.com"].Value.(*localBrandingEntry).expiresAt = time.Now().Add(-time.Second)
+		_, found := cache.Get(ctx, "domain:portal.acme.com")
+		assert.False(t, found)
+		assert.Empty(t, cache.entries)
+	})
+}
+
+func TestBrandingCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	cache := NewBrandingCache(nil, 0, zerolog.Nop())
+	cache.capacity = 2
+
+	require.NoError(t, cache.Set(ctx, "a", &outbound.CachedBranding{Host: "a.com"}))
+	require.NoError(t, cache.Set(ctx, "b", &outbound.CachedBranding{Host: "b.com"}))
+	_, found := cache.Get(ctx, "a")
+	require.True(t, found)
+	require.NoError(t, cache.Set(ctx, "c", &outbound.CachedBranding{Host: "c.com"}))
+
+	_, found = cache.Get(ctx, "b")
+	assert.False(t, found, "b was the least recently used")
+	_, found = cache.Get(ctx, "a")
+	assert.True(t, found)
+	_, found = cache.Get(ctx, "c")
+	assert.True(t, found)
+}
+
+func TestBrandingCache_InvalidatesHostLookupsByCacheKey(t *testing.T) {
+	ctx := context.Background()
+	cache := NewBrandingCache(nil, 0, zerolog.Nop())
+	clientID := uuid.New().String()
+
+	// Lookups of a host nobody claims yet, as requested (mixed case, port, trailing dot)
+	for _, key := range []string{outbound.HostCacheKey("Acme.Portal.farohq.com:443", ""), outbound.HostCacheKey("acme.portal.farohq.com.", clientID)} {
+		require.NoError(t, cache.Set(ctx, key, &outbound.CachedBranding{Host: "Acme.Portal.farohq.com:443"}))
+	}
+	require.NoError(t, cache.Set(ctx, outbound.DomainCacheKey("Portal.Acme.com"), &outbound.CachedBranding{Host: "Portal.Acme.com"}))
+
+	// A branding claims them, invalidating by its stored subdomain and domain
+	require.NoError(t, cache.Invalidate(ctx, uuid.New(), "acme.portal.farohq.com", "portal.acme.com"))
+
+	_, found := cache.Get(ctx, outbound.HostCacheKey("acme.portal.farohq.com", ""))
+	assert.False(t, found)
+	_, found = cache.Get(ctx, outbound.HostCacheKey("ACME.portal.farohq.com", clientID))
+	assert.False(t, found)
+	_, found = cache.Get(ctx, outbound.DomainCacheKey("portal.acme.com"))
+	assert.False(t, found)
+}
@@ -0,0 +1,258 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	brand_domain "farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/rs/zerolog"
+)
+
+// DomainProvider manages custom domains as Cloudflare for SaaS custom hostnames
+// Customers point a CNAME at the zone's fallback origin (cnameTarget); Cloudflare validates the hostname
+// and issues its certificate (HTTP DCV, so no extra record is needed once the CNAME resolves).
+type DomainProvider struct {
+	apiToken    string
+	zoneID      string
+	cnameTarget string
+	baseURL     string
+	client      *http.Client
+	logger      zerolog.Logger
+}
+
+// NewDomainProvider creates a new Cloudflare for SaaS domain provider
+func NewDomainProvider(apiToken, zoneID, cnameTarget string, logger zerolog.Logger) *DomainProvider {
+	return &DomainProvider{
+		apiToken:    apiToken,
+		zoneID:      zoneID,
+		cnameTarget: cnameTarget,
+		baseURL:     "https://api.cloudflare.com/client/v4",
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// customHostname is a custom hostname from the Cloudflare API
+type customHostname struct {
+	ID       string `json:"id"`
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"` // "pending", "active", "moved", "blocked", ...
+	SSL      struct {
+		Status            string `json:"status"` // "initializing", "pending_validation", "active", "validation_timed_out", ...
+		ValidationRecords []struct {
+			TXTName  string `json:"txt_name"`
+			TXTValue string `json:"txt_value"`
+		} `json:"validation_records"`
+	} `json:"ssl"`
+	OwnershipVerification *struct {
+		Type  string `json:"type"`
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"ownership_verification"`
+}
+
+// sslSettings requests a DV certificate validated over HTTP
+var sslSettings = map[string]interface{}{
+	"ssl": map[string]string{"method": "http", "type": "dv"},
+}
+
+// AddDomain creates the custom hostname, or returns the existing one
+func (p *DomainProvider) AddDomain(ctx context.Context, domain string) (*outbound.CustomDomain, error) {
+	existing, err := p.find(ctx, domain)
+	if err == nil {
+		return p.customDomain(existing), nil
+	}
+	if err != brand_domain.ErrDomainNotRegistered {
+		return nil, err
+	}
+
+	body := map[string]interface{}{"hostname": domain}
+	for key, value := range sslSettings {
+		body[key] = value
+	}
+	var created customHostname
+	if err := p.do(ctx, http.MethodPost, "/custom_hostnames", body, &created); err != nil {
+		return nil, err
+	}
+	return p.customDomain(&created), nil
+}
+
+// GetExpectedRecords returns the CNAME record and any pending ownership or certificate TXT records
+func (p *DomainProvider) GetExpectedRecords(ctx context.Context, domain string) ([]model.DNSRecord, error) {
+	hostname, err := p.find(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return p.customDomain(hostname).Records, nil
+}
+
+// VerifyDomain asks Cloudflare to re-validate a pending hostname and returns whether it is active
+func (p *DomainProvider) VerifyDomain(ctx context.Context, domain string) (bool, error) {
+	hostname, err := p.find(ctx, domain)
+	if err != nil {
+		return false, err
+	}
+	if hostname.Status != "active" {
+		// Re-submitting the SSL settings triggers a new validation attempt
+		var updated customHostname
+		if err := p.do(ctx, http.MethodPatch, "/custom_hostnames/"+hostname.ID, sslSettings, &updated); err != nil {
+			return false, err
+		}
+		hostname = &updated
+	}
+	return hostname.Status == "active", nil
+}
+
+// GetDomainStatus returns the hostname's verification, SSL status and expected records
+func (p *DomainProvider) GetDomainStatus(ctx context.Context, domain string) (*outbound.CustomDomain, error) {
+	hostname, err := p.find(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return p.customDomain(hostname), nil
+}
+
+// GetSSLStatus returns the status of the hostname's certificate
+func (p *DomainProvider) GetSSLStatus(ctx context.Context, domain string) (model.SSLStatus, error) {
+	hostname, err := p.find(ctx, domain)
+	if err != nil {
+		return "", err
+	}
+	return sslStatus(hostname.SSL.Status), nil
+}
+
+// RemoveDomain deletes the custom hostname (idempotent)
+func (p *DomainProvider) RemoveDomain(ctx context.Context, domain string) error {
+	hostname, err := p.find(ctx, domain)
+	if err == brand_domain.ErrDomainNotRegistered {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = p.do(ctx, http.MethodDelete, "/custom_hostnames/"+hostname.ID, nil, nil)
+	if err == brand_domain.ErrDomainNotRegistered {
+		return nil
+	}
+	return err
+}
+
+// find looks up a custom hostname by name
+func (p *DomainProvider) find(ctx context.Context, domain string) (*customHostname, error) {
+	var hostnames []customHostname
+	if err := p.do(ctx, http.MethodGet, "/custom_hostnames?hostname="+url.QueryEscape(domain), nil, &hostnames); err != nil {
+		return nil, err
+	}
+	for i := range hostnames {
+		if strings.EqualFold(hostnames[i].Hostname, domain) {
+			return &hostnames[i], nil
+		}
+	}
+	return nil, brand_domain.ErrDomainNotRegistered
+}
+
+// do calls the custom hostnames API of the zone and decodes the envelope's result into out
+func (p *DomainProvider) do(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		reqBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.baseURL+"/zones/"+p.zoneID+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Cloudflare API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return brand_domain.ErrDomainNotRegistered
+	}
+
+	var envelope struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err != nil || resp.StatusCode >= 300 || !envelope.Success {
+		p.logger.Error().
+			Int("status", resp.StatusCode).
+			Str("response", string(respBody)).
+			Msg("Cloudflare API error")
+		return fmt.Errorf("cloudflare API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	}
+
+	if out != nil && len(envelope.Result) > 0 {
+		if err := json.Unmarshal(envelope.Result, out); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+	return nil
+}
+
+// customDomain converts a custom hostname
+// Ownership and certificate TXT records are only listed while Cloudflare still needs them.
+func (p *DomainProvider) customDomain(hostname *customHostname) *outbound.CustomDomain {
+	records := []model.DNSRecord{{Type: "CNAME", Name: hostname.Hostname, Value: p.cnameTarget}}
+	if hostname.OwnershipVerification != nil && hostname.Status != "active" {
+		records = append(records, model.DNSRecord{
+			Type:  strings.ToUpper(hostname.OwnershipVerification.Type),
+			Name:  hostname.OwnershipVerification.Name,
+			Value: hostname.OwnershipVerification.Value,
+		})
+	}
+	for _, validation := range hostname.SSL.ValidationRecords {
+		if validation.TXTName != "" {
+			records = append(records, model.DNSRecord{Type: "TXT", Name: validation.TXTName, Value: validation.TXTValue})
+		}
+	}
+
+	return &outbound.CustomDomain{
+		Domain:    hostname.Hostname,
+		Verified:  hostname.Status == "active",
+		SSLStatus: sslStatus(hostname.SSL.Status),
+		Records:   records,
+	}
+}
+
+// sslStatus maps a Cloudflare certificate status to pending, active or failed
+func sslStatus(status string) model.SSLStatus {
+	switch {
+	case status == "":
+		return ""
+	case status == "active" || status == "pending_expiration":
+		return model.SSLStatusActive
+	case strings.HasSuffix(status, "_timed_out"), status == "expired", status == "deleted", status == "inactive":
+		return model.SSLStatusFailed
+	}
+	return model.SSLStatusPending
+}
@@ -0,0 +1,146 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	brand_domain "farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCloudflare serves the custom hostnames API of one zone from memory
+type fakeCloudflare struct {
+	hostnames map[string]map[string]interface{} // By ID
+	patched   []string
+}
+
+func (f *fakeCloudflare) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "errors": []map[string]interface{}{{"code": 10000, "message": "Authentication error"}}})
+		return
+	}
+
+	const prefix = "/zones/zone/custom_hostnames"
+	var result interface{}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == prefix:
+		matches := []interface{}{}
+		for _, hostname := range f.hostnames {
+			if hostname["hostname"] == r.URL.Query().Get("hostname") {
+				matches = append(matches, hostname)
+			}
+		}
+		result = matches
+	case r.Method == http.MethodPost && r.URL.Path == prefix:
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		hostname := map[string]interface{}{
+			"id":       "ch-1",
+			"hostname": body["hostname"],
+			"status":   "pending",
+			"ssl":      map[string]interface{}{"status": "pending_validation"},
+			"ownership_verification": map[string]interface{}{
+				"type": "txt", "name": "_cf-custom-hostname." + body["hostname"].(string), "value": "token-1",
+			},
+		}
+		f.hostnames["ch-1"] = hostname
+		result = hostname
+	case r.Method == http.MethodPatch && f.hostnames[r.URL.Path[len(prefix)+1:]] != nil:
+		f.patched = append(f.patched, r.URL.Path[len(prefix)+1:])
+		result = f.hostnames[r.URL.Path[len(prefix)+1:]]
+	case r.Method == http.MethodDelete && f.hostnames[r.URL.Path[len(prefix)+1:]] != nil:
+		delete(f.hostnames, r.URL.Path[len(prefix)+1:])
+		result = map[string]string{"id": r.URL.Path[len(prefix)+1:]}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": false})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "errors": []interface{}{}, "result": result})
+}
+
+func newTestProvider(t *testing.T) (*DomainProvider, *fakeCloudflare) {
+	api := &fakeCloudflare{hostnames: make(map[string]map[string]interface{})}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	provider := NewDomainProvider("token", "zone", "customers.faro.example", zerolog.Nop())
+	provider.baseURL = server.URL
+	return provider, api
+}
+
+func TestDomainProvider_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	provider, api := newTestProvider(t)
+
+	_, err := provider.GetDomainStatus(ctx, "portal.acme.com")
+	assert.Equal(t, brand_domain.ErrDomainNotRegistered, err)
+
+	added, err := provider.AddDomain(ctx, "portal.acme.com")
+	require.NoError(t, err)
+	assert.False(t, added.Verified)
+	assert.Equal(t, model.SSLStatusPending, added.SSLStatus)
+	assert.Equal(t, []model.DNSRecord{
+		{Type: "CNAME", Name: "portal.acme.com", Value: "customers.faro.example"},
+		{Type: "TXT", Name: "_cf-custom-hostname.portal.acme.com", Value: "token-1"},
+	}, added.Records)
+
+	// Adding again returns the existing hostname instead of creating a duplicate
+	_, err = provider.AddDomain(ctx, "portal.acme.com")
+	require.NoError(t, err)
+	assert.Len(t, api.hostnames, 1)
+
+	// Pending hostnames are re-validated on verify
+	verified, err := provider.VerifyDomain(ctx, "portal.acme.com")
+	require.NoError(t, err)
+	assert.False(t, verified)
+	assert.Equal(t, []string{"ch-1"}, api.patched)
+
+	api.hostnames["ch-1"]["status"] = "active"
+	api.hostnames["ch-1"]["ssl"] = map[string]interface{}{"status": "active"}
+	verified, err = provider.VerifyDomain(ctx, "portal.acme.com")
+	require.NoError(t, err)
+	assert.True(t, verified)
+	assert.Len(t, api.patched, 1)
+
+	status, err := provider.GetDomainStatus(ctx, "portal.acme.com")
+	require.NoError(t, err)
+	assert.Equal(t, model.SSLStatusActive, status.SSLStatus)
+	// The ownership record is no longer needed once the hostname is active
+	assert.Equal(t, []model.DNSRecord{{Type: "CNAME", Name: "portal.acme.com", Value: "customers.faro.example"}}, status.Records)
+
+	require.NoError(t, provider.RemoveDomain(ctx, "portal.acme.com"))
+	assert.Empty(t, api.hostnames)
+	require.NoError(t, provider.RemoveDomain(ctx, "portal.acme.com"))
+}
+
+func TestDomainProvider_APIErrors(t *testing.T) {
+	provider, _ := newTestProvider(t)
+	provider.apiToken = "wrong"
+
+	_, err := provider.AddDomain(context.Background(), "portal.acme.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 403")
+}
+
+func TestSSLStatus(t *testing.T) {
+	for status, expected := range map[string]model.SSLStatus{
+		"":                     "",
+		"initializing":         model.SSLStatusPending,
+		"pending_validation":   model.SSLStatusPending,
+		"pending_deployment":   model.SSLStatusPending,
+		"active":               model.SSLStatusActive,
+		"validation_timed_out": model.SSLStatusFailed,
+		"expired":              model.SSLStatusFailed,
+	} {
+		assert.Equal(t, expected, sslStatus(status), status)
+	}
+}
@@ -30,8 +30,8 @@ func NewBrandRepository(db *pgxpool.Pool) outbound.BrandRepository {
 func (r *BrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.Branding, error) {
 	query := `
 		SELECT agency_id, domain, subdomain, domain_type, website, verified_at, logo_url, favicon_url, 
-		       primary_color, secondary_color, theme_json, hide_powered_by, email_domain, 
-		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at
+		       primary_color, secondary_color, theme_json, assets, hide_powered_by, email_domain, 
+		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at, version
 		FROM branding
 		WHERE agency_id = $1
 	`
@@ -48,12 +48,14 @@ func (r *BrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID
 		primaryColor            string
 		secondaryColor          string
 		themeJSONBytes          []byte
+		assetsBytes             []byte
 		hidePoweredBy           bool
 		emailDomain             string
 		cloudflareZoneID        string
 		domainVerificationToken string
 		sslStatusStr            *string
 		updatedAt               time.Time
+		version                 int64
 	)
 
 	err := r.db.QueryRow(ctx, query, agencyID).Scan(
@@ -68,12 +70,14 @@ func (r *BrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID
 		&primaryColor,
 		&secondaryColor,
 		&themeJSONBytes,
+		&assetsBytes,
 		&hidePoweredBy,
 		&emailDomain,
 		&cloudflareZoneID,
 		&domainVerificationToken,
 		&sslStatusStr,
 		&updatedAt,
+		&version,
 	)
 
 	if err != nil {
@@ -88,6 +92,11 @@ func (r *BrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID
 		json.Unmarshal(themeJSONBytes, &themeJSON)
 	}
 
+	var assets map[string]map[string]string
+	if len(assetsBytes) > 0 {
+		json.Unmarshal(assetsBytes, &assets)
+	}
+
 	var domainType *model.DomainType
 	if domainTypeStr != nil {
 		dt := model.DomainType(*domainTypeStr)
@@ -112,12 +121,14 @@ func (r *BrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID
 		primaryColor,
 		secondaryColor,
 		themeJSON,
+		assets,
 		hidePoweredBy,
 		emailDomain,
 		cloudflareZoneID,
 		domainVerificationToken,
 		sslStatus,
 		updatedAt,
+		version,
 	), nil
 }
 
@@ -125,8 +136,8 @@ func (r *BrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID
 func (r *BrandRepository) FindByDomain(ctx context.Context, domainParam string) (*model.Branding, error) {
 	query := `
 		SELECT agency_id, domain, subdomain, domain_type, website, verified_at, logo_url, favicon_url, 
-		       primary_color, secondary_color, theme_json, hide_powered_by, email_domain, 
-		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at
+		       primary_color, secondary_color, theme_json, assets, hide_powered_by, email_domain, 
+		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at, version
 		FROM branding
 		WHERE domain = $1 AND domain_type = 'custom'
 	`
@@ -143,12 +154,14 @@ func (r *BrandRepository) FindByDomain(ctx context.Context, domainParam string)
 		primaryColor            string
 		secondaryColor          string
 		themeJSONBytes          []byte
+		assetsBytes             []byte
 		hidePoweredBy           bool
 		emailDomain             string
 		cloudflareZoneID        string
 		domainVerificationToken string
 		sslStatusStr            *string
 		updatedAt               time.Time
+		version                 int64
 	)
 
 	err := r.db.QueryRow(ctx, query, domainParam).Scan(
@@ -163,12 +176,14 @@ func (r *BrandRepository) FindByDomain(ctx context.Context, domainParam string)
 		&primaryColor,
 		&secondaryColor,
 		&themeJSONBytes,
+		&assetsBytes,
 		&hidePoweredBy,
 		&emailDomain,
 		&cloudflareZoneID,
 		&domainVerificationToken,
 		&sslStatusStr,
 		&updatedAt,
+		&version,
 	)
 
 	if err != nil {
@@ -183,6 +198,11 @@ func (r *BrandRepository) FindByDomain(ctx context.Context, domainParam string)
 		json.Unmarshal(themeJSONBytes, &themeJSON)
 	}
 
+	var assets map[string]map[string]string
+	if len(assetsBytes) > 0 {
+		json.Unmarshal(assetsBytes, &assets)
+	}
+
 	var domainType *model.DomainType
 	if domainTypeStr != nil {
 		dt := model.DomainType(*domainTypeStr)
@@ -207,12 +227,14 @@ func (r *BrandRepository) FindByDomain(ctx context.Context, domainParam string)
 		primaryColor,
 		secondaryColor,
 		themeJSON,
+		assets,
 		hidePoweredBy,
 		emailDomain,
 		cloudflareZoneID,
 		domainVerificationToken,
 		sslStatus,
 		updatedAt,
+		version,
 	), nil
 }
 
@@ -220,8 +242,8 @@ func (r *BrandRepository) FindByDomain(ctx context.Context, domainParam string)
 func (r *BrandRepository) FindBySubdomain(ctx context.Context, subdomainParam string) (*model.Branding, error) {
 	query := `
 		SELECT agency_id, domain, subdomain, domain_type, website, verified_at, logo_url, favicon_url, 
-		       primary_color, secondary_color, theme_json, hide_powered_by, email_domain, 
-		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at
+		       primary_color, secondary_color, theme_json, assets, hide_powered_by, email_domain, 
+		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at, version
 		FROM branding
 		WHERE subdomain = $1
 	`
@@ -238,12 +260,14 @@ func (r *BrandRepository) FindBySubdomain(ctx context.Context, subdomainParam st
 		primaryColor            string
 		secondaryColor          string
 		themeJSONBytes          []byte
+		assetsBytes             []byte
 		hidePoweredBy           bool
 		emailDomain             string
 		cloudflareZoneID        string
 		domainVerificationToken string
 		sslStatusStr            *string
 		updatedAt               time.Time
+		version                 int64
 	)
 
 	err := r.db.QueryRow(ctx, query, subdomainParam).Scan(
@@ -258,12 +282,14 @@ func (r *BrandRepository) FindBySubdomain(ctx context.Context, subdomainParam st
 		&primaryColor,
 		&secondaryColor,
 		&themeJSONBytes,
+		&assetsBytes,
 		&hidePoweredBy,
 		&emailDomain,
 		&cloudflareZoneID,
 		&domainVerificationToken,
 		&sslStatusStr,
 		&updatedAt,
+		&version,
 	)
 
 	if err != nil {
@@ -278,6 +304,11 @@ func (r *BrandRepository) FindBySubdomain(ctx context.Context, subdomainParam st
 		json.Unmarshal(themeJSONBytes, &themeJSON)
 	}
 
+	var assets map[string]map[string]string
+	if len(assetsBytes) > 0 {
+		json.Unmarshal(assetsBytes, &assets)
+	}
+
 	var domainType *model.DomainType
 	if domainTypeStr != nil {
 		dt := model.DomainType(*domainTypeStr)
@@ -302,12 +333,14 @@ func (r *BrandRepository) FindBySubdomain(ctx context.Context, subdomainParam st
 		primaryColor,
 		secondaryColor,
 		themeJSON,
+		assets,
 		hidePoweredBy,
 		emailDomain,
 		cloudflareZoneID,
 		domainVerificationToken,
 		sslStatus,
 		updatedAt,
+		version,
 	), nil
 }
 
@@ -322,6 +355,7 @@ func (r *BrandRepository) CheckSubdomainExists(ctx context.Context, subdomainPar
 // Save saves a new branding
 func (r *BrandRepository) Save(ctx context.Context, branding *model.Branding) error {
 	themeJSONBytes, _ := json.Marshal(branding.ThemeJSON())
+	assetsBytes, _ := json.Marshal(branding.Assets())
 
 	var domainTypeStr *string
 	if branding.DomainType() != nil {
@@ -337,7 +371,7 @@ func (r *BrandRepository) Save(ctx context.Context, branding *model.Branding) er
 
 	query := `
 		INSERT INTO branding (agency_id, domain, subdomain, domain_type, website, verified_at, logo_url, favicon_url, 
-		                      primary_color, secondary_color, theme_json, hide_powered_by, email_domain, 
+		                      primary_color, secondary_color, theme_json, assets, hide_powered_by, email_domain, 
 		                      cloudflare_zone_id, domain_verification_token, ssl_status, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		ON CONFLICT (agency_id) DO UPDATE SET
@@ -356,7 +390,8 @@ func (r *BrandRepository) Save(ctx context.Context, branding *model.Branding) er
 			cloudflare_zone_id = EXCLUDED.cloudflare_zone_id,
 			domain_verification_token = EXCLUDED.domain_verification_token,
 			ssl_status = EXCLUDED.ssl_status,
-			updated_at = EXCLUDED.updated_at
+			updated_at = EXCLUDED.updated_at,
+			assets = EXCLUDED.assets
 	`
 
 	// Convert empty string domain to NULL to avoid unique constraint violations
@@ -382,6 +417,7 @@ func (r *BrandRepository) Save(ctx context.Context, branding *model.Branding) er
 		branding.DomainVerificationToken(),
 		sslStatusStr,
 		branding.UpdatedAt(),
+		assetsBytes,
 	)
 
 	return err
@@ -408,8 +444,10 @@ func stringPtr(s *string) string {
 }
 
 // Update updates an existing branding
+// The update only applies if the stored version still matches the branding's version; otherwise ErrVersionConflict is returned.
 func (r *BrandRepository) Update(ctx context.Context, branding *model.Branding) error {
 	themeJSONBytes, _ := json.Marshal(branding.ThemeJSON())
+	assetsBytes, _ := json.Marshal(branding.Assets())
 
 	var domainTypeStr *string
 	if branding.DomainType() != nil {
@@ -440,14 +478,17 @@ func (r *BrandRepository) Update(ctx context.Context, branding *model.Branding)
 			cloudflare_zone_id = $14,
 			domain_verification_token = $15,
 			ssl_status = $16,
-			updated_at = $17
-		WHERE agency_id = $1
+			updated_at = $17,
+			assets = $19
+		WHERE agency_id = $1 AND version = $18
+		RETURNING version
 	`
 
 	// Convert empty string domain to NULL to avoid unique constraint violations
 	domainValue := nullString(branding.Domain())
 
-	result, err := r.db.Exec(ctx, query,
+	var version int64
+	err := r.db.QueryRow(ctx, query,
 		branding.AgencyID(),
 		domainValue, // NULL for empty strings, pointer to string for actual values
 		branding.Subdomain(),
@@ -465,16 +506,25 @@ func (r *BrandRepository) Update(ctx context.Context, branding *model.Branding)
 		branding.DomainVerificationToken(),
 		sslStatusStr,
 		branding.UpdatedAt(),
-	)
+		branding.Version(),
+		assetsBytes,
+	).Scan(&version)
 
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			var exists bool
+			if err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM branding WHERE agency_id = $1)`, branding.AgencyID()).Scan(&exists); err != nil {
+				return err
+			}
+			if !exists {
+				return domain.ErrBrandingNotFound
+			}
+			return domain.ErrVersionConflict
+		}
 		return err
 	}
 
-	if result.RowsAffected() == 0 {
-		return domain.ErrBrandingNotFound
-	}
-
+	branding.SetVersion(version)
 	return nil
 }
 
@@ -498,8 +548,8 @@ func (r *BrandRepository) Delete(ctx context.Context, agencyID uuid.UUID) error
 func (r *BrandRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID) ([]*model.Branding, error) {
 	query := `
 		SELECT agency_id, domain, subdomain, domain_type, website, verified_at, logo_url, favicon_url, 
-		       primary_color, secondary_color, theme_json, hide_powered_by, email_domain, 
-		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at
+		       primary_color, secondary_color, theme_json, assets, hide_powered_by, email_domain, 
+		       cloudflare_zone_id, domain_verification_token, ssl_status, updated_at, version
 		FROM branding
 		WHERE agency_id = $1
 	`
@@ -524,12 +574,14 @@ func (r *BrandRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID
 			primaryColor            string
 			secondaryColor          string
 			themeJSONBytes          []byte
+			assetsBytes             []byte
 			hidePoweredBy           bool
 			emailDomain             string
 			cloudflareZoneID        string
 			domainVerificationToken string
 			sslStatusStr            *string
 			updatedAt               time.Time
+			version                 int64
 		)
 
 		if err := rows.Scan(
@@ -544,12 +596,14 @@ func (r *BrandRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID
 			&primaryColor,
 			&secondaryColor,
 			&themeJSONBytes,
+			&assetsBytes,
 			&hidePoweredBy,
 			&emailDomain,
 			&cloudflareZoneID,
 			&domainVerificationToken,
 			&sslStatusStr,
 			&updatedAt,
+			&version,
 		); err != nil {
 			return nil, err
 		}
@@ -559,6 +613,11 @@ func (r *BrandRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID
 			json.Unmarshal(themeJSONBytes, &themeJSON)
 		}
 
+		var assets map[string]map[string]string
+		if len(assetsBytes) > 0 {
+			json.Unmarshal(assetsBytes, &assets)
+		}
+
 		var domainType *model.DomainType
 		if domainTypeStr != nil {
 			dt := model.DomainType(*domainTypeStr)
@@ -583,12 +642,14 @@ func (r *BrandRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID
 			primaryColor,
 			secondaryColor,
 			themeJSON,
+			assets,
 			hidePoweredBy,
 			emailDomain,
 			cloudflareZoneID,
 			domainVerificationToken,
 			sslStatus,
 			updatedAt,
+			version,
 		))
 	}
 
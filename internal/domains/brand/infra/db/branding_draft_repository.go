@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BrandingDraftRepository implements the outbound.BrandingDraftRepository interface
+type BrandingDraftRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewBrandingDraftRepository creates a new PostgreSQL branding draft repository
+func NewBrandingDraftRepository(db *pgxpool.Pool) outbound.BrandingDraftRepository {
+	return &BrandingDraftRepository{
+		db: db,
+	}
+}
+
+// FindByAgencyID finds an agency's branding draft
+func (r *BrandingDraftRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.BrandingDraft, error) {
+	query := `
+		SELECT agency_id, website, logo_url, favicon_url, assets, primary_color, secondary_color, theme_json,
+		       hide_powered_by, preview_token, updated_at, version
+		FROM branding_drafts
+		WHERE agency_id = $1
+	`
+
+	var (
+		dbAgencyID     uuid.UUID
+		content        model.BrandingContent
+		assetsBytes    []byte
+		themeJSONBytes []byte
+		previewToken   string
+		updatedAt      time.Time
+		version        int64
+	)
+
+	err := r.db.QueryRow(ctx, query, agencyID).Scan(
+		&dbAgencyID,
+		&content.Website,
+		&content.LogoURL,
+		&content.FaviconURL,
+		&assetsBytes,
+		&content.PrimaryColor,
+		&content.SecondaryColor,
+		&themeJSONBytes,
+		&content.HidePoweredBy,
+		&previewToken,
+		&updatedAt,
+		&version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrBrandingDraftNotFound
+		}
+		return nil, err
+	}
+
+	if len(assetsBytes) > 0 {
+		json.Unmarshal(assetsBytes, &content.Assets)
+	}
+	if len(themeJSONBytes) > 0 {
+		json.Unmarshal(themeJSONBytes, &content.ThemeJSON)
+	}
+
+	return model.NewBrandingDraftWithID(dbAgencyID, content, previewToken, updatedAt, version), nil
+}
+
+// Save creates an agency's branding draft
+func (r *BrandingDraftRepository) Save(ctx context.Context, draft *model.BrandingDraft) error {
+	content := draft.Content()
+	assetsBytes, _ := json.Marshal(content.Assets)
+	themeJSONBytes, _ := json.Marshal(content.ThemeJSON)
+
+	query := `
+		INSERT INTO branding_drafts (agency_id, website, logo_url, favicon_url, assets, primary_color, secondary_color,
+		                             theme_json, hide_powered_by, preview_token, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING version
+	`
+
+	var version int64
+	err := r.db.QueryRow(ctx, query,
+		draft.AgencyID(),
+		content.Website,
+		content.LogoURL,
+		content.FaviconURL,
+		assetsBytes,
+		content.PrimaryColor,
+		content.SecondaryColor,
+		themeJSONBytes,
+		content.HidePoweredBy,
+		draft.PreviewToken(),
+		draft.UpdatedAt(),
+	).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	draft.SetVersion(version)
+	return nil
+}
+
+// Update updates an agency's branding draft
+// The update only applies if the stored version still matches the draft's version; otherwise ErrVersionConflict is returned.
+func (r *BrandingDraftRepository) Update(ctx context.Context, draft *model.BrandingDraft) error {
+	content := draft.Content()
+	assetsBytes, _ := json.Marshal(content.Assets)
+	themeJSONBytes, _ := json.Marshal(content.ThemeJSON)
+
+	query := `
+		UPDATE branding_drafts SET
+			website = $2,
+			logo_url = $3,
+			favicon_url = $4,
+			assets = $5,
+			primary_color = $6,
+			secondary_color = $7,
+			theme_json = $8,
+			hide_powered_by = $9,
+			updated_at = $10
+		WHERE agency_id = $1 AND version = $11
+		RETURNING version
+	`
+
+	var version int64
+	err := r.db.QueryRow(ctx, query,
+		draft.AgencyID(),
+		content.Website,
+		content.LogoURL,
+		content.FaviconURL,
+		assetsBytes,
+		content.PrimaryColor,
+		content.SecondaryColor,
+		themeJSONBytes,
+		content.HidePoweredBy,
+		draft.UpdatedAt(),
+		draft.Version(),
+	).Scan(&version)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			var exists bool
+			if err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM branding_drafts WHERE agency_id = $1)`, draft.AgencyID()).Scan(&exists); err != nil {
+				return err
+			}
+			if !exists {
+				return domain.ErrBrandingDraftNotFound
+			}
+			return domain.ErrVersionConflict
+		}
+		return err
+	}
+
+	draft.SetVersion(version)
+	return nil
+}
+
+// Delete deletes an agency's branding draft (discarded or published)
+func (r *BrandingDraftRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	query := `DELETE FROM branding_drafts WHERE agency_id = $1`
+
+	result, err := r.db.Exec(ctx, query, agencyID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrBrandingDraftNotFound
+	}
+
+	return nil
+}
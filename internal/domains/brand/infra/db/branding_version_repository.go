@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BrandingVersionRepository implements the outbound.BrandingVersionRepository interface
+// Rows are written by the archive_branding_version trigger on branding, never by the application.
+type BrandingVersionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewBrandingVersionRepository creates a new PostgreSQL branding version repository
+func NewBrandingVersionRepository(db *pgxpool.Pool) outbound.BrandingVersionRepository {
+	return &BrandingVersionRepository{
+		db: db,
+	}
+}
+
+// ListByAgencyID lists an agency's most recent branding versions, newest first
+func (r *BrandingVersionRepository) ListByAgencyID(ctx context.Context, agencyID uuid.UUID, limit int) ([]*model.BrandingVersion, error) {
+	query := `
+		SELECT agency_id, number, website, logo_url, favicon_url, assets, primary_color, secondary_color, theme_json,
+		       hide_powered_by, replaced_at
+		FROM branding_versions
+		WHERE agency_id = $1
+		ORDER BY number DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, agencyID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []*model.BrandingVersion
+	for rows.Next() {
+		version, err := scanBrandingVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// FindByNumber finds one of an agency's branding versions
+func (r *BrandingVersionRepository) FindByNumber(ctx context.Context, agencyID uuid.UUID, number int64) (*model.BrandingVersion, error) {
+	query := `
+		SELECT agency_id, number, website, logo_url, favicon_url, assets, primary_color, secondary_color, theme_json,
+		       hide_powered_by, replaced_at
+		FROM branding_versions
+		WHERE agency_id = $1 AND number = $2
+	`
+
+	version, err := scanBrandingVersion(r.db.QueryRow(ctx, query, agencyID, number))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrBrandingVersionNotFound
+		}
+		return nil, err
+	}
+	return version, nil
+}
+
+// scanBrandingVersion scans a branding_versions row
+func scanBrandingVersion(row pgx.Row) (*model.BrandingVersion, error) {
+	var (
+		agencyID       uuid.UUID
+		number         int64
+		content        model.BrandingContent
+		assetsBytes    []byte
+		themeJSONBytes []byte
+		replacedAt     time.Time
+	)
+
+	if err := row.Scan(
+		&agencyID,
+		&number,
+		&content.Website,
+		&content.LogoURL,
+		&content.FaviconURL,
+		&assetsBytes,
+		&content.PrimaryColor,
+		&content.SecondaryColor,
+		&themeJSONBytes,
+		&content.HidePoweredBy,
+		&replacedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if len(assetsBytes) > 0 {
+		json.Unmarshal(assetsBytes, &content.Assets)
+	}
+	if len(themeJSONBytes) > 0 {
+		json.Unmarshal(themeJSONBytes, &content.ThemeJSON)
+	}
+
+	return model.NewBrandingVersionWithID(agencyID, number, content, replacedAt), nil
+}
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ClientBrandingRepository implements the outbound.ClientBrandingRepository interface
+type ClientBrandingRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewClientBrandingRepository creates a new PostgreSQL client branding repository
+func NewClientBrandingRepository(db *pgxpool.Pool) outbound.ClientBrandingRepository {
+	return &ClientBrandingRepository{
+		db: db,
+	}
+}
+
+// FindByClientID finds a client's branding overrides
+func (r *ClientBrandingRepository) FindByClientID(ctx context.Context, clientID uuid.UUID) (*model.ClientBranding, error) {
+	query := `
+		SELECT client_id, agency_id, logo_url, favicon_url, primary_color, secondary_color, theme_json, updated_at, version
+		FROM client_branding
+		WHERE client_id = $1
+	`
+
+	var (
+		dbClientID     uuid.UUID
+		agencyID       uuid.UUID
+		logoURL        *string
+		faviconURL     *string
+		primaryColor   *string
+		secondaryColor *string
+		themeJSONBytes []byte
+		updatedAt      time.Time
+		version        int64
+	)
+
+	err := r.db.QueryRow(ctx, query, clientID).Scan(
+		&dbClientID,
+		&agencyID,
+		&logoURL,
+		&faviconURL,
+		&primaryColor,
+		&secondaryColor,
+		&themeJSONBytes,
+		&updatedAt,
+		&version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrClientBrandingNotFound
+		}
+		return nil, err
+	}
+
+	var themeJSON map[string]interface{}
+	if len(themeJSONBytes) > 0 {
+		json.Unmarshal(themeJSONBytes, &themeJSON)
+	}
+
+	return model.NewClientBrandingWithID(
+		dbClientID,
+		agencyID,
+		logoURL,
+		faviconURL,
+		primaryColor,
+		secondaryColor,
+		themeJSON,
+		updatedAt,
+		version,
+	), nil
+}
+
+// Save creates a client's branding overrides
+func (r *ClientBrandingRepository) Save(ctx context.Context, clientBranding *model.ClientBranding) error {
+	themeJSONBytes, _ := json.Marshal(clientBranding.ThemeJSON())
+
+	query := `
+		INSERT INTO client_branding (client_id, agency_id, logo_url, favicon_url, primary_color, secondary_color, theme_json, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING version
+	`
+
+	var version int64
+	err := r.db.QueryRow(ctx, query,
+		clientBranding.ClientID(),
+		clientBranding.AgencyID(),
+		clientBranding.LogoURL(),
+		clientBranding.FaviconURL(),
+		clientBranding.PrimaryColor(),
+		clientBranding.SecondaryColor(),
+		themeJSONBytes,
+		clientBranding.UpdatedAt(),
+	).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	clientBranding.SetVersion(version)
+	return nil
+}
+
+// Update updates a client's branding overrides
+// The update only applies if the stored version still matches the client branding's version; otherwise ErrVersionConflict is returned.
+func (r *ClientBrandingRepository) Update(ctx context.Context, clientBranding *model.ClientBranding) error {
+	themeJSONBytes, _ := json.Marshal(clientBranding.ThemeJSON())
+
+	query := `
+		UPDATE client_branding SET
+			logo_url = $2,
+			favicon_url = $3,
+			primary_color = $4,
+			secondary_color = $5,
+			theme_json = $6,
+			updated_at = $7
+		WHERE client_id = $1 AND version = $8
+		RETURNING version
+	`
+
+	var version int64
+	err := r.db.QueryRow(ctx, query,
+		clientBranding.ClientID(),
+		clientBranding.LogoURL(),
+		clientBranding.FaviconURL(),
+		clientBranding.PrimaryColor(),
+		clientBranding.SecondaryColor(),
+		themeJSONBytes,
+		clientBranding.UpdatedAt(),
+		clientBranding.Version(),
+	).Scan(&version)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			var exists bool
+			if err := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM client_branding WHERE client_id = $1)`, clientBranding.ClientID()).Scan(&exists); err != nil {
+				return err
+			}
+			if !exists {
+				return domain.ErrClientBrandingNotFound
+			}
+			return domain.ErrVersionConflict
+		}
+		return err
+	}
+
+	clientBranding.SetVersion(version)
+	return nil
+}
+
+// Delete deletes a client's branding overrides, so the client inherits the agency branding again
+func (r *ClientBrandingRepository) Delete(ctx context.Context, clientID uuid.UUID) error {
+	query := `DELETE FROM client_branding WHERE client_id = $1`
+
+	result, err := r.db.Exec(ctx, query, clientID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrClientBrandingNotFound
+	}
+
+	return nil
+}
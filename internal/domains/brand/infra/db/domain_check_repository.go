@@ -0,0 +1,165 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// domainCheckColumns are the columns scanned by scanDomainCheck
+const domainCheckColumns = `agency_id, domain, health, failures, last_error, checked_at, next_check_at, updated_at`
+
+// DomainCheckRepository implements the outbound.DomainCheckRepository interface
+type DomainCheckRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewDomainCheckRepository creates a new PostgreSQL domain check repository
+func NewDomainCheckRepository(db *pgxpool.Pool) outbound.DomainCheckRepository {
+	return &DomainCheckRepository{
+		db: db,
+	}
+}
+
+// FindByAgencyID finds the check of an agency's custom domain
+func (r *DomainCheckRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.DomainCheck, error) {
+	query := `SELECT ` + domainCheckColumns + ` FROM custom_domain_checks WHERE agency_id = $1`
+
+	check, err := scanDomainCheck(r.db.QueryRow(ctx, query, agencyID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDomainCheckNotFound
+		}
+		return nil, err
+	}
+	return check, nil
+}
+
+// ClaimDue starts monitoring new custom domains and claims the checks due at now
+// Rows locked by another instance's claim are skipped rather than waited for.
+func (r *DomainCheckRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.DomainCheck, error) {
+	// Domains set on branding since the last run (or set without pressing verify) are picked up here
+	insertQuery := `
+		INSERT INTO custom_domain_checks (agency_id, domain, next_check_at, updated_at)
+		SELECT agency_id, domain, $1, $1
+		FROM branding
+		WHERE domain IS NOT NULL AND domain <> ''
+		ON CONFLICT (agency_id) DO NOTHING
+	`
+	if _, err := r.db.Exec(ctx, insertQuery, now); err != nil {
+		return nil, err
+	}
+
+	claimQuery := `
+		UPDATE custom_domain_checks SET next_check_at = $2
+		WHERE agency_id IN (
+			SELECT agency_id
+			FROM custom_domain_checks
+			WHERE next_check_at <= $1
+			ORDER BY next_check_at ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + domainCheckColumns
+
+	rows, err := r.db.Query(ctx, claimQuery, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var checks []*model.DomainCheck
+	for rows.Next() {
+		check, err := scanDomainCheck(rows)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// Save creates or replaces the check of an agency's custom domain
+func (r *DomainCheckRepository) Save(ctx context.Context, check *model.DomainCheck) error {
+	query := `
+		INSERT INTO custom_domain_checks (` + domainCheckColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (agency_id) DO UPDATE SET
+			domain = EXCLUDED.domain,
+			health = EXCLUDED.health,
+			failures = EXCLUDED.failures,
+			last_error = EXCLUDED.last_error,
+			checked_at = EXCLUDED.checked_at,
+			next_check_at = EXCLUDED.next_check_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		check.AgencyID(),
+		check.Domain(),
+		string(check.Health()),
+		check.Failures(),
+		check.LastError(),
+		check.CheckedAt(),
+		check.NextCheckAt(),
+		check.UpdatedAt(),
+	)
+	return err
+}
+
+// Delete stops monitoring an agency's custom domain
+func (r *DomainCheckRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	query := `DELETE FROM custom_domain_checks WHERE agency_id = $1`
+
+	result, err := r.db.Exec(ctx, query, agencyID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrDomainCheckNotFound
+	}
+
+	return nil
+}
+
+// scanDomainCheck scans a row selected with domainCheckColumns
+func scanDomainCheck(row pgx.Row) (*model.DomainCheck, error) {
+	var (
+		agencyID    uuid.UUID
+		domainName  string
+		health      string
+		failures    int
+		lastError   string
+		checkedAt   *time.Time
+		nextCheckAt time.Time
+		updatedAt   time.Time
+	)
+
+	if err := row.Scan(&agencyID, &domainName, &health, &failures, &lastError, &checkedAt, &nextCheckAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return model.NewDomainCheckWithID(
+		agencyID,
+		domainName,
+		model.DomainHealth(health),
+		failures,
+		lastError,
+		checkedAt,
+		nextCheckAt,
+		updatedAt,
+	), nil
+}
@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SendingDomainRepository implements the outbound.SendingDomainRepository interface
+type SendingDomainRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewSendingDomainRepository creates a new PostgreSQL sending domain repository
+func NewSendingDomainRepository(db *pgxpool.Pool) outbound.SendingDomainRepository {
+	return &SendingDomainRepository{
+		db: db,
+	}
+}
+
+// FindByAgencyID finds an agency's sending domain
+func (r *SendingDomainRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.SendingDomain, error) {
+	query := `
+		SELECT agency_id, domain, local_part, from_name, provider_id, dkim_host, dkim_value, return_path_host,
+		       return_path_target, spf_value, dkim_verified, spf_verified, return_path_verified, verified_at, checked_at,
+		       created_at, updated_at
+		FROM sending_domains
+		WHERE agency_id = $1
+	`
+
+	return scanSendingDomain(r.db.QueryRow(ctx, query, agencyID))
+}
+
+// FindByDomain finds the sending domain registered for a domain name
+func (r *SendingDomainRepository) FindByDomain(ctx context.Context, domainName string) (*model.SendingDomain, error) {
+	query := `
+		SELECT agency_id, domain, local_part, from_name, provider_id, dkim_host, dkim_value, return_path_host,
+		       return_path_target, spf_value, dkim_verified, spf_verified, return_path_verified, verified_at, checked_at,
+		       created_at, updated_at
+		FROM sending_domains
+		WHERE domain = $1
+	`
+
+	return scanSendingDomain(r.db.QueryRow(ctx, query, domainName))
+}
+
+// scanSendingDomain scans a sending_domains row
+func scanSendingDomain(row pgx.Row) (*model.SendingDomain, error) {
+	var (
+		dbAgencyID                                    uuid.UUID
+		domainName, localPart, fromName, providerID   string
+		dkimHost, dkimValue                           string
+		returnPathHost, returnPathTarget, spfValue    string
+		dkimVerified, spfVerified, returnPathVerified bool
+		verifiedAt, checkedAt                         *time.Time
+		createdAt, updatedAt                          time.Time
+	)
+
+	err := row.Scan(
+		&dbAgencyID,
+		&domainName,
+		&localPart,
+		&fromName,
+		&providerID,
+		&dkimHost,
+		&dkimValue,
+		&returnPathHost,
+		&returnPathTarget,
+		&spfValue,
+		&dkimVerified,
+		&spfVerified,
+		&returnPathVerified,
+		&verifiedAt,
+		&checkedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrSendingDomainNotFound
+		}
+		return nil, err
+	}
+
+	return model.NewSendingDomainWithID(
+		dbAgencyID,
+		domainName, localPart, fromName, providerID,
+		model.DNSRecord{Type: "TXT", Name: dkimHost, Value: dkimValue},
+		model.DNSRecord{Type: "CNAME", Name: returnPathHost, Value: returnPathTarget},
+		model.DNSRecord{Type: "TXT", Name: domainName, Value: spfValue},
+		dkimVerified, spfVerified, returnPathVerified,
+		verifiedAt, checkedAt,
+		createdAt, updatedAt,
+	), nil
+}
+
+// Save creates or replaces an agency's sending domain
+func (r *SendingDomainRepository) Save(ctx context.Context, sendingDomain *model.SendingDomain) error {
+	records := make(map[model.SendingRecordPurpose]model.SendingRecord)
+	for _, record := range sendingDomain.Records() {
+		records[record.Purpose] = record
+	}
+	dkim := records[model.SendingRecordDKIM]
+	spf := records[model.SendingRecordSPF]
+	returnPath := records[model.SendingRecordReturnPath]
+
+	query := `
+		INSERT INTO sending_domains (agency_id, domain, local_part, from_name, provider_id, dkim_host, dkim_value,
+		                             return_path_host, return_path_target, spf_value, dkim_verified, spf_verified,
+		                             return_path_verified, verified_at, checked_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		ON CONFLICT (agency_id) DO UPDATE SET
+			domain = EXCLUDED.domain,
+			local_part = EXCLUDED.local_part,
+			from_name = EXCLUDED.from_name,
+			provider_id = EXCLUDED.provider_id,
+			dkim_host = EXCLUDED.dkim_host,
+			dkim_value = EXCLUDED.dkim_value,
+			return_path_host = EXCLUDED.return_path_host,
+			return_path_target = EXCLUDED.return_path_target,
+			spf_value = EXCLUDED.spf_value,
+			dkim_verified = EXCLUDED.dkim_verified,
+			spf_verified = EXCLUDED.spf_verified,
+			return_path_verified = EXCLUDED.return_path_verified,
+			verified_at = EXCLUDED.verified_at,
+			checked_at = EXCLUDED.checked_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		sendingDomain.AgencyID(),
+		sendingDomain.Domain(),
+		sendingDomain.LocalPart(),
+		sendingDomain.FromName(),
+		sendingDomain.ProviderID(),
+		dkim.Record.Name,
+		dkim.Record.Value,
+		returnPath.Record.Name,
+		returnPath.Record.Value,
+		spf.Record.Value,
+		dkim.Verified,
+		spf.Verified,
+		returnPath.Verified,
+		sendingDomain.VerifiedAt(),
+		sendingDomain.CheckedAt(),
+		sendingDomain.CreatedAt(),
+		sendingDomain.UpdatedAt(),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrSendingDomainTaken
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete deletes an agency's sending domain
+func (r *SendingDomainRepository) Delete(ctx context.Context, agencyID uuid.UUID) error {
+	query := `DELETE FROM sending_domains WHERE agency_id = $1`
+
+	result, err := r.db.Exec(ctx, query, agencyID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrSendingDomainNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// DefaultResolvers are the resolvers ownership records are checked against when none are configured
+var DefaultResolvers = []string{"1.1.1.1", "8.8.8.8", "https://cloudflare-dns.com/dns-query"}
+
+// Resolver limits: queries time out after queryTimeout; UDP responses advertise ednsPayloadSize (larger answers
+// come back truncated and are retried over TCP).
+const (
+	queryTimeout    = 5 * time.Second
+	ednsPayloadSize = 1232
+	maxMessageSize  = 65535
+)
+
+// Resolver queries a single DNS server over UDP (falling back to TCP) or DNS-over-HTTPS
+type Resolver struct {
+	name      string // How the resolver is reported in propagation results
+	addr      string // host:port of a UDP/TCP server
+	dohURL    string // DNS-over-HTTPS endpoint (RFC 8484), used instead of addr
+	recursive bool   // Ask for recursion; authoritative nameservers are queried without it
+	client    *http.Client
+}
+
+// ParseResolver parses a resolver spec: an https:// URL for DNS-over-HTTPS, otherwise host[:port] (port 53 by default)
+func ParseResolver(spec string) (*Resolver, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, errors.New("empty resolver")
+	}
+	if strings.HasPrefix(spec, "https://") {
+		return &Resolver{
+			name:      spec,
+			dohURL:    spec,
+			recursive: true,
+			client:    &http.Client{Timeout: queryTimeout},
+		}, nil
+	}
+
+	addr := spec
+	if _, _, err := net.SplitHostPort(spec); err != nil {
+		addr = net.JoinHostPort(strings.Trim(spec, "[]"), "53")
+	}
+	return &Resolver{name: spec, addr: addr, recursive: true}, nil
+}
+
+// newAuthoritativeResolver creates a resolver for one of a zone's nameservers
+func newAuthoritativeResolver(host, addr string) *Resolver {
+	return &Resolver{name: host, addr: addr}
+}
+
+// String returns how the resolver is reported
+func (r *Resolver) String() string {
+	return r.name
+}
+
+// LookupTXT returns the TXT records of name, each record's strings joined
+// A name that does not exist (NXDOMAIN) or has no TXT records returns no values and no error.
+func (r *Resolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	msg, err := r.query(ctx, name, dnsmessage.TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, answer := range msg.Answers {
+		if txt, ok := answer.Body.(*dnsmessage.TXTResource); ok {
+			values = append(values, strings.Join(txt.TXT, ""))
+		}
+	}
+	return values, nil
+}
+
+// LookupCNAME returns the canonical names name is an alias for (usually one)
+// A name that does not exist or is not an alias returns no values and no error.
+func (r *Resolver) LookupCNAME(ctx context.Context, name string) ([]string, error) {
+	msg, err := r.query(ctx, name, dnsmessage.TypeCNAME)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []string
+	for _, answer := range msg.Answers {
+		if cname, ok := answer.Body.(*dnsmessage.CNAMEResource); ok && strings.EqualFold(answer.Header.Name.String(), fqdn(name)) {
+			targets = append(targets, strings.TrimSuffix(cname.CNAME.String(), "."))
+		}
+	}
+	return targets, nil
+}
+
+// LookupNS returns the nameservers of zone, or none if zone is not a zone apex
+func (r *Resolver) LookupNS(ctx context.Context, zone string) ([]string, error) {
+	msg, err := r.query(ctx, zone, dnsmessage.TypeNS)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, answer := range msg.Answers {
+		if ns, ok := answer.Body.(*dnsmessage.NSResource); ok && strings.EqualFold(answer.Header.Name.String(), fqdn(zone)) {
+			hosts = append(hosts, strings.TrimSuffix(ns.NS.String(), "."))
+		}
+	}
+	return hosts, nil
+}
+
+// LookupIP returns the IPv4 and IPv6 addresses of host
+func (r *Resolver) LookupIP(ctx context.Context, host string) ([]net.IP, error) {
+	var ips []net.IP
+	var lastErr error
+	for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		msg, err := r.query(ctx, host, qtype)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		for _, answer := range msg.Answers {
+			switch body := answer.Body.(type) {
+			case *dnsmessage.AResource:
+				ips = append(ips, net.IP(body.A[:]))
+			case *dnsmessage.AAAAResource:
+				ips = append(ips, net.IP(body.AAAA[:]))
+			}
+		}
+	}
+	if len(ips) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return ips, nil
+}
+
+// query sends a question and returns the parsed response
+// NXDOMAIN and NODATA responses are returned as responses without answers; other failure codes are errors.
+func (r *Resolver) query(ctx context.Context, name string, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	qname, err := dnsmessage.NewName(fqdn(name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid name %q: %w", name, err)
+	}
+
+	// DoH queries use ID 0 so responses are cacheable (RFC 8484 section 4.1)
+	var id uint16
+	if r.dohURL == "" {
+		var b [2]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return nil, err
+		}
+		id = binary.BigEndian.Uint16(b[:])
+	}
+
+	question := dnsmessage.Question{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}
+	query, err := packQuery(id, question, r.recursive)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []byte
+	switch {
+	case r.dohURL != "":
+		resp, err = r.exchangeHTTPS(ctx, query)
+	default:
+		resp, err = r.exchangeUDP(ctx, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := parseResponse(resp, id, question)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Truncated && r.dohURL == "" {
+		if resp, err = r.exchangeTCP(ctx, query); err != nil {
+			return nil, err
+		}
+		if msg, err = parseResponse(resp, id, question); err != nil {
+			return nil, err
+		}
+	}
+
+	switch msg.RCode {
+	case dnsmessage.RCodeSuccess, dnsmessage.RCodeNameError:
+		return msg, nil
+	default:
+		return nil, fmt.Errorf("%s answered %s", r.name, msg.RCode)
+	}
+}
+
+// exchangeUDP sends a query over UDP
+func (r *Resolver) exchangeUDP(ctx context.Context, query []byte) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", r.addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, maxMessageSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// exchangeTCP sends a query over TCP (messages are prefixed with their length)
+func (r *Resolver) exchangeTCP(ctx context.Context, query []byte) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", r.addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	framed := make([]byte, 2+len(query))
+	binary.BigEndian.PutUint16(framed, uint16(len(query)))
+	copy(framed[2:], query)
+	if _, err := conn.Write(framed); err != nil {
+		return nil, err
+	}
+
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, err
+	}
+	resp := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// exchangeHTTPS sends a query to a DNS-over-HTTPS endpoint (RFC 8484 POST)
+func (r *Resolver) exchangeHTTPS(ctx context.Context, query []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.dohURL, bytes.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", r.name, resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+}
+
+// packQuery builds a query message with an EDNS0 record advertising ednsPayloadSize
+func packQuery(id uint16, question dnsmessage.Question, recursive bool) ([]byte, error) {
+	var opt dnsmessage.ResourceHeader
+	if err := opt.SetEDNS0(ednsPayloadSize, dnsmessage.RCodeSuccess, false); err != nil {
+		return nil, err
+	}
+	msg := dnsmessage.Message{
+		Header:      dnsmessage.Header{ID: id, RecursionDesired: recursive},
+		Questions:   []dnsmessage.Question{question},
+		Additionals: []dnsmessage.Resource{{Header: opt, Body: &dnsmessage.OPTResource{}}},
+	}
+	return msg.Pack()
+}
+
+// parseResponse unpacks a response and checks that it answers the query
+func parseResponse(resp []byte, id uint16, question dnsmessage.Question) (*dnsmessage.Message, error) {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(resp); err != nil {
+		return nil, fmt.Errorf("invalid DNS response: %w", err)
+	}
+	if !msg.Response || msg.ID != id {
+		return nil, errors.New("DNS response does not match the query")
+	}
+	if len(msg.Questions) != 1 || msg.Questions[0].Type != question.Type ||
+		!strings.EqualFold(msg.Questions[0].Name.String(), question.Name.String()) {
+		return nil, errors.New("DNS response does not match the query")
+	}
+	return &msg, nil
+}
+
+// fqdn returns name with a trailing dot
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
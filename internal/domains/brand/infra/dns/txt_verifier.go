@@ -0,0 +1,242 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/rs/zerolog"
+)
+
+// TXTVerifier checks ownership TXT records (and the TXT and CNAME records of sending domains) against the configured
+// resolvers and the domain's nameservers
+// The authoritative nameservers decide when they can be reached: a record they all serve is proven even before
+// it propagates to caches. Otherwise a majority of the configured resolvers must see it.
+// Each resolver's answer is reported so the UI can show propagation.
+type TXTVerifier struct {
+	resolvers     []*Resolver
+	authoritative bool
+	nsPort        string // Port nameservers are queried on (tests point it at an in-process server)
+	logger        zerolog.Logger
+}
+
+// NewTXTVerifier creates a TXT verifier from resolver specs (see ParseResolver); DefaultResolvers are used when empty
+// With authoritative set, the nameservers of the record's zone are found through the resolvers and queried directly.
+func NewTXTVerifier(specs []string, authoritative bool, logger zerolog.Logger) (outbound.OwnershipVerifier, error) {
+	return newTXTVerifier(specs, authoritative, "53", logger)
+}
+
+// NewRecordVerifier creates a verifier for sending domain records, configured like NewTXTVerifier
+func NewRecordVerifier(specs []string, authoritative bool, logger zerolog.Logger) (outbound.RecordVerifier, error) {
+	return newTXTVerifier(specs, authoritative, "53", logger)
+}
+
+func newTXTVerifier(specs []string, authoritative bool, nsPort string, logger zerolog.Logger) (*TXTVerifier, error) {
+	if len(specs) == 0 {
+		specs = DefaultResolvers
+	}
+	resolvers := make([]*Resolver, 0, len(specs))
+	for _, spec := range specs {
+		resolver, err := ParseResolver(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DNS resolver %q: %w", spec, err)
+		}
+		resolvers = append(resolvers, resolver)
+	}
+	return &TXTVerifier{
+		resolvers:     resolvers,
+		authoritative: authoritative,
+		nsPort:        nsPort,
+		logger:        logger,
+	}, nil
+}
+
+// VerifyTXT looks the record up on every resolver (and nameserver) in parallel
+func (v *TXTVerifier) VerifyTXT(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error) {
+	if record.Name == "" || record.Value == "" {
+		return nil, errors.New("ownership record requires a name and a value")
+	}
+	record.Type = "TXT"
+	return v.verify(ctx, record)
+}
+
+// VerifyRecord looks a TXT or CNAME record up on every resolver (and nameserver) in parallel
+func (v *TXTVerifier) VerifyRecord(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error) {
+	if record.Name == "" || record.Value == "" {
+		return nil, errors.New("record requires a name and a value")
+	}
+	record.Type = strings.ToUpper(record.Type)
+	if record.Type != "TXT" && record.Type != "CNAME" {
+		return nil, fmt.Errorf("unsupported record type %q", record.Type)
+	}
+	return v.verify(ctx, record)
+}
+
+// verify looks the record up on every resolver (and nameserver) and applies the verification rule
+func (v *TXTVerifier) verify(ctx context.Context, record model.DNSRecord) (*model.OwnershipCheck, error) {
+	resolvers := v.resolvers
+	var nsErr error
+	if v.authoritative {
+		var nameservers []*Resolver
+		nameservers, nsErr = v.nameservers(ctx, record.Name)
+		resolvers = append(append([]*Resolver{}, resolvers...), nameservers...)
+	}
+
+	lookups := make([]model.TXTLookup, len(resolvers))
+	var wg sync.WaitGroup
+	for i, resolver := range resolvers {
+		wg.Add(1)
+		go func(i int, resolver *Resolver) {
+			defer wg.Done()
+			lookups[i] = lookupRecord(ctx, resolver, record)
+		}(i, resolver)
+	}
+	wg.Wait()
+
+	if nsErr != nil {
+		v.logger.Debug().Err(nsErr).Str("name", record.Name).Msg("Authoritative nameservers not found")
+		lookups = append(lookups, model.TXTLookup{Resolver: "authoritative", Authoritative: true, Error: nsErr.Error()})
+	}
+
+	return &model.OwnershipCheck{
+		Record:   record,
+		Verified: v.verified(lookups),
+		Lookups:  lookups,
+	}, nil
+}
+
+// verified applies the verification rule to the lookups
+func (v *TXTVerifier) verified(lookups []model.TXTLookup) bool {
+	var nsAnswered, nsFound, found int
+	for _, lookup := range lookups {
+		switch {
+		case lookup.Error != "":
+		case lookup.Authoritative:
+			nsAnswered++
+			if lookup.Found {
+				nsFound++
+			}
+		case lookup.Found:
+			found++
+		}
+	}
+	if nsAnswered > 0 {
+		return nsFound == nsAnswered
+	}
+	return found > 0 && found*2 > len(v.resolvers)
+}
+
+// nameservers finds the nameservers of the zone containing name, walking up from name to the registrable domain
+func (v *TXTVerifier) nameservers(ctx context.Context, name string) ([]*Resolver, error) {
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+	for i := 0; i < len(labels)-1; i++ {
+		zone := strings.Join(labels[i:], ".")
+
+		var hosts []string
+		err := v.bootstrap(func(resolver *Resolver) (err error) {
+			hosts, err = resolver.LookupNS(ctx, zone)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(hosts) == 0 {
+			continue
+		}
+
+		var nameservers []*Resolver
+		for _, host := range hosts {
+			var ips []net.IP
+			if err := v.bootstrap(func(resolver *Resolver) (err error) {
+				ips, err = resolver.LookupIP(ctx, host)
+				return err
+			}); err != nil || len(ips) == 0 {
+				v.logger.Debug().Err(err).Str("nameserver", host).Msg("Nameserver address not found")
+				continue
+			}
+			nameservers = append(nameservers, newAuthoritativeResolver(host, net.JoinHostPort(ips[0].String(), v.nsPort)))
+		}
+		if len(nameservers) == 0 {
+			return nil, fmt.Errorf("no address found for the nameservers of %s", zone)
+		}
+		return nameservers, nil
+	}
+	return nil, fmt.Errorf("no nameservers found for %s", name)
+}
+
+// bootstrap runs query on the configured resolvers in order until one answers
+func (v *TXTVerifier) bootstrap(query func(*Resolver) error) error {
+	var err error
+	for _, resolver := range v.resolvers {
+		if err = query(resolver); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// lookupRecord looks the record up on one resolver
+func lookupRecord(ctx context.Context, resolver *Resolver, record model.DNSRecord) model.TXTLookup {
+	lookup := model.TXTLookup{
+		Resolver:      resolver.String(),
+		Authoritative: !resolver.recursive,
+	}
+
+	var values []string
+	var err error
+	if record.Type == "CNAME" {
+		values, err = resolver.LookupCNAME(ctx, record.Name)
+	} else {
+		values, err = resolver.LookupTXT(ctx, record.Name)
+	}
+	if err != nil {
+		lookup.Error = err.Error()
+		return lookup
+	}
+
+	lookup.Values = values
+	for _, value := range values {
+		if recordMatches(record, value) {
+			lookup.Found = true
+		}
+	}
+	return lookup
+}
+
+// recordMatches reports whether a published value satisfies the expected record
+func recordMatches(record model.DNSRecord, value string) bool {
+	switch {
+	case record.Type == "CNAME":
+		return strings.EqualFold(strings.TrimSuffix(value, "."), strings.TrimSuffix(record.Value, "."))
+	case isSPF(record.Value):
+		return isSPF(value) && spfIncludes(value, record.Value)
+	default:
+		return value == record.Value
+	}
+}
+
+// isSPF reports whether a TXT value is an SPF policy
+func isSPF(value string) bool {
+	return strings.HasPrefix(strings.ToLower(value), "v=spf1 ") || strings.EqualFold(value, "v=spf1")
+}
+
+// spfIncludes reports whether a published SPF policy has every include mechanism of the expected one
+// Agencies usually merge the include into the policy they already publish, so other mechanisms are allowed.
+func spfIncludes(published, expected string) bool {
+	have := make(map[string]bool)
+	for _, term := range strings.Fields(strings.ToLower(published)) {
+		have[strings.TrimLeft(term, "+")] = true
+	}
+	for _, term := range strings.Fields(strings.ToLower(expected)) {
+		if strings.HasPrefix(term, "include:") && !have[term] {
+			return false
+		}
+	}
+	return true
+}
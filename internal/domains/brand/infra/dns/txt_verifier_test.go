@@ -0,0 +1,307 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testDNSServer is an in-process DNS server answering from a fixed set of records over UDP, TCP and DoH
+type testDNSServer struct {
+	records     []dnsmessage.Resource
+	truncateUDP bool // Answer UDP queries with the TC bit so clients retry over TCP
+	addr        string
+}
+
+func startTestDNSServer(t *testing.T, truncateUDP bool, records ...dnsmessage.Resource) *testDNSServer {
+	s := &testDNSServer{records: records, truncateUDP: truncateUDP}
+
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s.addr = udp.LocalAddr().String()
+	tcp, err := net.Listen("tcp", s.addr)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		udp.Close()
+		tcp.Close()
+	})
+
+	go func() {
+		buf := make([]byte, maxMessageSize)
+		for {
+			n, from, err := udp.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp := s.answer(buf[:n], s.truncateUDP); resp != nil {
+				udp.WriteTo(resp, from)
+			}
+		}
+	}()
+	go func() {
+		for {
+			conn, err := tcp.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				var length [2]byte
+				if _, err := io.ReadFull(conn, length[:]); err != nil {
+					return
+				}
+				query := make([]byte, binary.BigEndian.Uint16(length[:]))
+				if _, err := io.ReadFull(conn, query); err != nil {
+					return
+				}
+				resp := s.answer(query, false)
+				binary.BigEndian.PutUint16(length[:], uint16(len(resp)))
+				conn.Write(append(length[:], resp...))
+			}()
+		}
+	}()
+	return s
+}
+
+// startDoH serves the server's records over DNS-over-HTTPS at /dns-query
+func (s *testDNSServer) startDoH(t *testing.T) *httptest.Server {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/dns-message" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		query, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/dns-message")
+		w.Write(s.answer(query, false))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *testDNSServer) answer(query []byte, truncate bool) []byte {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(query); err != nil || len(msg.Questions) != 1 {
+		return nil
+	}
+	question := msg.Questions[0]
+	resp := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: msg.ID, Response: true, Authoritative: true, Truncated: truncate},
+		Questions: msg.Questions,
+	}
+	if !truncate {
+		known := false
+		for _, record := range s.records {
+			if !strings.EqualFold(record.Header.Name.String(), question.Name.String()) {
+				continue
+			}
+			known = true
+			if record.Header.Type == question.Type {
+				resp.Answers = append(resp.Answers, record)
+			}
+		}
+		if !known {
+			resp.RCode = dnsmessage.RCodeNameError
+		}
+	}
+	packed, _ := resp.Pack()
+	return packed
+}
+
+func resourceHeader(name string, qtype dnsmessage.Type) dnsmessage.ResourceHeader {
+	return dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(fqdn(name)), Type: qtype, Class: dnsmessage.ClassINET, TTL: 60}
+}
+
+func txtRecord(name string, values ...string) dnsmessage.Resource {
+	return dnsmessage.Resource{Header: resourceHeader(name, dnsmessage.TypeTXT), Body: &dnsmessage.TXTResource{TXT: values}}
+}
+
+func nsRecord(zone, host string) dnsmessage.Resource {
+	return dnsmessage.Resource{Header: resourceHeader(zone, dnsmessage.TypeNS), Body: &dnsmessage.NSResource{NS: dnsmessage.MustNewName(fqdn(host))}}
+}
+
+func cnameRecord(name, target string) dnsmessage.Resource {
+	return dnsmessage.Resource{Header: resourceHeader(name, dnsmessage.TypeCNAME), Body: &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName(fqdn(target))}}
+}
+
+func aRecord(host string, ip [4]byte) dnsmessage.Resource {
+	return dnsmessage.Resource{Header: resourceHeader(host, dnsmessage.TypeA), Body: &dnsmessage.AResource{A: ip}}
+}
+
+func TestTXTVerifier_VerifyTXT(t *testing.T) {
+	record := model.OwnershipRecord("portal.example.test", "0123456789abcdef")
+	// Each TXT record is separate; the strings of one record are joined
+	published := []dnsmessage.Resource{txtRecord(record.Name, "v=spf1 -all"), txtRecord(record.Name, "faro-verify=", "0123456789abcdef")}
+
+	t.Run("records seen by the resolvers are verified", func(t *testing.T) {
+		server := startTestDNSServer(t, false, published...)
+		doh := server.startDoH(t)
+		verifier, err := newTXTVerifier([]string{server.addr, doh.URL + "/dns-query"}, false, "53", zerolog.Nop())
+		require.NoError(t, err)
+		verifier.resolvers[1].client = doh.Client()
+
+		check, err := verifier.VerifyTXT(context.Background(), record)
+
+		require.NoError(t, err)
+		assert.True(t, check.Verified)
+		require.Len(t, check.Lookups, 2)
+		for _, lookup := range check.Lookups {
+			assert.True(t, lookup.Found, lookup.Resolver)
+			assert.Equal(t, []string{"v=spf1 -all", record.Value}, lookup.Values)
+		}
+	})
+
+	t.Run("records still propagating are reported per resolver", func(t *testing.T) {
+		fresh := startTestDNSServer(t, false, published...)
+		stale := startTestDNSServer(t, false, txtRecord(record.Name, "faro-verify=old"))
+		verifier, err := newTXTVerifier([]string{fresh.addr, stale.addr}, false, "53", zerolog.Nop())
+		require.NoError(t, err)
+
+		check, err := verifier.VerifyTXT(context.Background(), record)
+
+		require.NoError(t, err)
+		assert.False(t, check.Verified, "one of two resolvers is not a majority")
+		assert.True(t, check.Lookups[0].Found)
+		assert.False(t, check.Lookups[1].Found)
+		assert.Equal(t, []string{"faro-verify=old"}, check.Lookups[1].Values)
+	})
+
+	t.Run("authoritative nameservers decide before caches catch up", func(t *testing.T) {
+		authoritative := startTestDNSServer(t, false, append([]dnsmessage.Resource{nsRecord("example.test", "ns1.example.test")}, published...)...)
+		_, port, _ := net.SplitHostPort(authoritative.addr)
+		// The recursive resolver knows the delegation but has not seen the record yet
+		recursive := startTestDNSServer(t, false,
+			nsRecord("example.test", "ns1.example.test"),
+			aRecord("ns1.example.test", [4]byte{127, 0, 0, 1}),
+		)
+		verifier, err := newTXTVerifier([]string{recursive.addr}, true, port, zerolog.Nop())
+		require.NoError(t, err)
+
+		check, err := verifier.VerifyTXT(context.Background(), record)
+
+		require.NoError(t, err)
+		assert.True(t, check.Verified)
+		require.Len(t, check.Lookups, 2)
+		assert.False(t, check.Lookups[0].Found)
+		assert.Equal(t, model.TXTLookup{
+			Resolver:      "ns1.example.test",
+			Authoritative: true,
+			Found:         true,
+			Values:        []string{"v=spf1 -all", record.Value},
+		}, check.Lookups[1])
+	})
+
+	t.Run("nameservers without the record fail verification", func(t *testing.T) {
+		authoritative := startTestDNSServer(t, false, nsRecord("example.test", "ns1.example.test"))
+		_, port, _ := net.SplitHostPort(authoritative.addr)
+		recursive := startTestDNSServer(t, false, append([]dnsmessage.Resource{
+			nsRecord("example.test", "ns1.example.test"),
+			aRecord("ns1.example.test", [4]byte{127, 0, 0, 1}),
+		}, published...)...)
+		verifier, err := newTXTVerifier([]string{recursive.addr}, true, port, zerolog.Nop())
+		require.NoError(t, err)
+
+		check, err := verifier.VerifyTXT(context.Background(), record)
+
+		require.NoError(t, err)
+		assert.False(t, check.Verified, "a cached record the nameservers no longer serve is not proof")
+		assert.True(t, check.Lookups[0].Found)
+		assert.False(t, check.Lookups[1].Found)
+	})
+
+	t.Run("unreachable resolvers are reported", func(t *testing.T) {
+		closed, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := closed.LocalAddr().String()
+		closed.Close()
+		verifier, err := newTXTVerifier([]string{addr}, true, "53", zerolog.Nop())
+		require.NoError(t, err)
+
+		check, err := verifier.VerifyTXT(context.Background(), record)
+
+		require.NoError(t, err)
+		assert.False(t, check.Verified)
+		require.Len(t, check.Lookups, 2)
+		assert.NotEmpty(t, check.Lookups[0].Error)
+		assert.Equal(t, "authoritative", check.Lookups[1].Resolver)
+		assert.NotEmpty(t, check.Lookups[1].Error)
+	})
+}
+
+func TestTXTVerifier_VerifyRecord(t *testing.T) {
+	server := startTestDNSServer(t, false,
+		txtRecord("mail.example.test", "v=spf1 include:_spf.google.com include:spf.mtasv.net ~all"),
+		txtRecord("other.example.test", "v=spf1 include:_spf.google.com ~all"),
+		cnameRecord("pm-bounces.mail.example.test", "pm.mtasv.net"),
+	)
+	verifier, err := newTXTVerifier([]string{server.addr}, false, "53", zerolog.Nop())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		record   model.DNSRecord
+		verified bool
+	}{
+		{"SPF policy with the include merged in", model.DNSRecord{Type: "TXT", Name: "mail.example.test", Value: "v=spf1 include:spf.mtasv.net ~all"}, true},
+		{"SPF policy without the include", model.DNSRecord{Type: "TXT", Name: "other.example.test", Value: "v=spf1 include:spf.mtasv.net ~all"}, false},
+		{"CNAME target", model.DNSRecord{Type: "CNAME", Name: "pm-bounces.mail.example.test", Value: "PM.mtasv.net."}, true},
+		{"wrong CNAME target", model.DNSRecord{Type: "CNAME", Name: "pm-bounces.mail.example.test", Value: "bounces.example.test"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check, err := verifier.VerifyRecord(context.Background(), tt.record)
+			require.NoError(t, err)
+			assert.Equal(t, tt.verified, check.Verified)
+		})
+	}
+
+	_, err = verifier.VerifyRecord(context.Background(), model.DNSRecord{Type: "MX", Name: "mail.example.test", Value: "mx.example.test"})
+	assert.Error(t, err)
+}
+
+func TestResolver_LookupTXT(t *testing.T) {
+	long := strings.Repeat("x", 255)
+	server := startTestDNSServer(t, true, txtRecord("big.example.test", long, long, long))
+	resolver, err := ParseResolver(server.addr)
+	require.NoError(t, err)
+
+	t.Run("truncated answers are retried over TCP", func(t *testing.T) {
+		values, err := resolver.LookupTXT(context.Background(), "big.example.test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{long + long + long}, values)
+	})
+
+	t.Run("missing names have no values", func(t *testing.T) {
+		values, err := resolver.LookupTXT(context.Background(), "missing.example.test")
+		require.NoError(t, err)
+		assert.Empty(t, values)
+	})
+}
+
+func TestParseResolver(t *testing.T) {
+	resolver, err := ParseResolver("1.1.1.1")
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.1.1:53", resolver.addr)
+
+	resolver, err = ParseResolver("[2606:4700:4700::1111]")
+	require.NoError(t, err)
+	assert.Equal(t, "[2606:4700:4700::1111]:53", resolver.addr)
+
+	resolver, err = ParseResolver("https://dns.google/dns-query")
+	require.NoError(t, err)
+	assert.Equal(t, "https://dns.google/dns-query", resolver.dohURL)
+
+	_, err = ParseResolver(" ")
+	assert.Error(t, err)
+}
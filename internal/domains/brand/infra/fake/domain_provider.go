@@ -0,0 +1,140 @@
+package fake
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	brand_domain "farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+)
+
+// DomainProvider is an in-memory DomainProvider for tests and local development
+// Domains are pending until verified: with autoVerify, VerifyDomain verifies them and activates SSL right away;
+// otherwise tests drive the state with SetVerified and SetSSLStatus.
+type DomainProvider struct {
+	mu          sync.Mutex
+	domains     map[string]*outbound.CustomDomain
+	cnameTarget string
+	autoVerify  bool
+}
+
+// NewDomainProvider creates a new in-memory domain provider
+func NewDomainProvider(cnameTarget string, autoVerify bool) *DomainProvider {
+	return &DomainProvider{
+		domains:     make(map[string]*outbound.CustomDomain),
+		cnameTarget: cnameTarget,
+		autoVerify:  autoVerify,
+	}
+}
+
+// AddDomain registers a domain, or returns the registered one
+func (p *DomainProvider) AddDomain(ctx context.Context, domain string) (*outbound.CustomDomain, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := strings.ToLower(domain)
+	if _, ok := p.domains[key]; !ok {
+		p.domains[key] = &outbound.CustomDomain{
+			Domain: key,
+			Records: []model.DNSRecord{
+				{Type: "CNAME", Name: key, Value: p.cnameTarget},
+			},
+		}
+	}
+	return p.copyOf(key), nil
+}
+
+// GetExpectedRecords returns the domain's CNAME record
+func (p *DomainProvider) GetExpectedRecords(ctx context.Context, domain string) ([]model.DNSRecord, error) {
+	status, err := p.GetDomainStatus(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return status.Records, nil
+}
+
+// VerifyDomain returns whether the domain is verified, verifying it first with autoVerify
+func (p *DomainProvider) VerifyDomain(ctx context.Context, domain string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stored, ok := p.domains[strings.ToLower(domain)]
+	if !ok {
+		return false, brand_domain.ErrDomainNotRegistered
+	}
+	if p.autoVerify {
+		stored.Verified = true
+		stored.SSLStatus = model.SSLStatusActive
+	}
+	return stored.Verified, nil
+}
+
+// GetDomainStatus returns the registered domain
+func (p *DomainProvider) GetDomainStatus(ctx context.Context, domain string) (*outbound.CustomDomain, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := strings.ToLower(domain)
+	if _, ok := p.domains[key]; !ok {
+		return nil, brand_domain.ErrDomainNotRegistered
+	}
+	return p.copyOf(key), nil
+}
+
+// GetSSLStatus returns the registered domain's SSL status
+func (p *DomainProvider) GetSSLStatus(ctx context.Context, domain string) (model.SSLStatus, error) {
+	status, err := p.GetDomainStatus(ctx, domain)
+	if err != nil {
+		return "", err
+	}
+	return status.SSLStatus, nil
+}
+
+// RemoveDomain unregisters a domain (idempotent)
+func (p *DomainProvider) RemoveDomain(ctx context.Context, domain string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.domains, strings.ToLower(domain))
+	return nil
+}
+
+// SetVerified sets a registered domain's verification status
+func (p *DomainProvider) SetVerified(domain string, verified bool) {
+	p.update(domain, func(stored *outbound.CustomDomain) { stored.Verified = verified })
+}
+
+// SetSSLStatus sets a registered domain's SSL status
+func (p *DomainProvider) SetSSLStatus(domain string, status model.SSLStatus) {
+	p.update(domain, func(stored *outbound.CustomDomain) { stored.SSLStatus = status })
+}
+
+// Domains returns the registered domain names
+func (p *DomainProvider) Domains() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	domains := make([]string, 0, len(p.domains))
+	for domain := range p.domains {
+		domains = append(domains, domain)
+	}
+	return domains
+}
+
+func (p *DomainProvider) update(domain string, fn func(*outbound.CustomDomain)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if stored, ok := p.domains[strings.ToLower(domain)]; ok {
+		fn(stored)
+	}
+}
+
+// copyOf returns a copy of a registered domain, so callers cannot change the stored state
+func (p *DomainProvider) copyOf(key string) *outbound.CustomDomain {
+	stored := *p.domains[key]
+	stored.Records = append([]model.DNSRecord(nil), stored.Records...)
+	return &stored
+}
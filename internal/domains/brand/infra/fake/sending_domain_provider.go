@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"sync"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+)
+
+// SendingDomainProvider is an in-memory SendingDomainProvider for tests and local development
+// Registrations get a random DKIM key and the provider confirms every domain right away.
+type SendingDomainProvider struct {
+	mu      sync.Mutex
+	domains map[string]*outbound.SendingDomainRegistration // By provider ID
+}
+
+// NewSendingDomainProvider creates a new in-memory sending domain provider
+func NewSendingDomainProvider() *SendingDomainProvider {
+	return &SendingDomainProvider{
+		domains: make(map[string]*outbound.SendingDomainRegistration),
+	}
+}
+
+// RegisterDomain registers a domain, or returns its registration
+func (p *SendingDomainProvider) RegisterDomain(ctx context.Context, domain string) (*outbound.SendingDomainRegistration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := "fake-" + strings.ToLower(domain)
+	if registration, ok := p.domains[id]; ok {
+		copied := *registration
+		return &copied, nil
+	}
+
+	key := make([]byte, 32)
+	rand.Read(key)
+	registration := &outbound.SendingDomainRegistration{
+		ProviderID: id,
+		DKIM:       model.DNSRecord{Type: "TXT", Name: "faro._domainkey." + domain, Value: "k=rsa;p=" + base64.StdEncoding.EncodeToString(key)},
+		ReturnPath: model.DNSRecord{Type: "CNAME", Name: "bounces." + domain, Value: "bounces.localhost"},
+		SPFInclude: "spf.localhost",
+	}
+	p.domains[id] = registration
+	copied := *registration
+	return &copied, nil
+}
+
+// ConfirmDomain confirms registered domains
+func (p *SendingDomainProvider) ConfirmDomain(ctx context.Context, providerID string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.domains[providerID]
+	return ok, nil
+}
+
+// RemoveDomain forgets a domain
+func (p *SendingDomainProvider) RemoveDomain(ctx context.Context, providerID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.domains, providerID)
+	return nil
+}
@@ -0,0 +1,257 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// GetBrandingDraftHandler handles GET /api/v1/brands/{brandId}/draft
+// Returns the unpublished draft, its preview token and what publishing it would change.
+func (h *Handlers) GetBrandingDraftHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.getBrandingDraft.Execute(r.Context(), &inbound.GetBrandingDraftRequest{
+		BrandID: chi.URLParam(r, "brandId"),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get branding draft")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Draft.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.buildBrandingDraftResponse(r.Context(), resp.Draft, resp.Branding, resp.Changes))
+}
+
+// PutBrandingDraftHandler handles PUT /api/v1/brands/{brandId}/draft
+// Edits the draft without touching the live branding; omitted or null fields keep their drafted value.
+// Editing an existing draft requires If-Match with its current ETag; without If-Match the draft is only created.
+func (h *Handlers) PutBrandingDraftHandler(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err == httpserver.ErrPreconditionRequired {
+		var none int64
+		expectedVersion = &none
+	}
+
+	var req struct {
+		Website        *string                 `json:"website"`
+		LogoURL        *string                 `json:"logo_url"`
+		FaviconURL     *string                 `json:"favicon_url"`
+		PrimaryColor   *string                 `json:"primary_color"`
+		SecondaryColor *string                 `json:"secondary_color"`
+		ThemeJSON      *map[string]interface{} `json:"theme_json"`
+		HidePoweredBy  *bool                   `json:"hide_powered_by"` // Growth+ tiers only
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	putReq := &inbound.PutBrandingDraftRequest{
+		BrandID:         chi.URLParam(r, "brandId"),
+		Website:         req.Website,
+		LogoURL:         req.LogoURL,
+		FaviconURL:      req.FaviconURL,
+		PrimaryColor:    req.PrimaryColor,
+		SecondaryColor:  req.SecondaryColor,
+		ThemeJSON:       req.ThemeJSON,
+		HidePoweredBy:   req.HidePoweredBy,
+		ExpectedVersion: expectedVersion,
+	}
+
+	resp, err := h.putBrandingDraft.Execute(r.Context(), putReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			h.writeDraftPreconditionFailed(w, r, putReq.BrandID)
+			return
+		}
+		h.writeError(w, r, err, "Failed to update branding draft")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Draft.Version())
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(h.buildBrandingDraftResponse(r.Context(), resp.Draft, resp.Branding, resp.Changes))
+}
+
+// DeleteBrandingDraftHandler handles DELETE /api/v1/brands/{brandId}/draft
+// Discards the draft; its preview token stops working.
+func (h *Handlers) DeleteBrandingDraftHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.deleteBrandingDraft.Execute(r.Context(), &inbound.DeleteBrandingDraftRequest{
+		BrandID: chi.URLParam(r, "brandId"),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to delete branding draft")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": resp.Success,
+	})
+}
+
+// PublishBrandingDraftHandler handles POST /api/v1/brands/{brandId}/draft/publish
+// Makes the draft live; the replaced branding is kept in the version history. An optional If-Match
+// with the draft's ETag makes sure the reviewed draft is the one published.
+func (h *Handlers) PublishBrandingDraftHandler(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, _ := httpserver.IfMatchVersion(r)
+
+	publishReq := &inbound.PublishBrandingDraftRequest{
+		BrandID:         chi.URLParam(r, "brandId"),
+		ExpectedVersion: expectedVersion,
+	}
+
+	resp, err := h.publishBrandingDraft.Execute(r.Context(), publishReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			h.writeDraftPreconditionFailed(w, r, publishReq.BrandID)
+			return
+		}
+		h.writeError(w, r, err, "Failed to publish branding draft")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Branding.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
+}
+
+// ListBrandingVersionsHandler handles GET /api/v1/brands/{brandId}/versions
+// Lists previously live brandings, newest first, each with what replaced it.
+func (h *Handlers) ListBrandingVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	var limit int
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed < 1 {
+			httpserver.WriteBadRequest(w, r, "limit must be a positive integer")
+			return
+		}
+		limit = parsed
+	}
+
+	resp, err := h.listBrandingVersions.Execute(r.Context(), &inbound.ListBrandingVersionsRequest{
+		BrandID: chi.URLParam(r, "brandId"),
+		Limit:   limit,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to list branding versions")
+		return
+	}
+
+	versions := make([]map[string]interface{}, len(resp.Versions))
+	for i, entry := range resp.Versions {
+		version := brandingContentResponse(entry.Version.Content())
+		version["version"] = entry.Version.Number()
+		version["replaced_at"] = entry.Version.ReplacedAt().Format("2006-01-02T15:04:05Z07:00")
+		version["changes"] = brandingChangesResponse(entry.Changes)
+		versions[i] = version
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"versions": versions,
+	})
+}
+
+// RollbackBrandingHandler handles POST /api/v1/brands/{brandId}/versions/{version}/rollback
+// Requires If-Match with the brand's current ETag. Restores the version's presentation as the live branding;
+// the branding it replaces is kept in the history, so a rollback can itself be rolled back.
+func (h *Handlers) RollbackBrandingHandler(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.ParseInt(chi.URLParam(r, "version"), 10, 64)
+	if err != nil || number < 1 {
+		httpserver.WriteProblem(w, r, problems.Problem(domain.ErrBrandingVersionNotFound))
+		return
+	}
+
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err != nil {
+		httpserver.WritePreconditionRequired(w, r)
+		return
+	}
+
+	rollbackReq := &inbound.RollbackBrandingRequest{
+		BrandID:         chi.URLParam(r, "brandId"),
+		Version:         number,
+		ExpectedVersion: expectedVersion,
+	}
+
+	resp, err := h.rollbackBranding.Execute(r.Context(), rollbackReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			current, getErr := h.getBrand.Execute(r.Context(), &inbound.GetBrandRequest{BrandID: rollbackReq.BrandID})
+			if getErr != nil {
+				httpserver.WriteProblem(w, r, problems.Problem(domain.ErrBrandingNotFound))
+				return
+			}
+			httpserver.WritePreconditionFailed(w, r, current.Branding.Version(), h.buildBrandResponse(r.Context(), current.Branding))
+			return
+		}
+		h.writeError(w, r, err, "Failed to roll back branding")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Branding.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
+}
+
+// writeDraftPreconditionFailed answers a stale draft write with the current draft
+func (h *Handlers) writeDraftPreconditionFailed(w http.ResponseWriter, r *http.Request, brandID string) {
+	current, err := h.getBrandingDraft.Execute(r.Context(), &inbound.GetBrandingDraftRequest{BrandID: brandID})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get branding draft")
+		return
+	}
+	httpserver.WritePreconditionFailed(w, r, current.Draft.Version(), h.buildBrandingDraftResponse(r.Context(), current.Draft, current.Branding, current.Changes))
+}
+
+// buildBrandingDraftResponse converts a draft, the live branding and their differences to a map for JSON encoding
+func (h *Handlers) buildBrandingDraftResponse(ctx context.Context, draft *model.BrandingDraft, live *model.Branding, changes []model.BrandingChange) map[string]interface{} {
+	response := brandingContentResponse(draft.Content())
+	response["agency_id"] = draft.AgencyID().String()
+	response["preview_token"] = draft.PreviewToken()
+	response["version"] = draft.Version()
+	response["updated_at"] = draft.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+	response["changes"] = brandingChangesResponse(changes)
+	response["live"] = h.buildBrandResponse(ctx, live)
+	return response
+}
+
+// brandingContentResponse converts a branding presentation to a map for JSON encoding
+func brandingContentResponse(content model.BrandingContent) map[string]interface{} {
+	return map[string]interface{}{
+		"website":         content.Website,
+		"logo_url":        content.LogoURL,
+		"favicon_url":     content.FaviconURL,
+		"assets":          content.Assets,
+		"primary_color":   content.PrimaryColor,
+		"secondary_color": content.SecondaryColor,
+		"theme_json":      content.ThemeJSON,
+		"hide_powered_by": content.HidePoweredBy,
+	}
+}
+
+// brandingChangesResponse converts branding changes to a list for JSON encoding
+func brandingChangesResponse(changes []model.BrandingChange) []map[string]interface{} {
+	response := make([]map[string]interface{}, len(changes))
+	for i, change := range changes {
+		response[i] = map[string]interface{}{
+			"field": change.Field,
+			"from":  change.From,
+			"to":    change.To,
+		}
+	}
+	return response
+}
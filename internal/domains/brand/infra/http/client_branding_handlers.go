@@ -0,0 +1,141 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// GetClientBrandingHandler handles GET /api/v1/brands/{brandId}/clients/{clientId}
+// Returns the client's overrides and the effective branding; a client without overrides inherits the agency branding.
+func (h *Handlers) GetClientBrandingHandler(w http.ResponseWriter, r *http.Request) {
+	req := &inbound.GetClientBrandingRequest{
+		BrandID:  chi.URLParam(r, "brandId"),
+		ClientID: chi.URLParam(r, "clientId"),
+	}
+
+	resp, err := h.getClientBranding.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get client branding")
+		return
+	}
+
+	if resp.ClientBranding != nil {
+		httpserver.SetETag(w, resp.ClientBranding.Version())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.buildClientBrandingResponse(r.Context(), resp.ClientBranding, resp.Branding))
+}
+
+// PutClientBrandingHandler handles PUT /api/v1/brands/{brandId}/clients/{clientId}
+// Replaces the client's overrides; omitted or null fields are inherited from the agency branding.
+// Replacing existing overrides requires If-Match with their current ETag; without If-Match the overrides are only created.
+func (h *Handlers) PutClientBrandingHandler(w http.ResponseWriter, r *http.Request) {
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err == httpserver.ErrPreconditionRequired {
+		var none int64
+		expectedVersion = &none
+	}
+
+	var req struct {
+		LogoURL        *string                `json:"logo_url"`
+		FaviconURL     *string                `json:"favicon_url"`
+		PrimaryColor   *string                `json:"primary_color"`
+		SecondaryColor *string                `json:"secondary_color"`
+		ThemeJSON      map[string]interface{} `json:"theme_json"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	putReq := &inbound.PutClientBrandingRequest{
+		BrandID:         chi.URLParam(r, "brandId"),
+		ClientID:        chi.URLParam(r, "clientId"),
+		LogoURL:         req.LogoURL,
+		FaviconURL:      req.FaviconURL,
+		PrimaryColor:    req.PrimaryColor,
+		SecondaryColor:  req.SecondaryColor,
+		ThemeJSON:       req.ThemeJSON,
+		ExpectedVersion: expectedVersion,
+	}
+
+	resp, err := h.putClientBranding.Execute(r.Context(), putReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			current, getErr := h.getClientBranding.Execute(r.Context(), &inbound.GetClientBrandingRequest{BrandID: putReq.BrandID, ClientID: putReq.ClientID})
+			if getErr != nil || current.ClientBranding == nil {
+				httpserver.WriteProblem(w, r, problems.Problem(domain.ErrClientBrandingNotFound))
+				return
+			}
+			httpserver.WritePreconditionFailed(w, r, current.ClientBranding.Version(), h.buildClientBrandingResponse(r.Context(), current.ClientBranding, current.Branding))
+			return
+		}
+		h.writeError(w, r, err, "Failed to update client branding")
+		return
+	}
+
+	httpserver.SetETag(w, resp.ClientBranding.Version())
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(h.buildClientBrandingResponse(r.Context(), resp.ClientBranding, resp.Branding))
+}
+
+// DeleteClientBrandingHandler handles DELETE /api/v1/brands/{brandId}/clients/{clientId}
+// The client inherits the agency branding again.
+func (h *Handlers) DeleteClientBrandingHandler(w http.ResponseWriter, r *http.Request) {
+	req := &inbound.DeleteClientBrandingRequest{
+		BrandID:  chi.URLParam(r, "brandId"),
+		ClientID: chi.URLParam(r, "clientId"),
+	}
+
+	resp, err := h.deleteClientBranding.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err, "Failed to delete client branding")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": resp.Success,
+	})
+}
+
+// buildClientBrandingResponse converts a client's overrides (nil when it has none) and its effective branding to a map for JSON encoding
+func (h *Handlers) buildClientBrandingResponse(ctx context.Context, clientBranding *model.ClientBranding, effective *model.Branding) map[string]interface{} {
+	response := map[string]interface{}{
+		"client_id":       effective.ClientID().String(),
+		"agency_id":       effective.AgencyID().String(),
+		"logo_url":        nil,
+		"favicon_url":     nil,
+		"primary_color":   nil,
+		"secondary_color": nil,
+		"theme_json":      map[string]interface{}{},
+		"inherited":       clientBranding == nil,
+		"version":         0,
+		"updated_at":      nil,
+		"effective":       h.buildBrandResponse(ctx, effective),
+	}
+
+	if clientBranding != nil {
+		response["logo_url"] = clientBranding.LogoURL()
+		response["favicon_url"] = clientBranding.FaviconURL()
+		response["primary_color"] = clientBranding.PrimaryColor()
+		response["secondary_color"] = clientBranding.SecondaryColor()
+		response["theme_json"] = clientBranding.ThemeJSON()
+		response["version"] = clientBranding.Version()
+		response["updated_at"] = clientBranding.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
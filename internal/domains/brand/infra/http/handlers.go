@@ -11,25 +11,42 @@ import (
 	"farohq-core-app/internal/domains/brand/domain"
 	"farohq-core-app/internal/domains/brand/domain/model"
 	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
 	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
 	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+	"farohq-core-app/internal/platform/httpserver"
 	"farohq-core-app/internal/platform/tenant"
 )
 
 // Handlers provides HTTP handlers for the brand domain
 type Handlers struct {
-	logger              zerolog.Logger
-	getByDomain         inbound.GetByDomain
-	getByHost           inbound.GetByHost
-	listBrands          inbound.ListBrands
-	createBrand         inbound.CreateBrand
-	getBrand            inbound.GetBrand
-	updateBrand         inbound.UpdateBrand
-	deleteBrand         inbound.DeleteBrand
-	verifyDomain        inbound.VerifyDomain
-	getDomainStatus     inbound.GetDomainStatus
+	logger                zerolog.Logger
+	getByDomain           inbound.GetByDomain
+	getByHost             inbound.GetByHost
+	listBrands            inbound.ListBrands
+	createBrand           inbound.CreateBrand
+	getBrand              inbound.GetBrand
+	updateBrand           inbound.UpdateBrand
+	deleteBrand           inbound.DeleteBrand
+	verifyDomain          inbound.VerifyDomain
+	getDomainStatus       inbound.GetDomainStatus
 	getDomainInstructions inbound.GetDomainInstructions
-	tenantRepo          tenants_outbound.TenantRepository // For tier-based flags in responses
+	getClientBranding     inbound.GetClientBranding
+	putClientBranding     inbound.PutClientBranding
+	deleteClientBranding  inbound.DeleteClientBranding
+	getBrandingDraft      inbound.GetBrandingDraft
+	putBrandingDraft      inbound.PutBrandingDraft
+	deleteBrandingDraft   inbound.DeleteBrandingDraft
+	publishBrandingDraft  inbound.PublishBrandingDraft
+	listBrandingVersions  inbound.ListBrandingVersions
+	rollbackBranding      inbound.RollbackBranding
+	getSendingDomain      inbound.GetSendingDomain
+	putSendingDomain      inbound.PutSendingDomain
+	verifySendingDomain   inbound.VerifySendingDomain
+	deleteSendingDomain   inbound.DeleteSendingDomain
+	getTheme              inbound.GetTheme
+	brandingCache         outbound.BrandingCache            // Optional; public lookups resolve on every request without it
+	tenantRepo            tenants_outbound.TenantRepository // For tier-based flags in responses
 }
 
 // NewHandlers creates new brand HTTP handlers
@@ -45,21 +62,51 @@ func NewHandlers(
 	verifyDomain inbound.VerifyDomain,
 	getDomainStatus inbound.GetDomainStatus,
 	getDomainInstructions inbound.GetDomainInstructions,
+	getClientBranding inbound.GetClientBranding,
+	putClientBranding inbound.PutClientBranding,
+	deleteClientBranding inbound.DeleteClientBranding,
+	getBrandingDraft inbound.GetBrandingDraft,
+	putBrandingDraft inbound.PutBrandingDraft,
+	deleteBrandingDraft inbound.DeleteBrandingDraft,
+	publishBrandingDraft inbound.PublishBrandingDraft,
+	listBrandingVersions inbound.ListBrandingVersions,
+	rollbackBranding inbound.RollbackBranding,
+	getSendingDomain inbound.GetSendingDomain,
+	putSendingDomain inbound.PutSendingDomain,
+	verifySendingDomain inbound.VerifySendingDomain,
+	deleteSendingDomain inbound.DeleteSendingDomain,
+	getTheme inbound.GetTheme,
+	brandingCache outbound.BrandingCache,
 	tenantRepo tenants_outbound.TenantRepository,
 ) *Handlers {
 	return &Handlers{
-		logger:              logger,
-		getByDomain:         getByDomain,
-		getByHost:           getByHost,
-		listBrands:          listBrands,
-		createBrand:         createBrand,
-		getBrand:            getBrand,
-		updateBrand:         updateBrand,
-		deleteBrand:         deleteBrand,
-		verifyDomain:        verifyDomain,
-		getDomainStatus:     getDomainStatus,
+		logger:                logger,
+		getByDomain:           getByDomain,
+		getByHost:             getByHost,
+		listBrands:            listBrands,
+		createBrand:           createBrand,
+		getBrand:              getBrand,
+		updateBrand:           updateBrand,
+		deleteBrand:           deleteBrand,
+		verifyDomain:          verifyDomain,
+		getDomainStatus:       getDomainStatus,
 		getDomainInstructions: getDomainInstructions,
-		tenantRepo:          tenantRepo,
+		getClientBranding:     getClientBranding,
+		putClientBranding:     putClientBranding,
+		deleteClientBranding:  deleteClientBranding,
+		getBrandingDraft:      getBrandingDraft,
+		putBrandingDraft:      putBrandingDraft,
+		deleteBrandingDraft:   deleteBrandingDraft,
+		publishBrandingDraft:  publishBrandingDraft,
+		listBrandingVersions:  listBrandingVersions,
+		rollbackBranding:      rollbackBranding,
+		getSendingDomain:      getSendingDomain,
+		putSendingDomain:      putSendingDomain,
+		verifySendingDomain:   verifySendingDomain,
+		deleteSendingDomain:   deleteSendingDomain,
+		getTheme:              getTheme,
+		brandingCache:         brandingCache,
+		tenantRepo:            tenantRepo,
 	}
 }
 
@@ -67,54 +114,123 @@ func NewHandlers(
 func (h *Handlers) GetByDomainHandler(w http.ResponseWriter, r *http.Request) {
 	domainParam := r.URL.Query().Get("domain")
 	if domainParam == "" {
-		http.Error(w, "domain parameter is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "domain", "domain parameter is required")
 		return
 	}
 
-	req := &inbound.GetByDomainRequest{
-		Domain: domainParam,
-	}
-
-	resp, err := h.getByDomain.Execute(r.Context(), req)
-	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Branding not found", http.StatusNotFound)
-			return
+	h.serveBranding(w, r, outbound.DomainCacheKey(domainParam), domainParam, func() (*model.Branding, error) {
+		resp, err := h.getByDomain.Execute(r.Context(), &inbound.GetByDomainRequest{
+			Domain: domainParam,
+		})
+		if err != nil {
+			return nil, err
 		}
-		h.logger.Error().Err(err).Msg("Failed to get branding by domain")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
+		return resp.Branding, nil
+	}, "Failed to get branding by domain")
 }
 
 // GetByHostHandler handles GET /api/v1/brand/by-host
 func (h *Handlers) GetByHostHandler(w http.ResponseWriter, r *http.Request) {
 	host := r.URL.Query().Get("host")
 	if host == "" {
-		http.Error(w, "host parameter is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "host", "host parameter is required")
 		return
 	}
 
 	req := &inbound.GetByHostRequest{
-		Host: host,
+		Host:         host,
+		ClientID:     clientIDParam(r),
+		PreviewToken: previewTokenParam(r),
+	}
+
+	// Draft previews render unpublished branding and are never cached
+	if req.PreviewToken != "" {
+		resp, err := h.getByHost.Execute(r.Context(), req)
+		if err != nil {
+			h.writeError(w, r, err, "Failed to get branding by host")
+			return
+		}
+
+		w.Header().Set("Cache-Control", previewCacheControl)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
+		return
 	}
 
-	resp, err := h.getByHost.Execute(r.Context(), req)
+	h.serveBranding(w, r, outbound.HostCacheKey(host, req.ClientID), host, func() (*model.Branding, error) {
+		resp, err := h.getByHost.Execute(r.Context(), req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Branding, nil
+	}, "Failed to get branding by host")
+}
+
+// brandingCacheControl lets browsers and CDNs reuse a branding briefly, then serve it stale while revalidating by ETag
+const brandingCacheControl = "public, max-age=60, stale-while-revalidate=300"
+
+// notFoundCacheControl lets browsers and CDNs absorb repeated lookups of unknown hosts
+const notFoundCacheControl = "public, max-age=30"
+
+// serveBranding answers a public branding lookup from the cache, resolving and caching it on a miss
+// Unknown hosts are cached as negative entries so they cannot be used to hammer the database.
+func (h *Handlers) serveBranding(w http.ResponseWriter, r *http.Request, key, host string, resolve func() (*model.Branding, error), errMsg string) {
+	if h.brandingCache != nil {
+		if entry, found := h.brandingCache.Get(r.Context(), key); found {
+			h.writeCachedBranding(w, r, entry)
+			return
+		}
+	}
+
+	entry := &outbound.CachedBranding{Host: outbound.BrandingHost(host)}
+	branding, err := resolve()
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Branding not found", http.StatusNotFound)
+		if err != domain.ErrBrandingNotFound {
+			h.writeError(w, r, err, errMsg)
 			return
 		}
-		h.logger.Error().Err(err).Msg("Failed to get branding by host")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	} else {
+		body, err := json.Marshal(h.buildBrandResponse(r.Context(), branding))
+		if err != nil {
+			h.writeError(w, r, err, errMsg)
+			return
+		}
+		entry.AgencyID, entry.Body = branding.AgencyID(), body
+	}
+
+	if h.brandingCache != nil {
+		if err := h.brandingCache.Set(r.Context(), key, entry); err != nil {
+			h.logger.Warn().Err(err).Str("key", key).Msg("Failed to cache branding")
+		}
+	}
+	h.writeCachedBranding(w, r, entry)
+}
+
+// writeCachedBranding writes a resolved branding with its ETag, or the not-found problem of a negative entry
+func (h *Handlers) writeCachedBranding(w http.ResponseWriter, r *http.Request, entry *outbound.CachedBranding) {
+	w.Header().Set("Vary", "X-Client-ID, X-Preview-Token")
+	if !entry.Found() {
+		w.Header().Set("Cache-Control", notFoundCacheControl)
+		h.writeError(w, r, domain.ErrBrandingNotFound, "Branding not found")
 		return
 	}
+	httpserver.WriteCacheable(w, r, "application/json", brandingCacheControl, entry.Body)
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
+// clientIDParam returns the optional client context of a public branding lookup (client_id query or X-Client-ID header)
+func clientIDParam(r *http.Request) string {
+	if clientID := r.URL.Query().Get("client_id"); clientID != "" {
+		return clientID
+	}
+	return r.Header.Get("X-Client-ID")
+}
+
+// previewTokenParam returns the optional draft preview token of a public branding lookup (preview query or X-Preview-Token header)
+func previewTokenParam(r *http.Request) string {
+	if token := r.URL.Query().Get("preview"); token != "" {
+		return token
+	}
+	return r.Header.Get("X-Preview-Token")
 }
 
 // buildBrandResponse converts a Branding entity to a map for JSON encoding with tier-based flags
@@ -146,8 +262,15 @@ func (h *Handlers) buildBrandResponse(ctx context.Context, branding *model.Brand
 		canConfigureDomain = tenants_model.TierSupportsCustomDomain(tier)
 	}
 
+	var clientID *string
+	if branding.ClientID() != nil {
+		id := branding.ClientID().String()
+		clientID = &id
+	}
+
 	response := map[string]interface{}{
 		"agency_id":            branding.AgencyID().String(),
+		"client_id":            clientID,
 		"domain":               branding.Domain(),
 		"subdomain":            branding.Subdomain(),
 		"domain_type":          domainType,
@@ -155,6 +278,7 @@ func (h *Handlers) buildBrandResponse(ctx context.Context, branding *model.Brand
 		"verified_at":          verifiedAt,
 		"logo_url":             branding.LogoURL(),
 		"favicon_url":          branding.FaviconURL(),
+		"assets":               branding.Assets(),
 		"primary_color":        branding.PrimaryColor(),
 		"secondary_color":      branding.SecondaryColor(),
 		"theme_json":           branding.ThemeJSON(),
@@ -163,6 +287,7 @@ func (h *Handlers) buildBrandResponse(ctx context.Context, branding *model.Brand
 		"can_configure_domain": canConfigureDomain,
 		"email_domain":         branding.EmailDomain(),
 		"ssl_status":           sslStatus,
+		"version":              branding.Version(),
 		"updated_at":           branding.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
 
@@ -174,7 +299,7 @@ func (h *Handlers) ListBrandsHandler(w http.ResponseWriter, r *http.Request) {
 	// Get tenant ID from context
 	tenantID, ok := tenant.GetTenantFromContext(r.Context())
 	if !ok {
-		http.Error(w, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain.", http.StatusBadRequest)
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
 		return
 	}
 
@@ -184,8 +309,7 @@ func (h *Handlers) ListBrandsHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.listBrands.Execute(r.Context(), req)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to list brands")
-		http.Error(w, "Failed to query brands", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list brands")
 		return
 	}
 
@@ -203,13 +327,13 @@ func (h *Handlers) CreateBrandHandler(w http.ResponseWriter, r *http.Request) {
 	// Get tenant ID from context
 	tenantID, ok := tenant.GetTenantFromContext(r.Context())
 	if !ok {
-		http.Error(w, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain.", http.StatusBadRequest)
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
 		return
 	}
 
 	var req struct {
-		Domain         string                 `json:"domain"`          // Optional: Custom domain (Scale tier only)
-		Website        string                 `json:"website"`         // Optional: Agency website URL
+		Domain         string                 `json:"domain"`  // Optional: Custom domain (Scale tier only)
+		Website        string                 `json:"website"` // Optional: Agency website URL
 		LogoURL        string                 `json:"logo_url"`
 		FaviconURL     string                 `json:"favicon_url"`
 		PrimaryColor   string                 `json:"primary_color"`
@@ -218,7 +342,7 @@ func (h *Handlers) CreateBrandHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
@@ -235,8 +359,7 @@ func (h *Handlers) CreateBrandHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.createBrand.Execute(r.Context(), createReq)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to create/update brand")
-		http.Error(w, "Failed to create brand", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to create/update brand")
 		return
 	}
 
@@ -249,7 +372,7 @@ func (h *Handlers) CreateBrandHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) GetBrandHandler(w http.ResponseWriter, r *http.Request) {
 	brandID := chi.URLParam(r, "brandId")
 	if brandID == "" {
-		http.Error(w, "brand ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
 		return
 	}
 
@@ -259,76 +382,164 @@ func (h *Handlers) GetBrandHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.getBrand.Execute(r.Context(), req)
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Brand not found", http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to get brand")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to get brand")
 		return
 	}
 
+	httpserver.SetETag(w, resp.Branding.Version())
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
 }
 
 // UpdateBrandHandler handles PUT /api/v1/brands/{brandId}
+// Requires If-Match with the brand's current ETag
 func (h *Handlers) UpdateBrandHandler(w http.ResponseWriter, r *http.Request) {
 	brandID := chi.URLParam(r, "brandId")
 	if brandID == "" {
-		http.Error(w, "brand ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
+		return
+	}
+
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err != nil {
+		httpserver.WritePreconditionRequired(w, r)
 		return
 	}
 
 	var req struct {
-		Domain         *string                `json:"domain"`          // Optional: Custom domain (Scale tier only)
-		Website        *string                `json:"website"`         // Optional: Agency website URL
-		LogoURL        *string                `json:"logo_url"`
-		FaviconURL     *string                `json:"favicon_url"`
-		PrimaryColor   *string                `json:"primary_color"`
-		SecondaryColor *string                `json:"secondary_color"`
+		Domain         *string                 `json:"domain"`  // Optional: Custom domain (Scale tier only)
+		Website        *string                 `json:"website"` // Optional: Agency website URL
+		LogoURL        *string                 `json:"logo_url"`
+		FaviconURL     *string                 `json:"favicon_url"`
+		PrimaryColor   *string                 `json:"primary_color"`
+		SecondaryColor *string                 `json:"secondary_color"`
 		ThemeJSON      *map[string]interface{} `json:"theme_json"`
 		HidePoweredBy  *bool                   `json:"hide_powered_by"` // Optional: Hide "Powered by Faro" badge (Growth+ tiers only)
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	updateReq := &inbound.UpdateBrandRequest{
-		BrandID:        brandID,
-		Domain:         req.Domain,
-		Website:        req.Website,
-		LogoURL:        req.LogoURL,
-		FaviconURL:     req.FaviconURL,
-		PrimaryColor:   req.PrimaryColor,
-		SecondaryColor: req.SecondaryColor,
-		ThemeJSON:      req.ThemeJSON,
-		HidePoweredBy:  req.HidePoweredBy,
+		BrandID:         brandID,
+		Domain:          req.Domain,
+		Website:         req.Website,
+		LogoURL:         req.LogoURL,
+		FaviconURL:      req.FaviconURL,
+		PrimaryColor:    req.PrimaryColor,
+		SecondaryColor:  req.SecondaryColor,
+		ThemeJSON:       req.ThemeJSON,
+		HidePoweredBy:   req.HidePoweredBy,
+		ExpectedVersion: expectedVersion,
+	}
+
+	h.executeUpdateBrand(w, r, updateReq)
+}
+
+// PatchBrandHandler handles PATCH /api/v1/brands/{brandId} (JSON Merge Patch)
+// Requires If-Match with the brand's current ETag. Only members present in the patch are updated,
+// so tier-restricted settings the patch does not touch are not re-validated.
+func (h *Handlers) PatchBrandHandler(w http.ResponseWriter, r *http.Request) {
+	brandID := chi.URLParam(r, "brandId")
+	if brandID == "" {
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
+		return
 	}
 
-	resp, err := h.updateBrand.Execute(r.Context(), updateReq)
+	patch, ok := httpserver.ReadConditionalMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	current, err := h.getBrand.Execute(r.Context(), &inbound.GetBrandRequest{BrandID: brandID})
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Brand not found", http.StatusNotFound)
-			return
-		}
-		// Check for tier-related errors
-		errMsg := err.Error()
-		if errMsg == "Custom domain support is only available for Scale tier" {
-			http.Error(w, errMsg, http.StatusForbidden)
-			return
+		h.writeError(w, r, err, "Failed to get brand")
+		return
+	}
+
+	var doc brandPatchDocument
+	expectedVersion, ok := patch.Apply(w, r, brandPatchDocument{
+		Domain:         current.Branding.Domain(),
+		Website:        current.Branding.Website(),
+		LogoURL:        current.Branding.LogoURL(),
+		FaviconURL:     current.Branding.FaviconURL(),
+		PrimaryColor:   current.Branding.PrimaryColor(),
+		SecondaryColor: current.Branding.SecondaryColor(),
+		ThemeJSON:      current.Branding.ThemeJSON(),
+		HidePoweredBy:  current.Branding.HidePoweredBy(),
+	}, current.Branding.Version(), &doc)
+	if !ok {
+		return
+	}
+
+	updateReq := &inbound.UpdateBrandRequest{
+		BrandID:         brandID,
+		ExpectedVersion: expectedVersion,
+	}
+	if patch.Has("domain") {
+		updateReq.Domain = &doc.Domain
+	}
+	if patch.Has("website") {
+		updateReq.Website = &doc.Website
+	}
+	if patch.Has("logo_url") {
+		updateReq.LogoURL = &doc.LogoURL
+	}
+	if patch.Has("favicon_url") {
+		updateReq.FaviconURL = &doc.FaviconURL
+	}
+	if patch.Has("primary_color") {
+		updateReq.PrimaryColor = &doc.PrimaryColor
+	}
+	if patch.Has("secondary_color") {
+		updateReq.SecondaryColor = &doc.SecondaryColor
+	}
+	if patch.Has("theme_json") {
+		themeJSON := doc.ThemeJSON
+		if themeJSON == nil {
+			themeJSON = map[string]interface{}{}
 		}
-		if errMsg == "Hide 'Powered by Faro' badge is only available for Growth+ tiers" {
-			http.Error(w, errMsg, http.StatusForbidden)
+		updateReq.ThemeJSON = &themeJSON
+	}
+	if patch.Has("hide_powered_by") {
+		updateReq.HidePoweredBy = &doc.HidePoweredBy
+	}
+
+	h.executeUpdateBrand(w, r, updateReq)
+}
+
+// brandPatchDocument is the editable part of a brand that PATCH merges into
+type brandPatchDocument struct {
+	Domain         string                 `json:"domain"`
+	Website        string                 `json:"website"`
+	LogoURL        string                 `json:"logo_url"`
+	FaviconURL     string                 `json:"favicon_url"`
+	PrimaryColor   string                 `json:"primary_color"`
+	SecondaryColor string                 `json:"secondary_color"`
+	ThemeJSON      map[string]interface{} `json:"theme_json"`
+	HidePoweredBy  bool                   `json:"hide_powered_by"`
+}
+
+// executeUpdateBrand runs a brand update for PUT and PATCH and writes the response
+func (h *Handlers) executeUpdateBrand(w http.ResponseWriter, r *http.Request, updateReq *inbound.UpdateBrandRequest) {
+	resp, err := h.updateBrand.Execute(r.Context(), updateReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			current, getErr := h.getBrand.Execute(r.Context(), &inbound.GetBrandRequest{BrandID: updateReq.BrandID})
+			if getErr != nil {
+				httpserver.WriteProblem(w, r, problems.Problem(domain.ErrBrandingNotFound))
+				return
+			}
+			httpserver.WritePreconditionFailed(w, r, current.Branding.Version(), h.buildBrandResponse(r.Context(), current.Branding))
 			return
 		}
-		h.logger.Error().Err(err).Msg("Failed to update brand")
-		http.Error(w, "Failed to update brand", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to update brand")
 		return
 	}
 
+	httpserver.SetETag(w, resp.Branding.Version())
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
 }
@@ -337,7 +548,7 @@ func (h *Handlers) UpdateBrandHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) DeleteBrandHandler(w http.ResponseWriter, r *http.Request) {
 	brandID := chi.URLParam(r, "brandId")
 	if brandID == "" {
-		http.Error(w, "brand ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
 		return
 	}
 
@@ -347,12 +558,7 @@ func (h *Handlers) DeleteBrandHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.deleteBrand.Execute(r.Context(), req)
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Brand not found", http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to delete brand")
-		http.Error(w, "Failed to delete brand", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to delete brand")
 		return
 	}
 
@@ -366,7 +572,7 @@ func (h *Handlers) DeleteBrandHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) VerifyDomainHandler(w http.ResponseWriter, r *http.Request) {
 	brandID := chi.URLParam(r, "brandId")
 	if brandID == "" {
-		http.Error(w, "brand ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
 		return
 	}
 
@@ -377,7 +583,7 @@ func (h *Handlers) VerifyDomainHandler(w http.ResponseWriter, r *http.Request) {
 	// Allow empty body (use domain from brand if not provided)
 	if r.Body != nil && r.ContentLength > 0 {
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			httpserver.WriteInvalidJSON(w, r)
 			return
 		}
 	}
@@ -389,17 +595,7 @@ func (h *Handlers) VerifyDomainHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.verifyDomain.Execute(r.Context(), verifyReq)
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Brand not found", http.StatusNotFound)
-			return
-		}
-		// Check for tier-related errors
-		if err.Error() == "Custom domain support is only available for Scale tier" {
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to verify domain")
-		http.Error(w, "Failed to verify domain", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to verify domain")
 		return
 	}
 
@@ -410,6 +606,8 @@ func (h *Handlers) VerifyDomainHandler(w http.ResponseWriter, r *http.Request) {
 		"expected_cname": resp.ExpectedCNAME,
 		"current_cname":  resp.CurrentCNAME, // Optional, for UX feedback only
 		"ssl_status":     resp.SSLStatus,
+		"dns_records":    dnsRecordsResponse(resp.Records),
+		"ownership":      ownershipCheckResponse(resp.Ownership),
 	})
 }
 
@@ -417,7 +615,7 @@ func (h *Handlers) VerifyDomainHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) GetDomainStatusHandler(w http.ResponseWriter, r *http.Request) {
 	brandID := chi.URLParam(r, "brandId")
 	if brandID == "" {
-		http.Error(w, "brand ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
 		return
 	}
 
@@ -427,17 +625,7 @@ func (h *Handlers) GetDomainStatusHandler(w http.ResponseWriter, r *http.Request
 
 	resp, err := h.getDomainStatus.Execute(r.Context(), req)
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Brand not found", http.StatusNotFound)
-			return
-		}
-		// Check for tier-related errors
-		if err.Error() == "Custom domain support is only available for Scale tier" {
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to get domain status")
-		http.Error(w, "Failed to get domain status", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to get domain status")
 		return
 	}
 
@@ -447,6 +635,9 @@ func (h *Handlers) GetDomainStatusHandler(w http.ResponseWriter, r *http.Request
 		"verified":       resp.Verified,
 		"expected_cname": resp.ExpectedCNAME,
 		"ssl_status":     resp.SSLStatus,
+		"dns_records":    dnsRecordsResponse(resp.Records),
+		"monitoring":     domainCheckResponse(resp.Check),
+		"ownership":      ownershipCheckResponse(resp.Ownership),
 	})
 }
 
@@ -454,7 +645,7 @@ func (h *Handlers) GetDomainStatusHandler(w http.ResponseWriter, r *http.Request
 func (h *Handlers) GetDomainInstructionsHandler(w http.ResponseWriter, r *http.Request) {
 	brandID := chi.URLParam(r, "brandId")
 	if brandID == "" {
-		http.Error(w, "brand ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "brand_id", "brand ID is required")
 		return
 	}
 
@@ -464,54 +655,54 @@ func (h *Handlers) GetDomainInstructionsHandler(w http.ResponseWriter, r *http.R
 
 	resp, err := h.getDomainInstructions.Execute(r.Context(), req)
 	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Brand not found", http.StatusNotFound)
-			return
-		}
-		// Check for tier-related errors
-		if err.Error() == "Custom domain support is only available for Scale tier" {
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to get domain instructions")
-		http.Error(w, "Failed to get domain instructions", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to get domain instructions")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"domain":        resp.Domain,
-		"cname_target":  resp.CNAMETarget,
-		"instructions":  resp.Instructions,
+		"domain":       resp.Domain,
+		"cname_target": resp.CNAMETarget,
+		"dns_records":  dnsRecordsResponse(resp.Records),
+		"instructions": resp.Instructions,
 	})
 }
 
+// dnsRecordsResponse builds the JSON for the DNS records a custom domain needs
+func dnsRecordsResponse(records []model.DNSRecord) []map[string]string {
+	response := make([]map[string]string, 0, len(records))
+	for _, record := range records {
+		response = append(response, map[string]string{
+			"type":  record.Type,
+			"name":  record.Name,
+			"value": record.Value,
+		})
+	}
+	return response
+}
+
 // GetBySubdomainHandler handles GET /api/v1/brands/by-subdomain?subdomain={subdomain}
 func (h *Handlers) GetBySubdomainHandler(w http.ResponseWriter, r *http.Request) {
 	subdomain := r.URL.Query().Get("subdomain")
 	if subdomain == "" {
-		http.Error(w, "subdomain parameter is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "subdomain", "subdomain parameter is required")
 		return
 	}
 
 	// Note: This endpoint would need a new use case for subdomain lookup
 	// For now, we can use getByHost which handles subdomain resolution
 	req := &inbound.GetByHostRequest{
-		Host: subdomain,
+		Host:     subdomain,
+		ClientID: clientIDParam(r),
 	}
 
-	resp, err := h.getByHost.Execute(r.Context(), req)
-	if err != nil {
-		if err == domain.ErrBrandingNotFound {
-			http.Error(w, "Branding not found", http.StatusNotFound)
-			return
+	h.serveBranding(w, r, outbound.HostCacheKey(subdomain, req.ClientID), subdomain, func() (*model.Branding, error) {
+		resp, err := h.getByHost.Execute(r.Context(), req)
+		if err != nil {
+			return nil, err
 		}
-		h.logger.Error().Err(err).Msg("Failed to get branding by subdomain")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-		return
-	}
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(h.buildBrandResponse(r.Context(), resp.Branding))
+		return resp.Branding, nil
+	}, "Failed to get branding by subdomain")
 }
 
 // GetSSLStatusHandler handles GET /api/v1/brands/{brandId}/ssl-status
@@ -520,3 +711,42 @@ func (h *Handlers) GetSSLStatusHandler(w http.ResponseWriter, r *http.Request) {
 	// Or we can call GetDomainStatus and extract SSL status
 	h.GetDomainStatusHandler(w, r)
 }
+
+// domainCheckResponse builds the JSON for a custom domain's background monitoring state
+func domainCheckResponse(check *model.DomainCheck) map[string]interface{} {
+	if check == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"health":        check.Health(),
+		"last_error":    check.LastError(),
+		"checked_at":    check.CheckedAt(),
+		"next_check_at": check.NextCheckAt(),
+	}
+}
+
+// ownershipCheckResponse builds the JSON for a domain ownership check with its propagation per resolver
+func ownershipCheckResponse(check *model.OwnershipCheck) map[string]interface{} {
+	if check == nil {
+		return nil
+	}
+	lookups := make([]map[string]interface{}, 0, len(check.Lookups))
+	for _, lookup := range check.Lookups {
+		values := lookup.Values
+		if values == nil {
+			values = []string{}
+		}
+		lookups = append(lookups, map[string]interface{}{
+			"resolver":      lookup.Resolver,
+			"authoritative": lookup.Authoritative,
+			"found":         lookup.Found,
+			"values":        values,
+			"error":         lookup.Error,
+		})
+	}
+	return map[string]interface{}{
+		"record":   dnsRecordsResponse([]model.DNSRecord{check.Record})[0],
+		"verified": check.Verified,
+		"lookups":  lookups,
+	}
+}
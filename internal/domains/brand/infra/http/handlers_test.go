@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"farohq-core-app/internal/domains/brand/app/usecases"
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+	tenants_domain "farohq-core-app/internal/domains/tenants/domain"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// memoryBrandRepository is an in-memory outbound.BrandRepository that compares versions on update like the postgres repository
+type memoryBrandRepository struct {
+	outbound.BrandRepository
+	brands map[uuid.UUID]model.Branding
+}
+
+func (r *memoryBrandRepository) FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (*model.Branding, error) {
+	branding, ok := r.brands[agencyID]
+	if !ok {
+		return nil, domain.ErrBrandingNotFound
+	}
+	return &branding, nil
+}
+
+func (r *memoryBrandRepository) Update(ctx context.Context, branding *model.Branding) error {
+	stored := r.brands[branding.AgencyID()]
+	if stored.Version() != branding.Version() {
+		return domain.ErrVersionConflict
+	}
+	branding.SetVersion(branding.Version() + 1)
+	r.brands[branding.AgencyID()] = *branding
+	return nil
+}
+
+// memoryTenantRepository is an in-memory tenants_outbound.TenantRepository for tier lookups
+type memoryTenantRepository struct {
+	tenants_outbound.TenantRepository
+	tenants map[uuid.UUID]*tenants_model.Tenant
+}
+
+func (r *memoryTenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*tenants_model.Tenant, error) {
+	tenant, ok := r.tenants[id]
+	if !ok {
+		return nil, tenants_domain.ErrTenantNotFound
+	}
+	return tenant, nil
+}
+
+// newPatchBrandRouter routes PATCH /brands/{brandId} to handlers backed by one starter-tier brand at version 1
+func newPatchBrandRouter() (chi.Router, uuid.UUID) {
+	tier := tenants_model.TierStarter
+	tenant := tenants_model.NewTenant("Acme", "acme", &tier, 5, nil)
+	branding := model.NewBranding(tenant.ID(), "", "acme", nil, "https://acme.example", "https://cdn.example/logo.png", "", "#111111", "#222222", nil)
+
+	brandRepo := &memoryBrandRepository{brands: map[uuid.UUID]model.Branding{tenant.ID(): *branding}}
+	tenantRepo := &memoryTenantRepository{tenants: map[uuid.UUID]*tenants_model.Tenant{tenant.ID(): tenant}}
+
+	h := &Handlers{
+		logger:      zerolog.Nop(),
+		getBrand:    usecases.NewGetBrand(brandRepo, tenantRepo),
+		updateBrand: usecases.NewUpdateBrand(brandRepo, tenantRepo),
+		tenantRepo:  tenantRepo,
+	}
+
+	router := chi.NewRouter()
+	router.Patch("/brands/{brandId}", h.PatchBrandHandler)
+	return router, tenant.ID()
+}
+
+func patchBrand(router chi.Router, brandID uuid.UUID, ifMatch, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPatch, "/brands/"+brandID.String(), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPatchBrandHandler(t *testing.T) {
+	router, brandID := newPatchBrandRouter()
+
+	rec := patchBrand(router, brandID, "", `{"primary_color":"#333333"}`)
+	assert.Equal(t, http.StatusPreconditionRequired, rec.Code, "PATCH without If-Match is rejected")
+
+	// Tier-restricted settings absent from the patch are not re-validated for a starter tenant
+	rec = patchBrand(router, brandID, `"1"`, `{"primary_color":"#333333","favicon_url":"https://cdn.example/favicon.ico"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "#333333", body["primary_color"])
+	assert.Equal(t, "https://cdn.example/favicon.ico", body["favicon_url"])
+	assert.Equal(t, "#222222", body["secondary_color"], "members absent from the patch are kept")
+	assert.Equal(t, "https://cdn.example/logo.png", body["logo_url"])
+
+	rec = patchBrand(router, brandID, `"1"`, `{"secondary_color":"#444444"}`)
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+	assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+	var problem struct {
+		Current map[string]interface{} `json:"current"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, "#333333", problem.Current["primary_color"], "412 carries the current representation")
+	assert.Equal(t, "#222222", problem.Current["secondary_color"])
+	assert.Equal(t, float64(2), problem.Current["version"])
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"net/http"
+
+	"farohq-core-app/internal/domains/brand/domain"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// problems maps brand domain errors to problem responses
+var problems = httpserver.NewErrorMapper(
+	httpserver.ErrorMapping{Err: domain.ErrBrandingNotFound, Status: http.StatusNotFound, Code: "branding_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrClientBrandingNotFound, Status: http.StatusNotFound, Code: "client_branding_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrClientNotFound, Status: http.StatusNotFound, Code: "client_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrBrandingDraftNotFound, Status: http.StatusNotFound, Code: "branding_draft_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrBrandingVersionNotFound, Status: http.StatusNotFound, Code: "branding_version_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidPreviewToken, Status: http.StatusForbidden, Code: "invalid_preview_token"},
+	httpserver.ErrorMapping{Err: domain.ErrVersionConflict, Status: http.StatusPreconditionFailed, Code: httpserver.PreconditionCodeFailed},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidDomain, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "domain"},
+	httpserver.ErrorMapping{Err: domain.ErrDomainRequired, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "domain"},
+	httpserver.ErrorMapping{Err: domain.ErrDomainNotRegistered, Status: http.StatusNotFound, Code: "domain_not_registered"},
+	httpserver.ErrorMapping{Err: domain.ErrSendingDomainNotFound, Status: http.StatusNotFound, Code: "sending_domain_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrSendingDomainTaken, Status: http.StatusConflict, Code: "sending_domain_taken", Field: "domain"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidSenderAddress, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "local_part"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidSenderName, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "from_name"},
+	httpserver.ErrorMapping{Err: domain.ErrSendingDomainNotAllowed, Status: http.StatusForbidden, Code: "sending_domain_not_allowed"},
+	httpserver.ErrorMapping{Err: domain.ErrCustomDomainNotAllowed, Status: http.StatusForbidden, Code: "custom_domain_not_allowed"},
+	httpserver.ErrorMapping{Err: domain.ErrHidePoweredByNotAllowed, Status: http.StatusForbidden, Code: "hide_powered_by_not_allowed"},
+)
+
+// writeError writes the problem mapped from a domain error
+// Unmapped errors are logged with msg and reported as a 500 without exposing the cause.
+func (h *Handlers) writeError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	if problem := problems.Problem(err); problem != nil {
+		httpserver.WriteProblem(w, r, problem)
+		return
+	}
+	h.logger.Error().Err(err).Msg(msg)
+	httpserver.WriteInternalError(w, r)
+}
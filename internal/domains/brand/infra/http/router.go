@@ -12,12 +12,29 @@ func (h *Handlers) RegisterRoutes(r chi.Router) {
 		r.Post("/", h.CreateBrandHandler)
 		r.Get("/{brandId}", h.GetBrandHandler)
 		r.Put("/{brandId}", h.UpdateBrandHandler)
+		r.Patch("/{brandId}", h.PatchBrandHandler)
 		r.Delete("/{brandId}", h.DeleteBrandHandler)
 		// Domain verification routes (Scale tier only)
 		r.Post("/{brandId}/verify-domain", h.VerifyDomainHandler)
 		r.Get("/{brandId}/domain-status", h.GetDomainStatusHandler)
 		r.Get("/{brandId}/domain-instructions", h.GetDomainInstructionsHandler)
 		r.Get("/{brandId}/ssl-status", h.GetSSLStatusHandler)
+		// Client branding overrides (inherit from the agency brand)
+		r.Get("/{brandId}/clients/{clientId}", h.GetClientBrandingHandler)
+		r.Put("/{brandId}/clients/{clientId}", h.PutClientBrandingHandler)
+		r.Delete("/{brandId}/clients/{clientId}", h.DeleteClientBrandingHandler)
+		// Unpublished draft (previewable by token) and the history of previously live brandings
+		r.Get("/{brandId}/draft", h.GetBrandingDraftHandler)
+		r.Put("/{brandId}/draft", h.PutBrandingDraftHandler)
+		r.Delete("/{brandId}/draft", h.DeleteBrandingDraftHandler)
+		r.Post("/{brandId}/draft/publish", h.PublishBrandingDraftHandler)
+		r.Get("/{brandId}/versions", h.ListBrandingVersionsHandler)
+		r.Post("/{brandId}/versions/{version}/rollback", h.RollbackBrandingHandler)
+		// White-label sending domain for agency emails (Growth+ tiers)
+		r.Get("/{brandId}/sending-domain", h.GetSendingDomainHandler)
+		r.Put("/{brandId}/sending-domain", h.PutSendingDomainHandler)
+		r.Delete("/{brandId}/sending-domain", h.DeleteSendingDomainHandler)
+		r.Post("/{brandId}/sending-domain/verify", h.VerifySendingDomainHandler)
 	})
 }
 
@@ -27,6 +44,9 @@ func (h *Handlers) RegisterPublicRoutes(r chi.Router) {
 		r.Get("/by-domain", h.GetByDomainHandler)
 		r.Get("/by-host", h.GetByHostHandler)
 		r.Get("/by-subdomain", h.GetBySubdomainHandler)
+		// Compiled design tokens of the branding served on a host
+		r.Get("/theme", h.GetThemeHandler)
+		r.Get("/theme.css", h.GetThemeCSSHandler)
 	})
 }
 
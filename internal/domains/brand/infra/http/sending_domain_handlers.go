@@ -0,0 +1,127 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// GetSendingDomainHandler handles GET /api/v1/brands/{brandId}/sending-domain
+// Returns the agency's sending domain with the DNS records to publish and their last check results.
+func (h *Handlers) GetSendingDomainHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.getSendingDomain.Execute(r.Context(), &inbound.GetSendingDomainRequest{
+		BrandID: chi.URLParam(r, "brandId"),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get sending domain")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(sendingDomainResponse(resp.SendingDomain, nil))
+}
+
+// PutSendingDomainHandler handles PUT /api/v1/brands/{brandId}/sending-domain (Growth+ tiers only)
+// Setting a new domain registers it with the email provider; emails are sent from the platform address
+// until its records are verified. Keeping the domain only updates the sender address and name.
+func (h *Handlers) PutSendingDomainHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Domain    string `json:"domain"`
+		LocalPart string `json:"local_part"`
+		FromName  string `json:"from_name"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	if req.Domain == "" {
+		httpserver.WriteMissingField(w, r, "domain", "domain is required")
+		return
+	}
+
+	resp, err := h.putSendingDomain.Execute(r.Context(), &inbound.PutSendingDomainRequest{
+		BrandID:   chi.URLParam(r, "brandId"),
+		Domain:    req.Domain,
+		LocalPart: req.LocalPart,
+		FromName:  req.FromName,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to set sending domain")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(sendingDomainResponse(resp.SendingDomain, nil))
+}
+
+// VerifySendingDomainHandler handles POST /api/v1/brands/{brandId}/sending-domain/verify (Growth+ tiers only)
+// Looks up each record and, once all are published, has the email provider confirm them.
+func (h *Handlers) VerifySendingDomainHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.verifySendingDomain.Execute(r.Context(), &inbound.VerifySendingDomainRequest{
+		BrandID: chi.URLParam(r, "brandId"),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to verify sending domain")
+		return
+	}
+
+	response := sendingDomainResponse(resp.SendingDomain, resp.Checks)
+	response["provider_confirmed"] = resp.ProviderConfirmed
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteSendingDomainHandler handles DELETE /api/v1/brands/{brandId}/sending-domain
+// Emails go back to the platform address.
+func (h *Handlers) DeleteSendingDomainHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.deleteSendingDomain.Execute(r.Context(), &inbound.DeleteSendingDomainRequest{
+		BrandID: chi.URLParam(r, "brandId"),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to delete sending domain")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": resp.Success,
+	})
+}
+
+// sendingDomainResponse builds the JSON for a sending domain, with the propagation of each record when checked
+func sendingDomainResponse(sendingDomain *model.SendingDomain, checks map[model.SendingRecordPurpose]*model.OwnershipCheck) map[string]interface{} {
+	records := make([]map[string]interface{}, 0, len(sendingDomain.Records()))
+	for _, record := range sendingDomain.Records() {
+		records = append(records, map[string]interface{}{
+			"purpose":  record.Purpose,
+			"type":     record.Record.Type,
+			"name":     record.Record.Name,
+			"value":    record.Record.Value,
+			"verified": record.Verified,
+			"check":    ownershipCheckResponse(checks[record.Purpose]),
+		})
+	}
+
+	return map[string]interface{}{
+		"agency_id":   sendingDomain.AgencyID().String(),
+		"domain":      sendingDomain.Domain(),
+		"local_part":  sendingDomain.LocalPart(),
+		"from_name":   sendingDomain.FromName(),
+		"from_email":  sendingDomain.Address(),
+		"verified":    sendingDomain.Verified(),
+		"verified_at": sendingDomain.VerifiedAt(),
+		"checked_at":  sendingDomain.CheckedAt(),
+		"dns_records": records,
+	}
+}
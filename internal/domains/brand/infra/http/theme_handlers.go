@@ -0,0 +1,133 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// themeCacheControl lets browsers and CDNs reuse a theme briefly and revalidate it by ETag afterwards
+const themeCacheControl = "public, max-age=300"
+
+// previewCacheControl keeps draft previews out of shared caches; they render unpublished branding
+const previewCacheControl = "private, no-store"
+
+// GetThemeHandler handles GET /api/v1/brand/theme
+func (h *Handlers) GetThemeHandler(w http.ResponseWriter, r *http.Request) {
+	theme, ok := h.executeGetTheme(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := json.Marshal(theme)
+	if err != nil {
+		h.writeError(w, r, err, "Failed to encode theme")
+		return
+	}
+
+	w.Header().Set("Vary", "X-Client-ID")
+	httpserver.WriteCacheable(w, r, "application/json", themeCacheControlFor(r), body)
+}
+
+// GetThemeCSSHandler handles GET /api/v1/brand/theme.css
+// Serves the theme as CSS custom properties: light tokens on :root, dark tokens for prefers-color-scheme: dark
+// and for an explicit [data-theme="dark"] opt-in.
+func (h *Handlers) GetThemeCSSHandler(w http.ResponseWriter, r *http.Request) {
+	theme, ok := h.executeGetTheme(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Vary", "X-Client-ID")
+	httpserver.WriteCacheable(w, r, "text/css; charset=utf-8", themeCacheControlFor(r), []byte(renderThemeCSS(theme)))
+}
+
+// executeGetTheme compiles the theme for the request's host and client context, writing the problem on failure
+func (h *Handlers) executeGetTheme(w http.ResponseWriter, r *http.Request) (*model.Theme, bool) {
+	host := r.URL.Query().Get("host")
+	if host == "" {
+		httpserver.WriteMissingField(w, r, "host", "host parameter is required")
+		return nil, false
+	}
+
+	resp, err := h.getTheme.Execute(r.Context(), &inbound.GetThemeRequest{
+		Host:         host,
+		ClientID:     clientIDParam(r),
+		PreviewToken: previewTokenParam(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get theme")
+		return nil, false
+	}
+	return resp.Theme, true
+}
+
+// themeCacheControlFor returns the caching policy of a theme response; draft previews are never cached
+func themeCacheControlFor(r *http.Request) string {
+	if previewTokenParam(r) != "" {
+		return previewCacheControl
+	}
+	return themeCacheControl
+}
+
+// renderThemeCSS renders a theme as a stylesheet of --faro-* custom properties
+func renderThemeCSS(theme *model.Theme) string {
+	var root []string
+	for _, name := range sortedKeys(theme.Palettes) {
+		for _, step := range sortedKeys(theme.Palettes[name]) {
+			root = append(root, cssProperty("color-"+name+"-"+step, theme.Palettes[name][step]))
+		}
+	}
+	for _, token := range sortedKeys(theme.Light) {
+		root = append(root, cssProperty("color-"+token, theme.Light[token]))
+	}
+	for _, component := range sortedKeys(theme.Radii) {
+		root = append(root, cssProperty("radius-"+component, theme.Radii[component]))
+	}
+	root = append(root, "  color-scheme: light dark;")
+
+	var dark []string
+	for _, token := range sortedKeys(theme.Dark) {
+		dark = append(dark, cssProperty("color-"+token, theme.Dark[token]))
+	}
+
+	var b strings.Builder
+	b.WriteString(":root {\n" + strings.Join(root, "\n") + "\n}\n\n")
+	b.WriteString("@media (prefers-color-scheme: dark) {\n  :root:not([data-theme=\"light\"]) {\n")
+	for _, line := range dark {
+		b.WriteString("  " + line + "\n")
+	}
+	b.WriteString("  }\n}\n\n")
+	b.WriteString("[data-theme=\"dark\"] {\n" + strings.Join(dark, "\n") + "\n}\n")
+	return b.String()
+}
+
+// cssProperty formats a custom property declaration
+// Values are compiler output (hex colors and validated CSS lengths), so they need no escaping.
+func cssProperty(name, value string) string {
+	return "  --faro-" + name + ": " + value + ";"
+}
+
+// sortedKeys returns the keys of a map in order (numeric keys such as palette steps by value),
+// so the stylesheet and its ETag are stable
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, aErr := strconv.Atoi(keys[i])
+		b, bErr := strconv.Atoi(keys[j])
+		if aErr == nil && bErr == nil {
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
@@ -0,0 +1,193 @@
+package postmark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+
+	"github.com/rs/zerolog"
+)
+
+// Postmark sends through spf.mtasv.net; the return-path CNAME of each domain points at pm.mtasv.net
+const (
+	spfInclude       = "spf.mtasv.net"
+	returnPathLabel  = "pm-bounces"
+	domainsPageLimit = 500
+)
+
+// SendingDomainProvider manages sending domains with the Postmark Domains API (account API token)
+type SendingDomainProvider struct {
+	accountToken string
+	baseURL      string
+	client       *http.Client
+	logger       zerolog.Logger
+}
+
+// NewSendingDomainProvider creates a new Postmark sending domain provider
+func NewSendingDomainProvider(accountToken string, logger zerolog.Logger) *SendingDomainProvider {
+	return &SendingDomainProvider{
+		accountToken: accountToken,
+		baseURL:      "https://api.postmarkapp.com",
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// domainDetails is a domain from the Postmark Domains API
+// A new domain's DKIM key is pending until Postmark verifies it; DKIMHost is only set afterwards.
+type domainDetails struct {
+	ID                         int64  `json:"ID"`
+	Name                       string `json:"Name"`
+	DKIMVerified               bool   `json:"DKIMVerified"`
+	DKIMHost                   string `json:"DKIMHost"`
+	DKIMTextValue              string `json:"DKIMTextValue"`
+	DKIMPendingHost            string `json:"DKIMPendingHost"`
+	DKIMPendingTextValue       string `json:"DKIMPendingTextValue"`
+	ReturnPathDomain           string `json:"ReturnPathDomain"`
+	ReturnPathDomainVerified   bool   `json:"ReturnPathDomainVerified"`
+	ReturnPathDomainCNAMEValue string `json:"ReturnPathDomainCNAMEValue"`
+}
+
+// RegisterDomain creates the domain with a pm-bounces return path, or returns the existing one
+func (p *SendingDomainProvider) RegisterDomain(ctx context.Context, domain string) (*outbound.SendingDomainRegistration, error) {
+	id, err := p.find(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var details domainDetails
+	if id != 0 {
+		err = p.do(ctx, http.MethodGet, "/domains/"+strconv.FormatInt(id, 10), nil, &details)
+	} else {
+		err = p.do(ctx, http.MethodPost, "/domains", map[string]string{
+			"Name":             domain,
+			"ReturnPathDomain": returnPathLabel + "." + domain,
+		}, &details)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return registration(&details), nil
+}
+
+// ConfirmDomain asks Postmark to verify the DKIM and return-path records
+func (p *SendingDomainProvider) ConfirmDomain(ctx context.Context, providerID string) (bool, error) {
+	var details domainDetails
+	if err := p.do(ctx, http.MethodPut, "/domains/"+providerID+"/verifyDkim", nil, &details); err != nil {
+		return false, err
+	}
+	if !details.ReturnPathDomainVerified {
+		if err := p.do(ctx, http.MethodPut, "/domains/"+providerID+"/verifyReturnPath", nil, &details); err != nil {
+			return false, err
+		}
+	}
+	return details.DKIMVerified && details.ReturnPathDomainVerified, nil
+}
+
+// RemoveDomain deletes the domain (idempotent)
+func (p *SendingDomainProvider) RemoveDomain(ctx context.Context, providerID string) error {
+	err := p.do(ctx, http.MethodDelete, "/domains/"+providerID, nil, nil)
+	if err == errNotFound {
+		return nil
+	}
+	return err
+}
+
+// find returns the ID of a domain by name, or 0 if it is not registered
+func (p *SendingDomainProvider) find(ctx context.Context, domain string) (int64, error) {
+	for offset := 0; ; offset += domainsPageLimit {
+		var page struct {
+			TotalCount int             `json:"TotalCount"`
+			Domains    []domainDetails `json:"Domains"`
+		}
+		path := fmt.Sprintf("/domains?count=%d&offset=%d", domainsPageLimit, offset)
+		if err := p.do(ctx, http.MethodGet, path, nil, &page); err != nil {
+			return 0, err
+		}
+		for _, d := range page.Domains {
+			if strings.EqualFold(d.Name, domain) {
+				return d.ID, nil
+			}
+		}
+		if offset+len(page.Domains) >= page.TotalCount || len(page.Domains) == 0 {
+			return 0, nil
+		}
+	}
+}
+
+// errNotFound is returned by do when Postmark does not know the domain
+var errNotFound = errors.New("postmark domain not found")
+
+// do calls the Postmark API and decodes the response into out
+func (p *SendingDomainProvider) do(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		reqBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Postmark-Account-Token", p.accountToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call Postmark API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+	if resp.StatusCode >= 300 {
+		p.logger.Error().
+			Int("status", resp.StatusCode).
+			Str("response", string(respBody)).
+			Msg("Postmark API error")
+		return fmt.Errorf("postmark API error: status %d, body: %s", resp.StatusCode, string(respBody))
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+	return nil
+}
+
+// registration converts a Postmark domain, preferring the pending DKIM key Postmark is waiting to see published
+func registration(details *domainDetails) *outbound.SendingDomainRegistration {
+	dkim := model.DNSRecord{Type: "TXT", Name: details.DKIMHost, Value: details.DKIMTextValue}
+	if details.DKIMPendingHost != "" {
+		dkim = model.DNSRecord{Type: "TXT", Name: details.DKIMPendingHost, Value: details.DKIMPendingTextValue}
+	}
+	return &outbound.SendingDomainRegistration{
+		ProviderID: strconv.FormatInt(details.ID, 10),
+		DKIM:       dkim,
+		ReturnPath: model.DNSRecord{Type: "CNAME", Name: details.ReturnPathDomain, Value: details.ReturnPathDomainCNAMEValue},
+		SPFInclude: spfInclude,
+	}
+}
@@ -0,0 +1,130 @@
+package postmark
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePostmark serves the Domains API of one account from memory
+type fakePostmark struct {
+	domains map[int64]*domainDetails
+	nextID  int64
+}
+
+func (f *fakePostmark) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Postmark-Account-Token") != "token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ErrorCode": 10, "Message": "Bad or missing API token"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var id int64
+	if len(parts) > 1 {
+		id, _ = strconv.ParseInt(parts[1], 10, 64)
+	}
+	details := f.domains[id]
+
+	var result interface{}
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 1:
+		list := []*domainDetails{}
+		for _, d := range f.domains {
+			list = append(list, d)
+		}
+		result = map[string]interface{}{"TotalCount": len(list), "Domains": list}
+	case r.Method == http.MethodPost && len(parts) == 1:
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		f.nextID++
+		details = &domainDetails{
+			ID:                         f.nextID,
+			Name:                       body["Name"],
+			DKIMPendingHost:            "20240101pm._domainkey." + body["Name"],
+			DKIMPendingTextValue:       "k=rsa;p=KEY",
+			ReturnPathDomain:           body["ReturnPathDomain"],
+			ReturnPathDomainCNAMEValue: "pm.mtasv.net",
+		}
+		f.domains[f.nextID] = details
+		result = details
+	case details == nil:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ErrorCode": 510, "Message": "Domain not found"})
+		return
+	case r.Method == http.MethodGet:
+		result = details
+	case r.Method == http.MethodPut && parts[2] == "verifyDkim":
+		details.DKIMVerified = true
+		details.DKIMHost, details.DKIMTextValue = details.DKIMPendingHost, details.DKIMPendingTextValue
+		details.DKIMPendingHost, details.DKIMPendingTextValue = "", ""
+		result = details
+	case r.Method == http.MethodPut && parts[2] == "verifyReturnPath":
+		result = details // The CNAME is not published yet
+	case r.Method == http.MethodDelete:
+		delete(f.domains, id)
+		result = map[string]interface{}{"ErrorCode": 0, "Message": "Domain removed."}
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+func newTestProvider(t *testing.T) (*SendingDomainProvider, *fakePostmark) {
+	api := &fakePostmark{domains: make(map[int64]*domainDetails)}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	provider := NewSendingDomainProvider("token", zerolog.Nop())
+	provider.baseURL = server.URL
+	return provider, api
+}
+
+func TestSendingDomainProvider_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	provider, api := newTestProvider(t)
+
+	registration, err := provider.RegisterDomain(ctx, "mail.acme.com")
+	require.NoError(t, err)
+	assert.Equal(t, "1", registration.ProviderID)
+	assert.Equal(t, model.DNSRecord{Type: "TXT", Name: "20240101pm._domainkey.mail.acme.com", Value: "k=rsa;p=KEY"}, registration.DKIM)
+	assert.Equal(t, model.DNSRecord{Type: "CNAME", Name: "pm-bounces.mail.acme.com", Value: "pm.mtasv.net"}, registration.ReturnPath)
+	assert.Equal(t, "spf.mtasv.net", registration.SPFInclude)
+
+	// Registering again returns the existing domain instead of creating a duplicate
+	again, err := provider.RegisterDomain(ctx, "Mail.Acme.com")
+	require.NoError(t, err)
+	assert.Equal(t, registration, again)
+	assert.Len(t, api.domains, 1)
+
+	// Both the DKIM key and the return path must be verified
+	confirmed, err := provider.ConfirmDomain(ctx, registration.ProviderID)
+	require.NoError(t, err)
+	assert.False(t, confirmed)
+
+	api.domains[1].ReturnPathDomainVerified = true
+	confirmed, err = provider.ConfirmDomain(ctx, registration.ProviderID)
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+
+	require.NoError(t, provider.RemoveDomain(ctx, registration.ProviderID))
+	assert.Empty(t, api.domains)
+	require.NoError(t, provider.RemoveDomain(ctx, registration.ProviderID))
+}
+
+func TestSendingDomainProvider_APIErrors(t *testing.T) {
+	provider, _ := newTestProvider(t)
+	provider.accountToken = "wrong"
+
+	_, err := provider.RegisterDomain(context.Background(), "mail.acme.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+}
@@ -0,0 +1,102 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"farohq-core-app/internal/domains/brand/domain/model"
+	"farohq-core-app/internal/domains/brand/domain/ports/outbound"
+)
+
+// DomainProvider adapts VercelService to the DomainProvider port
+// Custom domains are added to the Vercel project that serves the portal.
+type DomainProvider struct {
+	service *VercelService
+}
+
+// NewDomainProvider creates a new Vercel domain provider
+func NewDomainProvider(service *VercelService) *DomainProvider {
+	return &DomainProvider{service: service}
+}
+
+// AddDomain adds the domain to the Vercel project
+// Vercel rejects domains that are already in the project, so a failed add falls back to the existing status.
+func (p *DomainProvider) AddDomain(ctx context.Context, domain string) (*outbound.CustomDomain, error) {
+	if _, err := p.service.AddDomainToProject(ctx, domain); err != nil {
+		status, statusErr := p.service.GetDomainStatus(ctx, domain)
+		if statusErr != nil {
+			return nil, fmt.Errorf("failed to add domain to Vercel: %w", err)
+		}
+		return customDomain(status), nil
+	}
+	return p.GetDomainStatus(ctx, domain)
+}
+
+// GetExpectedRecords returns the CNAME record and any ownership TXT records Vercel asks for
+func (p *DomainProvider) GetExpectedRecords(ctx context.Context, domain string) ([]model.DNSRecord, error) {
+	status, err := p.service.GetDomainStatus(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return customDomain(status).Records, nil
+}
+
+// VerifyDomain returns Vercel's verification status (Vercel API is authoritative)
+// A verified domain whose DNS no longer points at Vercel is not routed, so it is reported as unverified.
+func (p *DomainProvider) VerifyDomain(ctx context.Context, domain string) (bool, error) {
+	verified, err := p.service.VerifyDomain(ctx, domain)
+	if err != nil || !verified {
+		return false, err
+	}
+	config, err := p.service.GetDNSConfig(ctx, domain)
+	if err != nil {
+		return false, err
+	}
+	return !config.Misconfigured, nil
+}
+
+// GetDomainStatus returns the domain's verification, SSL status and expected records
+func (p *DomainProvider) GetDomainStatus(ctx context.Context, domain string) (*outbound.CustomDomain, error) {
+	status, err := p.service.GetDomainStatus(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return customDomain(status), nil
+}
+
+// GetSSLStatus returns the status of the certificate Vercel provisions once DNS is correct
+func (p *DomainProvider) GetSSLStatus(ctx context.Context, domain string) (model.SSLStatus, error) {
+	status, err := p.service.GetSSLStatus(ctx, domain)
+	if err != nil {
+		return "", err
+	}
+	return model.SSLStatus(status), nil
+}
+
+// RemoveDomain removes the domain from the Vercel project
+func (p *DomainProvider) RemoveDomain(ctx context.Context, domain string) error {
+	return p.service.RemoveDomain(ctx, domain)
+}
+
+// customDomain converts a Vercel domain status
+func customDomain(status *DomainStatus) *outbound.CustomDomain {
+	target := status.CNAMETarget()
+	if target == "" {
+		target = DefaultCNAMETarget
+	}
+	records := []model.DNSRecord{{Type: "CNAME", Name: status.Domain, Value: target}}
+	// Domains used elsewhere on Vercel need a TXT record proving ownership
+	for _, verif := range status.Verification {
+		if strings.EqualFold(verif.Type, "TXT") {
+			records = append(records, model.DNSRecord{Type: "TXT", Name: verif.Domain, Value: verif.Value})
+		}
+	}
+
+	return &outbound.CustomDomain{
+		Domain:    status.Domain,
+		Verified:  status.Verified,
+		SSLStatus: model.SSLStatus(status.SSL.Status),
+		Records:   records,
+	}
+}
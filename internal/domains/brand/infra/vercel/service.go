@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"time"
 
+	brand_domain "farohq-core-app/internal/domains/brand/domain"
+
 	"github.com/rs/zerolog"
 )
 
@@ -59,6 +61,28 @@ type DomainStatus struct {
 	} `json:"ssl"`
 }
 
+// DefaultCNAMETarget is the CNAME target Vercel typically uses, for responses that do not include one
+const DefaultCNAMETarget = "cname.vercel-dns.com"
+
+// CNAMETarget returns the CNAME target from the domain's config, falling back to the verification array
+// Returns "" when the response has neither (value may vary, don't hardcode).
+func (st *DomainStatus) CNAMETarget() string {
+	if len(st.Config) > 0 {
+		config := st.Config[0]
+		if config.RecordType == "CNAME" {
+			return config.RecordValue
+		} else if config.CNAMETarget != "" {
+			return config.CNAMETarget
+		}
+	}
+	for _, verif := range st.Verification {
+		if verif.Type == "cname" {
+			return verif.Value
+		}
+	}
+	return ""
+}
+
 // AddDomainToProject adds a custom domain to Vercel project
 // Returns expected DNS configuration (CNAME target - value may vary, don't hardcode)
 func (s *VercelService) AddDomainToProject(ctx context.Context, domain string) (*DomainConfig, error) {
@@ -104,30 +128,11 @@ func (s *VercelService) AddDomainToProject(ctx context.Context, domain string) (
 	}
 
 	// Extract CNAME target from config (value may vary, don't hardcode)
-	var cnameTarget string
-	if len(domainStatus.Config) > 0 {
-		config := domainStatus.Config[0]
-		if config.RecordType == "CNAME" {
-			cnameTarget = config.RecordValue
-		} else if config.CNAMETarget != "" {
-			cnameTarget = config.CNAMETarget
-		}
-	}
-
-	if cnameTarget == "" {
-		// Fallback: Vercel might return CNAME target in verification array
-		for _, verif := range domainStatus.Verification {
-			if verif.Type == "cname" {
-				cnameTarget = verif.Value
-				break
-			}
-		}
-	}
-
+	cnameTarget := domainStatus.CNAMETarget()
 	if cnameTarget == "" {
 		s.logger.Warn().Str("domain", domain).Msg("No CNAME target found in Vercel API response")
 		// Return a default that Vercel typically uses, but this should be fetched from API
-		cnameTarget = "cname.vercel-dns.com"
+		cnameTarget = DefaultCNAMETarget
 	}
 
 	return &DomainConfig{
@@ -146,26 +151,7 @@ func (s *VercelService) GetExpectedDNSConfig(ctx context.Context, domain string)
 	}
 
 	// Extract CNAME target from config (value may vary, don't hardcode)
-	var cnameTarget string
-	if len(status.Config) > 0 {
-		config := status.Config[0]
-		if config.RecordType == "CNAME" {
-			cnameTarget = config.RecordValue
-		} else if config.CNAMETarget != "" {
-			cnameTarget = config.CNAMETarget
-		}
-	}
-
-	if cnameTarget == "" {
-		// Fallback: Vercel might return CNAME target in verification array
-		for _, verif := range status.Verification {
-			if verif.Type == "cname" {
-				cnameTarget = verif.Value
-				break
-			}
-		}
-	}
-
+	cnameTarget := status.CNAMETarget()
 	if cnameTarget == "" {
 		return nil, fmt.Errorf("no CNAME target found in Vercel API response for domain %s", domain)
 	}
@@ -211,7 +197,7 @@ func (s *VercelService) GetDomainStatus(ctx context.Context, domain string) (*Do
 	body, _ := io.ReadAll(resp.Body)
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("domain not found in Vercel project")
+		return nil, brand_domain.ErrDomainNotRegistered
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -231,6 +217,51 @@ func (s *VercelService) GetDomainStatus(ctx context.Context, domain string) (*Do
 	return &domainStatus, nil
 }
 
+// DNSConfigStatus represents the DNS configuration check from Vercel API
+type DNSConfigStatus struct {
+	Misconfigured bool `json:"misconfigured"` // DNS no longer (or not yet) points the domain at Vercel
+}
+
+// GetDNSConfig checks whether the domain's DNS currently points at Vercel
+// A project domain stays verified after its CNAME is removed; only this check notices.
+func (s *VercelService) GetDNSConfig(ctx context.Context, domain string) (*DNSConfigStatus, error) {
+	url := fmt.Sprintf("%s/v6/domains/%s/config", s.baseURL, domain)
+	if s.teamID != "" {
+		url += "?teamId=" + s.teamID
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+s.apiToken)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Vercel API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Error().
+			Int("status", resp.StatusCode).
+			Str("response", string(body)).
+			Msg("Vercel API error getting domain config")
+		return nil, fmt.Errorf("vercel API error: status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var config DNSConfigStatus
+	if err := json.Unmarshal(body, &config); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to parse Vercel API response")
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &config, nil
+}
+
 // GetSSLStatus checks SSL certificate status via Vercel API
 // Vercel automatically provisions SSL after DNS is correct and propagated
 // SSL provisioning time varies (often minutes-hours, sometimes longer)
@@ -262,6 +293,11 @@ func (s *VercelService) RemoveDomain(ctx context.Context, domain string) error {
 	}
 	defer resp.Body.Close()
 
+	// Domain already removed (idempotent)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
 		s.logger.Error().
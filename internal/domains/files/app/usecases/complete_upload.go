@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
+	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+	"farohq-core-app/internal/domains/files/domain/services"
+
+	"github.com/google/uuid"
+)
+
+// CompleteUpload implements the CompleteUpload inbound port
+type CompleteUpload struct {
+	storage        outbound.Storage
+	fileRepo       outbound.FileRepository
+	assetValidator *services.AssetValidator
+	bucket         string
+}
+
+// NewCompleteUpload creates a new CompleteUpload use case
+func NewCompleteUpload(
+	storage outbound.Storage,
+	fileRepo outbound.FileRepository,
+	assetValidator *services.AssetValidator,
+	bucket string,
+) inbound.CompleteUpload {
+	return &CompleteUpload{
+		storage:        storage,
+		fileRepo:       fileRepo,
+		assetValidator: assetValidator,
+		bucket:         bucket,
+	}
+}
+
+// Execute executes the use case
+// The stored object is read with a HEAD request: it must exist, match the declared size and checksum and stay
+// within the asset's size limit. Its size, content type and checksum are then recorded and the file is uploaded.
+// Confirming an uploaded file again refreshes its metadata.
+func (uc *CompleteUpload) Execute(ctx context.Context, req *inbound.CompleteUploadRequest) (*inbound.CompleteUploadResponse, error) {
+	file, err := uc.fileRepo.FindByKey(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if (req.AgencyID != uuid.Nil && file.TenantID() != req.AgencyID) || file.Status() == model.FileStatusDeleted {
+		return nil, domain.ErrFileNotFound
+	}
+
+	object, err := uc.storage.StatObject(ctx, uc.bucket, req.Key)
+	if err != nil {
+		if err == domain.ErrFileNotFound {
+			return nil, domain.ErrUploadIncomplete
+		}
+		return nil, err
+	}
+
+	if !file.Matches(object.Size, object.Checksum) {
+		return nil, domain.ErrUploadMismatch
+	}
+	if object.Size > uc.assetValidator.MaxFileSize(file.AssetType()) {
+		return nil, domain.ErrFileTooLarge
+	}
+
+	file.MarkUploaded(object.Size, object.ContentType, object.Checksum, time.Now())
+	if err := uc.fileRepo.Update(ctx, file); err != nil {
+		return nil, err
+	}
+
+	return &inbound.CompleteUploadResponse{
+		File: file,
+	}, nil
+}
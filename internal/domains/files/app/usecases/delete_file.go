@@ -2,8 +2,11 @@ package usecases
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
 	"farohq-core-app/internal/domains/files/domain/ports/inbound"
 	"farohq-core-app/internal/domains/files/domain/ports/outbound"
 	"farohq-core-app/internal/domains/files/domain/services"
@@ -12,6 +15,7 @@ import (
 // DeleteFile implements the DeleteFile inbound port
 type DeleteFile struct {
 	storage      outbound.Storage
+	fileRepo     outbound.FileRepository
 	keyGenerator *services.KeyGenerator
 	bucket       string
 }
@@ -19,17 +23,21 @@ type DeleteFile struct {
 // NewDeleteFile creates a new DeleteFile use case
 func NewDeleteFile(
 	storage outbound.Storage,
+	fileRepo outbound.FileRepository,
 	keyGenerator *services.KeyGenerator,
 	bucket string,
 ) inbound.DeleteFile {
 	return &DeleteFile{
 		storage:      storage,
+		fileRepo:     fileRepo,
 		keyGenerator: keyGenerator,
 		bucket:       bucket,
 	}
 }
 
 // Execute executes the use case
+// The object is removed from storage and its registry entry marked deleted. Pending uploads can be deleted
+// even though nothing was stored; objects uploaded before the registry existed are deleted from storage only.
 func (uc *DeleteFile) Execute(ctx context.Context, req *inbound.DeleteFileRequest) (*inbound.DeleteFileResponse, error) {
 	// Validate key
 	if req.Key == "" {
@@ -40,13 +48,34 @@ func (uc *DeleteFile) Execute(ctx context.Context, req *inbound.DeleteFileReques
 		return nil, domain.ErrInvalidAsset
 	}
 
+	// Only the tenant's own objects can be deleted: {tenant_id}/...
+	if !strings.HasPrefix(req.Key, req.AgencyID.String()+"/") {
+		return nil, domain.ErrFileNotFound
+	}
+
+	file, err := uc.fileRepo.FindByKey(ctx, req.Key)
+	if err != nil && err != domain.ErrFileNotFound {
+		return nil, err
+	}
+	if file != nil && file.Status() == model.FileStatusDeleted {
+		return nil, domain.ErrFileNotFound
+	}
+
 	// Delete from storage
 	if err := uc.storage.DeleteFile(ctx, uc.bucket, req.Key); err != nil {
-		return nil, domain.ErrFileNotFound
+		if file == nil || file.Status() != model.FileStatusPending {
+			return nil, domain.ErrFileNotFound
+		}
+	}
+
+	if file != nil {
+		file.MarkDeleted(time.Now())
+		if err := uc.fileRepo.Update(ctx, file); err != nil {
+			return nil, err
+		}
 	}
 
 	return &inbound.DeleteFileResponse{
 		Success: true,
 	}, nil
 }
-
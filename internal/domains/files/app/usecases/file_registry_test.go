@@ -0,0 +1,158 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
+	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+	"farohq-core-app/internal/domains/files/domain/services"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryFileRepository is an in-memory outbound.FileRepository
+type memoryFileRepository struct {
+	files map[string]*model.File
+}
+
+func newMemoryFileRepository() *memoryFileRepository {
+	return &memoryFileRepository{files: make(map[string]*model.File)}
+}
+
+func (r *memoryFileRepository) FindByKey(ctx context.Context, key string) (*model.File, error) {
+	file, ok := r.files[key]
+	if !ok {
+		return nil, domain.ErrFileNotFound
+	}
+	return file, nil
+}
+
+func (r *memoryFileRepository) Save(ctx context.Context, file *model.File) error {
+	r.files[file.Key()] = file
+	return nil
+}
+
+func (r *memoryFileRepository) Update(ctx context.Context, file *model.File) error {
+	if _, ok := r.files[file.Key()]; !ok {
+		return domain.ErrFileNotFound
+	}
+	r.files[file.Key()] = file
+	return nil
+}
+
+func (r *memoryFileRepository) ListByTenantID(ctx context.Context, tenantID uuid.UUID, filter outbound.FileFilter) ([]*model.File, error) {
+	files := []*model.File{}
+	for _, file := range r.files {
+		if file.TenantID() != tenantID {
+			continue
+		}
+		if filter.Status != "" && file.Status() != filter.Status {
+			continue
+		}
+		if filter.Status == "" && file.Status() == model.FileStatusDeleted {
+			continue
+		}
+		if filter.AssetType != "" && file.AssetType() != filter.AssetType {
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func TestFileRegistry_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	agencyID := uuid.New()
+	uploaderID := uuid.New()
+	checksum := "XUFAKrxLKna5cZ2REBfFkg==" // MD5 of "hello"
+
+	storage := new(MockStorage)
+	storage.On("GeneratePresignedURL", mock.Anything, "bucket", mock.Anything, mock.Anything).Return("https://storage.example.com/signed", nil, nil)
+	fileRepo := newMemoryFileRepository()
+	validator := services.NewAssetValidator()
+	keyGenerator := services.NewKeyGenerator()
+
+	signUpload := NewSignUpload(storage, fileRepo, validator, keyGenerator, "bucket", 0)
+	completeUpload := NewCompleteUpload(storage, fileRepo, validator, "bucket")
+	listFiles := NewListFiles(fileRepo)
+	deleteFile := NewDeleteFile(storage, fileRepo, keyGenerator, "bucket")
+
+	signed, err := signUpload.Execute(ctx, &inbound.SignUploadRequest{
+		AgencyID:    agencyID.String(),
+		Asset:       "logo.png",
+		UploadedBy:  &uploaderID,
+		ContentType: "image/png",
+		Size:        5,
+		Checksum:    checksum,
+	})
+	require.NoError(t, err)
+
+	listed, err := listFiles.Execute(ctx, &inbound.ListFilesRequest{AgencyID: agencyID, Status: "pending"})
+	require.NoError(t, err)
+	require.Len(t, listed.Files, 1)
+	assert.Equal(t, &uploaderID, listed.Files[0].UploadedBy())
+
+	t.Run("completing before the object is stored is rejected", func(t *testing.T) {
+		storage.On("StatObject", mock.Anything, "bucket", signed.Key).Return(nil, domain.ErrFileNotFound).Once()
+		_, err := completeUpload.Execute(ctx, &inbound.CompleteUploadRequest{AgencyID: agencyID, Key: signed.Key})
+		assert.Equal(t, domain.ErrUploadIncomplete, err)
+	})
+
+	t.Run("objects that do not match the declared upload are rejected", func(t *testing.T) {
+		storage.On("StatObject", mock.Anything, "bucket", signed.Key).Return(&outbound.ObjectInfo{Size: 6, Checksum: checksum}, nil).Once()
+		_, err := completeUpload.Execute(ctx, &inbound.CompleteUploadRequest{AgencyID: agencyID, Key: signed.Key})
+		assert.Equal(t, domain.ErrUploadMismatch, err)
+	})
+
+	t.Run("other tenants cannot complete the upload", func(t *testing.T) {
+		_, err := completeUpload.Execute(ctx, &inbound.CompleteUploadRequest{AgencyID: uuid.New(), Key: signed.Key})
+		assert.Equal(t, domain.ErrFileNotFound, err)
+	})
+
+	storage.On("StatObject", mock.Anything, "bucket", signed.Key).Return(&outbound.ObjectInfo{Size: 5, ContentType: "image/png", Checksum: checksum}, nil).Once()
+	completed, err := completeUpload.Execute(ctx, &inbound.CompleteUploadRequest{Key: signed.Key})
+	require.NoError(t, err)
+	assert.Equal(t, model.FileStatusUploaded, completed.File.Status())
+	assert.NotNil(t, completed.File.UploadedAt())
+
+	listed, err = listFiles.Execute(ctx, &inbound.ListFilesRequest{AgencyID: agencyID, AssetType: "logo"})
+	require.NoError(t, err)
+	require.Len(t, listed.Files, 1)
+	assert.Equal(t, model.FileStatusUploaded, listed.Files[0].Status())
+
+	_, err = listFiles.Execute(ctx, &inbound.ListFilesRequest{AgencyID: agencyID, Status: "archived"})
+	assert.Equal(t, domain.ErrInvalidFileStatus, err)
+
+	_, err = deleteFile.Execute(ctx, &inbound.DeleteFileRequest{AgencyID: uuid.New(), Key: signed.Key})
+	assert.Equal(t, domain.ErrFileNotFound, err, "keys outside the tenant's prefix are not found")
+
+	storage.On("DeleteFile", mock.Anything, "bucket", signed.Key).Return(nil).Once()
+	_, err = deleteFile.Execute(ctx, &inbound.DeleteFileRequest{AgencyID: agencyID, Key: signed.Key})
+	require.NoError(t, err)
+
+	listed, err = listFiles.Execute(ctx, &inbound.ListFilesRequest{AgencyID: agencyID})
+	require.NoError(t, err)
+	assert.Empty(t, listed.Files, "deleted files are not listed by default")
+
+	_, err = deleteFile.Execute(ctx, &inbound.DeleteFileRequest{AgencyID: agencyID, Key: signed.Key})
+	assert.Equal(t, domain.ErrFileNotFound, err)
+}
+
+func TestSignUpload_RejectsInvalidDeclarations(t *testing.T) {
+	storage := new(MockStorage)
+	uc := NewSignUpload(storage, newMemoryFileRepository(), services.NewAssetValidator(), services.NewKeyGenerator(), "bucket", 0)
+
+	_, err := uc.Execute(context.Background(), &inbound.SignUploadRequest{AgencyID: uuid.New().String(), Asset: "favicon.png", Size: 10 << 20})
+	assert.Equal(t, domain.ErrFileTooLarge, err)
+
+	_, err = uc.Execute(context.Background(), &inbound.SignUploadRequest{AgencyID: uuid.New().String(), Asset: "logo.png", Checksum: "not-md5"})
+	assert.Equal(t, domain.ErrInvalidChecksum, err)
+
+	storage.AssertNotCalled(t, "GeneratePresignedURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
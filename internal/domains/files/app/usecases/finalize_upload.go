@@ -0,0 +1,229 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	_ "image/jpeg" // Register JPEG decoding
+	_ "image/png"  // Register PNG decoding
+	"io"
+	"strings"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+	"farohq-core-app/internal/domains/files/domain/services"
+	_ "farohq-core-app/internal/domains/files/domain/utils" // Register ICO decoding
+)
+
+// processableAssets are the asset types finalized into derivatives
+var processableAssets = map[string]bool{"logo": true, "favicon": true}
+
+// supportedImageFormats are the decoded formats accepted for branding assets
+var supportedImageFormats = map[string]bool{"png": true, "jpeg": true, "ico": true}
+
+// FinalizeUpload implements the FinalizeUpload inbound port
+type FinalizeUpload struct {
+	storage        outbound.Storage
+	brandingAssets outbound.BrandingAssets
+	assetValidator *services.AssetValidator
+	assetProcessor *services.AssetProcessor
+	svgSanitizer   *services.SVGSanitizer
+	keyGenerator   *services.KeyGenerator
+	bucket         string
+	publicBaseURL  string
+}
+
+// NewFinalizeUpload creates a new FinalizeUpload use case
+// publicBaseURL is the URL stored objects are publicly served from (the object key is appended to it).
+func NewFinalizeUpload(
+	storage outbound.Storage,
+	brandingAssets outbound.BrandingAssets,
+	assetValidator *services.AssetValidator,
+	assetProcessor *services.AssetProcessor,
+	svgSanitizer *services.SVGSanitizer,
+	keyGenerator *services.KeyGenerator,
+	bucket string,
+	publicBaseURL string,
+) inbound.FinalizeUpload {
+	return &FinalizeUpload{
+		storage:        storage,
+		brandingAssets: brandingAssets,
+		assetValidator: assetValidator,
+		assetProcessor: assetProcessor,
+		svgSanitizer:   svgSanitizer,
+		keyGenerator:   keyGenerator,
+		bucket:         bucket,
+		publicBaseURL:  strings.TrimRight(publicBaseURL, "/"),
+	}
+}
+
+// Execute executes the use case
+// The uploaded object is downloaded and decoded, checked against the asset validator's size, dimension and
+// aspect ratio rules, and its derivatives are stored next to it under the tenant's branding prefix.
+// Rejected uploads are deleted so they are never served from their public URL.
+// SVG logos are sanitized and the sanitized document replaces the upload; they get no raster derivatives.
+// The URLs are then written back onto the tenant's branding.
+func (uc *FinalizeUpload) Execute(ctx context.Context, req *inbound.FinalizeUploadRequest) (*inbound.FinalizeUploadResponse, error) {
+	// Only keys signed for this tenant's branding can be finalized: {tenant_id}/branding/{asset}
+	prefix := req.AgencyID.String() + "/branding/"
+	if !strings.HasPrefix(req.Key, prefix) || !uc.keyGenerator.ValidateKey(req.Key) {
+		return nil, domain.ErrInvalidKey
+	}
+	asset := strings.TrimPrefix(req.Key, prefix)
+	if strings.Contains(asset, "/") || !uc.assetValidator.IsValidAsset(asset) {
+		return nil, domain.ErrInvalidKey
+	}
+	assetType, _, _ := strings.Cut(asset, ".")
+	if !processableAssets[assetType] {
+		return nil, domain.ErrInvalidAsset
+	}
+
+	content, err := uc.download(ctx, req.Key, uc.assetValidator.MaxFileSize(assetType))
+	if err != nil {
+		if err == domain.ErrFileTooLarge {
+			return nil, uc.reject(ctx, req.Key, err)
+		}
+		return nil, err
+	}
+	if !uc.assetValidator.ValidateFileSize(int64(len(content)), assetType) {
+		return nil, uc.reject(ctx, req.Key, domain.ErrFileTooLarge)
+	}
+
+	if assetType == "logo" && looksLikeMarkup(content) {
+		return uc.finalizeSVG(ctx, req, assetType, content)
+	}
+
+	// Check the dimensions from the header before decoding the pixels
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || !supportedImageFormats[format] {
+		return nil, uc.reject(ctx, req.Key, domain.ErrUnsupportedImage)
+	}
+	if err := uc.validateDimensions(config.Width, config.Height, assetType); err != nil {
+		return nil, uc.reject(ctx, req.Key, err)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, uc.reject(ctx, req.Key, domain.ErrUnsupportedImage)
+	}
+	// An ICO's directory can disagree with its embedded image
+	bounds := img.Bounds()
+	if err := uc.validateDimensions(bounds.Dx(), bounds.Dy(), assetType); err != nil {
+		return nil, uc.reject(ctx, req.Key, err)
+	}
+
+	derivatives, err := uc.assetProcessor.Derivatives(assetType, img)
+	if err != nil {
+		return nil, err
+	}
+
+	version := contentVersion(content)
+	urls := map[string]string{
+		services.VariantOriginal: uc.publicURL(req.Key, version),
+	}
+	for _, derivative := range derivatives {
+		key := uc.keyGenerator.GenerateObjectKeyWithFilename(req.AgencyID, assetType, derivative.Filename)
+		if err := uc.storage.UploadFile(ctx, uc.bucket, key, bytes.NewReader(derivative.Content), derivative.ContentType); err != nil {
+			return nil, err
+		}
+		urls[derivative.Variant] = uc.publicURL(key, version)
+	}
+
+	if err := uc.brandingAssets.SetBrandingAssets(ctx, req.AgencyID, assetType, urls); err != nil {
+		return nil, err
+	}
+
+	return &inbound.FinalizeUploadResponse{
+		Key:       req.Key,
+		AssetType: assetType,
+		Width:     bounds.Dx(),
+		Height:    bounds.Dy(),
+		URLs:      urls,
+	}, nil
+}
+
+// finalizeSVG replaces an uploaded SVG logo with its sanitized version
+// The upload may have been served from its public URL in the meantime; the sanitized document overwrites it
+// before its URL is written onto the branding, and documents the sanitizer rejects are deleted.
+func (uc *FinalizeUpload) finalizeSVG(ctx context.Context, req *inbound.FinalizeUploadRequest, assetType string, content []byte) (*inbound.FinalizeUploadResponse, error) {
+	sanitized, removed, err := uc.svgSanitizer.Sanitize(content)
+	if err != nil {
+		return nil, uc.reject(ctx, req.Key, err)
+	}
+	if err := uc.storage.UploadFile(ctx, uc.bucket, req.Key, bytes.NewReader(sanitized), "image/svg+xml"); err != nil {
+		return nil, err
+	}
+
+	urls := map[string]string{
+		services.VariantOriginal: uc.publicURL(req.Key, contentVersion(sanitized)),
+	}
+	if err := uc.brandingAssets.SetBrandingAssets(ctx, req.AgencyID, assetType, urls); err != nil {
+		return nil, err
+	}
+
+	return &inbound.FinalizeUploadResponse{
+		Key:       req.Key,
+		AssetType: assetType,
+		URLs:      urls,
+		Removed:   removed,
+	}, nil
+}
+
+// looksLikeMarkup reports whether content starts like an XML document (after an optional BOM and whitespace)
+func looksLikeMarkup(content []byte) bool {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	return bytes.HasPrefix(bytes.TrimLeft(content, " \t\r\n"), []byte("<"))
+}
+
+// contentVersion returns the cache-busting version of stored content
+// Derivatives keep their keys across uploads, so URLs carry a content hash to bust CDN and browser caches.
+func contentVersion(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// download reads an uploaded object, reading at most one byte past maxSize so oversized uploads are rejected early
+func (uc *FinalizeUpload) download(ctx context.Context, key string, maxSize int64) ([]byte, error) {
+	reader, err := uc.storage.DownloadFile(ctx, uc.bucket, key)
+	if err != nil {
+		return nil, domain.ErrFileNotFound
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, domain.ErrFileTooLarge
+	}
+	return content, nil
+}
+
+// reject deletes an upload that failed validation and returns the validation error
+// If the delete fails the storage error is returned instead, so the client retries and the object is not left public.
+func (uc *FinalizeUpload) reject(ctx context.Context, key string, err error) error {
+	if deleteErr := uc.storage.DeleteFile(ctx, uc.bucket, key); deleteErr != nil {
+		return deleteErr
+	}
+	return err
+}
+
+// validateDimensions applies the asset validator's dimension and aspect ratio rules
+func (uc *FinalizeUpload) validateDimensions(width, height int, assetType string) error {
+	if !uc.assetValidator.ValidateImageDimensions(width, height, assetType) {
+		return domain.ErrInvalidImageDimensions
+	}
+	if !uc.assetValidator.ValidateAspectRatio(width, height, assetType) {
+		return domain.ErrInvalidAspectRatio
+	}
+	return nil
+}
+
+// publicURL returns the public URL of a stored object
+func (uc *FinalizeUpload) publicURL(key, version string) string {
+	return uc.publicBaseURL + "/" + key + "?v=" + version
+}
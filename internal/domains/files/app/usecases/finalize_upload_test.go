@@ -0,0 +1,271 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+	"farohq-core-app/internal/domains/files/domain/services"
+	"farohq-core-app/internal/domains/files/domain/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockStorage is a mock implementation of outbound.Storage
+type MockStorage struct {
+	mock.Mock
+	uploads map[string][]byte
+}
+
+func (m *MockStorage) GeneratePresignedURL(ctx context.Context, bucket, key string, expiresIn time.Duration) (string, map[string]string, error) {
+	args := m.Called(ctx, bucket, key, expiresIn)
+	return args.String(0), nil, args.Error(2)
+}
+
+func (m *MockStorage) GenerateDownloadURL(ctx context.Context, bucket, key string, expiresIn time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, key, expiresIn)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockStorage) DownloadFile(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return io.NopCloser(bytes.NewReader(args.Get(0).([]byte))), args.Error(1)
+}
+
+func (m *MockStorage) UploadFile(ctx context.Context, bucket, key string, content io.Reader, contentType string) error {
+	data, _ := io.ReadAll(content)
+	if m.uploads == nil {
+		m.uploads = make(map[string][]byte)
+	}
+	m.uploads[key] = data
+	args := m.Called(ctx, bucket, key, mock.Anything, contentType)
+	return args.Error(0)
+}
+
+func (m *MockStorage) ListObjects(ctx context.Context, bucket, prefix string) ([]outbound.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, prefix)
+	return nil, args.Error(1)
+}
+
+func (m *MockStorage) DeleteFile(ctx context.Context, bucket, key string) error {
+	args := m.Called(ctx, bucket, key)
+	return args.Error(0)
+}
+
+func (m *MockStorage) StatObject(ctx context.Context, bucket, key string) (*outbound.ObjectInfo, error) {
+	args := m.Called(ctx, bucket, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*outbound.ObjectInfo), args.Error(1)
+}
+
+// MockBrandingAssets is a mock implementation of outbound.BrandingAssets
+type MockBrandingAssets struct {
+	mock.Mock
+}
+
+func (m *MockBrandingAssets) SetBrandingAssets(ctx context.Context, agencyID uuid.UUID, assetType string, urls map[string]string) error {
+	args := m.Called(ctx, agencyID, assetType, urls)
+	return args.Error(0)
+}
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 0x80, A: 0xff})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func encodeTestICO(t *testing.T, size int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for i := range img.Pix {
+		img.Pix[i] = 0xc0
+	}
+	var buf bytes.Buffer
+	require.NoError(t, utils.EncodeICO(&buf, []image.Image{img}))
+	return buf.Bytes()
+}
+
+func TestFinalizeUpload_Execute(t *testing.T) {
+	agencyID := uuid.New()
+	prefix := agencyID.String() + "/branding/"
+
+	tests := []struct {
+		name            string
+		key             string
+		content         []byte
+		expectedErr     error
+		expectedDeleted bool // Rejected uploads are removed from storage
+		expectedUploads []string
+		expectedURLs    []string
+		expectedRemoved []string
+	}{
+		{
+			name:            "logo gets WebP variants and an email PNG",
+			key:             prefix + "logo.png",
+			content:         encodeTestPNG(t, 600, 200),
+			expectedUploads: []string{"logo/logo-128.webp", "logo/logo-256.webp", "logo/logo-512.webp", "logo/logo-email.png"},
+			expectedURLs:    []string{"original", "webp_128", "webp_256", "webp_512", "email_png"},
+		},
+		{
+			name:            "small logos are not upscaled",
+			key:             prefix + "logo",
+			content:         encodeTestPNG(t, 120, 64),
+			expectedUploads: []string{"logo/logo-128.webp", "logo/logo-email.png"},
+			expectedURLs:    []string{"original", "webp_128", "email_png"},
+		},
+		{
+			name:            "favicon gets an ICO and an apple-touch-icon",
+			key:             prefix + "favicon.ico",
+			content:         encodeTestICO(t, 64),
+			expectedUploads: []string{"favicon/favicon.ico", "favicon/apple-touch-icon.png"},
+			expectedURLs:    []string{"original", "ico", "apple_touch_icon"},
+		},
+		{
+			name:        "rejects another tenant's key",
+			key:         uuid.New().String() + "/branding/logo.png",
+			expectedErr: domain.ErrInvalidKey,
+		},
+		{
+			name:        "rejects assets without derivatives",
+			key:         prefix + "bg.png",
+			expectedErr: domain.ErrInvalidAsset,
+		},
+		{
+			name:            "rejects logos below the minimum size",
+			key:             prefix + "logo.png",
+			content:         encodeTestPNG(t, 32, 32),
+			expectedErr:     domain.ErrInvalidImageDimensions,
+			expectedDeleted: true,
+		},
+		{
+			name:            "rejects logos taller than wide",
+			key:             prefix + "logo.png",
+			content:         encodeTestPNG(t, 100, 300),
+			expectedErr:     domain.ErrInvalidAspectRatio,
+			expectedDeleted: true,
+		},
+		{
+			name:            "rejects oversized files before decoding",
+			key:             prefix + "favicon.png",
+			content:         bytes.Repeat([]byte{0}, 1024*1024+1),
+			expectedErr:     domain.ErrFileTooLarge,
+			expectedDeleted: true,
+		},
+		{
+			name:            "SVG logos are sanitized in place",
+			key:             prefix + "logo.svg",
+			content:         []byte(`<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"><path d="M0 0h10v10z"/></svg>`),
+			expectedUploads: []string{"logo.svg"},
+			expectedURLs:    []string{"original"},
+			expectedRemoved: []string{"@onload"},
+		},
+		{
+			name:            "rejects malformed SVG logos",
+			key:             prefix + "logo.svg",
+			content:         []byte(`<!DOCTYPE svg [<!ENTITY x "y">]><svg>&x;</svg>`),
+			expectedErr:     domain.ErrInvalidSVG,
+			expectedDeleted: true,
+		},
+		{
+			name:            "rejects SVG logos over the element limit",
+			key:             prefix + "logo.svg",
+			content:         []byte(`<svg xmlns="http://www.w3.org/2000/svg">` + strings.Repeat(`<g/>`, 5001) + `</svg>`),
+			expectedErr:     domain.ErrSVGTooComplex,
+			expectedDeleted: true,
+		},
+		{
+			name:            "rejects SVG favicons",
+			key:             prefix + "favicon.png",
+			content:         []byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`),
+			expectedErr:     domain.ErrUnsupportedImage,
+			expectedDeleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := new(MockStorage)
+			brandingAssets := new(MockBrandingAssets)
+			if tt.content != nil {
+				storage.On("DownloadFile", mock.Anything, "bucket", tt.key).Return(tt.content, nil)
+			}
+			storage.On("UploadFile", mock.Anything, "bucket", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			storage.On("DeleteFile", mock.Anything, "bucket", tt.key).Return(nil)
+			brandingAssets.On("SetBrandingAssets", mock.Anything, agencyID, mock.Anything, mock.Anything).Return(nil)
+
+			uc := NewFinalizeUpload(storage, brandingAssets, services.NewAssetValidator(), services.NewAssetProcessor(),
+				services.NewSVGSanitizer(), services.NewKeyGenerator(), "bucket", "https://cdn.example.com/")
+			resp, err := uc.Execute(context.Background(), &inbound.FinalizeUploadRequest{AgencyID: agencyID, Key: tt.key})
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, resp)
+				if tt.expectedDeleted {
+					storage.AssertCalled(t, "DeleteFile", mock.Anything, "bucket", tt.key)
+				} else {
+					storage.AssertNotCalled(t, "DeleteFile", mock.Anything, mock.Anything, mock.Anything)
+				}
+				brandingAssets.AssertNotCalled(t, "SetBrandingAssets", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+
+			uploaded := make([]string, 0, len(storage.uploads))
+			for key := range storage.uploads {
+				uploaded = append(uploaded, strings.TrimPrefix(key, prefix))
+			}
+			assert.ElementsMatch(t, tt.expectedUploads, uploaded)
+
+			variants := make([]string, 0, len(resp.URLs))
+			for variant, url := range resp.URLs {
+				variants = append(variants, variant)
+				assert.True(t, strings.HasPrefix(url, "https://cdn.example.com/"+prefix), url)
+				assert.Contains(t, url, "?v=")
+			}
+			assert.ElementsMatch(t, tt.expectedURLs, variants)
+			assert.Equal(t, tt.expectedRemoved, resp.Removed)
+			brandingAssets.AssertCalled(t, "SetBrandingAssets", mock.Anything, agencyID, resp.AssetType, resp.URLs)
+
+			for key, data := range storage.uploads {
+				config, format, err := image.DecodeConfig(bytes.NewReader(data))
+				if strings.HasSuffix(key, ".webp") || strings.HasSuffix(key, ".svg") {
+					continue // Covered by the encoder's and the sanitizer's tests
+				}
+				require.NoError(t, err, key)
+				switch {
+				case strings.HasSuffix(key, "favicon.ico"):
+					assert.Equal(t, "ico", format)
+					assert.Equal(t, 48, config.Width)
+				case strings.HasSuffix(key, "apple-touch-icon.png"):
+					assert.Equal(t, 180, config.Width)
+					assert.Equal(t, 180, config.Height)
+				case strings.HasSuffix(key, "logo-email.png"):
+					assert.LessOrEqual(t, config.Width, 400)
+					assert.LessOrEqual(t, config.Height, 200)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
+	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+)
+
+const (
+	defaultFileListLimit = 50
+	maxFileListLimit     = 200
+)
+
+// ListFiles implements the ListFiles inbound port
+type ListFiles struct {
+	fileRepo outbound.FileRepository
+}
+
+// NewListFiles creates a new ListFiles use case
+func NewListFiles(fileRepo outbound.FileRepository) inbound.ListFiles {
+	return &ListFiles{
+		fileRepo: fileRepo,
+	}
+}
+
+// Execute executes the use case
+func (uc *ListFiles) Execute(ctx context.Context, req *inbound.ListFilesRequest) (*inbound.ListFilesResponse, error) {
+	status := model.FileStatus(req.Status)
+	if status != "" && !status.Valid() {
+		return nil, domain.ErrInvalidFileStatus
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultFileListLimit
+	}
+	if limit > maxFileListLimit {
+		limit = maxFileListLimit
+	}
+
+	files, err := uc.fileRepo.ListByTenantID(ctx, req.AgencyID, outbound.FileFilter{
+		Status:     status,
+		AssetType:  req.AssetType,
+		UploadedBy: req.UploadedBy,
+		Limit:      limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &inbound.ListFilesResponse{
+		Files: files,
+	}, nil
+}
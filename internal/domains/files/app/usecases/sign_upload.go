@@ -2,10 +2,12 @@ package usecases
 
 import (
 	"context"
+	"encoding/base64"
 	"strings"
 	"time"
 
 	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
 	"farohq-core-app/internal/domains/files/domain/ports/inbound"
 	"farohq-core-app/internal/domains/files/domain/ports/outbound"
 	"farohq-core-app/internal/domains/files/domain/services"
@@ -16,6 +18,7 @@ import (
 // SignUpload implements the SignUpload inbound port
 type SignUpload struct {
 	storage        outbound.Storage
+	fileRepo       outbound.FileRepository
 	assetValidator *services.AssetValidator
 	keyGenerator   *services.KeyGenerator
 	bucket         string
@@ -25,6 +28,7 @@ type SignUpload struct {
 // NewSignUpload creates a new SignUpload use case
 func NewSignUpload(
 	storage outbound.Storage,
+	fileRepo outbound.FileRepository,
 	assetValidator *services.AssetValidator,
 	keyGenerator *services.KeyGenerator,
 	bucket string,
@@ -32,6 +36,7 @@ func NewSignUpload(
 ) inbound.SignUpload {
 	return &SignUpload{
 		storage:        storage,
+		fileRepo:       fileRepo,
 		assetValidator: assetValidator,
 		keyGenerator:   keyGenerator,
 		bucket:         bucket,
@@ -40,6 +45,8 @@ func NewSignUpload(
 }
 
 // Execute executes the use case
+// The upload is registered as pending until CompleteUpload confirms the object reached storage;
+// signing the same asset again replaces the registered file.
 func (uc *SignUpload) Execute(ctx context.Context, req *inbound.SignUploadRequest) (*inbound.SignUploadResponse, error) {
 	// Validate request
 	if req.AgencyID == "" {
@@ -66,6 +73,17 @@ func (uc *SignUpload) Execute(ctx context.Context, req *inbound.SignUploadReques
 		return nil, domain.ErrInvalidAgencyID
 	}
 
+	// Declared metadata is checked against the stored object once uploaded
+	assetType, _, _ := strings.Cut(req.Asset, ".")
+	if req.Size > uc.assetValidator.MaxFileSize(assetType) {
+		return nil, domain.ErrFileTooLarge
+	}
+	if req.Checksum != "" {
+		if md5, err := base64.StdEncoding.DecodeString(req.Checksum); err != nil || len(md5) != 16 {
+			return nil, domain.ErrInvalidChecksum
+		}
+	}
+
 	// Generate object key
 	key := uc.keyGenerator.GenerateObjectKey(agencyUUID, req.Asset)
 
@@ -75,6 +93,24 @@ func (uc *SignUpload) Execute(ctx context.Context, req *inbound.SignUploadReques
 		return nil, err
 	}
 
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = headers["Content-Type"]
+	}
+
+	file, err := uc.fileRepo.FindByKey(ctx, key)
+	switch {
+	case err == nil:
+		file.Resign(req.UploadedBy, contentType, req.Size, req.Checksum, time.Now())
+	case err == domain.ErrFileNotFound:
+		file = model.NewFile(agencyUUID, key, req.Asset, req.UploadedBy, contentType, req.Size, req.Checksum)
+	default:
+		return nil, err
+	}
+	if err := uc.fileRepo.Save(ctx, file); err != nil {
+		return nil, err
+	}
+
 	return &inbound.SignUploadResponse{
 		URL:     url,
 		Method:  "PUT",
@@ -11,5 +11,41 @@ var (
 
 	// ErrInvalidAgencyID is returned when agency ID is invalid
 	ErrInvalidAgencyID = errors.New("invalid agency ID")
+
+	// ErrInvalidKey is returned when a file key is malformed or outside the tenant's branding prefix
+	ErrInvalidKey = errors.New("invalid file key")
+
+	// ErrUnsupportedImage is returned when an uploaded asset is not a PNG, JPEG, ICO or SVG image
+	ErrUnsupportedImage = errors.New("unsupported image format")
+
+	// ErrFileTooLarge is returned when an uploaded asset exceeds its size limit
+	ErrFileTooLarge = errors.New("file too large")
+
+	// ErrInvalidImageDimensions is returned when an uploaded image is too small or too large
+	ErrInvalidImageDimensions = errors.New("invalid image dimensions")
+
+	// ErrInvalidAspectRatio is returned when an uploaded image has the wrong aspect ratio for its asset type
+	ErrInvalidAspectRatio = errors.New("invalid image aspect ratio")
+
+	// ErrInvalidSVG is returned when an uploaded SVG is not a well-formed SVG document
+	ErrInvalidSVG = errors.New("invalid SVG")
+
+	// ErrSVGTooComplex is returned when an uploaded SVG exceeds the sanitizer's size or complexity limits
+	ErrSVGTooComplex = errors.New("SVG too complex")
+
+	// ErrBrandingNotFound is returned when the tenant has no branding to attach processed assets to
+	ErrBrandingNotFound = errors.New("branding not found")
+
+	// ErrUploadIncomplete is returned when a signed upload is confirmed before its object exists in storage
+	ErrUploadIncomplete = errors.New("upload incomplete")
+
+	// ErrUploadMismatch is returned when a stored object's size or checksum differs from what was declared at signing
+	ErrUploadMismatch = errors.New("upload does not match declared size or checksum")
+
+	// ErrInvalidChecksum is returned when a declared checksum is not a base64 MD5 digest
+	ErrInvalidChecksum = errors.New("invalid checksum")
+
+	// ErrInvalidFileStatus is returned when filtering files by an unknown status
+	ErrInvalidFileStatus = errors.New("invalid file status")
 )
 
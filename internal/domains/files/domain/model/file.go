@@ -0,0 +1,206 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileStatus is the lifecycle state of a registered file
+type FileStatus string
+
+const (
+	FileStatusPending  FileStatus = "pending"  // Signed; the object has not been confirmed in storage yet
+	FileStatusUploaded FileStatus = "uploaded" // Confirmed by a HEAD on the stored object
+	FileStatusDeleted  FileStatus = "deleted"
+)
+
+// Valid reports whether the status is a known file status
+func (s FileStatus) Valid() bool {
+	return s == FileStatusPending || s == FileStatusUploaded || s == FileStatusDeleted
+}
+
+// File is the registry entry of an object uploaded through a signed URL
+// Content type, size and checksum hold what the uploader declared until the upload is confirmed,
+// and what storage reports afterwards.
+type File struct {
+	id          uuid.UUID
+	tenantID    uuid.UUID
+	key         string
+	asset       string // Asset the key was signed for ("logo", "favicon.ico", ...)
+	uploadedBy  *uuid.UUID
+	contentType string
+	size        int64
+	checksum    string // Base64 MD5 of the content (as in Content-MD5); empty when unknown
+	status      FileStatus
+	createdAt   time.Time
+	updatedAt   time.Time
+	uploadedAt  *time.Time
+	deletedAt   *time.Time
+}
+
+// NewFile creates a pending file for a signed upload
+func NewFile(tenantID uuid.UUID, key, asset string, uploadedBy *uuid.UUID, contentType string, size int64, checksum string) *File {
+	now := time.Now()
+	return &File{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		key:         key,
+		asset:       asset,
+		uploadedBy:  uploadedBy,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		status:      FileStatusPending,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+}
+
+// NewFileWithID recreates a file from persistence
+func NewFileWithID(
+	id, tenantID uuid.UUID,
+	key, asset string,
+	uploadedBy *uuid.UUID,
+	contentType string,
+	size int64,
+	checksum string,
+	status FileStatus,
+	createdAt, updatedAt time.Time,
+	uploadedAt, deletedAt *time.Time,
+) *File {
+	return &File{
+		id:          id,
+		tenantID:    tenantID,
+		key:         key,
+		asset:       asset,
+		uploadedBy:  uploadedBy,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		status:      status,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		uploadedAt:  uploadedAt,
+		deletedAt:   deletedAt,
+	}
+}
+
+// ID returns the file ID
+func (f *File) ID() uuid.UUID {
+	return f.id
+}
+
+// TenantID returns the tenant the file belongs to
+func (f *File) TenantID() uuid.UUID {
+	return f.tenantID
+}
+
+// Key returns the object key
+func (f *File) Key() string {
+	return f.key
+}
+
+// Asset returns the asset the key was signed for
+func (f *File) Asset() string {
+	return f.asset
+}
+
+// AssetType returns the asset without its extension ("logo.png" is a "logo")
+func (f *File) AssetType() string {
+	assetType, _, _ := strings.Cut(f.asset, ".")
+	return assetType
+}
+
+// UploadedBy returns the user who signed the upload, if known
+func (f *File) UploadedBy() *uuid.UUID {
+	return f.uploadedBy
+}
+
+// ContentType returns the content type
+func (f *File) ContentType() string {
+	return f.contentType
+}
+
+// Size returns the size in bytes; 0 when not declared and not yet uploaded
+func (f *File) Size() int64 {
+	return f.size
+}
+
+// Checksum returns the base64 MD5 of the content
+func (f *File) Checksum() string {
+	return f.checksum
+}
+
+// Status returns the file status
+func (f *File) Status() FileStatus {
+	return f.status
+}
+
+// CreatedAt returns when the file was first signed
+func (f *File) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+// UpdatedAt returns when the file last changed
+func (f *File) UpdatedAt() time.Time {
+	return f.updatedAt
+}
+
+// UploadedAt returns when the upload was confirmed
+func (f *File) UploadedAt() *time.Time {
+	return f.uploadedAt
+}
+
+// DeletedAt returns when the file was deleted
+func (f *File) DeletedAt() *time.Time {
+	return f.deletedAt
+}
+
+// Resign records a new signed upload to the same key, which replaces the object once uploaded
+func (f *File) Resign(uploadedBy *uuid.UUID, contentType string, size int64, checksum string, now time.Time) {
+	f.uploadedBy = uploadedBy
+	f.contentType = contentType
+	f.size = size
+	f.checksum = checksum
+	f.status = FileStatusPending
+	f.uploadedAt = nil
+	f.deletedAt = nil
+	f.updatedAt = now
+}
+
+// Matches reports whether a stored object agrees with the declared size and checksum
+// Values that were not declared, or that storage does not report, are not compared.
+func (f *File) Matches(size int64, checksum string) bool {
+	if f.status != FileStatusPending {
+		return true
+	}
+	if f.size > 0 && size != f.size {
+		return false
+	}
+	return f.checksum == "" || checksum == "" || checksum == f.checksum
+}
+
+// MarkUploaded records the stored object's metadata and confirms the upload
+func (f *File) MarkUploaded(size int64, contentType, checksum string, now time.Time) {
+	f.size = size
+	if contentType != "" {
+		f.contentType = contentType
+	}
+	if checksum != "" {
+		f.checksum = checksum
+	}
+	if f.status != FileStatusUploaded {
+		f.uploadedAt = &now
+	}
+	f.status = FileStatusUploaded
+	f.updatedAt = now
+}
+
+// MarkDeleted records that the object was removed
+func (f *File) MarkDeleted(now time.Time) {
+	f.status = FileStatusDeleted
+	f.deletedAt = &now
+	f.updatedAt = now
+}
@@ -0,0 +1,25 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/files/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// CompleteUpload is the inbound port for confirming a signed upload reached storage
+type CompleteUpload interface {
+	Execute(ctx context.Context, req *CompleteUploadRequest) (*CompleteUploadResponse, error)
+}
+
+// CompleteUploadRequest represents the request
+type CompleteUploadRequest struct {
+	AgencyID uuid.UUID // uuid.Nil for storage notifications, which may confirm any tenant's upload
+	Key      string    // Key returned by SignUpload
+}
+
+// CompleteUploadResponse represents the response
+type CompleteUploadResponse struct {
+	File *model.File
+}
@@ -2,6 +2,8 @@ package inbound
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 // DeleteFile is the inbound port for deleting files
@@ -11,11 +13,11 @@ type DeleteFile interface {
 
 // DeleteFileRequest represents the request
 type DeleteFileRequest struct {
-	Key string
+	AgencyID uuid.UUID
+	Key      string
 }
 
 // DeleteFileResponse represents the response
 type DeleteFileResponse struct {
 	Success bool
 }
-
@@ -0,0 +1,28 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FinalizeUpload is the inbound port for processing an uploaded branding asset
+type FinalizeUpload interface {
+	Execute(ctx context.Context, req *FinalizeUploadRequest) (*FinalizeUploadResponse, error)
+}
+
+// FinalizeUploadRequest represents the request
+type FinalizeUploadRequest struct {
+	AgencyID uuid.UUID
+	Key      string // Key returned by SignUpload
+}
+
+// FinalizeUploadResponse represents the response
+type FinalizeUploadResponse struct {
+	Key       string
+	AssetType string
+	Width     int // 0 for SVG logos
+	Height    int
+	URLs      map[string]string // Variant ("original", "webp_256", "ico", ...) to public URL
+	Removed   []string          // What the SVG sanitizer stripped ("<script>", "@onload", "css", ...)
+}
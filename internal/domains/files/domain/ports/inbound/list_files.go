@@ -0,0 +1,29 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/files/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// ListFiles is the inbound port for listing a tenant's registered files
+type ListFiles interface {
+	Execute(ctx context.Context, req *ListFilesRequest) (*ListFilesResponse, error)
+}
+
+// ListFilesRequest represents the request
+type ListFilesRequest struct {
+	AgencyID   uuid.UUID
+	Status     string // Empty lists pending and uploaded files
+	AssetType  string
+	UploadedBy *uuid.UUID
+	Limit      int // Files to return, newest first (default 50, at most 200)
+	Offset     int
+}
+
+// ListFilesResponse represents the response
+type ListFilesResponse struct {
+	Files []*model.File
+}
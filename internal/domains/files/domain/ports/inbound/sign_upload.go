@@ -3,6 +3,8 @@ package inbound
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // SignUpload is the inbound port for signing file uploads
@@ -11,9 +13,15 @@ type SignUpload interface {
 }
 
 // SignUploadRequest represents the request
+// The declared content type, size and checksum are recorded in the file registry; when set, the upload
+// is only confirmed if the stored object matches them.
 type SignUploadRequest struct {
-	AgencyID string
-	Asset    string
+	AgencyID    string
+	Asset       string
+	UploadedBy  *uuid.UUID // User signing the upload, if known
+	ContentType string
+	Size        int64  // Declared size in bytes; 0 when unknown
+	Checksum    string // Declared base64 MD5 of the content (as in Content-MD5); empty when unknown
 }
 
 // SignUploadResponse represents the response
@@ -24,4 +32,3 @@ type SignUploadResponse struct {
 	Key     string
 	Expires time.Time
 }
-
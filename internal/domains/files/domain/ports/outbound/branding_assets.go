@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BrandingAssets records processed branding assets on a tenant's branding
+type BrandingAssets interface {
+	// SetBrandingAssets stores the URLs of an asset ("logo" or "favicon") and its derivatives, keyed by variant
+	SetBrandingAssets(ctx context.Context, agencyID uuid.UUID, assetType string, urls map[string]string) error
+}
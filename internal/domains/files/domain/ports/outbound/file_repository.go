@@ -0,0 +1,28 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/files/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// FileFilter narrows a tenant's file listing; zero values do not filter
+type FileFilter struct {
+	Status     model.FileStatus // Without a status, deleted files are excluded
+	AssetType  string           // "logo" matches logo, logo.png and logo.svg
+	UploadedBy *uuid.UUID
+	Limit      int
+	Offset     int
+}
+
+// FileRepository defines the interface for the file metadata registry
+type FileRepository interface {
+	// FindByKey returns domain.ErrFileNotFound when no upload was ever signed for the key
+	FindByKey(ctx context.Context, key string) (*model.File, error)
+	// Save creates or replaces the file registered for its key
+	Save(ctx context.Context, file *model.File) error
+	Update(ctx context.Context, file *model.File) error
+	ListByTenantID(ctx context.Context, tenantID uuid.UUID, filter FileFilter) ([]*model.File, error)
+}
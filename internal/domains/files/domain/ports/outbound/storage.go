@@ -2,15 +2,40 @@ package outbound
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	Checksum    string // Base64 MD5 of the content; empty when storage does not report one (e.g. multipart uploads)
+	UpdatedAt   time.Time
+}
+
 // Storage defines the interface for file storage operations
 type Storage interface {
 	// GeneratePresignedURL generates a pre-signed URL for uploading a file
 	GeneratePresignedURL(ctx context.Context, bucket, key string, expiresIn time.Duration) (string, map[string]string, error)
 
+	// GenerateDownloadURL generates a pre-signed URL for downloading a file
+	GenerateDownloadURL(ctx context.Context, bucket, key string, expiresIn time.Duration) (string, error)
+
+	// DownloadFile opens a stored object for reading (server-side download); the caller closes the reader
+	DownloadFile(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+
+	// UploadFile writes content to storage (server-side upload)
+	UploadFile(ctx context.Context, bucket, key string, content io.Reader, contentType string) error
+
+	// StatObject reads a stored object's metadata without its content (HEAD)
+	// Returns domain.ErrFileNotFound when the object does not exist.
+	StatObject(ctx context.Context, bucket, key string) (*ObjectInfo, error)
+
+	// ListObjects lists all objects whose key starts with prefix
+	ListObjects(ctx context.Context, bucket, prefix string) ([]ObjectInfo, error)
+
 	// DeleteFile deletes a file from storage
 	DeleteFile(ctx context.Context, bucket, key string) error
 }
-
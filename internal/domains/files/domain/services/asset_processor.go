@@ -0,0 +1,141 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"farohq-core-app/internal/domains/files/domain/utils"
+)
+
+// Derivative variants, as stored on the branding next to the "original" upload
+const (
+	VariantOriginal       = "original"
+	VariantFaviconICO     = "ico"
+	VariantAppleTouchIcon = "apple_touch_icon"
+	VariantEmailPNG       = "email_png"
+)
+
+// logoWebPWidths are the WebP logo variants, as the box (in pixels) each one fits in
+var logoWebPWidths = []int{128, 256, 512}
+
+// faviconSizes are the sizes bundled in the generated favicon.ico
+var faviconSizes = []int{16, 32, 48}
+
+const (
+	// appleTouchIconSize is the iOS home screen icon size
+	appleTouchIconSize = 180
+
+	// emailLogoMaxWidth and emailLogoMaxHeight bound the email logo (2x a 200x100 header slot)
+	emailLogoMaxWidth  = 400
+	emailLogoMaxHeight = 200
+)
+
+// Derivative is a file generated from an uploaded branding asset
+type Derivative struct {
+	Variant     string
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// AssetProcessor generates the derivatives of uploaded branding assets
+type AssetProcessor struct{}
+
+// NewAssetProcessor creates a new asset processor
+func NewAssetProcessor() *AssetProcessor {
+	return &AssetProcessor{}
+}
+
+// Derivatives generates the derivatives of a decoded asset
+// Logos get WebP variants (128, 256 and 512 px boxes, never upscaled) and a PNG for emails, which often cannot
+// show WebP; favicons get a 16/32/48 favicon.ico and an opaque 180 px apple-touch-icon (iOS renders transparency black).
+func (p *AssetProcessor) Derivatives(assetType string, img image.Image) ([]Derivative, error) {
+	switch assetType {
+	case "logo":
+		return p.logoDerivatives(img)
+	case "favicon":
+		return p.faviconDerivatives(img)
+	}
+	return nil, nil
+}
+
+func (p *AssetProcessor) logoDerivatives(img image.Image) ([]Derivative, error) {
+	var derivatives []Derivative
+	var previous image.Point
+	for _, width := range logoWebPWidths {
+		variant := utils.FitWithin(img, width, width)
+		// Small logos are not upscaled, so larger boxes can repeat the previous variant
+		if variant.Bounds().Size() == previous {
+			continue
+		}
+		previous = variant.Bounds().Size()
+
+		var buf bytes.Buffer
+		if err := utils.EncodeWebP(&buf, variant); err != nil {
+			return nil, err
+		}
+		derivatives = append(derivatives, Derivative{
+			Variant:     fmt.Sprintf("webp_%d", width),
+			Filename:    fmt.Sprintf("logo-%d.webp", width),
+			ContentType: "image/webp",
+			Content:     buf.Bytes(),
+		})
+	}
+
+	email, err := encodePNG(utils.FitWithin(img, emailLogoMaxWidth, emailLogoMaxHeight))
+	if err != nil {
+		return nil, err
+	}
+	derivatives = append(derivatives, Derivative{
+		Variant:     VariantEmailPNG,
+		Filename:    "logo-email.png",
+		ContentType: "image/png",
+		Content:     email,
+	})
+
+	return derivatives, nil
+}
+
+func (p *AssetProcessor) faviconDerivatives(img image.Image) ([]Derivative, error) {
+	icons := make([]image.Image, len(faviconSizes))
+	for i, size := range faviconSizes {
+		icons[i] = utils.Square(img, size)
+	}
+	var ico bytes.Buffer
+	if err := utils.EncodeICO(&ico, icons); err != nil {
+		return nil, err
+	}
+
+	touchIcon, err := encodePNG(utils.Flatten(utils.Square(img, appleTouchIconSize), color.White))
+	if err != nil {
+		return nil, err
+	}
+
+	return []Derivative{
+		{
+			Variant:     VariantFaviconICO,
+			Filename:    "favicon.ico",
+			ContentType: "image/x-icon",
+			Content:     ico.Bytes(),
+		},
+		{
+			Variant:     VariantAppleTouchIcon,
+			Filename:    "apple-touch-icon.png",
+			ContentType: "image/png",
+			Content:     touchIcon,
+		},
+	}, nil
+}
+
+// encodePNG encodes an image as a best-compression PNG
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
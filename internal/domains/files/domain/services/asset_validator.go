@@ -75,66 +75,22 @@ func (v *AssetValidator) ValidateAspectRatio(width, height int, assetType string
 // ValidateFileSize validates file size
 // Max 2MB for logos, 1MB for favicons
 func (v *AssetValidator) ValidateFileSize(size int64, assetType string) bool {
+	return size <= v.MaxFileSize(assetType)
+}
+
+// MaxFileSize returns the size limit of an asset type in bytes
+func (v *AssetValidator) MaxFileSize(assetType string) int64 {
 	if assetType == "logo" {
-		return size <= 2*1024*1024 // 2MB
+		return 2 * 1024 * 1024 // 2MB
 	} else if assetType == "favicon" {
-		return size <= 1*1024*1024 // 1MB
+		return 1 * 1024 * 1024 // 1MB
 	}
-	return size <= 2*1024*1024 // Default: 2MB
+	return 2 * 1024 * 1024 // Default: 2MB
 }
 
-// ValidateSVGContent performs basic SVG validation
-// Checks for embedded scripts to prevent XSS attacks
-// Note: This is a basic check; full SVG validation would require a proper parser
+// ValidateSVGContent reports whether an SVG is already safe as uploaded
+// The SVG sanitizer decides: the document must parse and lose nothing when rewritten to the allowlist.
 func (v *AssetValidator) ValidateSVGContent(content []byte) bool {
-	contentStr := string(content)
-
-	// Reject SVG files with embedded scripts (prevent XSS)
-	// Check for common script patterns
-	scriptPatterns := []string{
-		"<script",
-		"javascript:",
-		"onerror=",
-		"onload=",
-		"<iframe",
-		"<embed",
-	}
-
-	for _, pattern := range scriptPatterns {
-		// Case-insensitive check
-		for i := 0; i <= len(contentStr)-len(pattern); i++ {
-			if len(contentStr) >= i+len(pattern) {
-				substr := contentStr[i : i+len(pattern)]
-				// Simple case-insensitive check
-				if substr == pattern || (len(substr) == len(pattern) && isCaseInsensitiveMatch(substr, pattern)) {
-					return false
-				}
-			}
-		}
-	}
-
-	return true
+	_, removed, err := NewSVGSanitizer().Sanitize(content)
+	return err == nil && len(removed) == 0
 }
-
-// isCaseInsensitiveMatch checks if two strings match case-insensitively
-func isCaseInsensitiveMatch(s1, s2 string) bool {
-	if len(s1) != len(s2) {
-		return false
-	}
-	for i := 0; i < len(s1); i++ {
-		c1 := s1[i]
-		c2 := s2[i]
-		// Convert to lowercase for comparison
-		if c1 >= 'A' && c1 <= 'Z' {
-			c1 += 32
-		}
-		if c2 >= 'A' && c2 <= 'Z' {
-			c2 += 32
-		}
-		if c1 != c2 {
-			return false
-		}
-	}
-	return true
-}
-
@@ -0,0 +1,448 @@
+package services
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"farohq-core-app/internal/domains/files/domain"
+)
+
+const (
+	svgNamespace   = "http://www.w3.org/2000/svg"
+	xlinkNamespace = "http://www.w3.org/1999/xlink"
+
+	// maxSVGSize is the largest SVG accepted, in bytes
+	maxSVGSize = 512 * 1024
+	// maxSVGElements is the most elements an SVG may contain
+	maxSVGElements = 5000
+	// maxSVGDepth is the deepest element nesting accepted
+	maxSVGDepth = 64
+	// maxSVGUseElements caps <use> elements, which renderers expand into copies of their target
+	maxSVGUseElements = 200
+	// maxSVGAttributeLength caps a single attribute value (long path data included)
+	maxSVGAttributeLength = 128 * 1024
+)
+
+// svgElements are the elements kept by the sanitizer: static shapes, text, paint servers, clipping, masking and filters
+// Anything else (script, foreignObject, image, a, animation, style sheets via <link>, ...) is removed with its content.
+var svgElements = map[string]bool{
+	"svg": true, "g": true, "defs": true, "symbol": true, "use": true, "title": true, "desc": true, "style": true,
+	"path": true, "rect": true, "circle": true, "ellipse": true, "line": true, "polyline": true, "polygon": true,
+	"text": true, "tspan": true, "textPath": true,
+	"linearGradient": true, "radialGradient": true, "stop": true, "pattern": true,
+	"clipPath": true, "mask": true, "marker": true,
+	"filter": true, "feBlend": true, "feColorMatrix": true, "feComponentTransfer": true, "feComposite": true,
+	"feDropShadow": true, "feFlood": true, "feFuncA": true, "feFuncB": true, "feFuncG": true, "feFuncR": true,
+	"feGaussianBlur": true, "feMerge": true, "feMergeNode": true, "feMorphology": true, "feOffset": true,
+}
+
+// svgHrefElements are the elements that may reference another element of the same document
+var svgHrefElements = map[string]bool{
+	"use": true, "linearGradient": true, "radialGradient": true, "pattern": true, "textPath": true, "filter": true,
+}
+
+// svgAttributes are the attributes kept by the sanitizer (href and style are handled separately)
+var svgAttributes = map[string]bool{
+	"id": true, "class": true, "version": true, "viewBox": true, "preserveAspectRatio": true, "transform": true,
+	"x": true, "y": true, "width": true, "height": true, "x1": true, "y1": true, "x2": true, "y2": true,
+	"cx": true, "cy": true, "r": true, "rx": true, "ry": true, "fx": true, "fy": true, "fr": true,
+	"d": true, "points": true, "pathLength": true, "dx": true, "dy": true, "rotate": true, "textLength": true,
+	"lengthAdjust": true, "startOffset": true,
+	"offset": true, "gradientUnits": true, "gradientTransform": true, "spreadMethod": true,
+	"patternUnits": true, "patternContentUnits": true, "patternTransform": true,
+	"clipPathUnits": true, "maskUnits": true, "maskContentUnits": true,
+	"markerWidth": true, "markerHeight": true, "markerUnits": true, "refX": true, "refY": true, "orient": true,
+	"filterUnits": true, "primitiveUnits": true, "in": true, "in2": true, "result": true, "mode": true,
+	"operator": true, "k1": true, "k2": true, "k3": true, "k4": true, "type": true, "values": true,
+	"tableValues": true, "slope": true, "intercept": true, "amplitude": true, "exponent": true,
+	"stdDeviation": true, "radius": true, "edgeMode": true,
+}
+
+// svgPresentationProperties are the presentation attributes and CSS properties kept by the sanitizer
+var svgPresentationProperties = map[string]bool{
+	"fill": true, "fill-opacity": true, "fill-rule": true, "stroke": true, "stroke-dasharray": true,
+	"stroke-dashoffset": true, "stroke-linecap": true, "stroke-linejoin": true, "stroke-miterlimit": true,
+	"stroke-opacity": true, "stroke-width": true, "opacity": true, "color": true, "display": true,
+	"visibility": true, "overflow": true, "clip-path": true, "clip-rule": true, "mask": true, "filter": true,
+	"marker-start": true, "marker-mid": true, "marker-end": true, "stop-color": true, "stop-opacity": true,
+	"flood-color": true, "flood-opacity": true, "lighting-color": true, "color-interpolation": true,
+	"color-interpolation-filters": true, "font-family": true, "font-size": true, "font-style": true,
+	"font-variant": true, "font-weight": true, "font-stretch": true, "letter-spacing": true, "word-spacing": true,
+	"text-anchor": true, "text-decoration": true, "dominant-baseline": true, "alignment-baseline": true,
+	"baseline-shift": true, "writing-mode": true, "direction": true, "unicode-bidi": true,
+	"shape-rendering": true, "text-rendering": true, "image-rendering": true, "vector-effect": true,
+	"paint-order": true, "mix-blend-mode": true, "isolation": true, "transform": true, "transform-origin": true,
+	"transform-box": true,
+}
+
+// svgFunctions are the functional notations allowed in attribute and CSS values (url() only with a local target)
+var svgFunctions = map[string]bool{
+	"url": true, "rgb": true, "rgba": true, "hsl": true, "hsla": true,
+	"matrix": true, "translate": true, "translatex": true, "translatey": true, "scale": true, "scalex": true,
+	"scaley": true, "rotate": true, "skewx": true, "skewy": true,
+}
+
+var (
+	// svgFunctionPattern finds functional notations, e.g. "url(" or "translate ("
+	svgFunctionPattern = regexp.MustCompile(`([A-Za-z_-]*)\s*\(`)
+	// svgLocalURLPattern matches a url() that points into the same document
+	svgLocalURLPattern = regexp.MustCompile(`^url\(\s*(['"]?)#[A-Za-z_][\w.:-]*(['"]?)\s*\)`)
+	// svgLocalRefPattern matches a same-document href
+	svgLocalRefPattern = regexp.MustCompile(`^#[A-Za-z_][\w.:-]*$`)
+	// svgSelectorPattern matches the CSS selectors kept in style elements (types, classes, IDs, combinators)
+	svgSelectorPattern = regexp.MustCompile(`^[\w\s.#,>+~*:-]+$`)
+	// cssCommentPattern matches CSS comments
+	cssCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	// svgEscaper escapes text and double-quoted attribute values (whitespace is kept as is, unlike xml.EscapeText)
+	svgEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// SVGSanitizer rewrites SVG documents to an allowlisted subset that is safe to serve and inline
+// Documents are parsed as XML and re-serialized from scratch: only allowlisted elements, attributes, CSS
+// properties and same-document references survive, so scripts, event handlers, foreign content, external
+// resources and javascript:/data: URLs cannot reach the output.
+type SVGSanitizer struct{}
+
+// NewSVGSanitizer creates a new SVG sanitizer
+func NewSVGSanitizer() *SVGSanitizer {
+	return &SVGSanitizer{}
+}
+
+// Sanitize returns the sanitized document and what was removed ("<element>", "@attribute", "css", "comment", ...)
+// Malformed documents (including undeclared entities and non-UTF-8 encodings) return ErrInvalidSVG;
+// documents over the size, element, depth or <use> limits return ErrSVGTooComplex.
+func (s *SVGSanitizer) Sanitize(content []byte) ([]byte, []string, error) {
+	if len(content) > maxSVGSize {
+		return nil, nil, domain.ErrSVGTooComplex
+	}
+
+	w := &svgWriter{removed: make(map[string]bool), idsWithUse: make(map[string]bool)}
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.Strict = true
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, domain.ErrInvalidSVG
+		}
+		if err := w.handle(token); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !w.sawRoot || w.depth != 0 {
+		return nil, nil, domain.ErrInvalidSVG
+	}
+	// A <use> whose target contains another <use> multiplies the rendered elements at every level
+	for _, target := range w.useTargets {
+		if w.idsWithUse[target] {
+			return nil, nil, domain.ErrSVGTooComplex
+		}
+	}
+
+	removed := make([]string, 0, len(w.removed))
+	for item := range w.removed {
+		removed = append(removed, item)
+	}
+	sort.Strings(removed)
+
+	return w.out.Bytes(), removed, nil
+}
+
+// svgWriter re-serializes the allowed parts of an SVG token stream
+type svgWriter struct {
+	out        bytes.Buffer
+	removed    map[string]bool
+	depth      int
+	skipDepth  int // > 0 while inside a removed element
+	elements   int
+	uses       int
+	sawRoot    bool
+	pending    bool // The last start tag is not closed yet, so an empty element can be written as <x/>
+	open       []svgOpenElement
+	style      *strings.Builder // Text of the style element being read
+	useTargets []string
+	idsWithUse map[string]bool
+}
+
+// svgOpenElement is an element whose end tag has not been read yet
+type svgOpenElement struct {
+	name string
+	id   string
+}
+
+func (w *svgWriter) handle(token xml.Token) error {
+	switch t := token.(type) {
+	case xml.StartElement:
+		return w.startElement(t)
+	case xml.EndElement:
+		w.endElement()
+	case xml.CharData:
+		if w.skipDepth > 0 || w.depth == 0 {
+			return nil
+		}
+		if w.style != nil {
+			w.style.Write(t)
+			return nil
+		}
+		w.closePending()
+		svgEscaper.WriteString(&w.out, string(t))
+	case xml.Comment:
+		w.removed["comment"] = true
+	case xml.ProcInst:
+		// The XML declaration is dropped silently; others (xml-stylesheet) can load external resources
+		if t.Target != "xml" {
+			w.removed["<?"+t.Target+"?>"] = true
+		}
+	case xml.Directive:
+		w.removed["<!DOCTYPE>"] = true
+	}
+	return nil
+}
+
+func (w *svgWriter) startElement(t xml.StartElement) error {
+	w.depth++
+	w.elements++
+	if w.elements > maxSVGElements || w.depth > maxSVGDepth {
+		return domain.ErrSVGTooComplex
+	}
+	if w.skipDepth > 0 {
+		w.skipDepth++
+		return nil
+	}
+
+	name := t.Name.Local
+	inSVGNamespace := t.Name.Space == svgNamespace || t.Name.Space == ""
+	if !w.sawRoot {
+		if name != "svg" || !inSVGNamespace {
+			return domain.ErrInvalidSVG
+		}
+		w.sawRoot = true
+	} else if w.depth == 1 {
+		return domain.ErrInvalidSVG
+	}
+
+	if !inSVGNamespace || !svgElements[name] || w.style != nil {
+		w.removed["<"+name+">"] = true
+		w.skipDepth = 1
+		return nil
+	}
+	if name == "use" {
+		w.uses++
+		if w.uses > maxSVGUseElements {
+			return domain.ErrSVGTooComplex
+		}
+		for _, ancestor := range w.open {
+			if ancestor.id != "" {
+				w.idsWithUse[ancestor.id] = true
+			}
+		}
+	}
+
+	w.closePending()
+	w.out.WriteString("<" + name)
+	if w.depth == 1 {
+		w.out.WriteString(` xmlns="` + svgNamespace + `" xmlns:xlink="` + xlinkNamespace + `"`)
+	}
+
+	element := svgOpenElement{name: name}
+	for _, attr := range t.Attr {
+		attrName, value, ok := w.sanitizeAttribute(name, attr)
+		if !ok {
+			continue
+		}
+		if attrName == "id" {
+			element.id = value
+		}
+		if name == "use" && (attrName == "href" || attrName == "xlink:href") {
+			w.useTargets = append(w.useTargets, strings.TrimPrefix(value, "#"))
+		}
+		w.out.WriteString(" " + attrName + `="` + svgEscaper.Replace(value) + `"`)
+	}
+	// The use itself counts as a descendant of its own target when it points at itself
+	if name == "use" && element.id != "" {
+		w.idsWithUse[element.id] = true
+	}
+	w.open = append(w.open, element)
+	w.pending = true
+
+	if name == "style" {
+		w.style = &strings.Builder{}
+	}
+	return nil
+}
+
+func (w *svgWriter) endElement() {
+	w.depth--
+	if w.skipDepth > 0 {
+		w.skipDepth--
+		return
+	}
+
+	element := w.open[len(w.open)-1]
+	w.open = w.open[:len(w.open)-1]
+
+	if w.style != nil {
+		css := sanitizeStyleSheet(w.style.String(), w.removed)
+		w.style = nil
+		if css != "" {
+			w.closePending()
+			svgEscaper.WriteString(&w.out, css)
+		}
+	}
+
+	if w.pending {
+		w.out.WriteString("/>")
+		w.pending = false
+		return
+	}
+	w.out.WriteString("</" + element.name + ">")
+}
+
+// closePending finishes the start tag written last
+func (w *svgWriter) closePending() {
+	if w.pending {
+		w.out.WriteString(">")
+		w.pending = false
+	}
+}
+
+// sanitizeAttribute returns the attribute's output name and value, or false when it is removed
+// Namespace declarations are dropped silently: the root element gets its own.
+func (w *svgWriter) sanitizeAttribute(element string, attr xml.Attr) (string, string, bool) {
+	name := attr.Name.Local
+	switch {
+	case attr.Name.Space == "xmlns" || (attr.Name.Space == "" && name == "xmlns"):
+		return "", "", false
+	case name == "href" && (attr.Name.Space == "" || attr.Name.Space == xlinkNamespace):
+		outName := "href"
+		if attr.Name.Space == xlinkNamespace {
+			outName = "xlink:href"
+		}
+		value := strings.TrimSpace(attr.Value)
+		if !svgHrefElements[element] || !svgLocalRefPattern.MatchString(value) {
+			w.removed["@"+outName] = true
+			return "", "", false
+		}
+		return outName, value, true
+	case attr.Name.Space != "":
+		w.removed["@"+name] = true
+		return "", "", false
+	case name == "style":
+		value := sanitizeDeclarations(attr.Value, w.removed)
+		return name, value, value != ""
+	case svgAttributes[name] || svgPresentationProperties[name]:
+		if !safeSVGValue(attr.Value) {
+			w.removed["@"+name] = true
+			return "", "", false
+		}
+		return name, attr.Value, true
+	}
+	w.removed["@"+name] = true
+	return "", "", false
+}
+
+// sanitizeStyleSheet keeps the plain rules of a style element (type, class and ID selectors with allowlisted declarations)
+// At-rules (@import, @font-face, @media, ...) are removed with their blocks.
+func sanitizeStyleSheet(css string, removed map[string]bool) string {
+	css = cssCommentPattern.ReplaceAllString(css, "")
+	if strings.ContainsAny(css, `<\`) {
+		removed["css"] = true
+		return ""
+	}
+
+	var out strings.Builder
+	for {
+		open := strings.IndexByte(css, '{')
+		if open < 0 {
+			if strings.TrimSpace(css) != "" {
+				removed["css"] = true
+			}
+			break
+		}
+		selector := strings.TrimSpace(css[:open])
+		// At-rules without a block (@import url(...);) end at the first semicolon
+		if semicolon := strings.IndexByte(selector, ';'); semicolon >= 0 {
+			removed["css"] = true
+			css = css[strings.IndexByte(css, ';')+1:]
+			continue
+		}
+		end := closingBrace(css, open)
+		if end < 0 {
+			removed["css"] = true
+			break
+		}
+		body := css[open+1 : end]
+		css = css[end+1:]
+
+		if strings.HasPrefix(selector, "@") || strings.Contains(body, "{") || !svgSelectorPattern.MatchString(selector) {
+			removed["css"] = true
+			continue
+		}
+		if declarations := sanitizeDeclarations(body, removed); declarations != "" {
+			out.WriteString(selector + "{" + declarations + "}")
+		}
+	}
+	return out.String()
+}
+
+// closingBrace returns the index of the brace closing the block opened at open, or -1
+func closingBrace(css string, open int) int {
+	depth := 0
+	for i := open; i < len(css); i++ {
+		switch css[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// sanitizeDeclarations keeps the allowlisted properties of a CSS declaration list with safe values
+func sanitizeDeclarations(declarations string, removed map[string]bool) string {
+	var kept []string
+	for _, declaration := range strings.Split(declarations, ";") {
+		if strings.TrimSpace(declaration) == "" {
+			continue
+		}
+		property, value, ok := strings.Cut(declaration, ":")
+		property = strings.ToLower(strings.TrimSpace(property))
+		value = strings.TrimSpace(value)
+		if !ok || !svgPresentationProperties[property] || !safeSVGValue(value) || strings.ContainsAny(value, `<{}`) {
+			removed["css"] = true
+			continue
+		}
+		kept = append(kept, property+":"+value)
+	}
+	return strings.Join(kept, ";")
+}
+
+// safeSVGValue reports whether an attribute or CSS value only uses allowed functions and same-document url()s
+// Backslashes are rejected outright: CSS escapes can spell out url( or javascript: past any pattern check.
+func safeSVGValue(value string) bool {
+	if len(value) > maxSVGAttributeLength || strings.ContainsAny(value, "\\\x00") {
+		return false
+	}
+	for _, match := range svgFunctionPattern.FindAllStringSubmatchIndex(value, -1) {
+		function := strings.ToLower(value[match[2]:match[3]])
+		if !svgFunctions[function] {
+			return false
+		}
+		if function == "url" && !svgLocalURLPattern.MatchString(value[match[0]:]) {
+			return false
+		}
+	}
+	return true
+}
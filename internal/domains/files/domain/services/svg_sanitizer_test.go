@@ -0,0 +1,189 @@
+package services
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"farohq-core-app/internal/domains/files/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const svgOpen = `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 100 100">`
+
+// xssPayloads are known SVG XSS vectors; none of them may survive sanitization
+var xssPayloads = map[string]string{
+	"script element":             svgOpen + `<script>alert(1)</script></svg>`,
+	"script in xlink namespace":  svgOpen + `<script xlink:href="https://evil.example/x.js"/></svg>`,
+	"uppercase script":           svgOpen + `<SCRIPT>alert(1)</SCRIPT></svg>`,
+	"script in CDATA":            svgOpen + `<script><![CDATA[alert(1)]]></script></svg>`,
+	"onload on root":             `<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"/>`,
+	"onclick on shape":           svgOpen + `<rect width="10" height="10" onclick="alert(1)"/></svg>`,
+	"mixed case handler":         svgOpen + `<circle r="5" OnMouseOver="alert(1)"/></svg>`,
+	"onerror on image":           svgOpen + `<image href="x" onerror="alert(1)"/></svg>`,
+	"foreignObject iframe":       svgOpen + `<foreignObject width="100" height="100"><iframe xmlns="http://www.w3.org/1999/xhtml" src="javascript:alert(1)"></iframe></foreignObject></svg>`,
+	"foreignObject body":         svgOpen + `<foreignObject><body xmlns="http://www.w3.org/1999/xhtml" onload="alert(1)"/></foreignObject></svg>`,
+	"xhtml script":               svgOpen + `<html:script xmlns:html="http://www.w3.org/1999/xhtml">alert(1)</html:script></svg>`,
+	"anchor javascript href":     svgOpen + `<a xlink:href="javascript:alert(1)"><text>x</text></a></svg>`,
+	"use with data URL":          svgOpen + `<use xlink:href="data:image/svg+xml;base64,PHN2ZyBvbmxvYWQ9YWxlcnQoMSk+#x"/></svg>`,
+	"use with external file":     svgOpen + `<use href="https://evil.example/sprite.svg#icon"/></svg>`,
+	"use with javascript href":   svgOpen + `<use href=" javascript:alert(1)"/></svg>`,
+	"gradient external href":     svgOpen + `<linearGradient id="g" xlink:href="https://evil.example/g.svg#g"/></svg>`,
+	"image data URL":             svgOpen + `<image xlink:href="data:image/svg+xml,&lt;svg onload=alert(1)&gt;"/></svg>`,
+	"feImage":                    svgOpen + `<filter id="f"><feImage href="https://evil.example/track.png"/></filter></svg>`,
+	"animate sets href":          svgOpen + `<a><animate attributeName="href" values="javascript:alert(1)"/><text>x</text></a></svg>`,
+	"set onbegin":                svgOpen + `<set attributeName="fill" to="red" onbegin="alert(1)"/></svg>`,
+	"fill url javascript":        svgOpen + `<rect fill="url(javascript:alert(1))"/></svg>`,
+	"fill url external":          svgOpen + `<rect fill="url(https://evil.example/x.svg#p)"/></svg>`,
+	"style attribute url":        svgOpen + `<rect style="fill: url('javascript:alert(1)')"/></svg>`,
+	"style attribute expression": svgOpen + `<rect style="width: expression(alert(1))"/></svg>`,
+	"style attribute behavior":   svgOpen + `<rect style="behavior: url(#x); -moz-binding: url(#y)"/></svg>`,
+	"style escape":               svgOpen + `<rect style="fill: u\72l(javascript:alert(1))"/></svg>`,
+	"style element import":       svgOpen + `<style>@import url("https://evil.example/x.css"); rect{fill:red}</style></svg>`,
+	"style element font-face":    svgOpen + `<style>@font-face{font-family:x;src:url(https://evil.example/f.woff)}</style></svg>`,
+	"style element url":          svgOpen + `<style>rect{background:url(javascript:alert(1))}</style></svg>`,
+	"style element escapes":      svgOpen + `<style>rect{fill:\75\72\6c(javascript:alert(1))}</style></svg>`,
+	"style element breakout":     svgOpen + `<style>rect{fill:red}&lt;/style&gt;&lt;script&gt;alert(1)&lt;/script&gt;</style></svg>`,
+	"style attribute selector":   svgOpen + `<style>input[value^="a"]{fill:url(#x)}</style></svg>`,
+	"xml-stylesheet":             `<?xml-stylesheet type="text/css" href="https://evil.example/x.css"?>` + svgOpen + `</svg>`,
+	"comment hiding script":      svgOpen + `<!--<script>alert(1)</script>--></svg>`,
+	"xlink show embed":           svgOpen + `<a xlink:show="embed" xlink:href="https://evil.example"><text>x</text></a></svg>`,
+	"xml:base":                   svgOpen + `<g xml:base="javascript:alert(1)//"><use href="#x"/></g></svg>`,
+	"text in iframe":             svgOpen + `<text><iframe src="javascript:alert(1)"/></text></svg>`,
+	"style inside style":         svgOpen + `<style><script>alert(1)</script></style></svg>`,
+}
+
+// dangerousMarkers must not appear anywhere in sanitized output
+var dangerousMarkers = []string{
+	"script", "javascript", "alert", "evil.example", "onload", "onclick", "onmouseover", "onerror", "onbegin",
+	"foreignobject", "iframe", "data:", "@import", "expression", "behavior", "binding", "<image", "<a ", "<a>",
+	"animate", "<set", "feimage", "xml:base", "xml-stylesheet", `\`, "<!--",
+}
+
+func TestSVGSanitizer_Sanitize_XSSCorpus(t *testing.T) {
+	sanitizer := NewSVGSanitizer()
+
+	for name, payload := range xssPayloads {
+		t.Run(name, func(t *testing.T) {
+			out, removed, err := sanitizer.Sanitize([]byte(payload))
+			require.NoError(t, err)
+			assert.NotEmpty(t, removed)
+
+			lower := strings.ToLower(string(out))
+			for _, marker := range dangerousMarkers {
+				assert.NotContains(t, lower, marker)
+			}
+			assertWellFormed(t, out)
+		})
+	}
+}
+
+func TestSVGSanitizer_Sanitize_RejectsMalformedDocuments(t *testing.T) {
+	tests := map[string]string{
+		"external entity":      `<!DOCTYPE svg [<!ENTITY xxe SYSTEM "file:///etc/passwd">]><svg xmlns="http://www.w3.org/2000/svg"><text>&xxe;</text></svg>`,
+		"internal entity":      `<!DOCTYPE svg [<!ENTITY js "javascript:alert(1)">]><svg xmlns="http://www.w3.org/2000/svg"><use href="&js;"/></svg>`,
+		"billion laughs":       `<!DOCTYPE svg [<!ENTITY a "aaaa"><!ENTITY b "&a;&a;&a;&a;">]><svg xmlns="http://www.w3.org/2000/svg"><text>&b;</text></svg>`,
+		"non-SVG root":         `<html xmlns="http://www.w3.org/1999/xhtml"><script>alert(1)</script></html>`,
+		"SVG root in XHTML":    `<svg xmlns="http://www.w3.org/1999/xhtml"/>`,
+		"unclosed element":     `<svg xmlns="http://www.w3.org/2000/svg"><g>`,
+		"second root":          `<svg xmlns="http://www.w3.org/2000/svg"/><script>alert(1)</script>`,
+		"non-UTF-8 encoding":   `<?xml version="1.0" encoding="UTF-7"?><svg xmlns="http://www.w3.org/2000/svg"/>`,
+		"empty document":       ``,
+		"HTML-style attribute": `<svg xmlns="http://www.w3.org/2000/svg" onload=alert(1)/>`,
+	}
+
+	for name, payload := range tests {
+		t.Run(name, func(t *testing.T) {
+			out, _, err := NewSVGSanitizer().Sanitize([]byte(payload))
+			assert.Equal(t, domain.ErrInvalidSVG, err)
+			assert.Nil(t, out)
+		})
+	}
+}
+
+func TestSVGSanitizer_Sanitize_EnforcesLimits(t *testing.T) {
+	deep := svgOpen + strings.Repeat("<g>", maxSVGDepth) + strings.Repeat("</g>", maxSVGDepth) + `</svg>`
+	many := svgOpen + strings.Repeat(`<rect/>`, maxSVGElements) + `</svg>`
+	uses := svgOpen + `<rect id="r"/>` + strings.Repeat(`<use href="#r"/>`, maxSVGUseElements+1) + `</svg>`
+	nestedUses := svgOpen + `<g id="a"><rect/></g><g id="b"><use href="#a"/><use href="#a"/></g><use href="#b"/></svg>`
+	selfUse := svgOpen + `<g id="a"><use href="#a"/></g></svg>`
+	large := svgOpen + `<path d="` + strings.Repeat("M0 0", maxSVGSize/4) + `"/></svg>`
+
+	for name, payload := range map[string]string{
+		"too deep": deep, "too many elements": many, "too many uses": uses,
+		"use of an element with uses": nestedUses, "recursive use": selfUse, "too large": large,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := NewSVGSanitizer().Sanitize([]byte(payload))
+			assert.Equal(t, domain.ErrSVGTooComplex, err)
+		})
+	}
+}
+
+func TestSVGSanitizer_Sanitize_KeepsSafeContent(t *testing.T) {
+	logo := `<?xml version="1.0" encoding="UTF-8"?>
+<!-- Generator: design tool -->
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 200 50" width="200" height="50">
+  <title>Acme &amp; Co</title>
+  <defs>
+    <linearGradient id="brand" x1="0" y1="0" x2="1" y2="0"><stop offset="0" stop-color="#0af"/><stop offset="1" stop-color="rgb(0, 80, 200)"/></linearGradient>
+    <style>.mark { fill: url(#brand); stroke: none } text { font-family: 'Inter', sans-serif; font-weight: 700 }</style>
+    <symbol id="dot"><circle cx="5" cy="5" r="5"/></symbol>
+  </defs>
+  <path class="mark" d="M0 0h50v50H0z" transform="translate(10, 0) rotate(5)"/>
+  <use xlink:href="#dot" x="70" y="20"/>
+  <text x="90" y="35" style="fill: #222; font-size: 24px">Acme</text>
+</svg>`
+
+	out, removed, err := NewSVGSanitizer().Sanitize([]byte(logo))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"comment"}, removed)
+	assertWellFormed(t, out)
+
+	for _, kept := range []string{
+		`viewBox="0 0 200 50"`, `<title>Acme &amp; Co</title>`, `stop-color="rgb(0, 80, 200)"`,
+		`.mark{fill:url(#brand);stroke:none}`, `font-family:'Inter', sans-serif`, `<circle cx="5" cy="5" r="5"/>`,
+		`transform="translate(10, 0) rotate(5)"`, `xlink:href="#dot"`, `style="fill:#222;font-size:24px"`,
+	} {
+		assert.Contains(t, string(out), kept)
+	}
+	assert.NotContains(t, string(out), "Generator")
+
+	// Sanitized output is a fixed point
+	again, removed, err := NewSVGSanitizer().Sanitize(out)
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+	assert.Equal(t, string(out), string(again))
+}
+
+func TestAssetValidator_ValidateSVGContent(t *testing.T) {
+	validator := NewAssetValidator()
+
+	assert.True(t, validator.ValidateSVGContent([]byte(svgOpen+`<rect width="10" height="10"/></svg>`)))
+	for name, payload := range xssPayloads {
+		assert.False(t, validator.ValidateSVGContent([]byte(payload)), name)
+	}
+}
+
+// assertWellFormed checks that sanitized output parses as XML with an SVG root
+func assertWellFormed(t *testing.T, content []byte) {
+	t.Helper()
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.Strict = true
+	root := true
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return
+		}
+		require.NoError(t, err, string(content))
+		if start, ok := token.(xml.StartElement); ok && root {
+			assert.Equal(t, xml.Name{Space: "http://www.w3.org/2000/svg", Local: "svg"}, start.Name)
+			root = false
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// icoHeader is the ICONDIR magic: reserved 0, type 1 (icon)
+const icoHeader = "\x00\x00\x01\x00"
+
+// ErrInvalidICO is returned for malformed or unsupported ICO files
+var ErrInvalidICO = errors.New("invalid ICO file")
+
+func init() {
+	image.RegisterFormat("ico", icoHeader, DecodeICO, DecodeICOConfig)
+}
+
+// icoEntry is an ICONDIRENTRY
+type icoEntry struct {
+	width, height int
+	bitCount      int
+	size, offset  uint32
+}
+
+// DecodeICO decodes the largest image of an ICO file (PNG or BMP entries with 1, 4, 8, 24 or 32 bits per pixel)
+func DecodeICO(r io.Reader) (image.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := largestICOEntry(data)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(entry.offset)+uint64(entry.size) > uint64(len(data)) {
+		return nil, ErrInvalidICO
+	}
+	payload := data[entry.offset : entry.offset+entry.size]
+
+	if bytes.HasPrefix(payload, []byte("\x89PNG\r\n\x1a\n")) {
+		return png.Decode(bytes.NewReader(payload))
+	}
+	return decodeICOBitmap(payload)
+}
+
+// DecodeICOConfig returns the dimensions of the largest image of an ICO file without decoding it
+func DecodeICOConfig(r io.Reader) (image.Config, error) {
+	header := make([]byte, 6)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return image.Config{}, err
+	}
+	count := int(binary.LittleEndian.Uint16(header[4:]))
+	directory := make([]byte, 16*count)
+	if _, err := io.ReadFull(r, directory); err != nil {
+		return image.Config{}, err
+	}
+	entry, err := largestICOEntry(append(header, directory...))
+	if err != nil {
+		return image.Config{}, err
+	}
+	return image.Config{ColorModel: color.NRGBAModel, Width: entry.width, Height: entry.height}, nil
+}
+
+// largestICOEntry reads the icon directory and returns its largest (then deepest) entry
+func largestICOEntry(data []byte) (icoEntry, error) {
+	if len(data) < 6 || string(data[:4]) != icoHeader {
+		return icoEntry{}, ErrInvalidICO
+	}
+	count := int(binary.LittleEndian.Uint16(data[4:]))
+	if count == 0 || len(data) < 6+16*count {
+		return icoEntry{}, ErrInvalidICO
+	}
+
+	var best icoEntry
+	for i := 0; i < count; i++ {
+		raw := data[6+16*i:]
+		entry := icoEntry{
+			width:    int(raw[0]),
+			height:   int(raw[1]),
+			bitCount: int(binary.LittleEndian.Uint16(raw[6:])),
+			size:     binary.LittleEndian.Uint32(raw[8:]),
+			offset:   binary.LittleEndian.Uint32(raw[12:]),
+		}
+		// 0 means 256 pixels
+		if entry.width == 0 {
+			entry.width = 256
+		}
+		if entry.height == 0 {
+			entry.height = 256
+		}
+		if area, bestArea := entry.width*entry.height, best.width*best.height; area > bestArea || (area == bestArea && entry.bitCount > best.bitCount) {
+			best = entry
+		}
+	}
+	return best, nil
+}
+
+// decodeICOBitmap decodes a BMP icon entry: a BITMAPINFOHEADER, optional palette, the bottom-up color bitmap
+// and a 1-bit AND (transparency) mask; the header height covers both bitmaps.
+func decodeICOBitmap(data []byte) (image.Image, error) {
+	if len(data) < 40 {
+		return nil, ErrInvalidICO
+	}
+	headerSize := int(binary.LittleEndian.Uint32(data[0:]))
+	width := int(int32(binary.LittleEndian.Uint32(data[4:])))
+	height := int(int32(binary.LittleEndian.Uint32(data[8:]))) / 2
+	bitCount := int(binary.LittleEndian.Uint16(data[14:]))
+	compression := binary.LittleEndian.Uint32(data[16:])
+	colorsUsed := int(binary.LittleEndian.Uint32(data[32:]))
+	if headerSize < 40 || width <= 0 || height <= 0 || width > 256 || height > 256 || compression != 0 {
+		return nil, ErrInvalidICO
+	}
+
+	offset := headerSize
+	var palette []color.NRGBA
+	if bitCount <= 8 {
+		switch bitCount {
+		case 1, 4, 8:
+		default:
+			return nil, ErrInvalidICO
+		}
+		if colorsUsed == 0 || colorsUsed > 1<<bitCount {
+			colorsUsed = 1 << bitCount
+		}
+		if len(data) < offset+4*colorsUsed {
+			return nil, ErrInvalidICO
+		}
+		palette = make([]color.NRGBA, colorsUsed)
+		for i := range palette {
+			p := data[offset+4*i:]
+			palette[i] = color.NRGBA{R: p[2], G: p[1], B: p[0], A: 0xff}
+		}
+		offset += 4 * colorsUsed
+	} else if bitCount != 24 && bitCount != 32 {
+		return nil, ErrInvalidICO
+	}
+
+	stride := (width*bitCount + 31) / 32 * 4
+	maskStride := (width + 31) / 32 * 4
+	maskOffset := offset + stride*height
+	hasMask := len(data) >= maskOffset+maskStride*height
+	if len(data) < maskOffset {
+		return nil, ErrInvalidICO
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	anyAlpha := false
+	for y := 0; y < height; y++ {
+		row := data[offset+(height-1-y)*stride:]
+		for x := 0; x < width; x++ {
+			var c color.NRGBA
+			switch bitCount {
+			case 32:
+				c = color.NRGBA{R: row[4*x+2], G: row[4*x+1], B: row[4*x], A: row[4*x+3]}
+				if c.A != 0 {
+					anyAlpha = true
+				}
+			case 24:
+				c = color.NRGBA{R: row[3*x+2], G: row[3*x+1], B: row[3*x], A: 0xff}
+			default:
+				perByte := 8 / bitCount
+				shift := uint(8 - bitCount*(x%perByte+1))
+				index := int(row[x/perByte]>>shift) & (1<<bitCount - 1)
+				if index < len(palette) {
+					c = palette[index]
+				}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	// 32-bit icons carry their own alpha; older ones (and 32-bit icons with an all-zero alpha channel) use the AND mask
+	if hasMask && (bitCount != 32 || !anyAlpha) {
+		for y := 0; y < height; y++ {
+			row := data[maskOffset+(height-1-y)*maskStride:]
+			for x := 0; x < width; x++ {
+				c := img.NRGBAAt(x, y)
+				c.A = 0xff
+				if row[x/8]>>(7-uint(x%8))&1 == 1 {
+					c.A = 0
+				}
+				img.SetNRGBA(x, y, c)
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// EncodeICO writes the images as a multi-size ICO file with 32-bit BMP entries (at most 256x256 each)
+// BMP entries, unlike PNG ones, are understood by every browser and OS icon loader.
+func EncodeICO(w io.Writer, images []image.Image) error {
+	var entries [][]byte
+	for _, img := range images {
+		entry, err := encodeICOBitmap(img)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(icoHeader)
+	binary.Write(&buf, binary.LittleEndian, uint16(len(images)))
+
+	offset := 6 + 16*len(images)
+	for i, img := range images {
+		bounds := img.Bounds()
+		buf.WriteByte(byte(bounds.Dx() % 256)) // 256 is written as 0
+		buf.WriteByte(byte(bounds.Dy() % 256))
+		buf.WriteByte(0)                                    // no palette
+		buf.WriteByte(0)                                    // reserved
+		binary.Write(&buf, binary.LittleEndian, uint16(1))  // color planes
+		binary.Write(&buf, binary.LittleEndian, uint16(32)) // bits per pixel
+		binary.Write(&buf, binary.LittleEndian, uint32(len(entries[i])))
+		binary.Write(&buf, binary.LittleEndian, uint32(offset))
+		offset += len(entries[i])
+	}
+	for _, entry := range entries {
+		buf.Write(entry)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// encodeICOBitmap encodes one 32-bit BGRA icon entry with an empty AND mask (transparency comes from the alpha channel)
+func encodeICOBitmap(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > 256 || height > 256 {
+		return nil, ErrInvalidICO
+	}
+	maskStride := (width + 31) / 32 * 4
+
+	var buf bytes.Buffer
+	header := []interface{}{
+		uint32(40),        // header size
+		int32(width),      // width
+		int32(2 * height), // color bitmap and mask
+		uint16(1),         // planes
+		uint16(32),        // bits per pixel
+		uint32(0),         // no compression
+		uint32(4*width*height + maskStride*height),
+		int32(0), int32(0), // resolution
+		uint32(0), uint32(0), // palette
+	}
+	for _, field := range header {
+		binary.Write(&buf, binary.LittleEndian, field)
+	}
+
+	for y := bounds.Max.Y - 1; y >= bounds.Min.Y; y-- {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			buf.Write([]byte{c.B, c.G, c.R, c.A})
+		}
+	}
+	buf.Write(make([]byte, maskStride*height))
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+
+	"golang.org/x/image/draw"
+)
+
+// FitWithin scales img down to fit inside maxWidth x maxHeight, keeping its aspect ratio
+// Images that already fit are returned unchanged (never upscaled).
+func FitWithin(img image.Image, maxWidth, maxHeight int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxWidth && height <= maxHeight {
+		return img
+	}
+
+	scale := min(float64(maxWidth)/float64(width), float64(maxHeight)/float64(height))
+	return Resize(img, max(1, int(float64(width)*scale+0.5)), max(1, int(float64(height)*scale+0.5)))
+}
+
+// Resize scales img to exactly width x height
+func Resize(img image.Image, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+	return dst
+}
+
+// Square scales img to fit a size x size square, centered on a transparent background
+// Used for icons, which must be square even when the source is slightly off.
+func Square(img image.Image, size int) *image.NRGBA {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	scaledWidth, scaledHeight := size, size
+	if width > height {
+		scaledHeight = max(1, int(float64(height)*float64(size)/float64(width)+0.5))
+	} else if height > width {
+		scaledWidth = max(1, int(float64(width)*float64(size)/float64(height)+0.5))
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, size, size))
+	x := (size - scaledWidth) / 2
+	y := (size - scaledHeight) / 2
+	draw.CatmullRom.Scale(dst, image.Rect(x, y, x+scaledWidth, y+scaledHeight), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// Flatten composites img onto an opaque background color
+func Flatten(img image.Image, background color.Color) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,459 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"sort"
+)
+
+// WebP lossless (VP8L) bitstream constants, see RFC 9649
+const (
+	vp8lSignature              = 0x2f
+	vp8lMaxDimension           = 1 << 14
+	vp8lMaxCodeLength          = 15
+	vp8lMaxCodeLenCode         = 7
+	vp8lNumLengthCodes         = 24
+	vp8lNumDistCodes           = 40
+	vp8lMaxCopyLength          = 4096
+	vp8lMinCopyLength          = 3
+	vp8lTransformSubtractGreen = 2
+)
+
+// vp8lCodeLengthCodeOrder is the order in which the code length code lengths are written
+var vp8lCodeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// ErrImageTooLarge is returned when an image exceeds the WebP dimension limit
+var ErrImageTooLarge = errors.New("image too large for WebP")
+
+// EncodeWebP writes img as a lossless WebP (VP8L) image
+// The encoder applies the subtract-green transform and backward references to the left and upper pixel,
+// which keeps flat logo artwork small without a full LZ77 search.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxDimension || height > vp8lMaxDimension {
+		return ErrImageTooLarge
+	}
+
+	pixels := make([]uint32, 0, width*height)
+	hasAlpha := false
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A != 0xff {
+				hasAlpha = true
+			}
+			// Subtract green from red and blue
+			red := uint32(c.R - c.G)
+			blue := uint32(c.B - c.G)
+			pixels = append(pixels, uint32(c.A)<<24|red<<16|uint32(c.G)<<8|blue)
+		}
+	}
+
+	tokens := tokenizePixels(pixels, width)
+
+	var (
+		green = make([]int, 256+vp8lNumLengthCodes)
+		red   = make([]int, 256)
+		blue  = make([]int, 256)
+		alpha = make([]int, 256)
+		dist  = make([]int, vp8lNumDistCodes)
+	)
+	for _, t := range tokens {
+		if t.length == 0 {
+			green[t.pixel>>8&0xff]++
+			red[t.pixel>>16&0xff]++
+			blue[t.pixel&0xff]++
+			alpha[t.pixel>>24]++
+			continue
+		}
+		lengthCode, _, _ := prefixEncode(t.length)
+		green[256+lengthCode]++
+		distCode, _, _ := prefixEncode(t.distance)
+		dist[distCode]++
+	}
+
+	codes := []*prefixCode{
+		newPrefixCode(green, vp8lMaxCodeLength),
+		newPrefixCode(red, vp8lMaxCodeLength),
+		newPrefixCode(blue, vp8lMaxCodeLength),
+		newPrefixCode(alpha, vp8lMaxCodeLength),
+		newPrefixCode(dist, vp8lMaxCodeLength),
+	}
+
+	bw := &bitWriter{}
+	bw.write(vp8lSignature, 8)
+	bw.write(uint32(width-1), 14)
+	bw.write(uint32(height-1), 14)
+	if hasAlpha {
+		bw.write(1, 1)
+	} else {
+		bw.write(0, 1)
+	}
+	bw.write(0, 3) // version
+
+	bw.write(1, 1) // transform present
+	bw.write(vp8lTransformSubtractGreen, 2)
+	bw.write(0, 1) // no more transforms
+
+	bw.write(0, 1) // no color cache
+	bw.write(0, 1) // a single prefix code group
+
+	for _, code := range codes {
+		code.writeTo(bw)
+	}
+
+	for _, t := range tokens {
+		if t.length == 0 {
+			codes[0].writeSymbol(bw, int(t.pixel>>8&0xff))
+			codes[1].writeSymbol(bw, int(t.pixel>>16&0xff))
+			codes[2].writeSymbol(bw, int(t.pixel&0xff))
+			codes[3].writeSymbol(bw, int(t.pixel>>24))
+			continue
+		}
+		lengthCode, extraBits, extra := prefixEncode(t.length)
+		codes[0].writeSymbol(bw, 256+lengthCode)
+		bw.write(extra, extraBits)
+		distCode, extraBits, extra := prefixEncode(t.distance)
+		codes[4].writeSymbol(bw, distCode)
+		bw.write(extra, extraBits)
+	}
+
+	data := bw.bytes()
+	chunkSize := len(data)
+	padded := chunkSize + chunkSize&1
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(4+8+padded))
+	buf.WriteString("WEBPVP8L")
+	binary.Write(&buf, binary.LittleEndian, uint32(chunkSize))
+	buf.Write(data)
+	if chunkSize&1 == 1 {
+		buf.WriteByte(0)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// vp8lToken is a literal pixel (length 0) or a backward reference
+type vp8lToken struct {
+	pixel    uint32
+	length   int
+	distance int // Distance code: 1 is the pixel above, 2 the pixel to the left
+}
+
+// tokenizePixels replaces runs that repeat the left or upper pixel with backward references
+func tokenizePixels(pixels []uint32, width int) []vp8lToken {
+	tokens := make([]vp8lToken, 0, len(pixels)/4)
+	for i := 0; i < len(pixels); {
+		bestLength, bestDistance := 0, 0
+		if i >= 1 {
+			if n := matchLength(pixels, i, 1); n > bestLength {
+				bestLength, bestDistance = n, 2
+			}
+		}
+		if i >= width {
+			if n := matchLength(pixels, i, width); n > bestLength {
+				bestLength, bestDistance = n, 1
+			}
+		}
+
+		if bestLength >= vp8lMinCopyLength {
+			tokens = append(tokens, vp8lToken{length: bestLength, distance: bestDistance})
+			i += bestLength
+			continue
+		}
+		tokens = append(tokens, vp8lToken{pixel: pixels[i]})
+		i++
+	}
+	return tokens
+}
+
+// matchLength returns how many pixels from i on repeat the pixels offset positions back
+func matchLength(pixels []uint32, i, offset int) int {
+	n := 0
+	for i+n < len(pixels) && n < vp8lMaxCopyLength && pixels[i+n] == pixels[i+n-offset] {
+		n++
+	}
+	return n
+}
+
+// prefixEncode splits a length or distance code value into its prefix symbol and extra bits
+func prefixEncode(value int) (symbol int, extraBits uint, extra uint32) {
+	d := value - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	highest := 0
+	for d>>(highest+1) != 0 {
+		highest++
+	}
+	second := (d >> (highest - 1)) & 1
+	extraBits = uint(highest - 1)
+	return 2*highest + second, extraBits, uint32(d) & (1<<extraBits - 1)
+}
+
+// prefixCode is a canonical Huffman code over one alphabet
+type prefixCode struct {
+	lengths []int
+	codes   []uint32 // Bit-reversed, ready to be written LSB first
+	simple  []int    // Symbols of a simple code (at most two symbols below 256), nil for a normal code
+}
+
+// newPrefixCode builds a length-limited Huffman code for the given symbol counts
+func newPrefixCode(counts []int, maxLength int) *prefixCode {
+	var used []int
+	for symbol, count := range counts {
+		if count > 0 {
+			used = append(used, symbol)
+		}
+	}
+
+	code := &prefixCode{lengths: make([]int, len(counts)), codes: make([]uint32, len(counts))}
+	if len(used) == 0 {
+		used = []int{0}
+	}
+	if len(used) <= 2 && used[len(used)-1] < 256 {
+		code.simple = used
+		if len(used) == 2 {
+			code.lengths[used[0]], code.lengths[used[1]] = 1, 1
+			code.codes[used[1]] = 1
+		}
+		return code
+	}
+
+	code.lengths = huffmanLengths(counts, maxLength)
+	code.codes = canonicalCodes(code.lengths)
+	return code
+}
+
+// writeTo writes the code definition
+func (c *prefixCode) writeTo(bw *bitWriter) {
+	if c.simple != nil {
+		bw.write(1, 1)
+		bw.write(uint32(len(c.simple)-1), 1)
+		if c.simple[0] < 2 {
+			bw.write(0, 1)
+			bw.write(uint32(c.simple[0]), 1)
+		} else {
+			bw.write(1, 1)
+			bw.write(uint32(c.simple[0]), 8)
+		}
+		if len(c.simple) == 2 {
+			bw.write(uint32(c.simple[1]), 8)
+		}
+		return
+	}
+
+	tokens, extras := codeLengthTokens(c.lengths)
+	counts := make([]int, 19)
+	for _, t := range tokens {
+		counts[t]++
+	}
+	lengthCode := huffmanLengths(counts, vp8lMaxCodeLenCode)
+	lengthCodes := canonicalCodes(lengthCode)
+
+	numCodes := 19
+	for numCodes > 4 && lengthCode[vp8lCodeLengthCodeOrder[numCodes-1]] == 0 {
+		numCodes--
+	}
+
+	bw.write(0, 1) // normal code
+	bw.write(uint32(numCodes-4), 4)
+	for i := 0; i < numCodes; i++ {
+		bw.write(uint32(lengthCode[vp8lCodeLengthCodeOrder[i]]), 3)
+	}
+	bw.write(0, 1) // lengths are given for the whole alphabet
+
+	for i, t := range tokens {
+		bw.write(lengthCodes[t], uint(lengthCode[t]))
+		switch t {
+		case 16:
+			bw.write(uint32(extras[i]-3), 2)
+		case 17:
+			bw.write(uint32(extras[i]-3), 3)
+		case 18:
+			bw.write(uint32(extras[i]-11), 7)
+		}
+	}
+}
+
+// writeSymbol writes the code of a symbol (nothing for a single-symbol code)
+func (c *prefixCode) writeSymbol(bw *bitWriter, symbol int) {
+	bw.write(c.codes[symbol], uint(c.lengths[symbol]))
+}
+
+// codeLengthTokens run-length encodes code lengths with the code length alphabet
+// (0-15 literal lengths, 16 repeats the previous length, 17 and 18 repeat zero); extras holds the repeat counts.
+func codeLengthTokens(lengths []int) (tokens []int, extras []int) {
+	for i := 0; i < len(lengths); {
+		value := lengths[i]
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == value {
+			run++
+		}
+		i += run
+
+		if value == 0 {
+			for run >= 11 {
+				n := min(run, 138)
+				tokens, extras = append(tokens, 18), append(extras, n)
+				run -= n
+			}
+			if run >= 3 {
+				tokens, extras = append(tokens, 17), append(extras, run)
+				run = 0
+			}
+		} else {
+			tokens, extras = append(tokens, value), append(extras, 0)
+			run--
+			for run >= 3 {
+				n := min(run, 6)
+				tokens, extras = append(tokens, 16), append(extras, n)
+				run -= n
+			}
+		}
+		for ; run > 0; run-- {
+			tokens, extras = append(tokens, value), append(extras, 0)
+		}
+	}
+	return tokens, extras
+}
+
+// huffmanLengths computes Huffman code lengths no longer than maxLength
+// Small counts are raised until the tree fits; at least two symbols always get a code so the code is complete.
+func huffmanLengths(counts []int, maxLength int) []int {
+	var used []int
+	for symbol, count := range counts {
+		if count > 0 {
+			used = append(used, symbol)
+		}
+	}
+	for symbol := 0; len(used) < 2; symbol++ {
+		if counts[symbol] == 0 {
+			used = append(used, symbol)
+		}
+	}
+	sort.Ints(used)
+
+	for minCount := 1; ; minCount *= 2 {
+		lengths := make([]int, len(counts))
+		type node struct {
+			count       int
+			symbol      int // -1 for internal nodes
+			left, right int
+		}
+		nodes := make([]node, 0, 2*len(used))
+		for _, symbol := range used {
+			nodes = append(nodes, node{count: max(counts[symbol], minCount), symbol: symbol})
+		}
+
+		// Repeatedly merge the two lightest trees
+		active := make([]int, len(nodes))
+		for i := range active {
+			active[i] = i
+		}
+		for len(active) > 1 {
+			sort.Slice(active, func(a, b int) bool {
+				na, nb := nodes[active[a]], nodes[active[b]]
+				if na.count != nb.count {
+					return na.count < nb.count
+				}
+				return active[a] < active[b]
+			})
+			nodes = append(nodes, node{count: nodes[active[0]].count + nodes[active[1]].count, symbol: -1, left: active[0], right: active[1]})
+			active = append([]int{len(nodes) - 1}, active[2:]...)
+		}
+
+		tooLong := false
+		var walk func(index, depth int)
+		walk = func(index, depth int) {
+			n := nodes[index]
+			if n.symbol >= 0 {
+				lengths[n.symbol] = depth
+				if depth > maxLength {
+					tooLong = true
+				}
+				return
+			}
+			walk(n.left, depth+1)
+			walk(n.right, depth+1)
+		}
+		walk(active[0], 0)
+
+		if !tooLong {
+			return lengths
+		}
+	}
+}
+
+// canonicalCodes assigns canonical Huffman codes to code lengths, bit-reversed for LSB-first writing
+func canonicalCodes(lengths []int) []uint32 {
+	maxLength := 0
+	for _, length := range lengths {
+		maxLength = max(maxLength, length)
+	}
+	countPerLength := make([]uint32, maxLength+1)
+	for _, length := range lengths {
+		if length > 0 {
+			countPerLength[length]++
+		}
+	}
+	next := make([]uint32, maxLength+2)
+	code := uint32(0)
+	for length := 1; length <= maxLength; length++ {
+		code = (code + countPerLength[length-1]) << 1
+		next[length] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for symbol, length := range lengths {
+		if length == 0 {
+			continue
+		}
+		codes[symbol] = reverseBits(next[length], length)
+		next[length]++
+	}
+	return codes
+}
+
+// reverseBits reverses the lowest n bits of code
+func reverseBits(code uint32, n int) uint32 {
+	var reversed uint32
+	for i := 0; i < n; i++ {
+		reversed = reversed<<1 | code&1
+		code >>= 1
+	}
+	return reversed
+}
+
+// bitWriter packs bits LSB first, as the VP8L bitstream requires
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (b *bitWriter) write(value uint32, n uint) {
+	b.acc |= uint64(value&(1<<n-1)) << b.nbits
+	b.nbits += n
+	for b.nbits >= 8 {
+		b.buf = append(b.buf, byte(b.acc))
+		b.acc >>= 8
+		b.nbits -= 8
+	}
+}
+
+func (b *bitWriter) bytes() []byte {
+	if b.nbits > 0 {
+		b.buf = append(b.buf, byte(b.acc))
+		b.acc, b.nbits = 0, 0
+	}
+	return b.buf
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebP_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	flat := image.NewNRGBA(image.Rect(0, 0, 300, 120))
+	for y := 0; y < 120; y++ {
+		for x := 0; x < 300; x++ {
+			switch {
+			case x > 40 && x < 260 && y > 30 && y < 90:
+				flat.SetNRGBA(x, y, color.NRGBA{R: 0x25, G: 0x63, B: 0xeb, A: 0xff})
+			case (x+y)%17 == 0:
+				flat.SetNRGBA(x, y, color.NRGBA{R: 0xf5, G: 0x9e, B: 0x0b, A: 0x80})
+			}
+		}
+	}
+
+	noisy := image.NewNRGBA(image.Rect(0, 0, 97, 61))
+	for i := range noisy.Pix {
+		noisy.Pix[i] = byte(rng.Intn(256))
+	}
+
+	opaque := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	opaque.SetNRGBA(0, 0, color.NRGBA{R: 1, G: 2, B: 3, A: 0xff})
+
+	gray := image.NewGray(image.Rect(0, 0, 64, 64))
+	for i := range gray.Pix {
+		gray.Pix[i] = byte(i / 64 * 4)
+	}
+
+	for name, img := range map[string]image.Image{"flat": flat, "noisy": noisy, "single pixel": opaque, "gray": gray} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, EncodeWebP(&buf, img))
+
+			decoded, err := webp.Decode(bytes.NewReader(buf.Bytes()))
+			require.NoError(t, err)
+			require.Equal(t, img.Bounds().Size(), decoded.Bounds().Size())
+
+			for y := 0; y < img.Bounds().Dy(); y++ {
+				for x := 0; x < img.Bounds().Dx(); x++ {
+					want := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+					got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+					if want.A == 0 {
+						assert.Zero(t, got.A)
+						continue
+					}
+					require.Equal(t, want, got, "pixel %d,%d", x, y)
+				}
+			}
+		})
+	}
+
+	var flatWebP bytes.Buffer
+	require.NoError(t, EncodeWebP(&flatWebP, flat))
+	assert.Less(t, flatWebP.Len(), len(flat.Pix)/10, "flat artwork should compress well")
+}
@@ -0,0 +1,192 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
+	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FileRepository implements the outbound.FileRepository interface
+type FileRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewFileRepository creates a new PostgreSQL file repository
+func NewFileRepository(db *pgxpool.Pool) outbound.FileRepository {
+	return &FileRepository{
+		db: db,
+	}
+}
+
+// fileColumns are the columns scanned by scanFile, in order
+const fileColumns = `id, tenant_id, object_key, asset, uploaded_by, content_type, size_bytes, checksum, status,
+		       created_at, updated_at, uploaded_at, deleted_at`
+
+// FindByKey finds the file registered for an object key
+func (r *FileRepository) FindByKey(ctx context.Context, key string) (*model.File, error) {
+	query := `SELECT ` + fileColumns + ` FROM files WHERE object_key = $1`
+
+	return scanFile(r.db.QueryRow(ctx, query, key))
+}
+
+// Save creates or replaces the file registered for its key
+func (r *FileRepository) Save(ctx context.Context, file *model.File) error {
+	query := `
+		INSERT INTO files (id, tenant_id, object_key, asset, uploaded_by, content_type, size_bytes, checksum, status,
+		                   created_at, updated_at, uploaded_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (object_key) DO UPDATE SET
+			asset = EXCLUDED.asset,
+			uploaded_by = EXCLUDED.uploaded_by,
+			content_type = EXCLUDED.content_type,
+			size_bytes = EXCLUDED.size_bytes,
+			checksum = EXCLUDED.checksum,
+			status = EXCLUDED.status,
+			updated_at = EXCLUDED.updated_at,
+			uploaded_at = EXCLUDED.uploaded_at,
+			deleted_at = EXCLUDED.deleted_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		file.ID(),
+		file.TenantID(),
+		file.Key(),
+		file.Asset(),
+		file.UploadedBy(),
+		file.ContentType(),
+		file.Size(),
+		file.Checksum(),
+		string(file.Status()),
+		file.CreatedAt(),
+		file.UpdatedAt(),
+		file.UploadedAt(),
+		file.DeletedAt(),
+	)
+	return err
+}
+
+// Update updates a file's metadata and status
+func (r *FileRepository) Update(ctx context.Context, file *model.File) error {
+	query := `
+		UPDATE files
+		SET content_type = $2, size_bytes = $3, checksum = $4, status = $5, updated_at = $6, uploaded_at = $7,
+		    deleted_at = $8
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		file.ID(),
+		file.ContentType(),
+		file.Size(),
+		file.Checksum(),
+		string(file.Status()),
+		file.UpdatedAt(),
+		file.UploadedAt(),
+		file.DeletedAt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrFileNotFound
+	}
+
+	return nil
+}
+
+// ListByTenantID lists a tenant's files, newest first
+func (r *FileRepository) ListByTenantID(ctx context.Context, tenantID uuid.UUID, filter outbound.FileFilter) ([]*model.File, error) {
+	query := `SELECT ` + fileColumns + ` FROM files WHERE tenant_id = $1`
+	args := []interface{}{tenantID}
+
+	if filter.Status != "" {
+		args = append(args, string(filter.Status))
+		query += fmt.Sprintf(" AND status = $%d", len(args))
+	} else {
+		query += " AND status <> 'deleted'"
+	}
+	if filter.AssetType != "" {
+		args = append(args, filter.AssetType)
+		query += fmt.Sprintf(" AND split_part(asset, '.', 1) = $%d", len(args))
+	}
+	if filter.UploadedBy != nil {
+		args = append(args, *filter.UploadedBy)
+		query += fmt.Sprintf(" AND uploaded_by = $%d", len(args))
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	files := []*model.File{}
+	for rows.Next() {
+		file, err := scanFile(rows)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return files, rows.Err()
+}
+
+// scanFile scans a files row
+func scanFile(row pgx.Row) (*model.File, error) {
+	var (
+		id, tenantID                      uuid.UUID
+		key, asset, contentType, checksum string
+		uploadedBy                        *uuid.UUID
+		size                              int64
+		status                            string
+		createdAt, updatedAt              time.Time
+		uploadedAt, deletedAt             *time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&tenantID,
+		&key,
+		&asset,
+		&uploadedBy,
+		&contentType,
+		&size,
+		&checksum,
+		&status,
+		&createdAt,
+		&updatedAt,
+		&uploadedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrFileNotFound
+		}
+		return nil, err
+	}
+
+	return model.NewFileWithID(
+		id, tenantID,
+		key, asset,
+		uploadedBy,
+		contentType,
+		size,
+		checksum,
+		model.FileStatus(status),
+		createdAt, updatedAt,
+		uploadedAt, deletedAt,
+	), nil
+}
@@ -2,11 +2,15 @@ package gcs
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"farohq-core-app/internal/domains/files/domain"
 	"farohq-core-app/internal/domains/files/domain/ports/outbound"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -80,6 +84,124 @@ func (s *Storage) GeneratePresignedURL(ctx context.Context, bucket, key string,
 	return url, headers, nil
 }
 
+// GenerateDownloadURL generates a signed URL for downloading a file from GCS
+func (s *Storage) GenerateDownloadURL(ctx context.Context, bucket, key string, expiresIn time.Duration) (string, error) {
+	// Use provided bucket or default to instance bucket
+	targetBucket := bucket
+	if targetBucket == "" {
+		targetBucket = s.bucket
+	}
+
+	url, err := s.client.Bucket(targetBucket).SignedURL(key, &storage.SignedURLOptions{
+		Method:  "GET",
+		Expires: time.Now().Add(expiresIn),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate signed download URL: %w", err)
+	}
+
+	return url, nil
+}
+
+// DownloadFile opens a GCS object for reading
+func (s *Storage) DownloadFile(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	// Use provided bucket or default to instance bucket
+	targetBucket := bucket
+	if targetBucket == "" {
+		targetBucket = s.bucket
+	}
+
+	reader, err := s.client.Bucket(targetBucket).Object(key).NewReader(ctx)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, fmt.Errorf("file not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	return reader, nil
+}
+
+// UploadFile writes content to a GCS object
+func (s *Storage) UploadFile(ctx context.Context, bucket, key string, content io.Reader, contentType string) error {
+	// Use provided bucket or default to instance bucket
+	targetBucket := bucket
+	if targetBucket == "" {
+		targetBucket = s.bucket
+	}
+
+	writer := s.client.Bucket(targetBucket).Object(key).NewWriter(ctx)
+	writer.ContentType = contentType
+
+	if _, err := io.Copy(writer, content); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finalize upload: %w", err)
+	}
+
+	return nil
+}
+
+// StatObject reads a GCS object's attributes
+func (s *Storage) StatObject(ctx context.Context, bucket, key string) (*outbound.ObjectInfo, error) {
+	// Use provided bucket or default to instance bucket
+	targetBucket := bucket
+	if targetBucket == "" {
+		targetBucket = s.bucket
+	}
+
+	attrs, err := s.client.Bucket(targetBucket).Object(key).Attrs(ctx)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, domain.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	info := &outbound.ObjectInfo{
+		Key:         attrs.Name,
+		Size:        attrs.Size,
+		ContentType: attrs.ContentType,
+		UpdatedAt:   attrs.Updated,
+	}
+	if len(attrs.MD5) > 0 {
+		info.Checksum = base64.StdEncoding.EncodeToString(attrs.MD5)
+	}
+	return info, nil
+}
+
+// ListObjects lists all objects in the GCS bucket whose key starts with prefix
+func (s *Storage) ListObjects(ctx context.Context, bucket, prefix string) ([]outbound.ObjectInfo, error) {
+	// Use provided bucket or default to instance bucket
+	targetBucket := bucket
+	if targetBucket == "" {
+		targetBucket = s.bucket
+	}
+
+	var objects []outbound.ObjectInfo
+	it := s.client.Bucket(targetBucket).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+		objects = append(objects, outbound.ObjectInfo{
+			Key:         attrs.Name,
+			Size:        attrs.Size,
+			ContentType: attrs.ContentType,
+			UpdatedAt:   attrs.Updated,
+		})
+	}
+
+	return objects, nil
+}
+
 // DeleteFile deletes a file from GCS bucket
 func (s *Storage) DeleteFile(ctx context.Context, bucket, key string) error {
 	// Use provided bucket or default to instance bucket
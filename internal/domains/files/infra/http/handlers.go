@@ -3,68 +3,155 @@ package http
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
 	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/model"
 	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	users_outbound "farohq-core-app/internal/domains/users/domain/ports/outbound"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/tenant"
 )
 
 // Handlers provides HTTP handlers for the files domain
 type Handlers struct {
-	logger     zerolog.Logger
-	signUpload inbound.SignUpload
-	deleteFile inbound.DeleteFile
+	logger            zerolog.Logger
+	signUpload        inbound.SignUpload
+	finalizeUpload    inbound.FinalizeUpload
+	completeUpload    inbound.CompleteUpload
+	listFiles         inbound.ListFiles
+	deleteFile        inbound.DeleteFile
+	userRepo          users_outbound.UserRepository // Resolves the uploader recorded in the file registry
+	notificationToken string                        // Shared secret of storage notifications; rejected when empty
 }
 
 // NewHandlers creates new files HTTP handlers
 func NewHandlers(
 	logger zerolog.Logger,
 	signUpload inbound.SignUpload,
+	finalizeUpload inbound.FinalizeUpload,
+	completeUpload inbound.CompleteUpload,
+	listFiles inbound.ListFiles,
 	deleteFile inbound.DeleteFile,
+	userRepo users_outbound.UserRepository,
+	notificationToken string,
 ) *Handlers {
 	return &Handlers{
-		logger:     logger,
-		signUpload: signUpload,
-		deleteFile: deleteFile,
+		logger:            logger,
+		signUpload:        signUpload,
+		finalizeUpload:    finalizeUpload,
+		completeUpload:    completeUpload,
+		listFiles:         listFiles,
+		deleteFile:        deleteFile,
+		userRepo:          userRepo,
+		notificationToken: notificationToken,
 	}
 }
 
 // ListFilesHandler handles GET /api/v1/files
-// Returns an empty list as file listing is not yet implemented
+// Lists the tenant's registered files, newest first; deleted files are only listed with status=deleted.
 func (h *Handlers) ListFilesHandler(w http.ResponseWriter, r *http.Request) {
-	// TODO: Implement file listing use case
-	// For now, return empty list to satisfy API contract
+	tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+	if !ok {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
+		return
+	}
+
+	query := r.URL.Query()
+	req := &inbound.ListFilesRequest{
+		AgencyID:  tenantID,
+		Status:    query.Get("status"),
+		AssetType: query.Get("asset_type"),
+	}
+
+	if uploadedBy := query.Get("uploaded_by"); uploadedBy != "" {
+		userID, err := uuid.Parse(uploadedBy)
+		if err != nil {
+			httpserver.WriteInvalidField(w, r, "uploaded_by", "uploaded_by must be a user ID")
+			return
+		}
+		req.UploadedBy = &userID
+	}
+	if limitParam := query.Get("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 {
+			httpserver.WriteBadRequest(w, r, "limit must be a positive integer")
+			return
+		}
+		req.Limit = limit
+	}
+	if offsetParam := query.Get("offset"); offsetParam != "" {
+		offset, err := strconv.Atoi(offsetParam)
+		if err != nil || offset < 0 {
+			httpserver.WriteBadRequest(w, r, "offset must be a non-negative integer")
+			return
+		}
+		req.Offset = offset
+	}
+
+	resp, err := h.listFiles.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, err, "Failed to list files")
+		return
+	}
+
+	files := make([]map[string]interface{}, len(resp.Files))
+	for i, file := range resp.Files {
+		files[i] = fileResponse(file)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode([]interface{}{})
+	json.NewEncoder(w).Encode(files)
 }
 
 // SignHandler handles POST /api/v1/files/sign
+// The upload is registered as pending in the file registry until it is completed.
 func (h *Handlers) SignHandler(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		AgencyID string `json:"agency_id"`
-		Asset    string `json:"asset"`
+		AgencyID    string `json:"agency_id"`
+		Asset       string `json:"asset"`
+		ContentType string `json:"content_type"`
+		Size        int64  `json:"size"`
+		Checksum    string `json:"checksum"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
+	if req.Size < 0 {
+		httpserver.WriteInvalidField(w, r, "size", "size must not be negative")
+		return
+	}
+
+	// Uploads are signed for the resolved tenant; agency_id may be omitted but cannot name another tenant
+	if tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context()); ok {
+		if req.AgencyID == "" {
+			req.AgencyID = tenantID.String()
+		} else if agencyID, err := uuid.Parse(req.AgencyID); err != nil || agencyID != tenantID {
+			h.writeError(w, r, domain.ErrInvalidAgencyID, "Failed to generate presigned URL")
+			return
+		}
+	}
+
 	signReq := &inbound.SignUploadRequest{
-		AgencyID: req.AgencyID,
-		Asset:    req.Asset,
+		AgencyID:    req.AgencyID,
+		Asset:       req.Asset,
+		UploadedBy:  h.actorUserID(r),
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		Checksum:    req.Checksum,
 	}
 
 	resp, err := h.signUpload.Execute(r.Context(), signReq)
 	if err != nil {
-		if err == domain.ErrInvalidAgencyID || err == domain.ErrInvalidAsset {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to generate presigned URL")
-		http.Error(w, "Failed to generate presigned URL", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to generate presigned URL")
 		return
 	}
 
@@ -72,26 +159,114 @@ func (h *Handlers) SignHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// CompleteHandler handles POST /api/v1/files/complete
+// Called after the client has uploaded to the signed URL: confirms the object with a HEAD request
+// and marks the registered file uploaded.
+func (h *Handlers) CompleteHandler(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+	if !ok {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
+		return
+	}
+
+	var req struct {
+		Key string `json:"key"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	if req.Key == "" {
+		httpserver.WriteMissingField(w, r, "key", "file key is required")
+		return
+	}
+
+	resp, err := h.completeUpload.Execute(r.Context(), &inbound.CompleteUploadRequest{
+		AgencyID: tenantID,
+		Key:      req.Key,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to complete upload")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(fileResponse(resp.File))
+}
+
+// FinalizeHandler handles POST /api/v1/files/finalize
+// Called after the client has uploaded to the signed URL: validates the image, generates its derivatives
+// and writes their URLs onto the tenant's branding.
+func (h *Handlers) FinalizeHandler(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+	if !ok {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
+		return
+	}
+
+	var req struct {
+		Key string `json:"key"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	if req.Key == "" {
+		httpserver.WriteMissingField(w, r, "key", "file key is required")
+		return
+	}
+
+	resp, err := h.finalizeUpload.Execute(r.Context(), &inbound.FinalizeUploadRequest{
+		AgencyID: tenantID,
+		Key:      req.Key,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to finalize upload")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	removed := resp.Removed
+	if removed == nil {
+		removed = []string{}
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"key":        resp.Key,
+		"asset_type": resp.AssetType,
+		"width":      resp.Width,
+		"height":     resp.Height,
+		"urls":       resp.URLs,
+		"removed":    removed,
+	})
+}
+
 // DeleteFileHandler handles DELETE /api/v1/files/{key}
+// Keys contain slashes, so they are sent URL-encoded.
 func (h *Handlers) DeleteFileHandler(w http.ResponseWriter, r *http.Request) {
-	key := chi.URLParam(r, "key")
-	if key == "" {
-		http.Error(w, "file key is required", http.StatusBadRequest)
+	tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+	if !ok {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
+		return
+	}
+
+	key, err := url.PathUnescape(chi.URLParam(r, "key"))
+	if err != nil || key == "" {
+		httpserver.WriteMissingField(w, r, "key", "file key is required")
 		return
 	}
 
 	deleteReq := &inbound.DeleteFileRequest{
-		Key: key,
+		AgencyID: tenantID,
+		Key:      key,
 	}
 
 	resp, err := h.deleteFile.Execute(r.Context(), deleteReq)
 	if err != nil {
-		if err == domain.ErrFileNotFound {
-			http.Error(w, "File not found", http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to delete file")
-		http.Error(w, "Failed to delete file", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to delete file")
 		return
 	}
 
@@ -100,3 +275,41 @@ func (h *Handlers) DeleteFileHandler(w http.ResponseWriter, r *http.Request) {
 		"success": resp.Success,
 	})
 }
+
+// actorUserID returns the database ID of the authenticated user, or nil when they cannot be resolved
+func (h *Handlers) actorUserID(r *http.Request) *uuid.UUID {
+	clerkUserID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		return nil
+	}
+	user, err := h.userRepo.FindByClerkUserID(r.Context(), clerkUserID)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("clerk_user_id", clerkUserID).Msg("Uploader not found in users table, registering file without uploader")
+		return nil
+	}
+	id := user.ID()
+	return &id
+}
+
+// fileResponse builds the JSON for a registered file
+func fileResponse(file *model.File) map[string]interface{} {
+	var uploadedBy *string
+	if file.UploadedBy() != nil {
+		id := file.UploadedBy().String()
+		uploadedBy = &id
+	}
+
+	return map[string]interface{}{
+		"id":           file.ID().String(),
+		"key":          file.Key(),
+		"asset":        file.Asset(),
+		"status":       file.Status(),
+		"content_type": file.ContentType(),
+		"size":         file.Size(),
+		"checksum":     file.Checksum(),
+		"uploaded_by":  uploadedBy,
+		"created_at":   file.CreatedAt(),
+		"uploaded_at":  file.UploadedAt(),
+		"deleted_at":   file.DeletedAt(),
+	}
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/domains/files/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// maxNotificationBodyBytes bounds the size of an accepted storage notification
+const maxNotificationBodyBytes = 1 << 20
+
+// storageNotification is the union of a GCS Pub/Sub push envelope, an S3 event notification and the SNS
+// envelope S3 events are delivered in (whose Message holds the S3 event as a string)
+type storageNotification struct {
+	Message *struct {
+		Attributes map[string]string `json:"attributes"`
+	} `json:"message"`
+	Type         string `json:"Type"`
+	SNSMessage   string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+	Records      []struct {
+		EventName string `json:"eventName"`
+		S3        struct {
+			Object struct {
+				Key string `json:"key"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// createdKeys returns the keys of the objects the notification reports as created
+func (n *storageNotification) createdKeys() []string {
+	var keys []string
+	if n.Message != nil && n.Message.Attributes["eventType"] == "OBJECT_FINALIZE" {
+		if key := n.Message.Attributes["objectId"]; key != "" {
+			keys = append(keys, key)
+		}
+	}
+	for _, record := range n.Records {
+		if !strings.HasPrefix(record.EventName, "ObjectCreated") {
+			continue
+		}
+		// S3 URL-encodes keys in event notifications
+		if key, err := url.QueryUnescape(record.S3.Object.Key); err == nil && key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// StorageNotificationHandler handles POST /api/v1/webhooks/storage
+// Confirms uploads reported by GCS (Pub/Sub push) or S3 (via SNS/EventBridge) object-created notifications,
+// so files are registered as uploaded even when the client never calls /files/complete.
+func (h *Handlers) StorageNotificationHandler(w http.ResponseWriter, r *http.Request) {
+	if h.notificationToken == "" {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusServiceUnavailable, httpserver.ProblemCodeUnavailable, "Storage notifications are not configured"))
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		token = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.notificationToken)) != 1 {
+		httpserver.WriteUnauthorized(w, r, "invalid_notification_token", "Invalid notification token")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxNotificationBodyBytes+1))
+	if err != nil || len(body) > maxNotificationBodyBytes {
+		httpserver.WriteBadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	var notification storageNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	switch notification.Type {
+	case "SubscriptionConfirmation":
+		// Subscriptions are confirmed by an operator, so a forged confirmation cannot subscribe the endpoint
+		h.logger.Warn().Str("subscribe_url", notification.SubscribeURL).Msg("SNS subscription to storage notifications awaits confirmation")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case "Notification":
+		var event storageNotification
+		if err := json.Unmarshal([]byte(notification.SNSMessage), &event); err != nil {
+			httpserver.WriteInvalidJSON(w, r)
+			return
+		}
+		notification = event
+	}
+
+	for _, key := range notification.createdKeys() {
+		_, err := h.completeUpload.Execute(r.Context(), &inbound.CompleteUploadRequest{
+			AgencyID: uuid.Nil, // Notifications are not scoped to a tenant; the key identifies the file
+			Key:      key,
+		})
+		if err != nil {
+			// Objects that were not signed here, or that no longer match, are acknowledged so they are not redelivered
+			if err == domain.ErrFileNotFound || err == domain.ErrUploadIncomplete ||
+				err == domain.ErrUploadMismatch || err == domain.ErrFileTooLarge {
+				h.logger.Warn().Err(err).Str("key", key).Msg("Ignored storage notification")
+				continue
+			}
+			h.writeError(w, r, err, "Failed to complete upload")
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
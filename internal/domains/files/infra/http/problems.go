@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	"farohq-core-app/internal/domains/files/domain"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// problems maps files domain errors to problem responses
+var problems = httpserver.NewErrorMapper(
+	httpserver.ErrorMapping{Err: domain.ErrFileNotFound, Status: http.StatusNotFound, Code: "file_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidAsset, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "asset"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidAgencyID, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "agency_id"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidKey, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "key"},
+	httpserver.ErrorMapping{Err: domain.ErrUnsupportedImage, Status: http.StatusBadRequest, Code: "unsupported_image"},
+	httpserver.ErrorMapping{Err: domain.ErrFileTooLarge, Status: http.StatusBadRequest, Code: "file_too_large"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidImageDimensions, Status: http.StatusBadRequest, Code: "invalid_image_dimensions"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidAspectRatio, Status: http.StatusBadRequest, Code: "invalid_aspect_ratio"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidSVG, Status: http.StatusBadRequest, Code: "invalid_svg"},
+	httpserver.ErrorMapping{Err: domain.ErrSVGTooComplex, Status: http.StatusBadRequest, Code: "svg_too_complex"},
+	httpserver.ErrorMapping{Err: domain.ErrBrandingNotFound, Status: http.StatusNotFound, Code: "branding_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrUploadIncomplete, Status: http.StatusConflict, Code: "upload_incomplete"},
+	httpserver.ErrorMapping{Err: domain.ErrUploadMismatch, Status: http.StatusConflict, Code: "upload_mismatch"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidChecksum, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "checksum"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidFileStatus, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "status"},
+)
+
+// writeError writes the problem mapped from a domain error
+// Unmapped errors are logged with msg and reported as a 500 without exposing the cause.
+func (h *Handlers) writeError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	if problem := problems.Problem(err); problem != nil {
+		httpserver.WriteProblem(w, r, problem)
+		return
+	}
+	h.logger.Error().Err(err).Msg(msg)
+	httpserver.WriteInternalError(w, r)
+}
@@ -7,8 +7,15 @@ import (
 // RegisterRoutes registers all files domain routes
 func (h *Handlers) RegisterRoutes(r chi.Router) {
 	r.Route("/files", func(r chi.Router) {
-		r.Get("/", h.ListFilesHandler) // List files (placeholder - returns empty list)
+		r.Get("/", h.ListFilesHandler)
 		r.Post("/sign", h.SignHandler)
+		r.Post("/complete", h.CompleteHandler)
+		r.Post("/finalize", h.FinalizeHandler)
 		r.Delete("/{key}", h.DeleteFileHandler)
 	})
 }
+
+// RegisterPublicRoutes registers public routes (authenticated by notification token, not by session)
+func (h *Handlers) RegisterPublicRoutes(r chi.Router) {
+	r.Post("/webhooks/storage", h.StorageNotificationHandler)
+}
@@ -2,7 +2,12 @@ package s3
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -11,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
+	"farohq-core-app/internal/domains/files/domain"
 	"farohq-core-app/internal/domains/files/domain/ports/outbound"
 )
 
@@ -90,6 +96,106 @@ func (s *Storage) GeneratePresignedURL(ctx context.Context, bucket, key string,
 	return request.URL, headers, nil
 }
 
+// GenerateDownloadURL generates a pre-signed URL for downloading a file
+func (s *Storage) GenerateDownloadURL(ctx context.Context, bucket, key string, expiresIn time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(s.client)
+
+	request, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expiresIn
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL, nil
+}
+
+// DownloadFile opens an object for reading
+func (s *Storage) DownloadFile(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+// UploadFile writes content to storage
+func (s *Storage) UploadFile(ctx context.Context, bucket, key string, content io.Reader, contentType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        content,
+		ContentType: aws.String(contentType),
+	})
+
+	return err
+}
+
+// StatObject reads an object's metadata with a HEAD request
+func (s *Storage) StatObject(ctx context.Context, bucket, key string) (*outbound.ObjectInfo, error) {
+	output, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, domain.ErrFileNotFound
+		}
+		return nil, err
+	}
+
+	info := &outbound.ObjectInfo{
+		Key:         key,
+		Size:        aws.ToInt64(output.ContentLength),
+		ContentType: aws.ToString(output.ContentType),
+	}
+	if output.LastModified != nil {
+		info.UpdatedAt = *output.LastModified
+	}
+	// Single-part uploads have the hex MD5 of the content as ETag; multipart ETags carry a part count suffix
+	if md5, err := hex.DecodeString(strings.Trim(aws.ToString(output.ETag), `"`)); err == nil && len(md5) == 16 {
+		info.Checksum = base64.StdEncoding.EncodeToString(md5)
+	}
+	return info, nil
+}
+
+// ListObjects lists all objects whose key starts with prefix
+func (s *Storage) ListObjects(ctx context.Context, bucket, prefix string) ([]outbound.ObjectInfo, error) {
+	var objects []outbound.ObjectInfo
+
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			info := outbound.ObjectInfo{
+				Key:  aws.ToString(obj.Key),
+				Size: aws.ToInt64(obj.Size),
+			}
+			if obj.LastModified != nil {
+				info.UpdatedAt = *obj.LastModified
+			}
+			objects = append(objects, info)
+		}
+	}
+
+	return objects, nil
+}
+
 // DeleteFile deletes a file from storage
 func (s *Storage) DeleteFile(ctx context.Context, bucket, key string) error {
 	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
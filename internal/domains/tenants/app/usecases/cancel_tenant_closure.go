@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// CancelTenantClosure handles the use case of cancelling a pending tenant closure
+type CancelTenantClosure struct {
+	tenantRepo outbound.TenantRepository
+}
+
+// NewCancelTenantClosure creates a new CancelTenantClosure use case
+func NewCancelTenantClosure(tenantRepo outbound.TenantRepository) *CancelTenantClosure {
+	return &CancelTenantClosure{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// CancelTenantClosureRequest represents the request to cancel a tenant closure
+type CancelTenantClosureRequest struct {
+	TenantID uuid.UUID
+}
+
+// CancelTenantClosureResponse represents the response from cancelling a tenant closure
+type CancelTenantClosureResponse struct {
+	Tenant *model.Tenant
+}
+
+// Execute executes the use case
+func (uc *CancelTenantClosure) Execute(ctx context.Context, req *CancelTenantClosureRequest) (*CancelTenantClosureResponse, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, req.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	if !tenant.IsClosing() {
+		return nil, domain.ErrTenantNotClosing
+	}
+
+	tenant.CancelClosure()
+
+	if err := uc.tenantRepo.Update(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	return &CancelTenantClosureResponse{
+		Tenant: tenant,
+	}, nil
+}
@@ -0,0 +1,542 @@
+package usecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// exportFormatVersion is bumped whenever the archive layout changes
+const exportFormatVersion = 1
+
+// BrandingExporter provides the branding record included in tenant exports (to avoid circular dependency)
+type BrandingExporter interface {
+	// ExportByAgencyID returns the branding fields for an agency, or nil if the agency has no branding
+	ExportByAgencyID(ctx context.Context, agencyID uuid.UUID) (map[string]interface{}, error)
+}
+
+// exportTable is one section of the archive, written as both JSON and CSV
+type exportTable struct {
+	name    string
+	columns []string
+	rows    []map[string]interface{}
+}
+
+// ExportTenantData builds the export archive for a tenant and stores it
+type ExportTenantData struct {
+	exportRepo       outbound.TenantExportRepository
+	tenantRepo       outbound.TenantRepository
+	memberRepo       outbound.TenantMemberRepository
+	inviteRepo       outbound.InviteRepository
+	clientRepo       outbound.ClientRepository
+	locationRepo     outbound.LocationRepository
+	clientMemberRepo outbound.ClientMemberRepository
+	brandingExporter BrandingExporter
+	userRepo         UserRepository
+	archiveStore     outbound.ExportArchiveStore
+	retention        time.Duration
+}
+
+// NewExportTenantData creates a new ExportTenantData use case
+func NewExportTenantData(
+	exportRepo outbound.TenantExportRepository,
+	tenantRepo outbound.TenantRepository,
+	memberRepo outbound.TenantMemberRepository,
+	inviteRepo outbound.InviteRepository,
+	clientRepo outbound.ClientRepository,
+	locationRepo outbound.LocationRepository,
+	clientMemberRepo outbound.ClientMemberRepository,
+	brandingExporter BrandingExporter,
+	userRepo UserRepository,
+	archiveStore outbound.ExportArchiveStore,
+	retention time.Duration,
+) *ExportTenantData {
+	return &ExportTenantData{
+		exportRepo:       exportRepo,
+		tenantRepo:       tenantRepo,
+		memberRepo:       memberRepo,
+		inviteRepo:       inviteRepo,
+		clientRepo:       clientRepo,
+		locationRepo:     locationRepo,
+		clientMemberRepo: clientMemberRepo,
+		brandingExporter: brandingExporter,
+		userRepo:         userRepo,
+		archiveStore:     archiveStore,
+		retention:        retention,
+	}
+}
+
+// ExportTenantDataRequest represents the request to run an export job
+type ExportTenantDataRequest struct {
+	Export *model.TenantExport
+}
+
+// ExportTenantDataResponse represents the response from running an export job
+type ExportTenantDataResponse struct {
+	Export *model.TenantExport
+}
+
+// Execute executes the use case
+// The export record is marked failed (and persisted) if any step fails.
+func (uc *ExportTenantData) Execute(ctx context.Context, req *ExportTenantDataRequest) (*ExportTenantDataResponse, error) {
+	export := req.Export
+	export.Start()
+	uc.saveProgress(ctx, export)
+
+	if err := uc.run(ctx, export); err != nil {
+		export.Fail(err.Error())
+		// Persist failure with a fresh context in case ctx timed out
+		if updateErr := uc.exportRepo.Update(context.Background(), export); updateErr != nil {
+			log.Error().Err(updateErr).Str("export_id", export.ID().String()).Msg("Failed to record export failure")
+		}
+		return nil, err
+	}
+
+	if err := uc.exportRepo.Update(ctx, export); err != nil {
+		return nil, err
+	}
+
+	return &ExportTenantDataResponse{
+		Export: export,
+	}, nil
+}
+
+// run collects every section, writes the archive and uploads it
+func (uc *ExportTenantData) run(ctx context.Context, export *model.TenantExport) error {
+	tenantID := export.TenantID()
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("load tenant: %w", err)
+	}
+
+	clients, err := uc.clientRepo.ListByAgency(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("load clients: %w", err)
+	}
+
+	steps := []struct {
+		name    string
+		collect func() ([]exportTable, error)
+	}{
+		{"tenant", func() ([]exportTable, error) { return []exportTable{buildTenantTable(tenant)}, nil }},
+		{"branding", func() ([]exportTable, error) { return uc.collectBranding(ctx, tenantID) }},
+		{"members", func() ([]exportTable, error) { return uc.collectMembers(ctx, tenantID) }},
+		{"invites", func() ([]exportTable, error) { return uc.collectInvites(ctx, tenantID) }},
+		{"clients", func() ([]exportTable, error) { return []exportTable{buildClientsTable(clients)}, nil }},
+		{"locations", func() ([]exportTable, error) { return uc.collectLocations(ctx, clients) }},
+		{"client_members", func() ([]exportTable, error) { return uc.collectClientMembers(ctx, clients) }},
+		{"files", func() ([]exportTable, error) { return uc.collectFiles(ctx, tenantID) }},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	manifestFiles := make([]map[string]interface{}, 0)
+
+	for i, step := range steps {
+		// Reserve the last 10% for upload
+		export.SetProgress(step.name, i*90/len(steps))
+		uc.saveProgress(ctx, export)
+
+		tables, err := step.collect()
+		if err != nil {
+			return fmt.Errorf("export %s: %w", step.name, err)
+		}
+
+		for _, table := range tables {
+			files, err := writeExportTable(zw, table)
+			if err != nil {
+				return fmt.Errorf("write %s: %w", table.name, err)
+			}
+			for _, file := range files {
+				manifestFiles = append(manifestFiles, map[string]interface{}{
+					"path":    file,
+					"section": step.name,
+					"records": len(table.rows),
+				})
+			}
+		}
+	}
+
+	if err := writeJSONFile(zw, "manifest.json", map[string]interface{}{
+		"format_version": exportFormatVersion,
+		"export_id":      export.ID().String(),
+		"tenant_id":      tenantID.String(),
+		"generated_at":   time.Now().UTC().Format(time.RFC3339),
+		"files":          manifestFiles,
+	}); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+
+	export.SetProgress("upload", 90)
+	uc.saveProgress(ctx, export)
+
+	key := ExportObjectKey(tenantID, export.ID())
+	size := int64(buf.Len())
+	if err := uc.archiveStore.Upload(ctx, key, bytes.NewReader(buf.Bytes()), "application/zip"); err != nil {
+		return fmt.Errorf("upload archive: %w", err)
+	}
+
+	export.Complete(key, size, uc.retention)
+	return nil
+}
+
+// saveProgress persists progress; failures are logged and do not abort the export
+func (uc *ExportTenantData) saveProgress(ctx context.Context, export *model.TenantExport) {
+	if err := uc.exportRepo.Update(ctx, export); err != nil {
+		log.Warn().Err(err).Str("export_id", export.ID().String()).Msg("Failed to update export progress")
+	}
+}
+
+// ExportObjectKey returns the storage key of an export archive
+// Format: {tenant_id}/exports/{export_id}.zip
+func ExportObjectKey(tenantID, exportID uuid.UUID) string {
+	return tenantID.String() + "/exports/" + exportID.String() + ".zip"
+}
+
+func buildTenantTable(tenant *model.Tenant) exportTable {
+	row := map[string]interface{}{
+		"id":                  tenant.ID().String(),
+		"name":                tenant.Name(),
+		"slug":                tenant.Slug(),
+		"status":              string(tenant.Status()),
+		"tier":                nil,
+		"agency_seat_limit":   tenant.AgencySeatLimit(),
+		"invite_expiry_hours": tenant.InviteExpiryHours(),
+		"suspension_reason":   nil,
+		"created_at":          formatExportTime(tenant.CreatedAt()),
+		"updated_at":          formatExportTime(tenant.UpdatedAt()),
+	}
+	if tenant.Tier() != nil {
+		row["tier"] = tenant.Tier().String()
+	}
+	if tenant.Suspension() != nil {
+		row["suspension_reason"] = tenant.Suspension().Reason().String()
+	}
+
+	return exportTable{
+		name:    "tenant",
+		columns: []string{"id", "name", "slug", "status", "tier", "agency_seat_limit", "invite_expiry_hours", "suspension_reason", "created_at", "updated_at"},
+		rows:    []map[string]interface{}{row},
+	}
+}
+
+func (uc *ExportTenantData) collectBranding(ctx context.Context, tenantID uuid.UUID) ([]exportTable, error) {
+	table := exportTable{name: "branding"}
+	if uc.brandingExporter == nil {
+		return []exportTable{table}, nil
+	}
+
+	branding, err := uc.brandingExporter.ExportByAgencyID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if branding != nil {
+		table.rows = append(table.rows, branding)
+	}
+
+	return []exportTable{table}, nil
+}
+
+func (uc *ExportTenantData) collectMembers(ctx context.Context, tenantID uuid.UUID) ([]exportTable, error) {
+	members, err := uc.memberRepo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := exportTable{
+		name:    "members",
+		columns: []string{"id", "user_id", "email", "full_name", "first_name", "last_name", "role", "client_id", "created_at", "updated_at"},
+	}
+	for _, member := range members {
+		row := map[string]interface{}{
+			"id":         member.ID().String(),
+			"user_id":    member.UserID().String(),
+			"email":      nil,
+			"full_name":  nil,
+			"first_name": nil,
+			"last_name":  nil,
+			"role":       string(member.Role()),
+			"client_id":  formatExportUUID(member.ClientID()),
+			"created_at": formatExportTime(member.CreatedAt()),
+			"updated_at": formatExportTime(member.UpdatedAt()),
+		}
+
+		// Profiles are optional (users may not be synced from Clerk yet)
+		if uc.userRepo != nil {
+			if user, err := uc.userRepo.FindByID(ctx, member.UserID()); err == nil && user != nil {
+				row["email"] = user.Email()
+				row["full_name"] = user.FullName()
+				row["first_name"] = user.FirstName()
+				row["last_name"] = user.LastName()
+			}
+		}
+
+		table.rows = append(table.rows, row)
+	}
+
+	return []exportTable{table}, nil
+}
+
+func (uc *ExportTenantData) collectInvites(ctx context.Context, tenantID uuid.UUID) ([]exportTable, error) {
+	invites, err := uc.inviteRepo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens are deliberately excluded: they grant access to the tenant
+	table := exportTable{
+		name:    "invites",
+		columns: []string{"id", "email", "role", "status", "created_by", "created_at", "expires_at", "accepted_at", "revoked_at"},
+	}
+	for _, invite := range invites {
+		status := "pending"
+		if invite.IsAccepted() {
+			status = "accepted"
+		} else if invite.IsRevoked() {
+			status = "revoked"
+		} else if invite.IsExpired() {
+			status = "expired"
+		}
+
+		table.rows = append(table.rows, map[string]interface{}{
+			"id":          invite.ID().String(),
+			"email":       invite.Email(),
+			"role":        string(invite.Role()),
+			"status":      status,
+			"created_by":  invite.CreatedBy().String(),
+			"created_at":  formatExportTime(invite.CreatedAt()),
+			"expires_at":  formatExportTime(invite.ExpiresAt()),
+			"accepted_at": formatExportTimePtr(invite.AcceptedAt()),
+			"revoked_at":  formatExportTimePtr(invite.RevokedAt()),
+		})
+	}
+
+	return []exportTable{table}, nil
+}
+
+func buildClientsTable(clients []*model.Client) exportTable {
+	table := exportTable{
+		name:    "clients",
+		columns: []string{"id", "name", "slug", "tier", "status", "suspension_reason", "created_at", "updated_at"},
+	}
+	for _, client := range clients {
+		row := map[string]interface{}{
+			"id":                client.ID().String(),
+			"name":              client.Name(),
+			"slug":              client.Slug(),
+			"tier":              client.Tier().String(),
+			"status":            string(client.Status()),
+			"suspension_reason": nil,
+			"created_at":        formatExportTime(client.CreatedAt()),
+			"updated_at":        formatExportTime(client.UpdatedAt()),
+		}
+		if client.Suspension() != nil {
+			row["suspension_reason"] = client.Suspension().Reason().String()
+		}
+		table.rows = append(table.rows, row)
+	}
+	return table
+}
+
+func (uc *ExportTenantData) collectLocations(ctx context.Context, clients []*model.Client) ([]exportTable, error) {
+	table := exportTable{
+		name:    "locations",
+		columns: []string{"id", "client_id", "name", "phone", "address", "business_hours", "categories", "is_active", "created_at", "updated_at"},
+	}
+	for _, client := range clients {
+		locations, err := uc.locationRepo.ListByClient(ctx, client.ID())
+		if err != nil {
+			return nil, err
+		}
+		for _, location := range locations {
+			table.rows = append(table.rows, map[string]interface{}{
+				"id":             location.ID().String(),
+				"client_id":      location.ClientID().String(),
+				"name":           location.Name(),
+				"phone":          location.Phone(),
+				"address":        location.Address(),
+				"business_hours": location.BusinessHours(),
+				"categories":     location.Categories(),
+				"is_active":      location.IsActive(),
+				"created_at":     formatExportTime(location.CreatedAt()),
+				"updated_at":     formatExportTime(location.UpdatedAt()),
+			})
+		}
+	}
+	return []exportTable{table}, nil
+}
+
+func (uc *ExportTenantData) collectClientMembers(ctx context.Context, clients []*model.Client) ([]exportTable, error) {
+	table := exportTable{
+		name:    "client_members",
+		columns: []string{"id", "client_id", "user_id", "role", "location_id", "created_at", "updated_at"},
+	}
+	for _, client := range clients {
+		members, err := uc.clientMemberRepo.ListByClient(ctx, client.ID(), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			table.rows = append(table.rows, map[string]interface{}{
+				"id":          member.ID().String(),
+				"client_id":   member.ClientID().String(),
+				"user_id":     member.UserID().String(),
+				"role":        string(member.Role()),
+				"location_id": formatExportUUID(member.LocationID()),
+				"created_at":  formatExportTime(member.CreatedAt()),
+				"updated_at":  formatExportTime(member.UpdatedAt()),
+			})
+		}
+	}
+	return []exportTable{table}, nil
+}
+
+func (uc *ExportTenantData) collectFiles(ctx context.Context, tenantID uuid.UUID) ([]exportTable, error) {
+	files, err := uc.archiveStore.ListTenantFiles(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Previous export archives are not part of the tenant's data
+	exportsPrefix := tenantID.String() + "/exports/"
+
+	table := exportTable{
+		name:    "files",
+		columns: []string{"key", "size_bytes", "content_type", "updated_at"},
+	}
+	for _, file := range files {
+		if strings.HasPrefix(file.Key, exportsPrefix) {
+			continue
+		}
+		table.rows = append(table.rows, map[string]interface{}{
+			"key":          file.Key,
+			"size_bytes":   file.Size,
+			"content_type": file.ContentType,
+			"updated_at":   formatExportTime(file.UpdatedAt),
+		})
+	}
+	return []exportTable{table}, nil
+}
+
+// writeExportTable writes a section as {name}.json and, for tabular sections, {name}.csv
+// Returns the paths written to the archive
+func writeExportTable(zw *zip.Writer, table exportTable) ([]string, error) {
+	jsonPath := table.name + ".json"
+	rows := table.rows
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+
+	// Single-record sections are written as an object rather than an array
+	var payload interface{} = rows
+	if table.name == "tenant" || table.name == "branding" {
+		payload = nil
+		if len(rows) > 0 {
+			payload = rows[0]
+		}
+	}
+	if err := writeJSONFile(zw, jsonPath, payload); err != nil {
+		return nil, err
+	}
+
+	if table.name == "tenant" || table.name == "branding" {
+		return []string{jsonPath}, nil
+	}
+
+	csvPath := table.name + ".csv"
+	w, err := zw.Create(csvPath)
+	if err != nil {
+		return nil, err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(table.columns); err != nil {
+		return nil, err
+	}
+	for _, row := range table.rows {
+		record := make([]string, len(table.columns))
+		for i, column := range table.columns {
+			record[i] = csvValue(row[column])
+		}
+		if err := cw.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return nil, err
+	}
+
+	return []string{jsonPath, csvPath}, nil
+}
+
+func writeJSONFile(zw *zip.Writer, path string, payload interface{}) error {
+	w, err := zw.Create(path)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(payload)
+}
+
+// csvValue flattens a JSON value into a CSV cell (nested values are JSON encoded)
+func csvValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case *string:
+		if val == nil {
+			return ""
+		}
+		return *val
+	case *int:
+		if val == nil {
+			return ""
+		}
+		return fmt.Sprint(*val)
+	case int, int64, bool:
+		return fmt.Sprint(val)
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(b)
+	}
+}
+
+func formatExportTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatExportTimePtr(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return formatExportTime(*t)
+}
+
+func formatExportUUID(id *uuid.UUID) interface{} {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
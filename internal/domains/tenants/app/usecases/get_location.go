@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// GetLocation handles the use case of getting a location by ID
+type GetLocation struct {
+	locationRepo outbound.LocationRepository
+}
+
+// NewGetLocation creates a new GetLocation use case
+func NewGetLocation(locationRepo outbound.LocationRepository) *GetLocation {
+	return &GetLocation{
+		locationRepo: locationRepo,
+	}
+}
+
+// GetLocationRequest represents the request to get a location
+type GetLocationRequest struct {
+	LocationID uuid.UUID
+}
+
+// GetLocationResponse represents the response from getting a location
+type GetLocationResponse struct {
+	Location *model.Location
+}
+
+// Execute executes the use case
+func (uc *GetLocation) Execute(ctx context.Context, req *GetLocationRequest) (*GetLocationResponse, error) {
+	location, err := uc.locationRepo.FindByID(ctx, req.LocationID)
+	if err != nil {
+		return nil, domain.ErrLocationNotFound
+	}
+
+	return &GetLocationResponse{
+		Location: location,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// GetTenantExport handles the use case of getting a tenant export and its download link
+type GetTenantExport struct {
+	exportRepo   outbound.TenantExportRepository
+	archiveStore outbound.ExportArchiveStore
+	urlExpiry    time.Duration
+}
+
+// NewGetTenantExport creates a new GetTenantExport use case
+func NewGetTenantExport(exportRepo outbound.TenantExportRepository, archiveStore outbound.ExportArchiveStore, urlExpiry time.Duration) *GetTenantExport {
+	return &GetTenantExport{
+		exportRepo:   exportRepo,
+		archiveStore: archiveStore,
+		urlExpiry:    urlExpiry,
+	}
+}
+
+// GetTenantExportRequest represents the request to get a tenant export
+type GetTenantExportRequest struct {
+	TenantID uuid.UUID
+	ExportID uuid.UUID
+}
+
+// GetTenantExportResponse represents the response from getting a tenant export
+type GetTenantExportResponse struct {
+	Export            *model.TenantExport
+	DownloadURL       string     // empty unless the archive is downloadable
+	DownloadExpiresAt *time.Time // expiry of DownloadURL
+}
+
+// Execute executes the use case
+func (uc *GetTenantExport) Execute(ctx context.Context, req *GetTenantExportRequest) (*GetTenantExportResponse, error) {
+	export, err := uc.exportRepo.FindByID(ctx, req.ExportID)
+	if err != nil {
+		return nil, domain.ErrExportNotFound
+	}
+
+	// Do not leak exports across tenants
+	if export.TenantID() != req.TenantID {
+		return nil, domain.ErrExportNotFound
+	}
+
+	resp := &GetTenantExportResponse{
+		Export: export,
+	}
+
+	if export.IsDownloadable() {
+		// Never sign past the archive's own retention window
+		expiry := uc.urlExpiry
+		if remaining := time.Until(*export.ExpiresAt()); remaining < expiry {
+			expiry = remaining
+		}
+
+		url, err := uc.archiveStore.SignedDownloadURL(ctx, export.ObjectKey(), expiry)
+		if err != nil {
+			return nil, err
+		}
+		expiresAt := time.Now().Add(expiry)
+		resp.DownloadURL = url
+		resp.DownloadExpiresAt = &expiresAt
+	}
+
+	return resp, nil
+}
@@ -20,6 +20,8 @@ import (
 // BrandRepository interface for fetching branding (to avoid circular dependency)
 type BrandRepository interface {
 	FindByAgencyID(ctx context.Context, agencyID uuid.UUID) (BrandingInfo, error)
+	// FindForClient returns the agency branding with the client's overrides applied
+	FindForClient(ctx context.Context, agencyID, clientID uuid.UUID) (BrandingInfo, error)
 }
 
 // BrandingInfo interface for accessing branding fields
@@ -30,6 +32,12 @@ type BrandingInfo interface {
 	HidePoweredBy() bool
 }
 
+// SenderRepository interface for fetching the address agency emails are sent from (to avoid circular dependency)
+type SenderRepository interface {
+	// FindSender returns the agency's verified sender, or nil when its emails are sent from the platform address
+	FindSender(ctx context.Context, agencyID uuid.UUID) (*outbound.Sender, error)
+}
+
 // UserRepository interface for fetching user information
 type UserRepository interface {
 	FindByID(ctx context.Context, userID uuid.UUID) (UserInfo, error)
@@ -51,6 +59,7 @@ type InviteMember struct {
 	brandRepo     BrandRepository
 	userRepo      UserRepository
 	emailService  outbound.EmailService
+	senderRepo    SenderRepository
 	seatValidator *services.SeatValidator
 	tokenExpiry   time.Duration
 	webURL        string
@@ -64,6 +73,7 @@ func NewInviteMember(
 	brandRepo BrandRepository,
 	userRepo UserRepository,
 	emailService outbound.EmailService,
+	senderRepo SenderRepository, // Optional, nil always sends from the platform address
 	seatValidator *services.SeatValidator,
 	tokenExpiry time.Duration,
 	webURL string,
@@ -75,6 +85,7 @@ func NewInviteMember(
 		brandRepo:     brandRepo,
 		userRepo:      userRepo,
 		emailService:  emailService,
+		senderRepo:    senderRepo,
 		seatValidator: seatValidator,
 		tokenExpiry:   tokenExpiry,
 		webURL:        webURL,
@@ -87,6 +98,7 @@ type InviteMemberRequest struct {
 	Email     string
 	Role      model.Role
 	CreatedBy uuid.UUID
+	ClientID  *uuid.UUID // Optional: client context; the invite email then uses the client's branding
 }
 
 // InviteMemberResponse represents the response from inviting a member
@@ -162,7 +174,7 @@ func (uc *InviteMember) Execute(ctx context.Context, req *InviteMemberRequest) (
 		acceptURL := fmt.Sprintf("%s/invites/accept/%s", uc.webURL, invite.Token())
 
 		// Build email context with branding and user information
-		emailCtx := uc.buildEmailContext(context.Background(), tenant, invite, acceptURL, req.CreatedBy)
+		emailCtx := uc.buildEmailContext(context.Background(), tenant, invite, acceptURL, req.CreatedBy, req.ClientID)
 
 		if err := uc.emailService.SendInviteEmail(context.Background(), emailCtx); err != nil {
 			// Log error but don't fail the invite creation
@@ -186,7 +198,8 @@ func (uc *InviteMember) Execute(ctx context.Context, req *InviteMemberRequest) (
 }
 
 // buildEmailContext builds the email context with branding and user information
-func (uc *InviteMember) buildEmailContext(ctx context.Context, tenant *model.Tenant, invite *model.Invite, acceptURL string, createdBy uuid.UUID) *outbound.InviteEmailContext {
+// With a client context the client's branding overrides are layered on the agency branding.
+func (uc *InviteMember) buildEmailContext(ctx context.Context, tenant *model.Tenant, invite *model.Invite, acceptURL string, createdBy uuid.UUID, clientID *uuid.UUID) *outbound.InviteEmailContext {
 	emailCtx := &outbound.InviteEmailContext{
 		Invite:     invite,
 		AcceptURL:  acceptURL,
@@ -196,7 +209,13 @@ func (uc *InviteMember) buildEmailContext(ctx context.Context, tenant *model.Ten
 
 	// Fetch branding information (optional - may not exist)
 	if uc.brandRepo != nil {
-		branding, err := uc.brandRepo.FindByAgencyID(ctx, tenant.ID())
+		var branding BrandingInfo
+		var err error
+		if clientID != nil {
+			branding, err = uc.brandRepo.FindForClient(ctx, tenant.ID(), *clientID)
+		} else {
+			branding, err = uc.brandRepo.FindByAgencyID(ctx, tenant.ID())
+		}
 		if err == nil && branding != nil {
 			emailCtx.LogoURL = branding.LogoURL()
 			emailCtx.PrimaryColor = branding.PrimaryColor()
@@ -211,6 +230,14 @@ func (uc *InviteMember) buildEmailContext(ctx context.Context, tenant *model.Ten
 		}
 	}
 
+	// Fetch the agency's sending address (optional - falls back to the platform address)
+	if uc.senderRepo != nil {
+		sender, err := uc.senderRepo.FindSender(ctx, tenant.ID())
+		if err == nil && sender != nil {
+			emailCtx.Sender = sender
+		}
+	}
+
 	// Fetch inviter user information (optional)
 	if uc.userRepo != nil {
 		user, err := uc.userRepo.FindByID(ctx, createdBy)
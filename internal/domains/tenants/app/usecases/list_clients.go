@@ -29,7 +29,8 @@ func NewListClients(
 
 // ListClientsRequest represents the request to list clients
 type ListClientsRequest struct {
-	AgencyID uuid.UUID
+	AgencyID         uuid.UUID
+	ExcludeSuspended bool // Hide suspended clients (e.g., for client viewers)
 }
 
 // ListClientsResponse represents the response from listing clients
@@ -51,6 +52,16 @@ func (uc *ListClients) Execute(ctx context.Context, req *ListClientsRequest) (*L
 		return nil, err
 	}
 
+	if req.ExcludeSuspended {
+		visible := make([]*model.Client, 0, len(clients))
+		for _, client := range clients {
+			if !client.IsSuspended() {
+				visible = append(visible, client)
+			}
+		}
+		clients = visible
+	}
+
 	return &ListClientsResponse{
 		Clients: clients,
 	}, nil
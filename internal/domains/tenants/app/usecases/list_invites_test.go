@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"testing"
+	"time"
 
 	"farohq-core-app/internal/domains/tenants/domain"
 	"farohq-core-app/internal/domains/tenants/domain/model"
@@ -57,6 +58,14 @@ func (m *MockInviteRepository) FindPendingInvitesByEmail(ctx context.Context, em
 	return args.Get(0).([]*model.Invite), args.Error(1)
 }
 
+func (m *MockInviteRepository) FindPendingByCreator(ctx context.Context, createdBy uuid.UUID) ([]*model.Invite, error) {
+	args := m.Called(ctx, createdBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Invite), args.Error(1)
+}
+
 func (m *MockInviteRepository) Save(ctx context.Context, invite *model.Invite) error {
 	args := m.Called(ctx, invite)
 	return args.Error(0)
@@ -85,6 +94,14 @@ func (m *MockTenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*mod
 	return args.Get(0).(*model.Tenant), args.Error(1)
 }
 
+func (m *MockTenantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Tenant, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Tenant), args.Error(1)
+}
+
 func (m *MockTenantRepository) FindBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
 	args := m.Called(ctx, slug)
 	if args.Get(0) == nil {
@@ -108,6 +125,19 @@ func (m *MockTenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockTenantRepository) ListDueForPurge(ctx context.Context, now, deletedBefore time.Time) ([]uuid.UUID, error) {
+	args := m.Called(ctx, now, deletedBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockTenantRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func TestListInvites_Execute(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
 	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 
 	"github.com/google/uuid"
@@ -51,22 +52,22 @@ func (uc *ListRoles) Execute(ctx context.Context, req *ListRolesRequest) (*ListR
 		{
 			Name:        "owner",
 			Description: "Full access to tenant settings and members",
-			Permissions: []string{"manage_tenant", "manage_members", "manage_branding", "view_all"},
+			Permissions: model.RolePermissions(model.RoleOwner),
 		},
 		{
 			Name:        "admin",
 			Description: "Can manage members and most tenant settings",
-			Permissions: []string{"manage_members", "manage_branding", "view_all"},
+			Permissions: model.RolePermissions(model.RoleAdmin),
 		},
 		{
 			Name:        "staff",
 			Description: "Can manage content and view tenant data",
-			Permissions: []string{"manage_content", "view_all"},
+			Permissions: model.RolePermissions(model.RoleStaff),
 		},
 		{
 			Name:        "viewer",
 			Description: "Read-only access to tenant data",
-			Permissions: []string{"view_all"},
+			Permissions: model.RolePermissions(model.RoleViewer),
 		},
 	}
 
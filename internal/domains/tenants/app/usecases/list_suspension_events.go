@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// ListSuspensionEvents handles the use case of listing the suspension history of a tenant
+type ListSuspensionEvents struct {
+	suspensionEventRepo outbound.SuspensionEventRepository
+	tenantRepo          outbound.TenantRepository
+}
+
+// NewListSuspensionEvents creates a new ListSuspensionEvents use case
+func NewListSuspensionEvents(
+	suspensionEventRepo outbound.SuspensionEventRepository,
+	tenantRepo outbound.TenantRepository,
+) *ListSuspensionEvents {
+	return &ListSuspensionEvents{
+		suspensionEventRepo: suspensionEventRepo,
+		tenantRepo:          tenantRepo,
+	}
+}
+
+// ListSuspensionEventsRequest represents the request to list suspension events
+type ListSuspensionEventsRequest struct {
+	TenantID uuid.UUID
+}
+
+// ListSuspensionEventsResponse represents the response from listing suspension events
+type ListSuspensionEventsResponse struct {
+	Events []*model.SuspensionEvent
+}
+
+// Execute executes the use case
+func (uc *ListSuspensionEvents) Execute(ctx context.Context, req *ListSuspensionEventsRequest) (*ListSuspensionEventsResponse, error) {
+	if _, err := uc.tenantRepo.FindByID(ctx, req.TenantID); err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	events, err := uc.suspensionEventRepo.ListByTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListSuspensionEventsResponse{
+		Events: events,
+	}, nil
+}
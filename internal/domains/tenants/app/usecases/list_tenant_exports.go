@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// ListTenantExports handles the use case of listing a tenant's export history
+type ListTenantExports struct {
+	exportRepo outbound.TenantExportRepository
+	tenantRepo outbound.TenantRepository
+}
+
+// NewListTenantExports creates a new ListTenantExports use case
+func NewListTenantExports(exportRepo outbound.TenantExportRepository, tenantRepo outbound.TenantRepository) *ListTenantExports {
+	return &ListTenantExports{
+		exportRepo: exportRepo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+// ListTenantExportsRequest represents the request to list tenant exports
+type ListTenantExportsRequest struct {
+	TenantID uuid.UUID
+}
+
+// ListTenantExportsResponse represents the response from listing tenant exports
+type ListTenantExportsResponse struct {
+	Exports []*model.TenantExport
+}
+
+// Execute executes the use case
+func (uc *ListTenantExports) Execute(ctx context.Context, req *ListTenantExportsRequest) (*ListTenantExportsResponse, error) {
+	if _, err := uc.tenantRepo.FindByID(ctx, req.TenantID); err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	exports, err := uc.exportRepo.ListByTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTenantExportsResponse{
+		Exports: exports,
+	}, nil
+}
@@ -47,11 +47,26 @@ func (uc *ListTenantsByUser) Execute(ctx context.Context, req *ListTenantsByUser
 		return nil, err
 	}
 
-	// Fetch tenant details for each member
+	// Fetch tenant details for all memberships at once
+	tenantIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		tenantIDs[i] = member.TenantID()
+	}
+
+	found, err := uc.tenantRepo.FindByIDs(ctx, tenantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantsByID := make(map[uuid.UUID]*model.Tenant, len(found))
+	for _, tenant := range found {
+		tenantsByID[tenant.ID()] = tenant
+	}
+
 	tenants := make([]TenantWithRole, 0, len(members))
 	for _, member := range members {
-		tenant, err := uc.tenantRepo.FindByID(ctx, member.TenantID())
-		if err != nil {
+		tenant, ok := tenantsByID[member.TenantID()]
+		if !ok {
 			// Skip if tenant not found (shouldn't happen but handle gracefully)
 			continue
 		}
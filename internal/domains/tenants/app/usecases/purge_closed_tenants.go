@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// BrandingReleaser releases external branding resources of a purged tenant (to avoid circular dependency)
+type BrandingReleaser interface {
+	// ReleaseByAgencyID removes the custom domain from the hosting provider and frees the subdomain
+	ReleaseByAgencyID(ctx context.Context, agencyID uuid.UUID) error
+}
+
+// PurgeClosedTenants handles the background job that hard-deletes tenants past their retention window
+type PurgeClosedTenants struct {
+	tenantRepo       outbound.TenantRepository
+	brandingReleaser BrandingReleaser
+	objectStore      outbound.TenantObjectStore
+	retention        time.Duration
+}
+
+// NewPurgeClosedTenants creates a new PurgeClosedTenants use case
+// retention also applies to legacy soft-deleted tenants (deleted_at set without a closure request).
+func NewPurgeClosedTenants(
+	tenantRepo outbound.TenantRepository,
+	brandingReleaser BrandingReleaser,
+	objectStore outbound.TenantObjectStore,
+	retention time.Duration,
+) *PurgeClosedTenants {
+	return &PurgeClosedTenants{
+		tenantRepo:       tenantRepo,
+		brandingReleaser: brandingReleaser,
+		objectStore:      objectStore,
+		retention:        retention,
+	}
+}
+
+// PurgeClosedTenantsRequest represents the request to run the purge job
+type PurgeClosedTenantsRequest struct {
+	Now time.Time
+}
+
+// PurgeClosedTenantsResponse represents the response from running the purge job
+type PurgeClosedTenantsResponse struct {
+	Purged []uuid.UUID
+	Failed []uuid.UUID
+}
+
+// Execute executes the use case
+// A tenant whose purge fails is left in place and retried on the next run.
+func (uc *PurgeClosedTenants) Execute(ctx context.Context, req *PurgeClosedTenantsRequest) (*PurgeClosedTenantsResponse, error) {
+	now := req.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	tenantIDs, err := uc.tenantRepo.ListDueForPurge(ctx, now, now.Add(-uc.retention))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PurgeClosedTenantsResponse{}
+	for _, tenantID := range tenantIDs {
+		if err := uc.purge(ctx, tenantID); err != nil {
+			log.Error().Err(err).Str("tenant_id", tenantID.String()).Msg("Failed to purge tenant")
+			resp.Failed = append(resp.Failed, tenantID)
+			continue
+		}
+		log.Info().Str("tenant_id", tenantID.String()).Msg("Tenant purged")
+		resp.Purged = append(resp.Purged, tenantID)
+	}
+
+	return resp, nil
+}
+
+// purge removes external resources first so nothing is orphaned if the database delete fails
+func (uc *PurgeClosedTenants) purge(ctx context.Context, tenantID uuid.UUID) error {
+	if uc.brandingReleaser != nil {
+		if err := uc.brandingReleaser.ReleaseByAgencyID(ctx, tenantID); err != nil {
+			return fmt.Errorf("release branding: %w", err)
+		}
+	}
+
+	deleted, err := uc.objectStore.DeleteTenantObjects(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("delete stored objects: %w", err)
+	}
+	log.Debug().Str("tenant_id", tenantID.String()).Int("objects", deleted).Msg("Deleted tenant objects")
+
+	// ErrTenantNotFound means another instance already purged the tenant
+	if err := uc.tenantRepo.Purge(ctx, tenantID); err != nil && err != domain.ErrTenantNotFound {
+		return fmt.Errorf("delete tenant rows: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBrandingReleaser is a mock implementation of BrandingReleaser
+type MockBrandingReleaser struct {
+	mock.Mock
+}
+
+func (m *MockBrandingReleaser) ReleaseByAgencyID(ctx context.Context, agencyID uuid.UUID) error {
+	args := m.Called(ctx, agencyID)
+	return args.Error(0)
+}
+
+// MockTenantObjectStore is a mock implementation of TenantObjectStore
+type MockTenantObjectStore struct {
+	mock.Mock
+}
+
+func (m *MockTenantObjectStore) DeleteTenantObjects(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Int(0), args.Error(1)
+}
+
+func TestRequestTenantClosure_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		confirmSlug   string
+		closing       bool
+		expectedError error
+	}{
+		{
+			name:        "schedules closure when slug is confirmed",
+			confirmSlug: "acme",
+		},
+		{
+			name:          "rejects mismatched confirmation",
+			confirmSlug:   "acme-inc",
+			expectedError: domain.ErrClosureConfirmationMismatch,
+		},
+		{
+			name:          "rejects when closure already requested",
+			confirmSlug:   "acme",
+			closing:       true,
+			expectedError: domain.ErrClosureAlreadyRequested,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantRepo := new(MockTenantRepository)
+			tenant := model.NewTenant("Acme", "acme", nil, 0, nil)
+			if tt.closing {
+				tenant.RequestClosure(nil, time.Hour)
+			}
+
+			tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+			if tt.expectedError == nil {
+				tenantRepo.On("Update", mock.Anything, tenant).Return(nil)
+			}
+
+			resp, err := NewRequestTenantClosure(tenantRepo, 30*24*time.Hour).Execute(context.Background(), &RequestTenantClosureRequest{
+				TenantID:    tenant.ID(),
+				ConfirmSlug: tt.confirmSlug,
+			})
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, resp)
+				tenantRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, resp.Tenant.IsClosing())
+				assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), resp.Tenant.Closure().PurgeAfter(), time.Minute)
+			}
+			tenantRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPurgeClosedTenants_Execute(t *testing.T) {
+	now := time.Now()
+	purgeable := uuid.New()
+	vercelDown := uuid.New()
+
+	tenantRepo := new(MockTenantRepository)
+	releaser := new(MockBrandingReleaser)
+	objectStore := new(MockTenantObjectStore)
+
+	tenantRepo.On("ListDueForPurge", mock.Anything, now, now.Add(-30*24*time.Hour)).Return([]uuid.UUID{purgeable, vercelDown}, nil)
+
+	releaser.On("ReleaseByAgencyID", mock.Anything, purgeable).Return(nil)
+	objectStore.On("DeleteTenantObjects", mock.Anything, purgeable).Return(3, nil)
+	tenantRepo.On("Purge", mock.Anything, purgeable).Return(nil)
+
+	// Releasing the custom domain fails: nothing else is deleted so the purge can be retried
+	releaser.On("ReleaseByAgencyID", mock.Anything, vercelDown).Return(errors.New("vercel unavailable"))
+
+	uc := NewPurgeClosedTenants(tenantRepo, releaser, objectStore, 30*24*time.Hour)
+	resp, err := uc.Execute(context.Background(), &PurgeClosedTenantsRequest{Now: now})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{purgeable}, resp.Purged)
+	assert.Equal(t, []uuid.UUID{vercelDown}, resp.Failed)
+	objectStore.AssertNotCalled(t, "DeleteTenantObjects", mock.Anything, vercelDown)
+	tenantRepo.AssertNotCalled(t, "Purge", mock.Anything, vercelDown)
+	tenantRepo.AssertExpectations(t)
+	releaser.AssertExpectations(t)
+	objectStore.AssertExpectations(t)
+}
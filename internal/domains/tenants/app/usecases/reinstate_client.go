@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// ReinstateClient handles the use case of lifting a client suspension (platform admin only)
+type ReinstateClient struct {
+	clientRepo          outbound.ClientRepository
+	suspensionEventRepo outbound.SuspensionEventRepository
+}
+
+// NewReinstateClient creates a new ReinstateClient use case
+func NewReinstateClient(
+	clientRepo outbound.ClientRepository,
+	suspensionEventRepo outbound.SuspensionEventRepository,
+) *ReinstateClient {
+	return &ReinstateClient{
+		clientRepo:          clientRepo,
+		suspensionEventRepo: suspensionEventRepo,
+	}
+}
+
+// ReinstateClientRequest represents the request to reinstate a client
+type ReinstateClientRequest struct {
+	ClientID    uuid.UUID
+	Note        string
+	ActorUserID *uuid.UUID
+}
+
+// ReinstateClientResponse represents the response from reinstating a client
+type ReinstateClientResponse struct {
+	Client *model.Client
+}
+
+// Execute executes the use case
+func (uc *ReinstateClient) Execute(ctx context.Context, req *ReinstateClientRequest) (*ReinstateClientResponse, error) {
+	client, err := uc.clientRepo.FindByID(ctx, req.ClientID)
+	if err != nil {
+		return nil, domain.ErrClientNotFound
+	}
+
+	if !client.IsSuspended() {
+		return nil, domain.ErrClientNotSuspended
+	}
+
+	client.Reinstate()
+
+	if err := uc.clientRepo.Save(ctx, client); err != nil {
+		return nil, err
+	}
+
+	clientID := client.ID()
+	event := model.NewSuspensionEvent(client.AgencyID(), &clientID, model.SuspensionActionReinstate, nil, strings.TrimSpace(req.Note), req.ActorUserID)
+	if err := uc.suspensionEventRepo.Save(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &ReinstateClientResponse{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// ReinstateTenant handles the use case of lifting a tenant suspension (platform admin only)
+type ReinstateTenant struct {
+	tenantRepo          outbound.TenantRepository
+	suspensionEventRepo outbound.SuspensionEventRepository
+}
+
+// NewReinstateTenant creates a new ReinstateTenant use case
+func NewReinstateTenant(
+	tenantRepo outbound.TenantRepository,
+	suspensionEventRepo outbound.SuspensionEventRepository,
+) *ReinstateTenant {
+	return &ReinstateTenant{
+		tenantRepo:          tenantRepo,
+		suspensionEventRepo: suspensionEventRepo,
+	}
+}
+
+// ReinstateTenantRequest represents the request to reinstate a tenant
+type ReinstateTenantRequest struct {
+	TenantID    uuid.UUID
+	Note        string
+	ActorUserID *uuid.UUID
+}
+
+// ReinstateTenantResponse represents the response from reinstating a tenant
+type ReinstateTenantResponse struct {
+	Tenant *model.Tenant
+}
+
+// Execute executes the use case
+func (uc *ReinstateTenant) Execute(ctx context.Context, req *ReinstateTenantRequest) (*ReinstateTenantResponse, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, req.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	if !tenant.IsSuspended() {
+		return nil, domain.ErrTenantNotSuspended
+	}
+
+	tenant.Reinstate()
+
+	if err := uc.tenantRepo.Update(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	event := model.NewSuspensionEvent(tenant.ID(), nil, model.SuspensionActionReinstate, nil, strings.TrimSpace(req.Note), req.ActorUserID)
+	if err := uc.suspensionEventRepo.Save(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &ReinstateTenantResponse{
+		Tenant: tenant,
+	}, nil
+}
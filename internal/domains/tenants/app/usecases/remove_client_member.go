@@ -12,12 +12,14 @@ import (
 // RemoveClientMember handles the use case of removing a member from a client (soft delete)
 type RemoveClientMember struct {
 	clientMemberRepo outbound.ClientMemberRepository
+	revoker          outbound.AccessRevoker
 }
 
 // NewRemoveClientMember creates a new RemoveClientMember use case
-func NewRemoveClientMember(clientMemberRepo outbound.ClientMemberRepository) *RemoveClientMember {
+func NewRemoveClientMember(clientMemberRepo outbound.ClientMemberRepository, revoker outbound.AccessRevoker) *RemoveClientMember {
 	return &RemoveClientMember{
 		clientMemberRepo: clientMemberRepo,
+		revoker:          revoker,
 	}
 }
 
@@ -47,6 +49,8 @@ func (uc *RemoveClientMember) Execute(ctx context.Context, req *RemoveClientMemb
 		return nil, err
 	}
 
+	revokeAccess(ctx, uc.revoker, member.UserID())
+
 	return &RemoveClientMemberResponse{
 		Success: true,
 	}, nil
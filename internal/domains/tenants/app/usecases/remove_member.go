@@ -7,19 +7,22 @@ import (
 	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 )
 
 // RemoveMember handles the use case of removing a member from a tenant
 type RemoveMember struct {
 	memberRepo outbound.TenantMemberRepository
 	tenantRepo outbound.TenantRepository
+	revoker    outbound.AccessRevoker
 }
 
 // NewRemoveMember creates a new RemoveMember use case
-func NewRemoveMember(memberRepo outbound.TenantMemberRepository, tenantRepo outbound.TenantRepository) *RemoveMember {
+func NewRemoveMember(memberRepo outbound.TenantMemberRepository, tenantRepo outbound.TenantRepository, revoker outbound.AccessRevoker) *RemoveMember {
 	return &RemoveMember{
 		memberRepo: memberRepo,
 		tenantRepo: tenantRepo,
+		revoker:    revoker,
 	}
 }
 
@@ -53,8 +56,20 @@ func (uc *RemoveMember) Execute(ctx context.Context, req *RemoveMemberRequest) (
 		return nil, err
 	}
 
+	revokeAccess(ctx, uc.revoker, req.UserID)
+
 	return &RemoveMemberResponse{
 		Success: true,
 	}, nil
 }
 
+// revokeAccess cuts off the user's cached access after a membership change
+// The membership change is already committed, so a failure is logged rather than returned; cached access then expires with its TTL.
+func revokeAccess(ctx context.Context, revoker outbound.AccessRevoker, userID uuid.UUID) {
+	if err := revoker.RevokeAccess(ctx, userID); err != nil {
+		log.Error().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("Failed to revoke access after membership change")
+	}
+}
@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// RemoveUserFromTenants handles the use case of removing a user from every tenant they belong to
+type RemoveUserFromTenants struct {
+	memberRepo       outbound.TenantMemberRepository
+	clientMemberRepo outbound.ClientMemberRepository
+	inviteRepo       outbound.InviteRepository
+	revoker          outbound.AccessRevoker
+}
+
+// NewRemoveUserFromTenants creates a new RemoveUserFromTenants use case
+func NewRemoveUserFromTenants(
+	memberRepo outbound.TenantMemberRepository,
+	clientMemberRepo outbound.ClientMemberRepository,
+	inviteRepo outbound.InviteRepository,
+	revoker outbound.AccessRevoker,
+) *RemoveUserFromTenants {
+	return &RemoveUserFromTenants{
+		memberRepo:       memberRepo,
+		clientMemberRepo: clientMemberRepo,
+		inviteRepo:       inviteRepo,
+		revoker:          revoker,
+	}
+}
+
+// RemoveUserFromTenantsRequest represents the request to remove a user from all tenants
+type RemoveUserFromTenantsRequest struct {
+	UserID uuid.UUID
+	// KeepLastOwnerships keeps memberships where the user is the sole owner instead of failing with ErrLastOwner.
+	// Used when the identity provider has already deleted the account and refusing would not stop the deletion.
+	KeepLastOwnerships bool
+}
+
+// RemoveUserFromTenantsResponse represents the response from removing a user from all tenants
+type RemoveUserFromTenantsResponse struct {
+	RemovedTenantIDs  []uuid.UUID
+	RetainedTenantIDs []uuid.UUID
+	ClientMemberships int
+	RevokedInvites    int
+}
+
+// Execute executes the use case
+// The last-owner check runs before anything is changed, so a rejected request leaves every membership in place.
+func (uc *RemoveUserFromTenants) Execute(ctx context.Context, req *RemoveUserFromTenantsRequest) (*RemoveUserFromTenantsResponse, error) {
+	memberships, err := uc.memberRepo.FindByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	lastOwnerOf := make(map[uuid.UUID]bool)
+	for _, membership := range memberships {
+		if membership.Role() != model.RoleOwner {
+			continue
+		}
+
+		isLast, err := isLastOwner(ctx, uc.memberRepo, membership.TenantID(), req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if isLast {
+			if !req.KeepLastOwnerships {
+				return nil, domain.ErrLastOwner
+			}
+			lastOwnerOf[membership.TenantID()] = true
+		}
+	}
+
+	resp := &RemoveUserFromTenantsResponse{}
+	for _, membership := range memberships {
+		if lastOwnerOf[membership.TenantID()] {
+			resp.RetainedTenantIDs = append(resp.RetainedTenantIDs, membership.TenantID())
+			continue
+		}
+
+		// ErrMemberNotFound means the membership was removed concurrently
+		if err := uc.memberRepo.DeleteByTenantAndUserID(ctx, membership.TenantID(), req.UserID); err != nil && err != domain.ErrMemberNotFound {
+			return nil, err
+		}
+		resp.RemovedTenantIDs = append(resp.RemovedTenantIDs, membership.TenantID())
+	}
+
+	resp.ClientMemberships, err = uc.clientMemberRepo.DeleteByUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.RemovedTenantIDs) > 0 || resp.ClientMemberships > 0 {
+		revokeAccess(ctx, uc.revoker, req.UserID)
+	}
+
+	invites, err := uc.inviteRepo.FindPendingByCreator(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invite := range invites {
+		invite.Revoke()
+		if err := uc.inviteRepo.Update(ctx, invite); err != nil {
+			return nil, err
+		}
+		resp.RevokedInvites++
+	}
+
+	log.Info().
+		Str("user_id", req.UserID.String()).
+		Int("removed_tenants", len(resp.RemovedTenantIDs)).
+		Int("retained_tenants", len(resp.RetainedTenantIDs)).
+		Int("client_memberships", resp.ClientMemberships).
+		Int("revoked_invites", resp.RevokedInvites).
+		Msg("Removed user from tenants")
+
+	return resp, nil
+}
+
+// isLastOwner reports whether userID is the only owner of the tenant
+func isLastOwner(ctx context.Context, memberRepo outbound.TenantMemberRepository, tenantID, userID uuid.UUID) (bool, error) {
+	members, err := memberRepo.FindByTenantID(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, member := range members {
+		if member.Role() == model.RoleOwner && member.UserID() != userID {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
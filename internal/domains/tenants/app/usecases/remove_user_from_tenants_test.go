@@ -0,0 +1,200 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTenantMemberRepository is a mock implementation of TenantMemberRepository
+type MockTenantMemberRepository struct {
+	mock.Mock
+}
+
+func (m *MockTenantMemberRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.TenantMember, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TenantMember), args.Error(1)
+}
+
+func (m *MockTenantMemberRepository) FindByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*model.TenantMember, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.TenantMember), args.Error(1)
+}
+
+func (m *MockTenantMemberRepository) FindByTenantAndUserID(ctx context.Context, tenantID, userID uuid.UUID) (*model.TenantMember, error) {
+	args := m.Called(ctx, tenantID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TenantMember), args.Error(1)
+}
+
+func (m *MockTenantMemberRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*model.TenantMember, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.TenantMember), args.Error(1)
+}
+
+func (m *MockTenantMemberRepository) Save(ctx context.Context, member *model.TenantMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockTenantMemberRepository) Update(ctx context.Context, member *model.TenantMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockTenantMemberRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTenantMemberRepository) DeleteByTenantAndUserID(ctx context.Context, tenantID, userID uuid.UUID) error {
+	args := m.Called(ctx, tenantID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTenantMemberRepository) CountByTenantID(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Int(0), args.Error(1)
+}
+
+// MockClientMemberRepository is a mock implementation of ClientMemberRepository
+type MockClientMemberRepository struct {
+	mock.Mock
+}
+
+func (m *MockClientMemberRepository) Save(ctx context.Context, member *model.ClientMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockClientMemberRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.ClientMember, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ClientMember), args.Error(1)
+}
+
+func (m *MockClientMemberRepository) FindByClientAndUser(ctx context.Context, clientID, userID uuid.UUID, locationID *uuid.UUID) (*model.ClientMember, error) {
+	args := m.Called(ctx, clientID, userID, locationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.ClientMember), args.Error(1)
+}
+
+func (m *MockClientMemberRepository) ListByClient(ctx context.Context, clientID uuid.UUID, locationID *uuid.UUID) ([]*model.ClientMember, error) {
+	args := m.Called(ctx, clientID, locationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.ClientMember), args.Error(1)
+}
+
+func (m *MockClientMemberRepository) CountByClient(ctx context.Context, clientID uuid.UUID) (int, error) {
+	args := m.Called(ctx, clientID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockClientMemberRepository) CountByClientAndLocation(ctx context.Context, clientID uuid.UUID, locationID uuid.UUID) (int, error) {
+	args := m.Called(ctx, clientID, locationID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockClientMemberRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func TestRemoveUserFromTenants_Execute(t *testing.T) {
+	userID := uuid.New()
+	otherOwnerID := uuid.New()
+	soleTenantID := uuid.New()
+	sharedTenantID := uuid.New()
+
+	soleOwnership := model.NewTenantMember(soleTenantID, userID, model.RoleOwner)
+	sharedOwnership := model.NewTenantMember(sharedTenantID, userID, model.RoleOwner)
+	sharedTenantMembers := []*model.TenantMember{
+		sharedOwnership,
+		model.NewTenantMember(sharedTenantID, otherOwnerID, model.RoleOwner),
+	}
+
+	tests := []struct {
+		name               string
+		keepLastOwnerships bool
+		expectedError      error
+	}{
+		{
+			name:          "rejects removal when user is the last owner",
+			expectedError: domain.ErrLastOwner,
+		},
+		{
+			name:               "keeps sole ownerships and removes everything else",
+			keepLastOwnerships: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memberRepo := new(MockTenantMemberRepository)
+			clientMemberRepo := new(MockClientMemberRepository)
+			inviteRepo := new(MockInviteRepository)
+			revoker := new(MockAccessRevoker)
+
+			memberRepo.On("FindByUserID", mock.Anything, userID).Return([]*model.TenantMember{sharedOwnership, soleOwnership}, nil)
+			memberRepo.On("FindByTenantID", mock.Anything, sharedTenantID).Return(sharedTenantMembers, nil)
+			memberRepo.On("FindByTenantID", mock.Anything, soleTenantID).Return([]*model.TenantMember{soleOwnership}, nil)
+
+			pendingInvite := model.NewInvite(sharedTenantID, "new@example.com", model.RoleStaff, "token", userID, time.Hour)
+			if tt.expectedError == nil {
+				memberRepo.On("DeleteByTenantAndUserID", mock.Anything, sharedTenantID, userID).Return(nil)
+				clientMemberRepo.On("DeleteByUserID", mock.Anything, userID).Return(2, nil)
+				inviteRepo.On("FindPendingByCreator", mock.Anything, userID).Return([]*model.Invite{pendingInvite}, nil)
+				inviteRepo.On("Update", mock.Anything, pendingInvite).Return(nil)
+				revoker.On("RevokeAccess", mock.Anything, userID).Return(nil)
+			}
+
+			uc := NewRemoveUserFromTenants(memberRepo, clientMemberRepo, inviteRepo, revoker)
+			resp, err := uc.Execute(context.Background(), &RemoveUserFromTenantsRequest{
+				UserID:             userID,
+				KeepLastOwnerships: tt.keepLastOwnerships,
+			})
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, resp)
+				memberRepo.AssertNotCalled(t, "DeleteByTenantAndUserID", mock.Anything, mock.Anything, mock.Anything)
+				clientMemberRepo.AssertNotCalled(t, "DeleteByUserID", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []uuid.UUID{sharedTenantID}, resp.RemovedTenantIDs)
+				assert.Equal(t, []uuid.UUID{soleTenantID}, resp.RetainedTenantIDs)
+				assert.Equal(t, 2, resp.ClientMemberships)
+				assert.Equal(t, 1, resp.RevokedInvites)
+				assert.True(t, pendingInvite.IsRevoked())
+			}
+			memberRepo.AssertExpectations(t)
+			clientMemberRepo.AssertExpectations(t)
+			inviteRepo.AssertExpectations(t)
+			revoker.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// RequestTenantClosure handles the use case of an owner requesting tenant closure
+// The tenant becomes read-only immediately and is purged after the retention window.
+type RequestTenantClosure struct {
+	tenantRepo outbound.TenantRepository
+	retention  time.Duration
+}
+
+// NewRequestTenantClosure creates a new RequestTenantClosure use case
+func NewRequestTenantClosure(tenantRepo outbound.TenantRepository, retention time.Duration) *RequestTenantClosure {
+	return &RequestTenantClosure{
+		tenantRepo: tenantRepo,
+		retention:  retention,
+	}
+}
+
+// RequestTenantClosureRequest represents the request to close a tenant
+type RequestTenantClosureRequest struct {
+	TenantID    uuid.UUID
+	ConfirmSlug string // must match the tenant slug to guard against accidental closure
+	RequestedBy *uuid.UUID
+}
+
+// RequestTenantClosureResponse represents the response from requesting tenant closure
+type RequestTenantClosureResponse struct {
+	Tenant *model.Tenant
+}
+
+// Execute executes the use case
+func (uc *RequestTenantClosure) Execute(ctx context.Context, req *RequestTenantClosureRequest) (*RequestTenantClosureResponse, error) {
+	tenant, err := uc.tenantRepo.FindByID(ctx, req.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	if tenant.IsClosing() {
+		return nil, domain.ErrClosureAlreadyRequested
+	}
+
+	if strings.TrimSpace(req.ConfirmSlug) != tenant.Slug() {
+		return nil, domain.ErrClosureConfirmationMismatch
+	}
+
+	tenant.RequestClosure(req.RequestedBy, uc.retention)
+
+	if err := uc.tenantRepo.Update(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	return &RequestTenantClosureResponse{
+		Tenant: tenant,
+	}, nil
+}
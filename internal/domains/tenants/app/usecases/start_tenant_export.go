@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// StartTenantExport handles the use case of requesting a full tenant data export
+type StartTenantExport struct {
+	exportRepo outbound.TenantExportRepository
+	tenantRepo outbound.TenantRepository
+	exporter   *ExportTenantData
+	jobTimeout time.Duration
+}
+
+// NewStartTenantExport creates a new StartTenantExport use case
+// jobTimeout bounds a single export run; active exports older than this are treated as abandoned.
+func NewStartTenantExport(
+	exportRepo outbound.TenantExportRepository,
+	tenantRepo outbound.TenantRepository,
+	exporter *ExportTenantData,
+	jobTimeout time.Duration,
+) *StartTenantExport {
+	return &StartTenantExport{
+		exportRepo: exportRepo,
+		tenantRepo: tenantRepo,
+		exporter:   exporter,
+		jobTimeout: jobTimeout,
+	}
+}
+
+// StartTenantExportRequest represents the request to start a tenant export
+type StartTenantExportRequest struct {
+	TenantID    uuid.UUID
+	RequestedBy *uuid.UUID
+}
+
+// StartTenantExportResponse represents the response from starting a tenant export
+type StartTenantExportResponse struct {
+	Export *model.TenantExport
+}
+
+// Execute executes the use case
+// The export runs in the background; poll GetTenantExport for progress.
+func (uc *StartTenantExport) Execute(ctx context.Context, req *StartTenantExportRequest) (*StartTenantExportResponse, error) {
+	if _, err := uc.tenantRepo.FindByID(ctx, req.TenantID); err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	// Only one export per tenant at a time; Save enforces it against concurrent starts
+	active, err := uc.exportRepo.FindActiveByTenant(ctx, req.TenantID)
+	if err != nil && err != domain.ErrExportNotFound {
+		return nil, err
+	}
+	if active != nil {
+		if time.Since(active.CreatedAt()) < uc.jobTimeout {
+			return nil, domain.ErrExportInProgress
+		}
+		// The job died without recording a result (e.g. the process restarted)
+		active.Fail("export timed out")
+		if err := uc.exportRepo.Update(ctx, active); err != nil {
+			return nil, err
+		}
+	}
+
+	export := model.NewTenantExport(req.TenantID, req.RequestedBy)
+	if err := uc.exportRepo.Save(ctx, export); err != nil {
+		return nil, err
+	}
+
+	// Run the export asynchronously (detached from the request context)
+	go func() {
+		jobCtx, cancel := context.WithTimeout(context.Background(), uc.jobTimeout)
+		defer cancel()
+
+		// Work on a fresh copy so the response can be serialized without racing the job
+		job, err := uc.exportRepo.FindByID(jobCtx, export.ID())
+		if err != nil {
+			log.Error().Err(err).Str("export_id", export.ID().String()).Msg("Failed to load tenant export")
+			return
+		}
+
+		if _, err := uc.exporter.Execute(jobCtx, &ExportTenantDataRequest{Export: job}); err != nil {
+			log.Error().
+				Err(err).
+				Str("export_id", export.ID().String()).
+				Str("tenant_id", req.TenantID.String()).
+				Msg("Tenant export failed")
+			return
+		}
+
+		log.Info().
+			Str("export_id", export.ID().String()).
+			Str("tenant_id", req.TenantID.String()).
+			Msg("Tenant export completed")
+	}()
+
+	return &StartTenantExportResponse{
+		Export: export,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTenantExportRepository is a mock implementation of TenantExportRepository
+type MockTenantExportRepository struct {
+	mock.Mock
+}
+
+func (m *MockTenantExportRepository) Save(ctx context.Context, export *model.TenantExport) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (m *MockTenantExportRepository) Update(ctx context.Context, export *model.TenantExport) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (m *MockTenantExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.TenantExport, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TenantExport), args.Error(1)
+}
+
+func (m *MockTenantExportRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.TenantExport, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.TenantExport), args.Error(1)
+}
+
+func (m *MockTenantExportRepository) FindActiveByTenant(ctx context.Context, tenantID uuid.UUID) (*model.TenantExport, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TenantExport), args.Error(1)
+}
+
+func TestStartTenantExport_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(*MockTenantExportRepository, *MockTenantRepository, *model.Tenant)
+		expectedError error
+	}{
+		{
+			name: "returns error when tenant not found",
+			mockSetup: func(exportRepo *MockTenantExportRepository, tenantRepo *MockTenantRepository, tenant *model.Tenant) {
+				tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(nil, domain.ErrTenantNotFound)
+			},
+			expectedError: domain.ErrTenantNotFound,
+		},
+		{
+			name: "rejects when an export is already running",
+			mockSetup: func(exportRepo *MockTenantExportRepository, tenantRepo *MockTenantRepository, tenant *model.Tenant) {
+				tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+				exportRepo.On("FindActiveByTenant", mock.Anything, tenant.ID()).Return(model.NewTenantExport(tenant.ID(), nil), nil)
+			},
+			expectedError: domain.ErrExportInProgress,
+		},
+		{
+			name: "rejects when a concurrent start saved its export first",
+			mockSetup: func(exportRepo *MockTenantExportRepository, tenantRepo *MockTenantRepository, tenant *model.Tenant) {
+				tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+				exportRepo.On("FindActiveByTenant", mock.Anything, tenant.ID()).Return(nil, domain.ErrExportNotFound)
+				exportRepo.On("Save", mock.Anything, mock.Anything).Return(domain.ErrExportInProgress)
+			},
+			expectedError: domain.ErrExportInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exportRepo := new(MockTenantExportRepository)
+			tenantRepo := new(MockTenantRepository)
+			tenant := model.NewTenant("Acme", "acme", nil, 0, nil)
+
+			tt.mockSetup(exportRepo, tenantRepo, tenant)
+
+			uc := NewStartTenantExport(exportRepo, tenantRepo, nil, 30*time.Minute)
+			resp, err := uc.Execute(context.Background(), &StartTenantExportRequest{
+				TenantID: tenant.ID(),
+			})
+
+			assert.Equal(t, tt.expectedError, err)
+			assert.Nil(t, resp)
+			tenantRepo.AssertExpectations(t)
+			exportRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWriteExportTable(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files, err := writeExportTable(zw, exportTable{
+		name:    "locations",
+		columns: []string{"id", "name", "categories", "is_active", "deleted_at"},
+		rows: []map[string]interface{}{
+			{"id": "loc-1", "name": "Main, Street", "categories": []string{"cafe"}, "is_active": true, "deleted_at": nil},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"locations.json", "locations.csv"}, files)
+	assert.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+
+	contents := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		assert.NoError(t, err)
+		b, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		rc.Close()
+		contents[f.Name] = string(b)
+	}
+
+	assert.Equal(t, "id,name,categories,is_active,deleted_at\nloc-1,\"Main, Street\",\"[\"\"cafe\"\"]\",true,\n", contents["locations.csv"])
+	assert.Contains(t, contents["locations.json"], `"name": "Main, Street"`)
+}
+
+func TestExportObjectKey(t *testing.T) {
+	tenantID := uuid.New()
+	exportID := uuid.New()
+
+	assert.Equal(t, tenantID.String()+"/exports/"+exportID.String()+".zip", ExportObjectKey(tenantID, exportID))
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// SuspendClient handles the use case of suspending a client (platform admin only)
+type SuspendClient struct {
+	clientRepo          outbound.ClientRepository
+	suspensionEventRepo outbound.SuspensionEventRepository
+}
+
+// NewSuspendClient creates a new SuspendClient use case
+func NewSuspendClient(
+	clientRepo outbound.ClientRepository,
+	suspensionEventRepo outbound.SuspensionEventRepository,
+) *SuspendClient {
+	return &SuspendClient{
+		clientRepo:          clientRepo,
+		suspensionEventRepo: suspensionEventRepo,
+	}
+}
+
+// SuspendClientRequest represents the request to suspend a client
+type SuspendClientRequest struct {
+	ClientID    uuid.UUID
+	Reason      model.SuspensionReason
+	Note        string
+	ActorUserID *uuid.UUID
+}
+
+// SuspendClientResponse represents the response from suspending a client
+type SuspendClientResponse struct {
+	Client *model.Client
+}
+
+// Execute executes the use case
+// Suspending an already suspended client replaces the reason and note
+func (uc *SuspendClient) Execute(ctx context.Context, req *SuspendClientRequest) (*SuspendClientResponse, error) {
+	if !model.IsValidSuspensionReason(req.Reason) {
+		return nil, domain.ErrInvalidSuspensionReason
+	}
+
+	client, err := uc.clientRepo.FindByID(ctx, req.ClientID)
+	if err != nil {
+		return nil, domain.ErrClientNotFound
+	}
+
+	note := strings.TrimSpace(req.Note)
+	client.Suspend(req.Reason, note, req.ActorUserID)
+
+	if err := uc.clientRepo.Save(ctx, client); err != nil {
+		return nil, err
+	}
+
+	clientID := client.ID()
+	reason := req.Reason
+	event := model.NewSuspensionEvent(client.AgencyID(), &clientID, model.SuspensionActionSuspend, &reason, note, req.ActorUserID)
+	if err := uc.suspensionEventRepo.Save(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &SuspendClientResponse{
+		Client: client,
+	}, nil
+}
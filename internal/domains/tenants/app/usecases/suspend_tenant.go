@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// SuspendTenant handles the use case of suspending a tenant (platform admin only)
+type SuspendTenant struct {
+	tenantRepo          outbound.TenantRepository
+	suspensionEventRepo outbound.SuspensionEventRepository
+}
+
+// NewSuspendTenant creates a new SuspendTenant use case
+func NewSuspendTenant(
+	tenantRepo outbound.TenantRepository,
+	suspensionEventRepo outbound.SuspensionEventRepository,
+) *SuspendTenant {
+	return &SuspendTenant{
+		tenantRepo:          tenantRepo,
+		suspensionEventRepo: suspensionEventRepo,
+	}
+}
+
+// SuspendTenantRequest represents the request to suspend a tenant
+type SuspendTenantRequest struct {
+	TenantID    uuid.UUID
+	Reason      model.SuspensionReason
+	Note        string
+	ActorUserID *uuid.UUID
+}
+
+// SuspendTenantResponse represents the response from suspending a tenant
+type SuspendTenantResponse struct {
+	Tenant *model.Tenant
+}
+
+// Execute executes the use case
+// Suspending an already suspended tenant replaces the reason and note
+func (uc *SuspendTenant) Execute(ctx context.Context, req *SuspendTenantRequest) (*SuspendTenantResponse, error) {
+	if !model.IsValidSuspensionReason(req.Reason) {
+		return nil, domain.ErrInvalidSuspensionReason
+	}
+
+	tenant, err := uc.tenantRepo.FindByID(ctx, req.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	note := strings.TrimSpace(req.Note)
+	tenant.Suspend(req.Reason, note, req.ActorUserID)
+
+	if err := uc.tenantRepo.Update(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	reason := req.Reason
+	event := model.NewSuspensionEvent(tenant.ID(), nil, model.SuspensionActionSuspend, &reason, note, req.ActorUserID)
+	if err := uc.suspensionEventRepo.Save(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &SuspendTenantResponse{
+		Tenant: tenant,
+	}, nil
+}
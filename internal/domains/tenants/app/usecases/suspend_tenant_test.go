@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSuspensionEventRepository is a mock implementation of SuspensionEventRepository
+type MockSuspensionEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockSuspensionEventRepository) Save(ctx context.Context, event *model.SuspensionEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockSuspensionEventRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.SuspensionEvent, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.SuspensionEvent), args.Error(1)
+}
+
+func TestSuspendTenant_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		reason         model.SuspensionReason
+		mockSetup      func(*MockTenantRepository, *MockSuspensionEventRepository, *model.Tenant)
+		expectedError  error
+		expectedAccess model.SuspensionAccess
+	}{
+		{
+			name:   "billing suspension is read-only",
+			reason: model.SuspensionReasonBilling,
+			mockSetup: func(tenantRepo *MockTenantRepository, eventRepo *MockSuspensionEventRepository, tenant *model.Tenant) {
+				tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+				tenantRepo.On("Update", mock.Anything, tenant).Return(nil)
+				eventRepo.On("Save", mock.Anything, mock.MatchedBy(func(e *model.SuspensionEvent) bool {
+					return e.Action() == model.SuspensionActionSuspend && e.Note() == "invoice overdue"
+				})).Return(nil)
+			},
+			expectedAccess: model.SuspensionAccessReadOnly,
+		},
+		{
+			name:   "policy violation suspension is blocked",
+			reason: model.SuspensionReasonPolicyViolation,
+			mockSetup: func(tenantRepo *MockTenantRepository, eventRepo *MockSuspensionEventRepository, tenant *model.Tenant) {
+				tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+				tenantRepo.On("Update", mock.Anything, tenant).Return(nil)
+				eventRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedAccess: model.SuspensionAccessBlocked,
+		},
+		{
+			name:          "rejects unknown reason",
+			reason:        model.SuspensionReason("because"),
+			expectedError: domain.ErrInvalidSuspensionReason,
+		},
+		{
+			name:   "returns error when tenant not found",
+			reason: model.SuspensionReasonSecurity,
+			mockSetup: func(tenantRepo *MockTenantRepository, eventRepo *MockSuspensionEventRepository, tenant *model.Tenant) {
+				tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(nil, domain.ErrTenantNotFound)
+			},
+			expectedError: domain.ErrTenantNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantRepo := new(MockTenantRepository)
+			eventRepo := new(MockSuspensionEventRepository)
+			tenant := model.NewTenant("Acme", "acme", nil, 0, nil)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(tenantRepo, eventRepo, tenant)
+			}
+
+			uc := NewSuspendTenant(tenantRepo, eventRepo)
+			resp, err := uc.Execute(context.Background(), &SuspendTenantRequest{
+				TenantID: tenant.ID(),
+				Reason:   tt.reason,
+				Note:     "  invoice overdue ",
+			})
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, resp.Tenant.IsSuspended())
+				assert.Equal(t, tt.reason, resp.Tenant.Suspension().Reason())
+				assert.Equal(t, tt.expectedAccess, resp.Tenant.SuspensionAccess())
+			}
+
+			tenantRepo.AssertExpectations(t)
+			eventRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReinstateTenant_Execute(t *testing.T) {
+	t.Run("reinstates suspended tenant", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		eventRepo := new(MockSuspensionEventRepository)
+		tenant := model.NewTenant("Acme", "acme", nil, 0, nil)
+		tenant.Suspend(model.SuspensionReasonBilling, "", nil)
+
+		tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+		tenantRepo.On("Update", mock.Anything, tenant).Return(nil)
+		eventRepo.On("Save", mock.Anything, mock.MatchedBy(func(e *model.SuspensionEvent) bool {
+			return e.Action() == model.SuspensionActionReinstate && e.Note() == "paid" && e.Reason() == nil
+		})).Return(nil)
+
+		resp, err := NewReinstateTenant(tenantRepo, eventRepo).Execute(context.Background(), &ReinstateTenantRequest{
+			TenantID: tenant.ID(),
+			Note:     "paid",
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, resp.Tenant.IsActive())
+		assert.Nil(t, resp.Tenant.Suspension())
+		tenantRepo.AssertExpectations(t)
+		eventRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns error when tenant is not suspended", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		eventRepo := new(MockSuspensionEventRepository)
+		tenant := model.NewTenant("Acme", "acme", nil, 0, nil)
+
+		tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+
+		resp, err := NewReinstateTenant(tenantRepo, eventRepo).Execute(context.Background(), &ReinstateTenantRequest{
+			TenantID: tenant.ID(),
+		})
+
+		assert.Equal(t, domain.ErrTenantNotSuspended, err)
+		assert.Nil(t, resp)
+		eventRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
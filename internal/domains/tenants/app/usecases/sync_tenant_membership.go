@@ -0,0 +1,153 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+)
+
+// SyncTenantMembership handles the use case of applying an identity provider membership change to a tenant
+type SyncTenantMembership struct {
+	tenantRepo outbound.TenantRepository
+	memberRepo outbound.TenantMemberRepository
+	revoker    outbound.AccessRevoker
+}
+
+// NewSyncTenantMembership creates a new SyncTenantMembership use case
+func NewSyncTenantMembership(tenantRepo outbound.TenantRepository, memberRepo outbound.TenantMemberRepository, revoker outbound.AccessRevoker) *SyncTenantMembership {
+	return &SyncTenantMembership{
+		tenantRepo: tenantRepo,
+		memberRepo: memberRepo,
+		revoker:    revoker,
+	}
+}
+
+// SyncTenantMembershipRequest represents a membership change to apply
+// TenantID is the tenant the organization is linked to; changes for unlinked organizations fail with ErrTenantNotFound.
+type SyncTenantMembershipRequest struct {
+	TenantID *uuid.UUID
+	UserID   uuid.UUID
+	Role     string // identity provider role, e.g. "org:admin"
+	Removed  bool
+}
+
+// SyncTenantMembershipResponse represents the response from syncing a membership
+type SyncTenantMembershipResponse struct {
+	TenantID uuid.UUID
+	Member   *model.TenantMember // nil when the membership was removed
+	Changed  bool
+}
+
+// Execute executes the use case
+// Applying the same change twice is a no-op, and a change that would leave the tenant without an owner fails with ErrLastOwner.
+func (uc *SyncTenantMembership) Execute(ctx context.Context, req *SyncTenantMembershipRequest) (*SyncTenantMembershipResponse, error) {
+	// Organization slugs are chosen by whoever creates the organization, so only a stored link identifies the tenant
+	if req.TenantID == nil {
+		return nil, domain.ErrTenantNotFound
+	}
+	tenant, err := uc.tenantRepo.FindByID(ctx, *req.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantNotFound
+	}
+
+	existing, err := uc.memberRepo.FindByTenantAndUserID(ctx, tenant.ID(), req.UserID)
+	if err != nil {
+		if err != domain.ErrMemberNotFound {
+			return nil, err
+		}
+		existing = nil
+	}
+
+	resp := &SyncTenantMembershipResponse{
+		TenantID: tenant.ID(),
+		Member:   existing,
+	}
+
+	if req.Removed {
+		if existing == nil {
+			return resp, nil
+		}
+		if err := uc.ensureNotLastOwner(ctx, existing); err != nil {
+			return nil, err
+		}
+		if err := uc.memberRepo.DeleteByTenantAndUserID(ctx, tenant.ID(), req.UserID); err != nil && err != domain.ErrMemberNotFound {
+			return nil, err
+		}
+		revokeAccess(ctx, uc.revoker, req.UserID)
+		resp.Member = nil
+		resp.Changed = true
+		return resp, nil
+	}
+
+	role, err := roleFromIdentityProvider(req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		member := model.NewTenantMember(tenant.ID(), req.UserID, role)
+		if err := uc.memberRepo.Save(ctx, member); err != nil {
+			return nil, err
+		}
+		resp.Member = member
+		resp.Changed = true
+		return resp, nil
+	}
+
+	if existing.Role() == role {
+		return resp, nil
+	}
+	if role != model.RoleOwner {
+		if err := uc.ensureNotLastOwner(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.SetRole(role)
+	if err := uc.memberRepo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	revokeAccess(ctx, uc.revoker, req.UserID)
+	resp.Changed = true
+
+	return resp, nil
+}
+
+// ensureNotLastOwner returns ErrLastOwner if member is the tenant's only owner
+func (uc *SyncTenantMembership) ensureNotLastOwner(ctx context.Context, member *model.TenantMember) error {
+	if member.Role() != model.RoleOwner {
+		return nil
+	}
+
+	isLast, err := isLastOwner(ctx, uc.memberRepo, member.TenantID(), member.UserID())
+	if err != nil {
+		return err
+	}
+	if isLast {
+		return domain.ErrLastOwner
+	}
+	return nil
+}
+
+// roleFromIdentityProvider maps an identity provider role to a tenant role
+// Clerk's built-in "org:member" maps to viewer (least privilege); client viewers are only created through client membership.
+func roleFromIdentityProvider(role string) (model.Role, error) {
+	normalized := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(role)), "org:")
+
+	switch model.Role(normalized) {
+	case model.RoleOwner, model.RoleAdmin, model.RoleStaff, model.RoleViewer:
+		return model.Role(normalized), nil
+	}
+
+	switch normalized {
+	case "member", "basic_member":
+		return model.RoleViewer, nil
+	}
+
+	return "", domain.ErrInvalidRole
+}
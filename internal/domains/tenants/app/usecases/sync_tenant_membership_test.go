@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccessRevoker is a mock implementation of AccessRevoker
+type MockAccessRevoker struct {
+	mock.Mock
+}
+
+func (m *MockAccessRevoker) RevokeAccess(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func TestSyncTenantMembership_Execute(t *testing.T) {
+	tenant := model.NewTenant("Acme", "acme", nil, 0, nil)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		existingRole  model.Role
+		otherOwner    bool
+		role          string
+		removed       bool
+		expectedRole  model.Role
+		expectChanged bool
+		expectedError error
+	}{
+		{
+			name:          "adds a new member with the mapped role",
+			role:          "org:admin",
+			expectedRole:  model.RoleAdmin,
+			expectChanged: true,
+		},
+		{
+			name:          "maps the built-in member role to viewer",
+			role:          "org:member",
+			expectedRole:  model.RoleViewer,
+			expectChanged: true,
+		},
+		{
+			name:         "is a no-op when the role is unchanged",
+			existingRole: model.RoleAdmin,
+			role:         "org:admin",
+			expectedRole: model.RoleAdmin,
+		},
+		{
+			name:          "rejects demoting the last owner",
+			existingRole:  model.RoleOwner,
+			role:          "org:member",
+			expectedError: domain.ErrLastOwner,
+		},
+		{
+			name:          "demotes an owner when another owner remains",
+			existingRole:  model.RoleOwner,
+			otherOwner:    true,
+			role:          "org:admin",
+			expectedRole:  model.RoleAdmin,
+			expectChanged: true,
+		},
+		{
+			name:          "removes an existing member",
+			existingRole:  model.RoleStaff,
+			removed:       true,
+			expectChanged: true,
+		},
+		{
+			name:    "removing a missing member is a no-op",
+			removed: true,
+		},
+		{
+			name:          "rejects unknown roles",
+			role:          "org:billing",
+			expectedError: domain.ErrInvalidRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantRepo := new(MockTenantRepository)
+			memberRepo := new(MockTenantMemberRepository)
+			revoker := new(MockAccessRevoker)
+
+			revoker.On("RevokeAccess", mock.Anything, userID).Return(nil).Maybe()
+			tenantRepo.On("FindByID", mock.Anything, tenant.ID()).Return(tenant, nil)
+
+			if tt.existingRole != "" {
+				existing := model.NewTenantMember(tenant.ID(), userID, tt.existingRole)
+				memberRepo.On("FindByTenantAndUserID", mock.Anything, tenant.ID(), userID).Return(existing, nil)
+
+				members := []*model.TenantMember{existing}
+				if tt.otherOwner {
+					members = append(members, model.NewTenantMember(tenant.ID(), uuid.New(), model.RoleOwner))
+				}
+				memberRepo.On("FindByTenantID", mock.Anything, tenant.ID()).Return(members, nil).Maybe()
+				memberRepo.On("Update", mock.Anything, existing).Return(nil).Maybe()
+				memberRepo.On("DeleteByTenantAndUserID", mock.Anything, tenant.ID(), userID).Return(nil).Maybe()
+			} else {
+				memberRepo.On("FindByTenantAndUserID", mock.Anything, tenant.ID(), userID).Return(nil, domain.ErrMemberNotFound)
+				memberRepo.On("Save", mock.Anything, mock.AnythingOfType("*model.TenantMember")).Return(nil).Maybe()
+			}
+
+			uc := NewSyncTenantMembership(tenantRepo, memberRepo, revoker)
+			tenantID := tenant.ID()
+			resp, err := uc.Execute(context.Background(), &SyncTenantMembershipRequest{
+				TenantID: &tenantID,
+				UserID:   userID,
+				Role:     tt.role,
+				Removed:  tt.removed,
+			})
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, resp)
+				memberRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				memberRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectChanged, resp.Changed)
+			if tt.removed {
+				assert.Nil(t, resp.Member)
+			} else {
+				assert.Equal(t, tt.expectedRole, resp.Member.Role())
+			}
+			if !tt.expectChanged {
+				memberRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				memberRepo.AssertNotCalled(t, "DeleteByTenantAndUserID", mock.Anything, mock.Anything, mock.Anything)
+			}
+			// Existing access is revoked when a membership is removed or its role changes
+			if tt.expectChanged && tt.existingRole != "" {
+				revoker.AssertCalled(t, "RevokeAccess", mock.Anything, userID)
+			} else {
+				revoker.AssertNotCalled(t, "RevokeAccess", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestSyncTenantMembership_IgnoresUnlinkedOrganizations(t *testing.T) {
+	tenantRepo := new(MockTenantRepository)
+	memberRepo := new(MockTenantMemberRepository)
+	revoker := new(MockAccessRevoker)
+
+	// An organization whose slug matches a tenant's is not linked to it; anyone can create one and be its admin
+	uc := NewSyncTenantMembership(tenantRepo, memberRepo, revoker)
+	for _, role := range []string{"org:admin", "org:owner"} {
+		resp, err := uc.Execute(context.Background(), &SyncTenantMembershipRequest{
+			UserID: uuid.New(),
+			Role:   role,
+		})
+		assert.Equal(t, domain.ErrTenantNotFound, err)
+		assert.Nil(t, resp)
+	}
+
+	tenantRepo.AssertNotCalled(t, "FindBySlug", mock.Anything, mock.Anything)
+	memberRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	memberRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
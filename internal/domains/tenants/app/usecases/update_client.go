@@ -29,6 +29,8 @@ type UpdateClientRequest struct {
 	Name     *string
 	Slug     *string
 	Status   *model.ClientStatus
+	// ExpectedVersion, if set, makes the update fail with ErrVersionConflict unless the client is still at this version
+	ExpectedVersion *int64
 }
 
 // UpdateClientResponse represents the response from updating a client
@@ -44,6 +46,10 @@ func (uc *UpdateClient) Execute(ctx context.Context, req *UpdateClientRequest) (
 		return nil, domain.ErrClientNotFound
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != client.Version() {
+		return nil, domain.ErrVersionConflict
+	}
+
 	// Update fields if provided
 	if req.Name != nil {
 		name := strings.TrimSpace(*req.Name)
@@ -60,6 +66,10 @@ func (uc *UpdateClient) Execute(ctx context.Context, req *UpdateClientRequest) (
 	}
 
 	if req.Status != nil {
+		// Suspension is managed through the admin suspend/reinstate flow so the reason is always recorded
+		if *req.Status != client.Status() && (*req.Status == model.ClientStatusSuspended || client.IsSuspended()) {
+			return nil, domain.ErrSuspensionStatusReadOnly
+		}
 		client.SetStatus(*req.Status)
 	}
 
@@ -31,6 +31,8 @@ type UpdateLocationRequest struct {
 	BusinessHours *map[string]interface{}
 	Categories    *[]string
 	IsActive      *bool
+	// ExpectedVersion, if set, makes the update fail with ErrVersionConflict unless the location is still at this version
+	ExpectedVersion *int64
 }
 
 // UpdateLocationResponse represents the response from updating a location
@@ -46,6 +48,10 @@ func (uc *UpdateLocation) Execute(ctx context.Context, req *UpdateLocationReques
 		return nil, domain.ErrLocationNotFound
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != location.Version() {
+		return nil, domain.ErrVersionConflict
+	}
+
 	// Update fields if provided
 	if req.Name != nil {
 		location.SetName(*req.Name)
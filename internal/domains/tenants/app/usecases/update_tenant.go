@@ -31,6 +31,8 @@ type UpdateTenantRequest struct {
 	Status          *model.TenantStatus
 	Tier            *model.Tier
 	AgencySeatLimit *int
+	// ExpectedVersion, if set, makes the update fail with ErrVersionConflict unless the tenant is still at this version
+	ExpectedVersion *int64
 }
 
 // UpdateTenantResponse represents the response from updating a tenant
@@ -46,6 +48,10 @@ func (uc *UpdateTenant) Execute(ctx context.Context, req *UpdateTenantRequest) (
 		return nil, domain.ErrTenantNotFound
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != tenant.Version() {
+		return nil, domain.ErrVersionConflict
+	}
+
 	// Update fields if provided
 	if req.Name != nil {
 		name := strings.TrimSpace(*req.Name)
@@ -70,6 +76,10 @@ func (uc *UpdateTenant) Execute(ctx context.Context, req *UpdateTenantRequest) (
 	}
 
 	if req.Status != nil {
+		// Suspension is managed through the admin suspend/reinstate flow so the reason is always recorded
+		if *req.Status != tenant.Status() && (*req.Status == model.TenantStatusSuspended || tenant.IsSuspended()) {
+			return nil, domain.ErrSuspensionStatusReadOnly
+		}
 		tenant.SetStatus(*req.Status)
 	}
 
@@ -6,6 +6,9 @@ var (
 	// ErrTenantNotFound is returned when a tenant is not found
 	ErrTenantNotFound = errors.New("tenant not found")
 
+	// ErrVersionConflict is returned when a resource was modified since the version the caller last read
+	ErrVersionConflict = errors.New("resource was modified by another request")
+
 	// ErrTenantAlreadyExists is returned when a tenant with the same slug already exists
 	ErrTenantAlreadyExists = errors.New("tenant already exists")
 
@@ -21,6 +24,9 @@ var (
 	// ErrMemberAlreadyExists is returned when a user is already a member
 	ErrMemberAlreadyExists = errors.New("member already exists")
 
+	// ErrLastOwner is returned when removing a user would leave a tenant without an owner
+	ErrLastOwner = errors.New("user is the last owner of a tenant")
+
 	// ErrInvalidRole is returned when an invalid role is provided
 	ErrInvalidRole = errors.New("invalid role")
 
@@ -62,4 +68,40 @@ var (
 
 	// ErrClientSeatLimitExceeded is returned when client seat limit is exceeded
 	ErrClientSeatLimitExceeded = errors.New("client seat limit exceeded")
+
+	// ErrTenantSuspended is returned when an operation is attempted on a suspended tenant
+	ErrTenantSuspended = errors.New("tenant suspended")
+
+	// ErrClientSuspended is returned when an operation is attempted on a suspended client
+	ErrClientSuspended = errors.New("client suspended")
+
+	// ErrTenantNotSuspended is returned when reinstating a tenant that is not suspended
+	ErrTenantNotSuspended = errors.New("tenant is not suspended")
+
+	// ErrClientNotSuspended is returned when reinstating a client that is not suspended
+	ErrClientNotSuspended = errors.New("client is not suspended")
+
+	// ErrInvalidSuspensionReason is returned when an unknown suspension reason is provided
+	ErrInvalidSuspensionReason = errors.New("invalid suspension reason")
+
+	// ErrSuspensionStatusReadOnly is returned when the suspended status is changed outside the admin suspend/reinstate flow
+	ErrSuspensionStatusReadOnly = errors.New("suspension status can only be changed by a platform admin")
+
+	// ErrExportNotFound is returned when a tenant export is not found
+	ErrExportNotFound = errors.New("export not found")
+
+	// ErrExportInProgress is returned when a tenant already has a pending or running export
+	ErrExportInProgress = errors.New("an export is already in progress for this tenant")
+
+	// ErrTenantClosing is returned when an operation is attempted on a tenant with a pending closure
+	ErrTenantClosing = errors.New("tenant is scheduled for closure")
+
+	// ErrClosureAlreadyRequested is returned when requesting closure for a tenant that is already closing
+	ErrClosureAlreadyRequested = errors.New("tenant closure already requested")
+
+	// ErrTenantNotClosing is returned when cancelling closure for a tenant that is not closing
+	ErrTenantNotClosing = errors.New("tenant closure has not been requested")
+
+	// ErrClosureConfirmationMismatch is returned when the closure confirmation does not match the tenant slug
+	ErrClosureConfirmationMismatch = errors.New("closure confirmation does not match tenant slug")
 )
@@ -9,15 +9,17 @@ import (
 
 // Client represents a client (SMB) account under an agency
 type Client struct {
-	id        uuid.UUID
-	agencyID  uuid.UUID
-	name      string
-	slug      string
-	tier      Tier
-	status    ClientStatus
-	createdAt time.Time
-	updatedAt time.Time
-	deletedAt *time.Time
+	id         uuid.UUID
+	agencyID   uuid.UUID
+	name       string
+	slug       string
+	tier       Tier
+	status     ClientStatus
+	createdAt  time.Time
+	updatedAt  time.Time
+	deletedAt  *time.Time
+	suspension *Suspension
+	version    int64
 }
 
 // ClientStatus represents the status of a client
@@ -42,21 +44,24 @@ func NewClient(agencyID uuid.UUID, name, slug string, tier Tier) *Client {
 		createdAt: now,
 		updatedAt: now,
 		deletedAt: nil,
+		version:   1,
 	}
 }
 
 // NewClientWithID creates a client entity with a specific ID (used for reconstruction from database)
-func NewClientWithID(id, agencyID uuid.UUID, name, slug string, tier Tier, status ClientStatus, createdAt, updatedAt time.Time, deletedAt *time.Time) *Client {
+func NewClientWithID(id, agencyID uuid.UUID, name, slug string, tier Tier, status ClientStatus, createdAt, updatedAt time.Time, deletedAt *time.Time, suspension *Suspension, version int64) *Client {
 	return &Client{
-		id:        id,
-		agencyID:  agencyID,
-		name:      name,
-		slug:      slug,
-		tier:      tier,
-		status:    status,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
-		deletedAt: deletedAt,
+		id:         id,
+		agencyID:   agencyID,
+		name:       name,
+		slug:       slug,
+		tier:       tier,
+		status:     status,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+		deletedAt:  deletedAt,
+		suspension: suspension,
+		version:    version,
 	}
 }
 
@@ -65,6 +70,16 @@ func (c *Client) ID() uuid.UUID {
 	return c.id
 }
 
+// Version returns the client version (bumped on every update; used for optimistic concurrency)
+func (c *Client) Version() int64 {
+	return c.version
+}
+
+// SetVersion sets the client version (called by the repository after a successful save)
+func (c *Client) SetVersion(version int64) {
+	c.version = version
+}
+
 // AgencyID returns the agency ID
 func (c *Client) AgencyID() uuid.UUID {
 	return c.agencyID
@@ -129,6 +144,30 @@ func (c *Client) SetStatus(status ClientStatus) {
 	c.updatedAt = time.Now()
 }
 
+// Suspension returns the active suspension (nil if not suspended)
+func (c *Client) Suspension() *Suspension {
+	return c.suspension
+}
+
+// IsSuspended checks if the client is suspended
+func (c *Client) IsSuspended() bool {
+	return c.status == ClientStatusSuspended
+}
+
+// Suspend marks the client as suspended with a reason
+func (c *Client) Suspend(reason SuspensionReason, note string, suspendedBy *uuid.UUID) {
+	c.status = ClientStatusSuspended
+	c.suspension = NewSuspension(reason, note, suspendedBy)
+	c.updatedAt = time.Now()
+}
+
+// Reinstate lifts the suspension and reactivates the client
+func (c *Client) Reinstate() {
+	c.status = ClientStatusActive
+	c.suspension = nil
+	c.updatedAt = time.Now()
+}
+
 // IsActive checks if the client is active
 func (c *Client) IsActive() bool {
 	return c.status == ClientStatusActive && !c.IsDeleted()
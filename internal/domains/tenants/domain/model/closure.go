@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenantClosure holds the details of a pending tenant closure
+// While a closure is pending the tenant is read-only; once PurgeAfter passes all tenant data is hard-deleted.
+type TenantClosure struct {
+	requestedAt time.Time
+	requestedBy *uuid.UUID
+	purgeAfter  time.Time
+}
+
+// NewTenantClosure creates a new closure starting now with the given retention window
+func NewTenantClosure(requestedBy *uuid.UUID, retention time.Duration) *TenantClosure {
+	now := time.Now()
+	return &TenantClosure{
+		requestedAt: now,
+		requestedBy: requestedBy,
+		purgeAfter:  now.Add(retention),
+	}
+}
+
+// NewTenantClosureWithTime creates a closure with specific timestamps (used for reconstruction from database)
+func NewTenantClosureWithTime(requestedAt time.Time, requestedBy *uuid.UUID, purgeAfter time.Time) *TenantClosure {
+	return &TenantClosure{
+		requestedAt: requestedAt,
+		requestedBy: requestedBy,
+		purgeAfter:  purgeAfter,
+	}
+}
+
+// RequestedAt returns when the closure was requested
+func (c *TenantClosure) RequestedAt() time.Time {
+	return c.requestedAt
+}
+
+// RequestedBy returns the user who requested the closure
+func (c *TenantClosure) RequestedBy() *uuid.UUID {
+	return c.requestedBy
+}
+
+// PurgeAfter returns when the tenant's data becomes eligible for purge
+func (c *TenantClosure) PurgeAfter() time.Time {
+	return c.purgeAfter
+}
+
+// IsDue checks if the retention window has elapsed
+func (c *TenantClosure) IsDue(now time.Time) bool {
+	return !now.Before(c.purgeAfter)
+}
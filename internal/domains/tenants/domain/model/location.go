@@ -19,6 +19,7 @@ type Location struct {
 	createdAt     time.Time
 	updatedAt     time.Time
 	deletedAt     *time.Time
+	version       int64
 }
 
 // NewLocation creates a new location entity
@@ -35,11 +36,12 @@ func NewLocation(clientID uuid.UUID, name string) *Location {
 		createdAt:     now,
 		updatedAt:     now,
 		deletedAt:     nil,
+		version:       1,
 	}
 }
 
 // NewLocationWithID creates a location entity with a specific ID (used for reconstruction from database)
-func NewLocationWithID(id, clientID uuid.UUID, name, phone string, address, businessHours map[string]interface{}, categories []string, isActive bool, createdAt, updatedAt time.Time, deletedAt *time.Time) *Location {
+func NewLocationWithID(id, clientID uuid.UUID, name, phone string, address, businessHours map[string]interface{}, categories []string, isActive bool, createdAt, updatedAt time.Time, deletedAt *time.Time, version int64) *Location {
 	if address == nil {
 		address = make(map[string]interface{})
 	}
@@ -61,6 +63,7 @@ func NewLocationWithID(id, clientID uuid.UUID, name, phone string, address, busi
 		createdAt:     createdAt,
 		updatedAt:     updatedAt,
 		deletedAt:     deletedAt,
+		version:       version,
 	}
 }
 
@@ -69,6 +72,16 @@ func (l *Location) ID() uuid.UUID {
 	return l.id
 }
 
+// Version returns the location version (bumped on every update; used for optimistic concurrency)
+func (l *Location) Version() int64 {
+	return l.version
+}
+
+// SetVersion sets the location version (called by the repository after a successful save)
+func (l *Location) SetVersion(version int64) {
+	l.version = version
+}
+
 // ClientID returns the client ID
 func (l *Location) ClientID() uuid.UUID {
 	return l.clientID
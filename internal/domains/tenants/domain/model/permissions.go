@@ -0,0 +1,30 @@
+package model
+
+// Permission names granted by tenant roles
+const (
+	PermissionManageTenant   = "manage_tenant"
+	PermissionManageMembers  = "manage_members"
+	PermissionManageBranding = "manage_branding"
+	PermissionManageContent  = "manage_content"
+	PermissionViewAll        = "view_all"
+	PermissionViewClient     = "view_client"
+)
+
+// RolePermissions returns the permissions granted by a role
+// Client viewers only see the clients they are assigned to
+func RolePermissions(role Role) []string {
+	switch role {
+	case RoleOwner:
+		return []string{PermissionManageTenant, PermissionManageMembers, PermissionManageBranding, PermissionViewAll}
+	case RoleAdmin:
+		return []string{PermissionManageMembers, PermissionManageBranding, PermissionViewAll}
+	case RoleStaff:
+		return []string{PermissionManageContent, PermissionViewAll}
+	case RoleViewer:
+		return []string{PermissionViewAll}
+	case RoleClientViewer:
+		return []string{PermissionViewClient}
+	default:
+		return []string{}
+	}
+}
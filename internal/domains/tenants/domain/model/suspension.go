@@ -0,0 +1,198 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SuspensionReason represents why a tenant or client was suspended
+type SuspensionReason string
+
+const (
+	// SuspensionReasonBilling is used for unpaid invoices or failed payments (read-only access)
+	SuspensionReasonBilling SuspensionReason = "billing"
+	// SuspensionReasonOwnerRequest is used when the account owner asked to pause the account (read-only access)
+	SuspensionReasonOwnerRequest SuspensionReason = "owner_request"
+	// SuspensionReasonPolicyViolation is used for terms of service or abuse violations (blocked access)
+	SuspensionReasonPolicyViolation SuspensionReason = "policy_violation"
+	// SuspensionReasonSecurity is used for compromised accounts or active investigations (blocked access)
+	SuspensionReasonSecurity SuspensionReason = "security"
+)
+
+// SuspensionAccess represents the level of access granted while suspended
+type SuspensionAccess string
+
+const (
+	// SuspensionAccessReadOnly allows safe (read) requests but rejects writes
+	SuspensionAccessReadOnly SuspensionAccess = "read_only"
+	// SuspensionAccessBlocked rejects all requests
+	SuspensionAccessBlocked SuspensionAccess = "blocked"
+)
+
+// IsValidSuspensionReason checks if a suspension reason is valid
+func IsValidSuspensionReason(reason SuspensionReason) bool {
+	switch reason {
+	case SuspensionReasonBilling, SuspensionReasonOwnerRequest, SuspensionReasonPolicyViolation, SuspensionReasonSecurity:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the string representation of the reason
+func (r SuspensionReason) String() string {
+	return string(r)
+}
+
+// Access returns the access level granted for this suspension reason
+// Unknown reasons fail closed (blocked)
+func (r SuspensionReason) Access() SuspensionAccess {
+	switch r {
+	case SuspensionReasonBilling, SuspensionReasonOwnerRequest:
+		return SuspensionAccessReadOnly
+	default:
+		return SuspensionAccessBlocked
+	}
+}
+
+// Suspension holds the details of an active suspension
+type Suspension struct {
+	reason      SuspensionReason
+	note        string
+	suspendedBy *uuid.UUID
+	suspendedAt time.Time
+}
+
+// NewSuspension creates a new suspension starting now
+func NewSuspension(reason SuspensionReason, note string, suspendedBy *uuid.UUID) *Suspension {
+	return &Suspension{
+		reason:      reason,
+		note:        note,
+		suspendedBy: suspendedBy,
+		suspendedAt: time.Now(),
+	}
+}
+
+// NewSuspensionWithTime creates a suspension with a specific timestamp (used for reconstruction from database)
+func NewSuspensionWithTime(reason SuspensionReason, note string, suspendedBy *uuid.UUID, suspendedAt time.Time) *Suspension {
+	return &Suspension{
+		reason:      reason,
+		note:        note,
+		suspendedBy: suspendedBy,
+		suspendedAt: suspendedAt,
+	}
+}
+
+// Reason returns the suspension reason
+func (s *Suspension) Reason() SuspensionReason {
+	return s.reason
+}
+
+// Note returns the note recorded when suspending
+func (s *Suspension) Note() string {
+	return s.note
+}
+
+// SuspendedBy returns the user who suspended the account (nil if system initiated)
+func (s *Suspension) SuspendedBy() *uuid.UUID {
+	return s.suspendedBy
+}
+
+// SuspendedAt returns when the suspension started
+func (s *Suspension) SuspendedAt() time.Time {
+	return s.suspendedAt
+}
+
+// Access returns the access level granted while suspended
+func (s *Suspension) Access() SuspensionAccess {
+	return s.reason.Access()
+}
+
+// SuspensionAction represents an action recorded in the suspension history
+type SuspensionAction string
+
+const (
+	SuspensionActionSuspend   SuspensionAction = "suspend"
+	SuspensionActionReinstate SuspensionAction = "reinstate"
+)
+
+// SuspensionEvent records a suspend or reinstate action for audit purposes
+type SuspensionEvent struct {
+	id          uuid.UUID
+	tenantID    uuid.UUID
+	clientID    *uuid.UUID
+	action      SuspensionAction
+	reason      *SuspensionReason
+	note        string
+	actorUserID *uuid.UUID
+	createdAt   time.Time
+}
+
+// NewSuspensionEvent creates a new suspension event
+func NewSuspensionEvent(tenantID uuid.UUID, clientID *uuid.UUID, action SuspensionAction, reason *SuspensionReason, note string, actorUserID *uuid.UUID) *SuspensionEvent {
+	return &SuspensionEvent{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		clientID:    clientID,
+		action:      action,
+		reason:      reason,
+		note:        note,
+		actorUserID: actorUserID,
+		createdAt:   time.Now(),
+	}
+}
+
+// NewSuspensionEventWithID creates a suspension event with a specific ID (used for reconstruction from database)
+func NewSuspensionEventWithID(id, tenantID uuid.UUID, clientID *uuid.UUID, action SuspensionAction, reason *SuspensionReason, note string, actorUserID *uuid.UUID, createdAt time.Time) *SuspensionEvent {
+	return &SuspensionEvent{
+		id:          id,
+		tenantID:    tenantID,
+		clientID:    clientID,
+		action:      action,
+		reason:      reason,
+		note:        note,
+		actorUserID: actorUserID,
+		createdAt:   createdAt,
+	}
+}
+
+// ID returns the event ID
+func (e *SuspensionEvent) ID() uuid.UUID {
+	return e.id
+}
+
+// TenantID returns the tenant ID
+func (e *SuspensionEvent) TenantID() uuid.UUID {
+	return e.tenantID
+}
+
+// ClientID returns the client ID (nil for tenant-level events)
+func (e *SuspensionEvent) ClientID() *uuid.UUID {
+	return e.clientID
+}
+
+// Action returns the recorded action
+func (e *SuspensionEvent) Action() SuspensionAction {
+	return e.action
+}
+
+// Reason returns the suspension reason (nil for reinstatements)
+func (e *SuspensionEvent) Reason() *SuspensionReason {
+	return e.reason
+}
+
+// Note returns the note attached to the action
+func (e *SuspensionEvent) Note() string {
+	return e.note
+}
+
+// ActorUserID returns the user who performed the action
+func (e *SuspensionEvent) ActorUserID() *uuid.UUID {
+	return e.actorUserID
+}
+
+// CreatedAt returns the creation timestamp
+func (e *SuspensionEvent) CreatedAt() time.Time {
+	return e.createdAt
+}
@@ -19,6 +19,9 @@ type Tenant struct {
 	createdAt        time.Time
 	updatedAt        time.Time
 	deletedAt        *time.Time
+	suspension       *Suspension
+	closure          *TenantClosure
+	version          int64
 }
 
 // TenantStatus represents the status of a tenant
@@ -49,11 +52,12 @@ func NewTenant(name, slug string, tier *Tier, agencySeatLimit int, inviteExpiryH
 		createdAt:        now,
 		updatedAt:        now,
 		deletedAt:        nil,
+		version:          1,
 	}
 }
 
 // NewTenantWithID creates a tenant entity with a specific ID (used for reconstruction from database)
-func NewTenantWithID(id uuid.UUID, name, slug string, status TenantStatus, tier *Tier, agencySeatLimit int, inviteExpiryHours *int, createdAt, updatedAt time.Time, deletedAt *time.Time) *Tenant {
+func NewTenantWithID(id uuid.UUID, name, slug string, status TenantStatus, tier *Tier, agencySeatLimit int, inviteExpiryHours *int, createdAt, updatedAt time.Time, deletedAt *time.Time, suspension *Suspension, closure *TenantClosure, version int64) *Tenant {
 	return &Tenant{
 		id:               id,
 		name:             name,
@@ -65,6 +69,9 @@ func NewTenantWithID(id uuid.UUID, name, slug string, status TenantStatus, tier
 		createdAt:        createdAt,
 		updatedAt:        updatedAt,
 		deletedAt:        deletedAt,
+		suspension:       suspension,
+		closure:          closure,
+		version:          version,
 	}
 }
 
@@ -103,6 +110,16 @@ func (t *Tenant) DeletedAt() *time.Time {
 	return t.deletedAt
 }
 
+// Version returns the tenant version (bumped on every update; used for optimistic concurrency)
+func (t *Tenant) Version() int64 {
+	return t.version
+}
+
+// SetVersion sets the tenant version (called by the repository after a successful update)
+func (t *Tenant) SetVersion(version int64) {
+	t.version = version
+}
+
 // Tier returns the tenant tier
 func (t *Tenant) Tier() *Tier {
 	return t.tier
@@ -169,6 +186,61 @@ func (t *Tenant) InviteExpiryDuration() time.Duration {
 	return time.Duration(*t.inviteExpiryHours) * time.Hour
 }
 
+// Suspension returns the active suspension (nil if not suspended)
+func (t *Tenant) Suspension() *Suspension {
+	return t.suspension
+}
+
+// IsSuspended checks if the tenant is suspended
+func (t *Tenant) IsSuspended() bool {
+	return t.status == TenantStatusSuspended
+}
+
+// Suspend marks the tenant as suspended with a reason
+func (t *Tenant) Suspend(reason SuspensionReason, note string, suspendedBy *uuid.UUID) {
+	t.status = TenantStatusSuspended
+	t.suspension = NewSuspension(reason, note, suspendedBy)
+	t.updatedAt = time.Now()
+}
+
+// Reinstate lifts the suspension and reactivates the tenant
+func (t *Tenant) Reinstate() {
+	t.status = TenantStatusActive
+	t.suspension = nil
+	t.updatedAt = time.Now()
+}
+
+// SuspensionAccess returns the access level for a suspended tenant
+// Suspended tenants without recorded details fail closed (blocked)
+func (t *Tenant) SuspensionAccess() SuspensionAccess {
+	if t.suspension == nil {
+		return SuspensionAccessBlocked
+	}
+	return t.suspension.Access()
+}
+
+// Closure returns the pending closure (nil if no closure was requested)
+func (t *Tenant) Closure() *TenantClosure {
+	return t.closure
+}
+
+// IsClosing checks if the tenant has a pending closure
+func (t *Tenant) IsClosing() bool {
+	return t.closure != nil
+}
+
+// RequestClosure schedules the tenant for purge after the retention window
+func (t *Tenant) RequestClosure(requestedBy *uuid.UUID, retention time.Duration) {
+	t.closure = NewTenantClosure(requestedBy, retention)
+	t.updatedAt = time.Now()
+}
+
+// CancelClosure cancels a pending closure
+func (t *Tenant) CancelClosure() {
+	t.closure = nil
+	t.updatedAt = time.Now()
+}
+
 // IsActive checks if the tenant is active
 func (t *Tenant) IsActive() bool {
 	return t.status == TenantStatusActive && !t.IsDeleted()
@@ -0,0 +1,194 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportStatus represents the status of a tenant data export
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "pending"
+	ExportStatusRunning   ExportStatus = "running"
+	ExportStatusCompleted ExportStatus = "completed"
+	ExportStatusFailed    ExportStatus = "failed"
+)
+
+// TenantExport represents an asynchronous export of all data held for a tenant
+type TenantExport struct {
+	id           uuid.UUID
+	tenantID     uuid.UUID
+	requestedBy  *uuid.UUID
+	status       ExportStatus
+	progress     int
+	currentStep  string
+	objectKey    string
+	sizeBytes    int64
+	errorMessage string
+	createdAt    time.Time
+	startedAt    *time.Time
+	completedAt  *time.Time
+	expiresAt    *time.Time
+}
+
+// NewTenantExport creates a new pending tenant export
+func NewTenantExport(tenantID uuid.UUID, requestedBy *uuid.UUID) *TenantExport {
+	return &TenantExport{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		requestedBy: requestedBy,
+		status:      ExportStatusPending,
+		createdAt:   time.Now(),
+	}
+}
+
+// NewTenantExportWithID creates a tenant export with a specific ID (used for reconstruction from database)
+func NewTenantExportWithID(
+	id, tenantID uuid.UUID,
+	requestedBy *uuid.UUID,
+	status ExportStatus,
+	progress int,
+	currentStep, objectKey string,
+	sizeBytes int64,
+	errorMessage string,
+	createdAt time.Time,
+	startedAt, completedAt, expiresAt *time.Time,
+) *TenantExport {
+	return &TenantExport{
+		id:           id,
+		tenantID:     tenantID,
+		requestedBy:  requestedBy,
+		status:       status,
+		progress:     progress,
+		currentStep:  currentStep,
+		objectKey:    objectKey,
+		sizeBytes:    sizeBytes,
+		errorMessage: errorMessage,
+		createdAt:    createdAt,
+		startedAt:    startedAt,
+		completedAt:  completedAt,
+		expiresAt:    expiresAt,
+	}
+}
+
+// ID returns the export ID
+func (e *TenantExport) ID() uuid.UUID {
+	return e.id
+}
+
+// TenantID returns the tenant ID
+func (e *TenantExport) TenantID() uuid.UUID {
+	return e.tenantID
+}
+
+// RequestedBy returns the user who requested the export
+func (e *TenantExport) RequestedBy() *uuid.UUID {
+	return e.requestedBy
+}
+
+// Status returns the export status
+func (e *TenantExport) Status() ExportStatus {
+	return e.status
+}
+
+// Progress returns the export progress as a percentage (0-100)
+func (e *TenantExport) Progress() int {
+	return e.progress
+}
+
+// CurrentStep returns the section currently being exported
+func (e *TenantExport) CurrentStep() string {
+	return e.currentStep
+}
+
+// ObjectKey returns the storage key of the archive (empty until completed)
+func (e *TenantExport) ObjectKey() string {
+	return e.objectKey
+}
+
+// SizeBytes returns the archive size in bytes
+func (e *TenantExport) SizeBytes() int64 {
+	return e.sizeBytes
+}
+
+// ErrorMessage returns the failure reason (empty unless failed)
+func (e *TenantExport) ErrorMessage() string {
+	return e.errorMessage
+}
+
+// CreatedAt returns when the export was requested
+func (e *TenantExport) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// StartedAt returns when the export job started
+func (e *TenantExport) StartedAt() *time.Time {
+	return e.startedAt
+}
+
+// CompletedAt returns when the export finished (successfully or not)
+func (e *TenantExport) CompletedAt() *time.Time {
+	return e.completedAt
+}
+
+// ExpiresAt returns when the archive stops being downloadable
+func (e *TenantExport) ExpiresAt() *time.Time {
+	return e.expiresAt
+}
+
+// Start marks the export as running
+func (e *TenantExport) Start() {
+	now := time.Now()
+	e.status = ExportStatusRunning
+	e.startedAt = &now
+}
+
+// SetProgress records the section currently being exported and the overall progress
+func (e *TenantExport) SetProgress(step string, progress int) {
+	if progress < 0 {
+		progress = 0
+	}
+	if progress > 100 {
+		progress = 100
+	}
+	e.currentStep = step
+	e.progress = progress
+}
+
+// Complete marks the export as completed with the stored archive
+func (e *TenantExport) Complete(objectKey string, sizeBytes int64, retention time.Duration) {
+	now := time.Now()
+	expiresAt := now.Add(retention)
+	e.status = ExportStatusCompleted
+	e.progress = 100
+	e.currentStep = ""
+	e.objectKey = objectKey
+	e.sizeBytes = sizeBytes
+	e.completedAt = &now
+	e.expiresAt = &expiresAt
+}
+
+// Fail marks the export as failed
+func (e *TenantExport) Fail(errorMessage string) {
+	now := time.Now()
+	e.status = ExportStatusFailed
+	e.errorMessage = errorMessage
+	e.completedAt = &now
+}
+
+// IsActive checks if the export is still pending or running
+func (e *TenantExport) IsActive() bool {
+	return e.status == ExportStatusPending || e.status == ExportStatusRunning
+}
+
+// IsExpired checks if the archive is past its download window
+func (e *TenantExport) IsExpired() bool {
+	return e.expiresAt != nil && time.Now().After(*e.expiresAt)
+}
+
+// IsDownloadable checks if the archive can be downloaded
+func (e *TenantExport) IsDownloadable() bool {
+	return e.status == ExportStatusCompleted && e.objectKey != "" && !e.IsExpired()
+}
@@ -47,7 +47,15 @@ func TierCanHidePoweredBy(tier *Tier) bool {
 		return false
 	}
 	return *tier == TierGrowth || *tier == TierScale
-}// TierUsesSubdomain checks if a tier uses subdomain for portal access
+}
+
+// TierSupportsSendingDomain checks if a tier can send emails from its own domain
+// Growth+ tiers (growth, scale) can, like other white-label features
+func TierSupportsSendingDomain(tier *Tier) bool {
+	return TierCanHidePoweredBy(tier)
+}
+
+// TierUsesSubdomain checks if a tier uses subdomain for portal access
 // Lower tiers (Starter, Growth) always use subdomain
 // Scale tier can use custom domain OR subdomain
 func TierUsesSubdomain(tier *Tier) bool {
@@ -55,4 +63,42 @@ func TierUsesSubdomain(tier *Tier) bool {
 		return true // Default to subdomain if tier not set
 	}
 	return *tier == TierStarter || *tier == TierGrowth
-}
\ No newline at end of file
+}
+// TierRateLimitMultiplier returns how many times the base per-tenant request rate a tier is allowed
+func TierRateLimitMultiplier(tier *Tier) int {
+	if tier == nil {
+		return 1
+	}
+	switch *tier {
+	case TierGrowth:
+		return 2
+	case TierScale:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// TierEntitlements describes what a tier allows an agency to do
+type TierEntitlements struct {
+	ClientLimit   int
+	CustomDomain  bool
+	HidePoweredBy bool
+	SendingDomain bool
+	UsesSubdomain bool
+}
+
+// EntitlementsForTier returns the entitlements of a tier (nil tier gets the most restrictive set)
+func EntitlementsForTier(tier *Tier) TierEntitlements {
+	clientLimit := 0
+	if tier != nil {
+		clientLimit = TierClientLimit(*tier)
+	}
+	return TierEntitlements{
+		ClientLimit:   clientLimit,
+		CustomDomain:  TierSupportsCustomDomain(tier),
+		HidePoweredBy: TierCanHidePoweredBy(tier),
+		SendingDomain: TierSupportsSendingDomain(tier),
+		UsesSubdomain: TierUsesSubdomain(tier),
+	}
+}
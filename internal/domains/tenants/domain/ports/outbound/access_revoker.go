@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AccessRevoker defines the interface for cutting off a user's existing access after a membership change
+type AccessRevoker interface {
+	// RevokeAccess drops the user's cached tenant access and rejects tokens issued before now
+	RevokeAccess(ctx context.Context, userID uuid.UUID) error
+}
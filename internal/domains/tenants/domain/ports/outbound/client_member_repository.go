@@ -27,5 +27,8 @@ type ClientMemberRepository interface {
 
 	// CountByClientAndLocation counts members for a client and location (excluding soft-deleted)
 	CountByClientAndLocation(ctx context.Context, clientID uuid.UUID, locationID uuid.UUID) (int, error)
+
+	// DeleteByUserID soft-deletes every client membership of a user and returns how many were removed
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) (int, error)
 }
 
@@ -6,6 +6,12 @@ import (
 	"farohq-core-app/internal/domains/tenants/domain/model"
 )
 
+// Sender is the address an email is sent from instead of the platform address
+type Sender struct {
+	Name  string // Display name
+	Email string
+}
+
 // InviteEmailContext contains all context needed for sending invitation emails
 type InviteEmailContext struct {
 	// Invite information
@@ -26,6 +32,25 @@ type InviteEmailContext struct {
 	InviteeFirstName string // Extracted from email if not available
 	InviterName      string // Name of person who sent invite
 	InviterEmail     string // Email of person who sent invite
+
+	// Sender information (optional - nil sends from the platform address)
+	Sender *Sender // The agency's verified sending domain
+}
+
+// DomainNoticeEmailContext contains all context needed for custom domain notification emails
+type DomainNoticeEmailContext struct {
+	// Recipient information
+	To        string
+	FirstName string // Optional - extracted from email if empty
+
+	// Agency/Tenant information
+	AgencyName string
+
+	// Domain information
+	Domain      string
+	Live        bool   // true when the domain went live, false when it broke
+	Problem     string // Why the domain broke (empty when it went live)
+	SettingsURL string // Where the agency manages its domain
 }
 
 // EmailService defines the interface for sending emails
@@ -34,4 +59,7 @@ type EmailService interface {
 	// ctx: request context
 	// emailCtx: context containing invite, branding, and user information
 	SendInviteEmail(ctx context.Context, emailCtx *InviteEmailContext) error
+
+	// SendDomainNoticeEmail tells an agency owner that their custom domain went live or broke
+	SendDomainNoticeEmail(ctx context.Context, emailCtx *DomainNoticeEmailContext) error
 }
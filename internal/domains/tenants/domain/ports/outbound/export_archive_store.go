@@ -0,0 +1,27 @@
+package outbound
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StoredFile describes a file held for a tenant in object storage
+type StoredFile struct {
+	Key         string
+	Size        int64
+	ContentType string
+	UpdatedAt   time.Time
+}
+
+// ExportArchiveStore defines the interface for storing export archives and listing tenant files
+type ExportArchiveStore interface {
+	// Upload stores an archive under the given key
+	Upload(ctx context.Context, key string, content io.Reader, contentType string) error
+	// SignedDownloadURL generates an expiring download URL for a stored archive
+	SignedDownloadURL(ctx context.Context, key string, expiresIn time.Duration) (string, error)
+	// ListTenantFiles lists file references stored for a tenant
+	ListTenantFiles(ctx context.Context, tenantID uuid.UUID) ([]StoredFile, error)
+}
@@ -15,6 +15,7 @@ type InviteRepository interface {
 	FindByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*model.Invite, error)
 	FindByEmail(ctx context.Context, email string, tenantID uuid.UUID) (*model.Invite, error)
 	FindPendingInvitesByEmail(ctx context.Context, email string) ([]*model.Invite, error)
+	FindPendingByCreator(ctx context.Context, createdBy uuid.UUID) ([]*model.Invite, error)
 	Save(ctx context.Context, invite *model.Invite) error
 	Update(ctx context.Context, invite *model.Invite) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -0,0 +1,18 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// SuspensionEventRepository defines the interface for suspension history persistence
+type SuspensionEventRepository interface {
+	// Save records a suspend or reinstate event
+	Save(ctx context.Context, event *model.SuspensionEvent) error
+
+	// ListByTenant lists suspension events for a tenant (including its clients), newest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.SuspensionEvent, error)
+}
@@ -0,0 +1,24 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/tenants/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// TenantExportRepository defines the interface for tenant export persistence
+type TenantExportRepository interface {
+	// Save creates a new export record
+	// Returns domain.ErrExportInProgress when the tenant already has a pending or running export.
+	Save(ctx context.Context, export *model.TenantExport) error
+	// Update persists status and progress changes
+	Update(ctx context.Context, export *model.TenantExport) error
+	// FindByID finds an export by ID
+	FindByID(ctx context.Context, id uuid.UUID) (*model.TenantExport, error)
+	// ListByTenant lists exports for a tenant, newest first
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.TenantExport, error)
+	// FindActiveByTenant finds a pending or running export for a tenant
+	FindActiveByTenant(ctx context.Context, tenantID uuid.UUID) (*model.TenantExport, error)
+}
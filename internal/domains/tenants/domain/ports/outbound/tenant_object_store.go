@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TenantObjectStore defines the interface for removing a tenant's objects from storage
+type TenantObjectStore interface {
+	// DeleteTenantObjects deletes every object under the tenant's prefix and returns how many were removed
+	DeleteTenantObjects(ctx context.Context, tenantID uuid.UUID) (int, error)
+}
@@ -2,6 +2,7 @@ package outbound
 
 import (
 	"context"
+	"time"
 
 	"farohq-core-app/internal/domains/tenants/domain/model"
 
@@ -11,9 +12,15 @@ import (
 // TenantRepository defines the interface for tenant data access
 type TenantRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*model.Tenant, error)
+	// FindByIDs finds the tenants with the given IDs; missing or deleted tenants are omitted
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Tenant, error)
 	FindBySlug(ctx context.Context, slug string) (*model.Tenant, error)
 	Save(ctx context.Context, tenant *model.Tenant) error
 	Update(ctx context.Context, tenant *model.Tenant) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// ListDueForPurge lists tenants whose closure window elapsed or that were soft-deleted before deletedBefore
+	ListDueForPurge(ctx context.Context, now, deletedBefore time.Time) ([]uuid.UUID, error)
+	// Purge hard-deletes a tenant and all rows it owns
+	Purge(ctx context.Context, id uuid.UUID) error
 }
 
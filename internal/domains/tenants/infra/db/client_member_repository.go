@@ -226,6 +226,22 @@ func (r *ClientMemberRepository) CountByClientAndLocation(ctx context.Context, c
 	return count, err
 }
 
+// DeleteByUserID soft-deletes every client membership of a user and returns how many were removed
+func (r *ClientMemberRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	query := `
+		UPDATE client_members
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE user_id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
 // mapToDomainMember maps database row to domain client member
 func (r *ClientMemberRepository) mapToDomainMember(id, clientID, userID uuid.UUID, role string, locationID *uuid.UUID, createdAt, updatedAt time.Time, deletedAt *time.Time) *model.ClientMember {
 	memberRole := model.Role(role)
@@ -26,6 +26,7 @@ func NewClientRepository(db *pgxpool.Pool) outbound.ClientRepository {
 }
 
 // Save saves or updates a client
+// An update only applies if the stored version still matches the client's version; otherwise ErrVersionConflict is returned.
 func (r *ClientRepository) Save(ctx context.Context, client *model.Client) error {
 	var tierStr *string
 	if client.Tier() != "" {
@@ -34,18 +35,28 @@ func (r *ClientRepository) Save(ctx context.Context, client *model.Client) error
 	}
 
 	query := `
-		INSERT INTO clients (id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO clients (id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (agency_id, slug) 
 		DO UPDATE SET
 			name = EXCLUDED.name,
 			tier = EXCLUDED.tier,
 			status = EXCLUDED.status,
 			updated_at = EXCLUDED.updated_at,
-			deleted_at = EXCLUDED.deleted_at
+			deleted_at = EXCLUDED.deleted_at,
+			suspension_reason = EXCLUDED.suspension_reason,
+			suspension_note = EXCLUDED.suspension_note,
+			suspended_by = EXCLUDED.suspended_by,
+			suspended_at = EXCLUDED.suspended_at
+		WHERE clients.version = $14
+		RETURNING version
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	suspension := suspensionColumnsFromDomain(client.Suspension())
+
+	var version int64
+	err := r.db.QueryRow(ctx, query,
 		client.ID(),
 		client.AgencyID(),
 		client.Name(),
@@ -55,15 +66,29 @@ func (r *ClientRepository) Save(ctx context.Context, client *model.Client) error
 		client.CreatedAt(),
 		client.UpdatedAt(),
 		client.DeletedAt(),
-	)
+		suspension.reason,
+		suspension.note,
+		suspension.suspendedBy,
+		suspension.suspendedAt,
+		client.Version(),
+	).Scan(&version)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrVersionConflict
+		}
+		return err
+	}
 
-	return err
+	client.SetVersion(version)
+	return nil
 }
 
 // FindByID finds a client by ID
 func (r *ClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Client, error) {
 	query := `
-		SELECT id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at
+		SELECT id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at, version
 		FROM clients
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -78,6 +103,8 @@ func (r *ClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.C
 		createdAt time.Time
 		updatedAt time.Time
 		deletedAt *time.Time
+		suspension suspensionColumns
+		version   int64
 	)
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
@@ -90,6 +117,11 @@ func (r *ClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.C
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
+		&suspension.reason,
+		&suspension.note,
+		&suspension.suspendedBy,
+		&suspension.suspendedAt,
+		&version,
 	)
 
 	if err != nil {
@@ -99,13 +131,14 @@ func (r *ClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.C
 		return nil, err
 	}
 
-	return r.mapToDomainClient(dbID, agencyID, name, slug, tier, status, createdAt, updatedAt, deletedAt), nil
+	return r.mapToDomainClient(dbID, agencyID, name, slug, tier, status, createdAt, updatedAt, deletedAt, suspension.toDomain(), version), nil
 }
 
 // FindBySlug finds a client by slug within an agency
 func (r *ClientRepository) FindBySlug(ctx context.Context, agencyID uuid.UUID, slug string) (*model.Client, error) {
 	query := `
-		SELECT id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at
+		SELECT id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at, version
 		FROM clients
 		WHERE agency_id = $1 AND slug = $2 AND deleted_at IS NULL
 	`
@@ -120,6 +153,8 @@ func (r *ClientRepository) FindBySlug(ctx context.Context, agencyID uuid.UUID, s
 		createdAt time.Time
 		updatedAt time.Time
 		deletedAt *time.Time
+		suspension suspensionColumns
+		version   int64
 	)
 
 	err := r.db.QueryRow(ctx, query, agencyID, slug).Scan(
@@ -132,6 +167,11 @@ func (r *ClientRepository) FindBySlug(ctx context.Context, agencyID uuid.UUID, s
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
+		&suspension.reason,
+		&suspension.note,
+		&suspension.suspendedBy,
+		&suspension.suspendedAt,
+		&version,
 	)
 
 	if err != nil {
@@ -141,13 +181,14 @@ func (r *ClientRepository) FindBySlug(ctx context.Context, agencyID uuid.UUID, s
 		return nil, err
 	}
 
-	return r.mapToDomainClient(id, dbAgencyID, name, dbSlug, tier, status, createdAt, updatedAt, deletedAt), nil
+	return r.mapToDomainClient(id, dbAgencyID, name, dbSlug, tier, status, createdAt, updatedAt, deletedAt, suspension.toDomain(), version), nil
 }
 
 // ListByAgency lists all clients for an agency
 func (r *ClientRepository) ListByAgency(ctx context.Context, agencyID uuid.UUID) ([]*model.Client, error) {
 	query := `
-		SELECT id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at
+		SELECT id, agency_id, name, slug, tier, status, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at, version
 		FROM clients
 		WHERE agency_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at ASC
@@ -171,13 +212,16 @@ func (r *ClientRepository) ListByAgency(ctx context.Context, agencyID uuid.UUID)
 			createdAt time.Time
 			updatedAt time.Time
 			deletedAt *time.Time
+			suspension suspensionColumns
+			version   int64
 		)
 
-		if err := rows.Scan(&id, &dbAgencyID, &name, &slug, &tier, &status, &createdAt, &updatedAt, &deletedAt); err != nil {
+		if err := rows.Scan(&id, &dbAgencyID, &name, &slug, &tier, &status, &createdAt, &updatedAt, &deletedAt,
+			&suspension.reason, &suspension.note, &suspension.suspendedBy, &suspension.suspendedAt, &version); err != nil {
 			return nil, err
 		}
 
-		clients = append(clients, r.mapToDomainClient(id, dbAgencyID, name, slug, tier, status, createdAt, updatedAt, deletedAt))
+		clients = append(clients, r.mapToDomainClient(id, dbAgencyID, name, slug, tier, status, createdAt, updatedAt, deletedAt, suspension.toDomain(), version))
 	}
 
 	if err := rows.Err(); err != nil {
@@ -215,12 +259,12 @@ func (r *ClientRepository) CountByAgencyAndTier(ctx context.Context, agencyID uu
 }
 
 // mapToDomainClient maps database row to domain client
-func (r *ClientRepository) mapToDomainClient(id, agencyID uuid.UUID, name, slug string, tier *string, status string, createdAt, updatedAt time.Time, deletedAt *time.Time) *model.Client {
+func (r *ClientRepository) mapToDomainClient(id, agencyID uuid.UUID, name, slug string, tier *string, status string, createdAt, updatedAt time.Time, deletedAt *time.Time, suspension *model.Suspension, version int64) *model.Client {
 	clientStatus := model.ClientStatus(status)
 	var domainTier model.Tier
 	if tier != nil {
 		domainTier = model.Tier(*tier)
 	}
-	return model.NewClientWithID(id, agencyID, name, slug, domainTier, clientStatus, createdAt, updatedAt, deletedAt)
+	return model.NewClientWithID(id, agencyID, name, slug, domainTier, clientStatus, createdAt, updatedAt, deletedAt, suspension, version)
 }
 
@@ -362,6 +362,53 @@ func (r *InviteRepository) FindPendingInvitesByEmail(ctx context.Context, email
 	return invites, nil
 }
 
+// FindPendingByCreator finds all pending invites created by a user across all tenants
+func (r *InviteRepository) FindPendingByCreator(ctx context.Context, createdBy uuid.UUID) ([]*model.Invite, error) {
+	query := `
+		SELECT id, tenant_id, email, role, token, expires_at, accepted_at, revoked_at, created_at, created_by
+		FROM tenant_invites
+		WHERE created_by = $1
+			AND accepted_at IS NULL
+			AND revoked_at IS NULL
+			AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []*model.Invite
+	for rows.Next() {
+		var (
+			id          uuid.UUID
+			tenantID    uuid.UUID
+			email       string
+			role        string
+			token       string
+			expiresAt   time.Time
+			acceptedAt  *time.Time
+			revokedAt   *time.Time
+			createdAt   time.Time
+			dbCreatedBy uuid.UUID
+		)
+
+		if err := rows.Scan(&id, &tenantID, &email, &role, &token, &expiresAt, &acceptedAt, &revokedAt, &createdAt, &dbCreatedBy); err != nil {
+			return nil, err
+		}
+
+		invites = append(invites, r.mapToDomainInvite(id, tenantID, email, role, token, expiresAt, acceptedAt, revokedAt, createdAt, dbCreatedBy))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invites, nil
+}
+
 // Save saves a new invite
 func (r *InviteRepository) Save(ctx context.Context, invite *model.Invite) error {
 	query := `
@@ -27,6 +27,7 @@ func NewLocationRepository(db *pgxpool.Pool) outbound.LocationRepository {
 }
 
 // Save saves or updates a location
+// An update only applies if the stored version still matches the location's version; otherwise ErrVersionConflict is returned.
 func (r *LocationRepository) Save(ctx context.Context, location *model.Location) error {
 	addressJSON, _ := json.Marshal(location.Address())
 	businessHoursJSON, _ := json.Marshal(location.BusinessHours())
@@ -44,9 +45,12 @@ func (r *LocationRepository) Save(ctx context.Context, location *model.Location)
 			is_active = EXCLUDED.is_active,
 			updated_at = EXCLUDED.updated_at,
 			deleted_at = EXCLUDED.deleted_at
+		WHERE locations.version = $12
+		RETURNING version
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	var version int64
+	err := r.db.QueryRow(ctx, query,
 		location.ID(),
 		location.ClientID(),
 		location.Name(),
@@ -58,15 +62,24 @@ func (r *LocationRepository) Save(ctx context.Context, location *model.Location)
 		location.CreatedAt(),
 		location.UpdatedAt(),
 		location.DeletedAt(),
-	)
+		location.Version(),
+	).Scan(&version)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrVersionConflict
+		}
+		return err
+	}
 
-	return err
+	location.SetVersion(version)
+	return nil
 }
 
 // FindByID finds a location by ID
 func (r *LocationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Location, error) {
 	query := `
-		SELECT id, client_id, name, address, phone, business_hours, categories, is_active, created_at, updated_at, deleted_at
+		SELECT id, client_id, name, address, phone, business_hours, categories, is_active, created_at, updated_at, deleted_at, version
 		FROM locations
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -83,6 +96,7 @@ func (r *LocationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model
 		createdAt       time.Time
 		updatedAt       time.Time
 		deletedAt       *time.Time
+		version         int64
 	)
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
@@ -97,6 +111,7 @@ func (r *LocationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
+		&version,
 	)
 
 	if err != nil {
@@ -116,13 +131,13 @@ func (r *LocationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model
 		json.Unmarshal(businessHoursJSON, &businessHours)
 	}
 
-	return r.mapToDomainLocation(dbID, clientID, name, phone, address, businessHours, categories, isActive, createdAt, updatedAt, deletedAt), nil
+	return r.mapToDomainLocation(dbID, clientID, name, phone, address, businessHours, categories, isActive, createdAt, updatedAt, deletedAt, version), nil
 }
 
 // ListByClient lists all locations for a client
 func (r *LocationRepository) ListByClient(ctx context.Context, clientID uuid.UUID) ([]*model.Location, error) {
 	query := `
-		SELECT id, client_id, name, address, phone, business_hours, categories, is_active, created_at, updated_at, deleted_at
+		SELECT id, client_id, name, address, phone, business_hours, categories, is_active, created_at, updated_at, deleted_at, version
 		FROM locations
 		WHERE client_id = $1 AND deleted_at IS NULL
 		ORDER BY created_at ASC
@@ -148,9 +163,10 @@ func (r *LocationRepository) ListByClient(ctx context.Context, clientID uuid.UUI
 			createdAt       time.Time
 			updatedAt       time.Time
 			deletedAt       *time.Time
+			version         int64
 		)
 
-		if err := rows.Scan(&id, &dbClientID, &name, &addressJSON, &phone, &businessHoursJSON, &categories, &isActive, &createdAt, &updatedAt, &deletedAt); err != nil {
+		if err := rows.Scan(&id, &dbClientID, &name, &addressJSON, &phone, &businessHoursJSON, &categories, &isActive, &createdAt, &updatedAt, &deletedAt, &version); err != nil {
 			return nil, err
 		}
 
@@ -164,7 +180,7 @@ func (r *LocationRepository) ListByClient(ctx context.Context, clientID uuid.UUI
 			json.Unmarshal(businessHoursJSON, &businessHours)
 		}
 
-		locations = append(locations, r.mapToDomainLocation(id, dbClientID, name, phone, address, businessHours, categories, isActive, createdAt, updatedAt, deletedAt))
+		locations = append(locations, r.mapToDomainLocation(id, dbClientID, name, phone, address, businessHours, categories, isActive, createdAt, updatedAt, deletedAt, version))
 	}
 
 	if err := rows.Err(); err != nil {
@@ -188,7 +204,7 @@ func (r *LocationRepository) CountByClient(ctx context.Context, clientID uuid.UU
 }
 
 // mapToDomainLocation maps database row to domain location
-func (r *LocationRepository) mapToDomainLocation(id, clientID uuid.UUID, name, phone string, address, businessHours map[string]interface{}, categories []string, isActive bool, createdAt, updatedAt time.Time, deletedAt *time.Time) *model.Location {
-	return model.NewLocationWithID(id, clientID, name, phone, address, businessHours, categories, isActive, createdAt, updatedAt, deletedAt)
+func (r *LocationRepository) mapToDomainLocation(id, clientID uuid.UUID, name, phone string, address, businessHours map[string]interface{}, categories []string, isActive bool, createdAt, updatedAt time.Time, deletedAt *time.Time, version int64) *model.Location {
+	return model.NewLocationWithID(id, clientID, name, phone, address, businessHours, categories, isActive, createdAt, updatedAt, deletedAt, version)
 }
 
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// suspensionColumns holds the nullable suspension columns shared by agencies and clients
+type suspensionColumns struct {
+	reason      *string
+	note        *string
+	suspendedBy *uuid.UUID
+	suspendedAt *time.Time
+}
+
+// suspensionColumnsFromDomain maps a domain suspension to nullable columns
+func suspensionColumnsFromDomain(suspension *model.Suspension) suspensionColumns {
+	if suspension == nil {
+		return suspensionColumns{}
+	}
+	reason := suspension.Reason().String()
+	note := suspension.Note()
+	suspendedAt := suspension.SuspendedAt()
+	return suspensionColumns{
+		reason:      &reason,
+		note:        &note,
+		suspendedBy: suspension.SuspendedBy(),
+		suspendedAt: &suspendedAt,
+	}
+}
+
+// toDomain maps nullable columns to a domain suspension (nil if not suspended)
+func (c suspensionColumns) toDomain() *model.Suspension {
+	if c.reason == nil || c.suspendedAt == nil {
+		return nil
+	}
+	var note string
+	if c.note != nil {
+		note = *c.note
+	}
+	return model.NewSuspensionWithTime(model.SuspensionReason(*c.reason), note, c.suspendedBy, *c.suspendedAt)
+}
+
+// SuspensionEventRepository implements the outbound.SuspensionEventRepository interface
+type SuspensionEventRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewSuspensionEventRepository creates a new PostgreSQL suspension event repository
+func NewSuspensionEventRepository(db *pgxpool.Pool) outbound.SuspensionEventRepository {
+	return &SuspensionEventRepository{
+		db: db,
+	}
+}
+
+// Save records a suspend or reinstate event
+func (r *SuspensionEventRepository) Save(ctx context.Context, event *model.SuspensionEvent) error {
+	var reason *string
+	if event.Reason() != nil {
+		s := event.Reason().String()
+		reason = &s
+	}
+
+	query := `
+		INSERT INTO suspension_events (id, tenant_id, client_id, action, reason, note, actor_user_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		event.ID(),
+		event.TenantID(),
+		event.ClientID(),
+		string(event.Action()),
+		reason,
+		event.Note(),
+		event.ActorUserID(),
+		event.CreatedAt(),
+	)
+
+	return err
+}
+
+// ListByTenant lists suspension events for a tenant (including its clients), newest first
+func (r *SuspensionEventRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.SuspensionEvent, error) {
+	query := `
+		SELECT id, tenant_id, client_id, action, reason, note, actor_user_id, created_at
+		FROM suspension_events
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*model.SuspensionEvent
+	for rows.Next() {
+		var (
+			id          uuid.UUID
+			dbTenantID  uuid.UUID
+			clientID    *uuid.UUID
+			action      string
+			reason      *string
+			note        string
+			actorUserID *uuid.UUID
+			createdAt   time.Time
+		)
+
+		if err := rows.Scan(&id, &dbTenantID, &clientID, &action, &reason, &note, &actorUserID, &createdAt); err != nil {
+			return nil, err
+		}
+
+		var domainReason *model.SuspensionReason
+		if reason != nil {
+			sr := model.SuspensionReason(*reason)
+			domainReason = &sr
+		}
+
+		events = append(events, model.NewSuspensionEventWithID(id, dbTenantID, clientID, model.SuspensionAction(action), domainReason, note, actorUserID, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,238 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const tenantExportColumns = `id, tenant_id, requested_by, status, progress, current_step, object_key, size_bytes,
+	error_message, created_at, started_at, completed_at, expires_at`
+
+// TenantExportRepository implements the outbound.TenantExportRepository interface
+type TenantExportRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewTenantExportRepository creates a new PostgreSQL tenant export repository
+func NewTenantExportRepository(db *pgxpool.Pool) outbound.TenantExportRepository {
+	return &TenantExportRepository{
+		db: db,
+	}
+}
+
+// Save creates a new export record
+func (r *TenantExportRepository) Save(ctx context.Context, export *model.TenantExport) error {
+	query := `
+		INSERT INTO tenant_exports (` + tenantExportColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		export.ID(),
+		export.TenantID(),
+		export.RequestedBy(),
+		string(export.Status()),
+		export.Progress(),
+		export.CurrentStep(),
+		nullableString(export.ObjectKey()),
+		export.SizeBytes(),
+		nullableString(export.ErrorMessage()),
+		export.CreatedAt(),
+		export.StartedAt(),
+		export.CompletedAt(),
+		export.ExpiresAt(),
+	)
+
+	if err != nil {
+		// Another export of the tenant is pending or running (idx_tenant_exports_active)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_tenant_exports_active" {
+			return domain.ErrExportInProgress
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Update persists status and progress changes
+func (r *TenantExportRepository) Update(ctx context.Context, export *model.TenantExport) error {
+	query := `
+		UPDATE tenant_exports
+		SET status = $2, progress = $3, current_step = $4, object_key = $5, size_bytes = $6,
+			error_message = $7, started_at = $8, completed_at = $9, expires_at = $10
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		export.ID(),
+		string(export.Status()),
+		export.Progress(),
+		export.CurrentStep(),
+		nullableString(export.ObjectKey()),
+		export.SizeBytes(),
+		nullableString(export.ErrorMessage()),
+		export.StartedAt(),
+		export.CompletedAt(),
+		export.ExpiresAt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrExportNotFound
+	}
+
+	return nil
+}
+
+// FindByID finds an export by ID
+func (r *TenantExportRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.TenantExport, error) {
+	query := `
+		SELECT ` + tenantExportColumns + `
+		FROM tenant_exports
+		WHERE id = $1
+	`
+
+	export, err := scanTenantExport(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrExportNotFound
+		}
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// ListByTenant lists exports for a tenant, newest first
+func (r *TenantExportRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.TenantExport, error) {
+	query := `
+		SELECT ` + tenantExportColumns + `
+		FROM tenant_exports
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exports []*model.TenantExport
+	for rows.Next() {
+		export, err := scanTenantExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// FindActiveByTenant finds the most recent pending or running export for a tenant
+func (r *TenantExportRepository) FindActiveByTenant(ctx context.Context, tenantID uuid.UUID) (*model.TenantExport, error) {
+	query := `
+		SELECT ` + tenantExportColumns + `
+		FROM tenant_exports
+		WHERE tenant_id = $1 AND status IN ('pending', 'running')
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	export, err := scanTenantExport(r.db.QueryRow(ctx, query, tenantID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrExportNotFound
+		}
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// scanTenantExport scans a single tenant_exports row
+func scanTenantExport(row pgx.Row) (*model.TenantExport, error) {
+	var (
+		id           uuid.UUID
+		tenantID     uuid.UUID
+		requestedBy  *uuid.UUID
+		status       string
+		progress     int
+		currentStep  string
+		objectKey    *string
+		sizeBytes    int64
+		errorMessage *string
+		createdAt    time.Time
+		startedAt    *time.Time
+		completedAt  *time.Time
+		expiresAt    *time.Time
+	)
+
+	if err := row.Scan(
+		&id,
+		&tenantID,
+		&requestedBy,
+		&status,
+		&progress,
+		&currentStep,
+		&objectKey,
+		&sizeBytes,
+		&errorMessage,
+		&createdAt,
+		&startedAt,
+		&completedAt,
+		&expiresAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return model.NewTenantExportWithID(
+		id,
+		tenantID,
+		requestedBy,
+		model.ExportStatus(status),
+		progress,
+		currentStep,
+		stringValue(objectKey),
+		sizeBytes,
+		stringValue(errorMessage),
+		createdAt,
+		startedAt,
+		completedAt,
+		expiresAt,
+	), nil
+}
+
+// nullableString maps an empty string to NULL
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// stringValue maps NULL to an empty string
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -28,7 +28,9 @@ func NewTenantRepository(db *pgxpool.Pool) outbound.TenantRepository {
 // FindByID finds a tenant by ID (from agencies table)
 func (r *TenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Tenant, error) {
 	query := `
-		SELECT id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at
+		SELECT id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at,
+			closure_requested_at, closure_requested_by, purge_after, version
 		FROM agencies
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -44,6 +46,9 @@ func (r *TenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.T
 		createdAt        time.Time
 		updatedAt        time.Time
 		deletedAt        *time.Time
+		suspension       suspensionColumns
+		closure          closureColumns
+		version          int64
 	)
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
@@ -57,6 +62,14 @@ func (r *TenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.T
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
+		&suspension.reason,
+		&suspension.note,
+		&suspension.suspendedBy,
+		&suspension.suspendedAt,
+		&closure.requestedAt,
+		&closure.requestedBy,
+		&closure.purgeAfter,
+		&version,
 	)
 
 	if err != nil {
@@ -66,13 +79,82 @@ func (r *TenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.T
 		return nil, err
 	}
 
-	return r.mapToDomainTenant(dbID, name, slug, status, tier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt), nil
+	return r.mapToDomainTenant(dbID, name, slug, status, tier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt, suspension.toDomain(), closure.toDomain(), version), nil
+}
+
+// FindByIDs finds the tenants with the given IDs in a single query; missing or deleted tenants are omitted
+func (r *TenantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Tenant, error) {
+	if len(ids) == 0 {
+		return []*model.Tenant{}, nil
+	}
+
+	query := `
+		SELECT id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at,
+			closure_requested_at, closure_requested_by, purge_after, version
+		FROM agencies
+		WHERE id = ANY($1) AND deleted_at IS NULL
+	`
+
+	rows, err := r.db.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tenants := make([]*model.Tenant, 0, len(ids))
+	for rows.Next() {
+		var (
+			id                uuid.UUID
+			name              string
+			slug              string
+			status            string
+			tier              *string
+			agencySeatLimit   int
+			inviteExpiryHours *int
+			createdAt         time.Time
+			updatedAt         time.Time
+			deletedAt         *time.Time
+			suspension        suspensionColumns
+			closure           closureColumns
+			version           int64
+		)
+
+		if err := rows.Scan(
+			&id,
+			&name,
+			&slug,
+			&status,
+			&tier,
+			&agencySeatLimit,
+			&inviteExpiryHours,
+			&createdAt,
+			&updatedAt,
+			&deletedAt,
+			&suspension.reason,
+			&suspension.note,
+			&suspension.suspendedBy,
+			&suspension.suspendedAt,
+			&closure.requestedAt,
+			&closure.requestedBy,
+			&closure.purgeAfter,
+			&version,
+		); err != nil {
+			return nil, err
+		}
+
+		tenants = append(tenants, r.mapToDomainTenant(id, name, slug, status, tier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt, suspension.toDomain(), closure.toDomain(), version))
+	}
+
+	return tenants, rows.Err()
 }
 
 // FindBySlug finds a tenant by slug (from agencies table)
 func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
 	query := `
-		SELECT id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at
+		SELECT id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at,
+			closure_requested_at, closure_requested_by, purge_after, version
 		FROM agencies
 		WHERE slug = $1 AND deleted_at IS NULL
 	`
@@ -88,6 +170,9 @@ func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*model.
 		createdAt        time.Time
 		updatedAt        time.Time
 		deletedAt        *time.Time
+		suspension       suspensionColumns
+		closure          closureColumns
+		version          int64
 	)
 
 	err := r.db.QueryRow(ctx, query, slug).Scan(
@@ -101,6 +186,14 @@ func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*model.
 		&createdAt,
 		&updatedAt,
 		&deletedAt,
+		&suspension.reason,
+		&suspension.note,
+		&suspension.suspendedBy,
+		&suspension.suspendedAt,
+		&closure.requestedAt,
+		&closure.requestedBy,
+		&closure.purgeAfter,
+		&version,
 	)
 
 	if err != nil {
@@ -110,7 +203,7 @@ func (r *TenantRepository) FindBySlug(ctx context.Context, slug string) (*model.
 		return nil, err
 	}
 
-	return r.mapToDomainTenant(id, name, dbSlug, status, tier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt), nil
+	return r.mapToDomainTenant(id, name, dbSlug, status, tier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt, suspension.toDomain(), closure.toDomain(), version), nil
 }
 
 // Save saves a new tenant (inserts into agencies table)
@@ -122,10 +215,15 @@ func (r *TenantRepository) Save(ctx context.Context, tenant *model.Tenant) error
 	}
 
 	query := `
-		INSERT INTO agencies (id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO agencies (id, name, slug, status, tier, agency_seat_limit, invite_expiry_hours, created_at, updated_at, deleted_at,
+			suspension_reason, suspension_note, suspended_by, suspended_at,
+			closure_requested_at, closure_requested_by, purge_after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 
+	suspension := suspensionColumnsFromDomain(tenant.Suspension())
+	closure := closureColumnsFromDomain(tenant.Closure())
+
 	_, err := r.db.Exec(ctx, query,
 		tenant.ID(),
 		tenant.Name(),
@@ -137,12 +235,20 @@ func (r *TenantRepository) Save(ctx context.Context, tenant *model.Tenant) error
 		tenant.CreatedAt(),
 		tenant.UpdatedAt(),
 		tenant.DeletedAt(),
+		suspension.reason,
+		suspension.note,
+		suspension.suspendedBy,
+		suspension.suspendedAt,
+		closure.requestedAt,
+		closure.requestedBy,
+		closure.purgeAfter,
 	)
 
 	return err
 }
 
 // Update updates an existing tenant (updates agencies table)
+// The update only applies if the stored version still matches the tenant's version; otherwise ErrVersionConflict is returned.
 func (r *TenantRepository) Update(ctx context.Context, tenant *model.Tenant) error {
 	var tierStr *string
 	if tenant.Tier() != nil {
@@ -159,11 +265,23 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *model.Tenant) err
 			agency_seat_limit = $6,
 			invite_expiry_hours = $7,
 			updated_at = $8,
-			deleted_at = $9
-		WHERE id = $1 AND deleted_at IS NULL
+			deleted_at = $9,
+			suspension_reason = $10,
+			suspension_note = $11,
+			suspended_by = $12,
+			suspended_at = $13,
+			closure_requested_at = $14,
+			closure_requested_by = $15,
+			purge_after = $16
+		WHERE id = $1 AND deleted_at IS NULL AND version = $17
+		RETURNING version
 	`
 
-	result, err := r.db.Exec(ctx, query,
+	suspension := suspensionColumnsFromDomain(tenant.Suspension())
+	closure := closureColumnsFromDomain(tenant.Closure())
+
+	var version int64
+	err := r.db.QueryRow(ctx, query,
 		tenant.ID(),
 		tenant.Name(),
 		tenant.Slug(),
@@ -173,17 +291,38 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *model.Tenant) err
 		tenant.InviteExpiryHours(),
 		tenant.UpdatedAt(),
 		tenant.DeletedAt(),
-	)
+		suspension.reason,
+		suspension.note,
+		suspension.suspendedBy,
+		suspension.suspendedAt,
+		closure.requestedAt,
+		closure.requestedBy,
+		closure.purgeAfter,
+		tenant.Version(),
+	).Scan(&version)
 
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return r.updateMissError(ctx, tenant.ID())
+		}
 		return err
 	}
 
-	if result.RowsAffected() == 0 {
+	tenant.SetVersion(version)
+	return nil
+}
+
+// updateMissError tells a missing tenant apart from a stale version after an update matched no row
+func (r *TenantRepository) updateMissError(ctx context.Context, id uuid.UUID) error {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM agencies WHERE id = $1 AND deleted_at IS NULL)`
+	if err := r.db.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
 		return domain.ErrTenantNotFound
 	}
-
-	return nil
+	return domain.ErrVersionConflict
 }
 
 // Delete deletes a tenant (soft delete)
@@ -202,13 +341,91 @@ func (r *TenantRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ListDueForPurge lists tenants whose closure retention window has elapsed,
+// plus soft-deleted tenants deleted before deletedBefore
+func (r *TenantRepository) ListDueForPurge(ctx context.Context, now, deletedBefore time.Time) ([]uuid.UUID, error) {
+	query := `
+		SELECT id
+		FROM agencies
+		WHERE (purge_after IS NOT NULL AND purge_after <= $1)
+			OR (deleted_at IS NOT NULL AND deleted_at <= $2)
+		ORDER BY COALESCE(purge_after, deleted_at) ASC
+	`
+
+	rows, err := r.db.Query(ctx, query, now, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Purge hard-deletes a tenant; all tenant-owned rows are removed via ON DELETE CASCADE
+func (r *TenantRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM agencies WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrTenantNotFound
+	}
+
+	return nil
+}
+
+// closureColumns holds the nullable closure columns of the agencies table
+type closureColumns struct {
+	requestedAt *time.Time
+	requestedBy *uuid.UUID
+	purgeAfter  *time.Time
+}
+
+// closureColumnsFromDomain maps a domain closure to nullable columns
+func closureColumnsFromDomain(closure *model.TenantClosure) closureColumns {
+	if closure == nil {
+		return closureColumns{}
+	}
+	requestedAt := closure.RequestedAt()
+	purgeAfter := closure.PurgeAfter()
+	return closureColumns{
+		requestedAt: &requestedAt,
+		requestedBy: closure.RequestedBy(),
+		purgeAfter:  &purgeAfter,
+	}
+}
+
+// toDomain maps nullable columns to a domain closure (nil if no closure is pending)
+func (c closureColumns) toDomain() *model.TenantClosure {
+	if c.requestedAt == nil || c.purgeAfter == nil {
+		return nil
+	}
+	return model.NewTenantClosureWithTime(*c.requestedAt, c.requestedBy, *c.purgeAfter)
+}
+
 // mapToDomainTenant maps database row to domain tenant
-func (r *TenantRepository) mapToDomainTenant(id uuid.UUID, name, slug, status string, tier *string, agencySeatLimit int, inviteExpiryHours *int, createdAt, updatedAt time.Time, deletedAt *time.Time) *model.Tenant {
+func (r *TenantRepository) mapToDomainTenant(id uuid.UUID, name, slug, status string, tier *string, agencySeatLimit int, inviteExpiryHours *int, createdAt, updatedAt time.Time, deletedAt *time.Time, suspension *model.Suspension, closure *model.TenantClosure, version int64) *model.Tenant {
 	tenantStatus := model.TenantStatus(status)
 	var domainTier *model.Tier
 	if tier != nil {
 		t := model.Tier(*tier)
 		domainTier = &t
 	}
-	return model.NewTenantWithID(id, name, slug, tenantStatus, domainTier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt)
+	return model.NewTenantWithID(id, name, slug, tenantStatus, domainTier, agencySeatLimit, inviteExpiryHours, createdAt, updatedAt, deletedAt, suspension, closure, version)
 }
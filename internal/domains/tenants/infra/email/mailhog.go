@@ -11,6 +11,9 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// mailhogFromEmail is the platform address of emails caught by Mailhog
+const mailhogFromEmail = "noreply@localhost"
+
 // MailhogEmailService implements EmailService using Mailhog SMTP server for local development
 type MailhogEmailService struct {
 	smtpHost string
@@ -69,9 +72,50 @@ func (s *MailhogEmailService) SendInviteEmail(ctx context.Context, emailCtx *out
 		return fmt.Errorf("failed to build HTML email: %w", err)
 	}
 
+	fromEmail := mailhogFromEmail
+	if emailCtx.Sender != nil {
+		fromName = emailCtx.Sender.Name
+		fromEmail = emailCtx.Sender.Email
+	}
+
+	if err := s.send(emailCtx.Invite.Email(), fromName, fromEmail, subject, htmlBody, fmt.Sprintf("X-Invite-ID: %s", emailCtx.Invite.ID().String())); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Str("to", emailCtx.Invite.Email()).
+		Str("invite_id", emailCtx.Invite.ID().String()).
+		Str("smtp_addr", s.addr()).
+		Str("mailhog_ui", fmt.Sprintf("http://%s:8025", s.smtpHost)).
+		Str("agency", emailCtx.AgencyName).
+		Bool("white_label", emailCtx.HidePoweredBy).
+		Msg("Invite email sent successfully via Mailhog SMTP")
+
+	return nil
+}
+
+// SendDomainNoticeEmail sends a custom domain notification via Mailhog SMTP
+func (s *MailhogEmailService) SendDomainNoticeEmail(ctx context.Context, emailCtx *outbound.DomainNoticeEmailContext) error {
+	data := domainNoticeEmailData(emailCtx)
+
+	if err := s.send(emailCtx.To, "FARO HQ", mailhogFromEmail, BuildDomainNoticeEmailSubject(data), BuildDomainNoticeEmailHTML(data)); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Str("to", emailCtx.To).
+		Str("domain", emailCtx.Domain).
+		Bool("live", emailCtx.Live).
+		Str("mailhog_ui", fmt.Sprintf("http://%s:8025", s.smtpHost)).
+		Msg("Domain notice email sent successfully via Mailhog SMTP")
+
+	return nil
+}
+
+// send sends an HTML email through the Mailhog SMTP server
+func (s *MailhogEmailService) send(to, fromName, fromEmail, subject, htmlBody string, extraHeaders ...string) error {
 	// Build email message (RFC 5322 format)
-	from := fmt.Sprintf("%s <noreply@localhost>", fromName)
-	to := emailCtx.Invite.Email()
+	from := fmt.Sprintf("%s <%s>", fromName, fromEmail)
 
 	// Email headers
 	headers := []string{
@@ -80,36 +124,29 @@ func (s *MailhogEmailService) SendInviteEmail(ctx context.Context, emailCtx *out
 		fmt.Sprintf("Subject: %s", subject),
 		"MIME-Version: 1.0",
 		"Content-Type: text/html; charset=UTF-8",
-		fmt.Sprintf("X-Invite-ID: %s", emailCtx.Invite.ID().String()),
-		"",
-		htmlBody,
 	}
+	headers = append(headers, extraHeaders...)
+	headers = append(headers, "", htmlBody)
 
 	message := []byte(strings.Join(headers, "\r\n"))
 
 	// Send email via SMTP
 	// Mailhog doesn't require authentication
-	addr := fmt.Sprintf("%s:%s", s.smtpHost, s.smtpPort)
 	auth := smtp.PlainAuth("", "", "", s.smtpHost)
 
-	err = smtp.SendMail(addr, auth, "noreply@localhost", []string{to}, message)
-	if err != nil {
+	if err := smtp.SendMail(s.addr(), auth, fromEmail, []string{to}, message); err != nil {
 		s.logger.Error().
 			Err(err).
-			Str("to", emailCtx.Invite.Email()).
-			Str("smtp_addr", addr).
+			Str("to", to).
+			Str("smtp_addr", s.addr()).
 			Msg("Failed to send email via Mailhog SMTP")
 		return fmt.Errorf("failed to send email via SMTP: %w", err)
 	}
 
-	s.logger.Info().
-		Str("to", emailCtx.Invite.Email()).
-		Str("invite_id", emailCtx.Invite.ID().String()).
-		Str("smtp_addr", addr).
-		Str("mailhog_ui", fmt.Sprintf("http://%s:8025", s.smtpHost)).
-		Str("agency", emailCtx.AgencyName).
-		Bool("white_label", emailCtx.HidePoweredBy).
-		Msg("Invite email sent successfully via Mailhog SMTP")
-
 	return nil
 }
+
+// addr returns the SMTP server address
+func (s *MailhogEmailService) addr() string {
+	return fmt.Sprintf("%s:%s", s.smtpHost, s.smtpPort)
+}
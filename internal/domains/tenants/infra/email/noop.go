@@ -22,12 +22,28 @@ func NewNoopEmailService(logger zerolog.Logger) outbound.EmailService {
 
 // SendInviteEmail logs the email send attempt but doesn't actually send
 func (s *NoopEmailService) SendInviteEmail(ctx context.Context, emailCtx *outbound.InviteEmailContext) error {
+	from := "platform"
+	if emailCtx.Sender != nil {
+		from = emailCtx.Sender.Email
+	}
 	s.logger.Info().
 		Str("to", emailCtx.Invite.Email()).
 		Str("invite_id", emailCtx.Invite.ID().String()).
 		Str("accept_url", emailCtx.AcceptURL).
 		Str("agency", emailCtx.AgencyName).
 		Bool("white_label", emailCtx.HidePoweredBy).
+		Str("from", from).
 		Msg("No-op email service: would send invite email (email sending disabled)")
 	return nil
 }
+
+// SendDomainNoticeEmail logs the email send attempt but doesn't actually send
+func (s *NoopEmailService) SendDomainNoticeEmail(ctx context.Context, emailCtx *outbound.DomainNoticeEmailContext) error {
+	s.logger.Info().
+		Str("to", emailCtx.To).
+		Str("domain", emailCtx.Domain).
+		Bool("live", emailCtx.Live).
+		Str("problem", emailCtx.Problem).
+		Msg("No-op email service: would send domain notice email (email sending disabled)")
+	return nil
+}
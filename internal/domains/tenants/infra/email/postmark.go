@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,6 +14,9 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// errPostmarkRejected is returned when the Postmark API rejects an email
+var errPostmarkRejected = errors.New("postmark API error")
+
 // PostmarkEmailService implements EmailService using Postmark API
 type PostmarkEmailService struct {
 	apiToken  string
@@ -70,10 +74,67 @@ func (s *PostmarkEmailService) SendInviteEmail(ctx context.Context, emailCtx *ou
 
 	textBody := BuildInviteEmailText(data)
 
+	// Agencies with a verified sending domain send from it; if Postmark rejects that sender
+	// (e.g. the domain was removed at Postmark), fall back to the platform address
+	if emailCtx.Sender != nil {
+		err := s.send(ctx, emailCtx.Invite.Email(), emailCtx.Sender.Name, emailCtx.Sender.Email, subject, htmlBody, textBody)
+		if err == nil {
+			s.logger.Info().
+				Str("to", emailCtx.Invite.Email()).
+				Str("invite_id", emailCtx.Invite.ID().String()).
+				Str("agency", emailCtx.AgencyName).
+				Str("from", emailCtx.Sender.Email).
+				Msg("Invite email sent successfully via Postmark")
+			return nil
+		}
+		if !errors.Is(err, errPostmarkRejected) {
+			return err
+		}
+		s.logger.Warn().
+			Err(err).
+			Str("invite_id", emailCtx.Invite.ID().String()).
+			Str("from", emailCtx.Sender.Email).
+			Msg("Postmark rejected the agency sender, sending from the platform address")
+	}
+
+	if err := s.send(ctx, emailCtx.Invite.Email(), fromName, s.fromEmail, subject, htmlBody, textBody); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Str("to", emailCtx.Invite.Email()).
+		Str("invite_id", emailCtx.Invite.ID().String()).
+		Str("agency", emailCtx.AgencyName).
+		Bool("white_label", emailCtx.HidePoweredBy).
+		Msg("Invite email sent successfully via Postmark")
+
+	return nil
+}
+
+// SendDomainNoticeEmail sends a custom domain notification via Postmark
+func (s *PostmarkEmailService) SendDomainNoticeEmail(ctx context.Context, emailCtx *outbound.DomainNoticeEmailContext) error {
+	data := domainNoticeEmailData(emailCtx)
+
+	if err := s.send(ctx, emailCtx.To, "FARO HQ", s.fromEmail, BuildDomainNoticeEmailSubject(data), BuildDomainNoticeEmailHTML(data), BuildDomainNoticeEmailText(data)); err != nil {
+		return err
+	}
+
+	s.logger.Info().
+		Str("to", emailCtx.To).
+		Str("domain", emailCtx.Domain).
+		Bool("live", emailCtx.Live).
+		Msg("Domain notice email sent successfully via Postmark")
+
+	return nil
+}
+
+// send sends an email through the Postmark API
+// Rejections by the API wrap errPostmarkRejected, so callers can tell them from transport failures.
+func (s *PostmarkEmailService) send(ctx context.Context, to, fromName, fromEmail, subject, htmlBody, textBody string) error {
 	// Postmark API request payload
 	payload := map[string]interface{}{
-		"From":          fmt.Sprintf("%s <%s>", fromName, s.fromEmail),
-		"To":            emailCtx.Invite.Email(),
+		"From":          fmt.Sprintf("%s <%s>", fromName, fromEmail),
+		"To":            to,
 		"Subject":       subject,
 		"HtmlBody":      htmlBody,
 		"TextBody":      textBody,
@@ -98,7 +159,7 @@ func (s *PostmarkEmailService) SendInviteEmail(ctx context.Context, emailCtx *ou
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		s.logger.Error().Err(err).Str("to", emailCtx.Invite.Email()).Msg("Failed to send email via Postmark")
+		s.logger.Error().Err(err).Str("to", to).Msg("Failed to send email via Postmark")
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 	defer resp.Body.Close()
@@ -109,17 +170,10 @@ func (s *PostmarkEmailService) SendInviteEmail(ctx context.Context, emailCtx *ou
 		s.logger.Error().
 			Int("status_code", resp.StatusCode).
 			Interface("error", errorResp).
-			Str("to", emailCtx.Invite.Email()).
+			Str("to", to).
 			Msg("Postmark API returned error")
-		return fmt.Errorf("postmark API error: status %d", resp.StatusCode)
+		return fmt.Errorf("%w: status %d", errPostmarkRejected, resp.StatusCode)
 	}
 
-	s.logger.Info().
-		Str("to", emailCtx.Invite.Email()).
-		Str("invite_id", emailCtx.Invite.ID().String()).
-		Str("agency", emailCtx.AgencyName).
-		Bool("white_label", emailCtx.HidePoweredBy).
-		Msg("Invite email sent successfully via Postmark")
-
 	return nil
 }
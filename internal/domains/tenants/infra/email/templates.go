@@ -2,8 +2,11 @@ package email
 
 import (
 	"fmt"
+	"html"
 	"strings"
 	"time"
+
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
 )
 
 // InviteEmailData contains all data needed for invitation email templates
@@ -359,3 +362,117 @@ Note: This invitation expires on %s.
 
 If you weren't expecting this invitation, you can safely ignore this email.%s`, greeting, mainContent, data.InviteURL, expiresAtFormatted, footer)
 }
+
+// DomainNoticeEmailData contains all data needed for custom domain notification email templates
+type DomainNoticeEmailData struct {
+	Email       string
+	FirstName   string
+	AgencyName  string
+	Domain      string
+	Live        bool
+	Problem     string
+	SettingsURL string
+}
+
+// domainNoticeEmailData builds the template data of a custom domain notification
+func domainNoticeEmailData(emailCtx *outbound.DomainNoticeEmailContext) DomainNoticeEmailData {
+	return DomainNoticeEmailData{
+		Email:       emailCtx.To,
+		FirstName:   emailCtx.FirstName,
+		AgencyName:  emailCtx.AgencyName,
+		Domain:      emailCtx.Domain,
+		Live:        emailCtx.Live,
+		Problem:     emailCtx.Problem,
+		SettingsURL: emailCtx.SettingsURL,
+	}
+}
+
+// BuildDomainNoticeEmailSubject builds the subject of a custom domain notification
+func BuildDomainNoticeEmailSubject(data DomainNoticeEmailData) string {
+	if data.Live {
+		return fmt.Sprintf("%s is live", data.Domain)
+	}
+	return fmt.Sprintf("Action needed: %s is not reachable", data.Domain)
+}
+
+// domainNoticeMessage returns the greeting and the paragraphs of a custom domain notification
+func domainNoticeMessage(data DomainNoticeEmailData) (string, []string) {
+	name := data.FirstName
+	if name == "" {
+		name = ExtractFirstName(data.Email)
+	}
+	greeting := "Hello,"
+	if name != "" {
+		greeting = "Hi " + name + ","
+	}
+
+	if data.Live {
+		return greeting, []string{
+			fmt.Sprintf("Good news: %s is now live. Your team and clients can reach %s's portal on it over HTTPS.", data.Domain, data.AgencyName),
+		}
+	}
+
+	paragraphs := []string{
+		fmt.Sprintf("%s, the custom domain of %s's portal, stopped working.", data.Domain, data.AgencyName),
+	}
+	if data.Problem != "" {
+		paragraphs = append(paragraphs, "Problem: "+data.Problem+".")
+	}
+	paragraphs = append(paragraphs, "Check that the DNS records from your domain settings are still in place. We keep checking the domain and will let you know once it is back.")
+	return greeting, paragraphs
+}
+
+// BuildDomainNoticeEmailHTML builds the HTML body of a custom domain notification (FARO-branded)
+func BuildDomainNoticeEmailHTML(data DomainNoticeEmailData) string {
+	greeting, paragraphs := domainNoticeMessage(data)
+
+	var body strings.Builder
+	for _, paragraph := range paragraphs {
+		body.WriteString("<p>" + html.EscapeString(paragraph) + "</p>\n\t\t\t")
+	}
+
+	heading := "Your domain is live"
+	if !data.Live {
+		heading = "Your domain needs attention"
+	}
+
+	button := ""
+	if data.SettingsURL != "" {
+		button = fmt.Sprintf(`<div style="text-align: center; margin: 30px 0;">
+				<a href="%s" style="display: inline-block; padding: 14px 32px; background-color: #007bff; color: #ffffff; text-decoration: none; border-radius: 6px; font-weight: 600;">Open domain settings</a>
+			</div>`, html.EscapeString(data.SettingsURL))
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="UTF-8">
+	<meta name="viewport" content="width=device-width, initial-scale=1.0">
+</head>
+<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333333; background-color: #f5f5f5; margin: 0; padding: 0;">
+	<div style="max-width: 600px; margin: 0 auto; background-color: #ffffff;">
+		<div style="padding: 40px 30px;">
+			<h1 style="color: #1a1a1a; font-size: 24px; font-weight: 600; margin: 0 0 20px 0;">%s</h1>
+			<p>%s</p>
+			%s
+			%s
+			<div style="margin-top: 40px; padding-top: 20px; border-top: 1px solid #e0e0e0; text-align: center; color: #666; font-size: 12px;">
+				<p><strong>FARO HQ</strong></p>
+				<p>Local Visibility Management Platform</p>
+			</div>
+		</div>
+	</div>
+</body>
+</html>`, heading, html.EscapeString(greeting), body.String(), button)
+}
+
+// BuildDomainNoticeEmailText builds the plain text body of a custom domain notification
+func BuildDomainNoticeEmailText(data DomainNoticeEmailData) string {
+	greeting, paragraphs := domainNoticeMessage(data)
+
+	text := greeting + "\n\n" + strings.Join(paragraphs, "\n\n")
+	if data.SettingsURL != "" {
+		text += "\n\nManage your domain:\n" + data.SettingsURL
+	}
+	return text + "\n\n—\nFARO HQ\nLocal Visibility Management Platform"
+}
@@ -0,0 +1,249 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"farohq-core-app/internal/domains/tenants/app/usecases"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// SuspendTenantHandler handles POST /api/v1/admin/tenants/{id}/suspend
+func (h *Handlers) SuspendTenantHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+		Note   string `json:"note"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	resp, err := h.suspendTenant.Execute(r.Context(), &usecases.SuspendTenantRequest{
+		TenantID:    id,
+		Reason:      model.SuspensionReason(req.Reason),
+		Note:        req.Note,
+		ActorUserID: h.actorUserID(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to suspend tenant")
+		return
+	}
+
+	h.logger.Info().
+		Str("tenant_id", id.String()).
+		Str("reason", req.Reason).
+		Msg("Tenant suspended")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":         resp.Tenant.ID().String(),
+		"status":     string(resp.Tenant.Status()),
+		"suspension": buildSuspensionResponse(resp.Tenant.Suspension()),
+	})
+}
+
+// ReinstateTenantHandler handles POST /api/v1/admin/tenants/{id}/reinstate
+func (h *Handlers) ReinstateTenantHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
+		return
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	resp, err := h.reinstateTenant.Execute(r.Context(), &usecases.ReinstateTenantRequest{
+		TenantID:    id,
+		Note:        req.Note,
+		ActorUserID: h.actorUserID(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to reinstate tenant")
+		return
+	}
+
+	h.logger.Info().Str("tenant_id", id.String()).Msg("Tenant reinstated")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":         resp.Tenant.ID().String(),
+		"status":     string(resp.Tenant.Status()),
+		"suspension": nil,
+	})
+}
+
+// SuspendClientHandler handles POST /api/v1/admin/clients/{id}/suspend
+func (h *Handlers) SuspendClientHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+		Note   string `json:"note"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	resp, err := h.suspendClient.Execute(r.Context(), &usecases.SuspendClientRequest{
+		ClientID:    id,
+		Reason:      model.SuspensionReason(req.Reason),
+		Note:        req.Note,
+		ActorUserID: h.actorUserID(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to suspend client")
+		return
+	}
+
+	h.logger.Info().
+		Str("client_id", id.String()).
+		Str("reason", req.Reason).
+		Msg("Client suspended")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":         resp.Client.ID().String(),
+		"agency_id":  resp.Client.AgencyID().String(),
+		"status":     string(resp.Client.Status()),
+		"suspension": buildSuspensionResponse(resp.Client.Suspension()),
+	})
+}
+
+// ReinstateClientHandler handles POST /api/v1/admin/clients/{id}/reinstate
+func (h *Handlers) ReinstateClientHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
+		return
+	}
+
+	var req struct {
+		Note string `json:"note"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	resp, err := h.reinstateClient.Execute(r.Context(), &usecases.ReinstateClientRequest{
+		ClientID:    id,
+		Note:        req.Note,
+		ActorUserID: h.actorUserID(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to reinstate client")
+		return
+	}
+
+	h.logger.Info().Str("client_id", id.String()).Msg("Client reinstated")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":         resp.Client.ID().String(),
+		"agency_id":  resp.Client.AgencyID().String(),
+		"status":     string(resp.Client.Status()),
+		"suspension": nil,
+	})
+}
+
+// ListSuspensionEventsHandler handles GET /api/v1/admin/tenants/{id}/suspension-events
+func (h *Handlers) ListSuspensionEventsHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
+		return
+	}
+
+	resp, err := h.listSuspensions.Execute(r.Context(), &usecases.ListSuspensionEventsRequest{
+		TenantID: id,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to list suspension events")
+		return
+	}
+
+	events := make([]map[string]interface{}, len(resp.Events))
+	for i, event := range resp.Events {
+		item := map[string]interface{}{
+			"id":         event.ID().String(),
+			"tenant_id":  event.TenantID().String(),
+			"client_id":  nil,
+			"action":     string(event.Action()),
+			"reason":     nil,
+			"note":       event.Note(),
+			"actor_id":   nil,
+			"created_at": event.CreatedAt().Format(time.RFC3339),
+		}
+		if event.ClientID() != nil {
+			item["client_id"] = event.ClientID().String()
+		}
+		if event.Reason() != nil {
+			item["reason"] = event.Reason().String()
+		}
+		if event.ActorUserID() != nil {
+			item["actor_id"] = event.ActorUserID().String()
+		}
+		events[i] = item
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"events": events,
+	})
+}
+
+// actorUserID resolves the database user ID of the authenticated caller
+// Returns nil if the caller has not been synced to the users table yet
+func (h *Handlers) actorUserID(r *http.Request) *uuid.UUID {
+	clerkUserID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		return nil
+	}
+	user, err := h.userRepo.FindByClerkUserID(r.Context(), clerkUserID)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("clerk_user_id", clerkUserID).Msg("Actor not found in users table, recording action without actor")
+		return nil
+	}
+	id := user.ID()
+	return &id
+}
+
+// buildSuspensionResponse builds the suspension details included in tenant and client responses
+func buildSuspensionResponse(suspension *model.Suspension) map[string]interface{} {
+	if suspension == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"reason":       suspension.Reason().String(),
+		"access":       string(suspension.Access()),
+		"note":         suspension.Note(),
+		"suspended_at": suspension.SuspendedAt().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"farohq-core-app/internal/domains/tenants/app/usecases"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/tenant"
+)
+
+// RequestTenantClosureHandler handles POST /api/v1/tenants/{id}/closure
+// The owner must confirm by sending the tenant slug
+func (h *Handlers) RequestTenantClosureHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.authorizeTenantRole(w, r, httpserver.RoleOwner)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		ConfirmSlug string `json:"confirm_slug"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	resp, err := h.requestClosure.Execute(r.Context(), &usecases.RequestTenantClosureRequest{
+		TenantID:    id,
+		ConfirmSlug: req.ConfirmSlug,
+		RequestedBy: h.actorUserID(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to request tenant closure")
+		return
+	}
+
+	h.logger.Info().
+		Str("tenant_id", id.String()).
+		Time("purge_after", resp.Tenant.Closure().PurgeAfter()).
+		Msg("Tenant closure requested")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":      resp.Tenant.ID().String(),
+		"closure": buildClosureResponse(resp.Tenant.Closure()),
+	})
+}
+
+// GetTenantClosureHandler handles GET /api/v1/tenants/{id}/closure
+func (h *Handlers) GetTenantClosureHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.authorizeTenantRole(w, r, httpserver.RoleOwner, httpserver.RoleAdmin)
+	if !ok {
+		return
+	}
+
+	resp, err := h.getTenant.Execute(r.Context(), &usecases.GetTenantRequest{
+		TenantID: id,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get tenant closure")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":      resp.Tenant.ID().String(),
+		"closure": buildClosureResponse(resp.Tenant.Closure()),
+	})
+}
+
+// CancelTenantClosureHandler handles DELETE /api/v1/tenants/{id}/closure
+func (h *Handlers) CancelTenantClosureHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.authorizeTenantRole(w, r, httpserver.RoleOwner)
+	if !ok {
+		return
+	}
+
+	resp, err := h.cancelClosure.Execute(r.Context(), &usecases.CancelTenantClosureRequest{
+		TenantID: id,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to cancel tenant closure")
+		return
+	}
+
+	h.logger.Info().Str("tenant_id", id.String()).Msg("Tenant closure cancelled")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":      resp.Tenant.ID().String(),
+		"closure": nil,
+	})
+}
+
+// authorizeTenantRole parses the tenant ID from the path and ensures the caller belongs to that tenant with one of roles
+// Used for sensitive tenant-wide operations (exports contain every member's PII, closure deletes everything)
+func (h *Handlers) authorizeTenantRole(w http.ResponseWriter, r *http.Request, roles ...string) (uuid.UUID, bool) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
+		return uuid.Nil, false
+	}
+
+	if tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context()); !ok || tenantID != id {
+		httpserver.WriteForbidden(w, r, httpserver.TenantCodeAccessDenied, "Forbidden: tenant mismatch")
+		return uuid.Nil, false
+	}
+
+	role := httpserver.GetRoleFromContext(r)
+	for _, allowed := range roles {
+		if role == allowed {
+			return id, true
+		}
+	}
+
+	httpserver.WriteForbidden(w, r, httpserver.RBACCodeInsufficientPermission, "Forbidden: insufficient permissions")
+	return uuid.Nil, false
+}
+
+// buildClosureResponse builds the closure details included in tenant responses
+func buildClosureResponse(closure *model.TenantClosure) map[string]interface{} {
+	if closure == nil {
+		return nil
+	}
+	response := map[string]interface{}{
+		"requested_at": closure.RequestedAt().Format(time.RFC3339),
+		"requested_by": nil,
+		"purge_after":  closure.PurgeAfter().Format(time.RFC3339),
+	}
+	if closure.RequestedBy() != nil {
+		response["requested_by"] = closure.RequestedBy().String()
+	}
+	return response
+}
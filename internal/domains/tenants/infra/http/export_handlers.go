@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"farohq-core-app/internal/domains/tenants/app/usecases"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// StartTenantExportHandler handles POST /api/v1/tenants/{id}/exports
+func (h *Handlers) StartTenantExportHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.authorizeTenantRole(w, r, httpserver.RoleOwner, httpserver.RoleAdmin)
+	if !ok {
+		return
+	}
+
+	resp, err := h.startExport.Execute(r.Context(), &usecases.StartTenantExportRequest{
+		TenantID:    id,
+		RequestedBy: h.actorUserID(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to start tenant export")
+		return
+	}
+
+	h.logger.Info().
+		Str("tenant_id", id.String()).
+		Str("export_id", resp.Export.ID().String()).
+		Msg("Tenant export started")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/v1/tenants/"+id.String()+"/exports/"+resp.Export.ID().String())
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(buildExportResponse(resp.Export))
+}
+
+// ListTenantExportsHandler handles GET /api/v1/tenants/{id}/exports
+func (h *Handlers) ListTenantExportsHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.authorizeTenantRole(w, r, httpserver.RoleOwner, httpserver.RoleAdmin)
+	if !ok {
+		return
+	}
+
+	resp, err := h.listExports.Execute(r.Context(), &usecases.ListTenantExportsRequest{
+		TenantID: id,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to list tenant exports")
+		return
+	}
+
+	exports := make([]map[string]interface{}, len(resp.Exports))
+	for i, export := range resp.Exports {
+		exports[i] = buildExportResponse(export)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"exports": exports,
+	})
+}
+
+// GetTenantExportHandler handles GET /api/v1/tenants/{id}/exports/{export_id}
+// Includes a short-lived signed download URL once the export has completed
+func (h *Handlers) GetTenantExportHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.authorizeTenantRole(w, r, httpserver.RoleOwner, httpserver.RoleAdmin)
+	if !ok {
+		return
+	}
+
+	exportID, err := parseUUID(chi.URLParam(r, "export_id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "export_id", "invalid export ID")
+		return
+	}
+
+	resp, err := h.getExport.Execute(r.Context(), &usecases.GetTenantExportRequest{
+		TenantID: id,
+		ExportID: exportID,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get tenant export")
+		return
+	}
+
+	response := buildExportResponse(resp.Export)
+	if resp.DownloadURL != "" {
+		response["download_url"] = resp.DownloadURL
+		response["download_url_expires_at"] = resp.DownloadExpiresAt.Format(time.RFC3339)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// buildExportResponse builds the JSON representation of a tenant export
+func buildExportResponse(export *model.TenantExport) map[string]interface{} {
+	response := map[string]interface{}{
+		"id":           export.ID().String(),
+		"tenant_id":    export.TenantID().String(),
+		"status":       string(export.Status()),
+		"progress":     export.Progress(),
+		"current_step": export.CurrentStep(),
+		"size_bytes":   export.SizeBytes(),
+		"requested_by": nil,
+		"error":        nil,
+		"created_at":   export.CreatedAt().Format(time.RFC3339),
+		"started_at":   nil,
+		"completed_at": nil,
+		"expires_at":   nil,
+		"expired":      export.IsExpired(),
+	}
+
+	if export.RequestedBy() != nil {
+		response["requested_by"] = export.RequestedBy().String()
+	}
+	if export.ErrorMessage() != "" {
+		response["error"] = export.ErrorMessage()
+	}
+	if export.StartedAt() != nil {
+		response["started_at"] = export.StartedAt().Format(time.RFC3339)
+	}
+	if export.CompletedAt() != nil {
+		response["completed_at"] = export.CompletedAt().Format(time.RFC3339)
+	}
+	if export.ExpiresAt() != nil {
+		response["expires_at"] = export.ExpiresAt().Format(time.RFC3339)
+	}
+
+	return response
+}
@@ -18,6 +18,9 @@ import (
 	users_domain "farohq-core-app/internal/domains/users/domain"
 	users_outbound "farohq-core-app/internal/domains/users/domain/ports/outbound"
 
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/tenant"
+
 	// Brand domain for fetching branding info
 	brand_outbound "farohq-core-app/internal/domains/brand/domain/ports/outbound"
 )
@@ -46,11 +49,22 @@ type Handlers struct {
 	removeClientMember *usecases.RemoveClientMember
 	createLocation     *usecases.CreateLocation
 	listLocations      *usecases.ListLocations
+	getLocation        *usecases.GetLocation
 	updateLocation     *usecases.UpdateLocation
 	getSeatUsage       *usecases.GetSeatUsage
 	listTenantsByUser  *usecases.ListTenantsByUser
 	validateSlug       *usecases.ValidateSlug
 	onboardTenant      *usecases.OnboardTenant
+	suspendTenant      *usecases.SuspendTenant
+	reinstateTenant    *usecases.ReinstateTenant
+	suspendClient      *usecases.SuspendClient
+	reinstateClient    *usecases.ReinstateClient
+	listSuspensions    *usecases.ListSuspensionEvents
+	startExport        *usecases.StartTenantExport
+	getExport          *usecases.GetTenantExport
+	listExports        *usecases.ListTenantExports
+	requestClosure     *usecases.RequestTenantClosure
+	cancelClosure      *usecases.CancelTenantClosure
 	userRepo           users_outbound.UserRepository
 	inviteRepo         tenants_outbound.InviteRepository
 	tenantRepo         tenants_outbound.TenantRepository
@@ -82,10 +96,21 @@ func NewHandlers(
 	removeClientMember *usecases.RemoveClientMember,
 	createLocation *usecases.CreateLocation,
 	listLocations *usecases.ListLocations,
+	getLocation *usecases.GetLocation,
 	updateLocation *usecases.UpdateLocation,
 	getSeatUsage *usecases.GetSeatUsage,
 	listTenantsByUser *usecases.ListTenantsByUser,
 	validateSlug *usecases.ValidateSlug,
+	suspendTenant *usecases.SuspendTenant,
+	reinstateTenant *usecases.ReinstateTenant,
+	suspendClient *usecases.SuspendClient,
+	reinstateClient *usecases.ReinstateClient,
+	listSuspensions *usecases.ListSuspensionEvents,
+	startExport *usecases.StartTenantExport,
+	getExport *usecases.GetTenantExport,
+	listExports *usecases.ListTenantExports,
+	requestClosure *usecases.RequestTenantClosure,
+	cancelClosure *usecases.CancelTenantClosure,
 	userRepo users_outbound.UserRepository,
 	inviteRepo tenants_outbound.InviteRepository,
 	tenantRepo tenants_outbound.TenantRepository,
@@ -115,10 +140,21 @@ func NewHandlers(
 		removeClientMember: removeClientMember,
 		createLocation:     createLocation,
 		listLocations:      listLocations,
+		getLocation:        getLocation,
 		updateLocation:     updateLocation,
 		getSeatUsage:       getSeatUsage,
 		listTenantsByUser:  listTenantsByUser,
 		validateSlug:       validateSlug,
+		suspendTenant:      suspendTenant,
+		reinstateTenant:    reinstateTenant,
+		suspendClient:      suspendClient,
+		reinstateClient:    reinstateClient,
+		listSuspensions:    listSuspensions,
+		startExport:        startExport,
+		getExport:          getExport,
+		listExports:        listExports,
+		requestClosure:     requestClosure,
+		cancelClosure:      cancelClosure,
 		userRepo:           userRepo,
 		inviteRepo:         inviteRepo,
 		tenantRepo:         tenantRepo,
@@ -134,7 +170,7 @@ func (h *Handlers) CreateTenantHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
@@ -145,16 +181,7 @@ func (h *Handlers) CreateTenantHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.createTenant.Execute(r.Context(), createReq)
 	if err != nil {
-		if err == domain.ErrTenantAlreadyExists {
-			http.Error(w, err.Error(), http.StatusConflict)
-			return
-		}
-		if err == domain.ErrInvalidTenantName || err == domain.ErrInvalidTenantSlug {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to create tenant")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to create tenant")
 		return
 	}
 
@@ -173,13 +200,13 @@ func (h *Handlers) CreateTenantHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) GetTenantHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	id, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
@@ -189,36 +216,33 @@ func (h *Handlers) GetTenantHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.getTenant.Execute(r.Context(), getReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to get tenant")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to get tenant")
 		return
 	}
 
+	httpserver.SetETag(w, resp.Tenant.Version())
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":         resp.Tenant.ID().String(),
-		"name":       resp.Tenant.Name(),
-		"slug":       resp.Tenant.Slug(),
-		"status":     string(resp.Tenant.Status()),
-		"created_at": resp.Tenant.CreatedAt().Format(time.RFC3339),
-	})
+	json.NewEncoder(w).Encode(buildTenantResponse(resp.Tenant))
 }
 
 // UpdateTenantHandler handles PUT /api/v1/tenants/{id}
+// Requires If-Match with the tenant's current ETag
 func (h *Handlers) UpdateTenantHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	id, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
+		return
+	}
+
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err != nil {
+		httpserver.WritePreconditionRequired(w, r)
 		return
 	}
 
@@ -229,14 +253,15 @@ func (h *Handlers) UpdateTenantHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	updateReq := &usecases.UpdateTenantRequest{
-		TenantID: id,
-		Name:     req.Name,
-		Slug:     req.Slug,
+		TenantID:        id,
+		Name:            req.Name,
+		Slug:            req.Slug,
+		ExpectedVersion: expectedVersion,
 	}
 
 	if req.Status != nil {
@@ -244,53 +269,27 @@ func (h *Handlers) UpdateTenantHandler(w http.ResponseWriter, r *http.Request) {
 		updateReq.Status = &status
 	}
 
-	resp, err := h.updateTenant.Execute(r.Context(), updateReq)
-	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		if err == domain.ErrTenantAlreadyExists {
-			http.Error(w, err.Error(), http.StatusConflict)
-			return
-		}
-		if err == domain.ErrInvalidTenantName || err == domain.ErrInvalidTenantSlug {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to update tenant")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":         resp.Tenant.ID().String(),
-		"name":       resp.Tenant.Name(),
-		"slug":       resp.Tenant.Slug(),
-		"status":     string(resp.Tenant.Status()),
-		"created_at": resp.Tenant.CreatedAt().Format(time.RFC3339),
-	})
+	h.executeUpdateTenant(w, r, updateReq)
 }
 
 // InviteMemberHandler handles POST /api/v1/tenants/{id}/invites
 func (h *Handlers) InviteMemberHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	id, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
 	// Get Clerk user ID from context (set by auth middleware)
 	clerkUserID, ok := r.Context().Value("user_id").(string)
 	if !ok {
-		http.Error(w, "user ID required", http.StatusUnauthorized)
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "user ID required")
 		return
 	}
 
@@ -298,7 +297,7 @@ func (h *Handlers) InviteMemberHandler(w http.ResponseWriter, r *http.Request) {
 	user, err := h.userRepo.FindByClerkUserID(r.Context(), clerkUserID)
 	if err != nil {
 		h.logger.Error().Err(err).Str("clerk_user_id", clerkUserID).Msg("Failed to find user by Clerk user ID")
-		http.Error(w, "User not found", http.StatusNotFound)
+		httpserver.WriteNotFound(w, r, httpserver.TenantCodeUserNotFound, "User not found")
 		return
 	}
 
@@ -308,7 +307,7 @@ func (h *Handlers) InviteMemberHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
@@ -319,23 +318,14 @@ func (h *Handlers) InviteMemberHandler(w http.ResponseWriter, r *http.Request) {
 		Role:      role,
 		CreatedBy: user.ID(),
 	}
+	// Invites sent from a client context are branded with the client's branding
+	if clientID, ok := tenant.GetClientUUIDFromContext(r.Context()); ok {
+		inviteReq.ClientID = &clientID
+	}
 
 	resp, err := h.inviteMember.Execute(r.Context(), inviteReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		if err == domain.ErrInvalidEmail || err == domain.ErrInvalidRole {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		if err == domain.ErrPendingInviteExists || err == domain.ErrInviteAlreadyAccepted {
-			http.Error(w, err.Error(), http.StatusConflict)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to invite member")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to invite member")
 		return
 	}
 
@@ -359,19 +349,19 @@ func (h *Handlers) AcceptInviteHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	if req.Token == "" {
-		http.Error(w, "token is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "token", "token is required")
 		return
 	}
 
 	// Get authenticated user from context (set by RequireAuth middleware)
 	clerkUserID, ok := r.Context().Value("user_id").(string)
 	if !ok || clerkUserID == "" {
-		http.Error(w, "authentication required", http.StatusUnauthorized)
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "authentication required")
 		return
 	}
 
@@ -382,19 +372,14 @@ func (h *Handlers) AcceptInviteHandler(w http.ResponseWriter, r *http.Request) {
 			Str("clerk_user_id", clerkUserID).
 			Err(err).
 			Msg("Failed to find user by Clerk user ID for invite acceptance")
-		http.Error(w, "User not found. Please ensure your account is synced.", http.StatusNotFound)
+		httpserver.WriteNotFound(w, r, httpserver.TenantCodeUserNotFound, "User not found. Please ensure your account is synced.")
 		return
 	}
 
 	// Find invite by token to validate email match
 	invite, err := h.inviteRepo.FindByToken(r.Context(), req.Token)
 	if err != nil {
-		if err == domain.ErrInviteNotFound {
-			http.Error(w, "Invitation not found", http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to find invite by token")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to find invite by token")
 		return
 	}
 
@@ -405,7 +390,7 @@ func (h *Handlers) AcceptInviteHandler(w http.ResponseWriter, r *http.Request) {
 			Str("invite_email", invite.Email()).
 			Str("invite_token", req.Token).
 			Msg("User email does not match invite email")
-		http.Error(w, "This invitation was sent to a different email address. Please sign in with the email that received the invitation.", http.StatusForbidden)
+		httpserver.WriteForbidden(w, r, "invite_email_mismatch", "This invitation was sent to a different email address. Please sign in with the email that received the invitation.")
 		return
 	}
 
@@ -419,24 +404,7 @@ func (h *Handlers) AcceptInviteHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.acceptInvite.Execute(r.Context(), acceptReq)
 	if err != nil {
-		if err == domain.ErrInviteNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		if err == domain.ErrInviteExpired {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		if err == domain.ErrInviteRevoked {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		if err == domain.ErrInviteAlreadyAccepted {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to accept invite")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to accept invite")
 		return
 	}
 
@@ -458,19 +426,14 @@ func (h *Handlers) AcceptInviteHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) GetInviteByTokenHandler(w http.ResponseWriter, r *http.Request) {
 	token := chi.URLParam(r, "token")
 	if token == "" {
-		http.Error(w, "token is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "token", "token is required")
 		return
 	}
 
 	// Find invite by token
 	invite, err := h.inviteRepo.FindByToken(r.Context(), token)
 	if err != nil {
-		if err == domain.ErrInviteNotFound {
-			http.Error(w, "Invitation not found", http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to find invite by token")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to find invite by token")
 		return
 	}
 
@@ -585,7 +548,7 @@ func (h *Handlers) FindInvitesByEmailHandler(w http.ResponseWriter, r *http.Requ
 	// #endregion
 
 	if email == "" {
-		http.Error(w, "email query parameter is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "email", "email query parameter is required")
 		return
 	}
 
@@ -621,11 +584,11 @@ func (h *Handlers) FindInvitesByEmailHandler(w http.ResponseWriter, r *http.Requ
 
 	if err != nil {
 		if err == domain.ErrInvalidEmail {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			httpserver.WriteProblem(w, r, problems.Problem(err))
 			return
 		}
 		h.logger.Error().Err(err).Str("email", email).Msg("Failed to find invites by email")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		httpserver.WriteInternalError(w, r)
 		return
 	}
 
@@ -713,13 +676,13 @@ func (h *Handlers) FindInvitesByEmailHandler(w http.ResponseWriter, r *http.Requ
 func (h *Handlers) ListInvitesHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	id, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
@@ -729,12 +692,7 @@ func (h *Handlers) ListInvitesHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.listInvites.Execute(r.Context(), listReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to list invites")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list invites")
 		return
 	}
 
@@ -775,25 +733,25 @@ func (h *Handlers) ListInvitesHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) RevokeInviteHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	inviteIDStr := chi.URLParam(r, "invite_id")
 	if inviteIDStr == "" {
-		http.Error(w, "invite ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "invite_id", "invite ID is required")
 		return
 	}
 
 	tenantUUID, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
 	inviteUUID, err := parseUUID(inviteIDStr)
 	if err != nil {
-		http.Error(w, "invalid invite ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "invite_id", "invalid invite ID")
 		return
 	}
 
@@ -807,12 +765,7 @@ func (h *Handlers) RevokeInviteHandler(w http.ResponseWriter, r *http.Request) {
 
 		resp, err := h.deleteInvite.Execute(r.Context(), deleteReq)
 		if err != nil {
-			if err == domain.ErrTenantNotFound || err == domain.ErrInviteNotFound {
-				http.Error(w, err.Error(), http.StatusNotFound)
-				return
-			}
-			h.logger.Error().Err(err).Msg("Failed to delete invite")
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			h.writeError(w, r, err, "Failed to delete invite")
 			return
 		}
 
@@ -832,16 +785,7 @@ func (h *Handlers) RevokeInviteHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.revokeInvite.Execute(r.Context(), revokeReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound || err == domain.ErrInviteNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		if err == domain.ErrInviteAlreadyAccepted {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to revoke invite")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to revoke invite")
 		return
 	}
 
@@ -867,13 +811,13 @@ func (h *Handlers) RevokeInviteHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) ListMembersHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	id, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
@@ -883,12 +827,7 @@ func (h *Handlers) ListMembersHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.listMembers.Execute(r.Context(), listReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to list members")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list members")
 		return
 	}
 
@@ -914,25 +853,25 @@ func (h *Handlers) ListMembersHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) RemoveMemberHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	userID := chi.URLParam(r, "user_id")
 	if userID == "" {
-		http.Error(w, "user ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "user_id", "user ID is required")
 		return
 	}
 
 	tenantUUID, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
 	userUUID, err := parseUUID(userID)
 	if err != nil {
-		http.Error(w, "invalid user ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "user_id", "invalid user ID")
 		return
 	}
 
@@ -943,12 +882,7 @@ func (h *Handlers) RemoveMemberHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.removeMember.Execute(r.Context(), removeReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound || err == domain.ErrMemberNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to remove member")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to remove member")
 		return
 	}
 
@@ -962,13 +896,13 @@ func (h *Handlers) RemoveMemberHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) ListRolesHandler(w http.ResponseWriter, r *http.Request) {
 	tenantID := chi.URLParam(r, "id")
 	if tenantID == "" {
-		http.Error(w, "tenant ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "tenant_id", "tenant ID is required")
 		return
 	}
 
 	id, err := parseUUID(tenantID)
 	if err != nil {
-		http.Error(w, "invalid tenant ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
 		return
 	}
 
@@ -978,12 +912,7 @@ func (h *Handlers) ListRolesHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.listRoles.Execute(r.Context(), listReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to list roles")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list roles")
 		return
 	}
 
@@ -997,13 +926,13 @@ func (h *Handlers) ListRolesHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) CreateClientHandler(w http.ResponseWriter, r *http.Request) {
 	agencyID := chi.URLParam(r, "id")
 	if agencyID == "" {
-		http.Error(w, "agency ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "agency_id", "agency ID is required")
 		return
 	}
 
 	id, err := parseUUID(agencyID)
 	if err != nil {
-		http.Error(w, "invalid agency ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "agency_id", "invalid agency ID")
 		return
 	}
 
@@ -1014,7 +943,7 @@ func (h *Handlers) CreateClientHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
@@ -1027,16 +956,7 @@ func (h *Handlers) CreateClientHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.createClient.Execute(r.Context(), createReq)
 	if err != nil {
-		if err == domain.ErrClientAlreadyExists {
-			http.Error(w, err.Error(), http.StatusConflict)
-			return
-		}
-		if err == domain.ErrInvalidTenantName || err == domain.ErrInvalidTenantSlug {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to create client")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to create client")
 		return
 	}
 
@@ -1058,28 +978,25 @@ func (h *Handlers) CreateClientHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) ListClientsHandler(w http.ResponseWriter, r *http.Request) {
 	agencyID := chi.URLParam(r, "id")
 	if agencyID == "" {
-		http.Error(w, "agency ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "agency_id", "agency ID is required")
 		return
 	}
 
 	id, err := parseUUID(agencyID)
 	if err != nil {
-		http.Error(w, "invalid agency ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "agency_id", "invalid agency ID")
 		return
 	}
 
+	// Suspended clients are hidden from client viewers
 	listReq := &usecases.ListClientsRequest{
-		AgencyID: id,
+		AgencyID:         id,
+		ExcludeSuspended: httpserver.GetRoleFromContext(r) == httpserver.RoleClientViewer,
 	}
 
 	resp, err := h.listClients.Execute(r.Context(), listReq)
 	if err != nil {
-		if err == domain.ErrTenantNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to list clients")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list clients")
 		return
 	}
 
@@ -1107,13 +1024,13 @@ func (h *Handlers) ListClientsHandler(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) GetClientHandler(w http.ResponseWriter, r *http.Request) {
 	clientID := chi.URLParam(r, "id")
 	if clientID == "" {
-		http.Error(w, "client ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "client_id", "client ID is required")
 		return
 	}
 
 	id, err := parseUUID(clientID)
 	if err != nil {
-		http.Error(w, "invalid client ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
 		return
 	}
 
@@ -1123,39 +1040,33 @@ func (h *Handlers) GetClientHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.getClient.Execute(r.Context(), getReq)
 	if err != nil {
-		if err == domain.ErrClientNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to get client")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to get client")
 		return
 	}
 
+	httpserver.SetETag(w, resp.Client.Version())
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":         resp.Client.ID().String(),
-		"agency_id":  resp.Client.AgencyID().String(),
-		"name":       resp.Client.Name(),
-		"slug":       resp.Client.Slug(),
-		"tier":       resp.Client.Tier().String(),
-		"status":     string(resp.Client.Status()),
-		"created_at": resp.Client.CreatedAt().Format(time.RFC3339),
-		"updated_at": resp.Client.UpdatedAt().Format(time.RFC3339),
-	})
+	json.NewEncoder(w).Encode(buildClientResponse(resp.Client))
 }
 
 // UpdateClientHandler handles PUT /api/v1/clients/{id}
+// Requires If-Match with the client's current ETag
 func (h *Handlers) UpdateClientHandler(w http.ResponseWriter, r *http.Request) {
 	clientID := chi.URLParam(r, "id")
 	if clientID == "" {
-		http.Error(w, "client ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "client_id", "client ID is required")
 		return
 	}
 
 	id, err := parseUUID(clientID)
 	if err != nil {
-		http.Error(w, "invalid client ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
+		return
+	}
+
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err != nil {
+		httpserver.WritePreconditionRequired(w, r)
 		return
 	}
 
@@ -1166,14 +1077,15 @@ func (h *Handlers) UpdateClientHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	updateReq := &usecases.UpdateClientRequest{
-		ClientID: id,
-		Name:     req.Name,
-		Slug:     req.Slug,
+		ClientID:        id,
+		Name:            req.Name,
+		Slug:            req.Slug,
+		ExpectedVersion: expectedVersion,
 	}
 
 	if req.Status != nil {
@@ -1181,41 +1093,20 @@ func (h *Handlers) UpdateClientHandler(w http.ResponseWriter, r *http.Request) {
 		updateReq.Status = &status
 	}
 
-	resp, err := h.updateClient.Execute(r.Context(), updateReq)
-	if err != nil {
-		if err == domain.ErrClientNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to update client")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":         resp.Client.ID().String(),
-		"agency_id":  resp.Client.AgencyID().String(),
-		"name":       resp.Client.Name(),
-		"slug":       resp.Client.Slug(),
-		"tier":       resp.Client.Tier().String(),
-		"status":     string(resp.Client.Status()),
-		"created_at": resp.Client.CreatedAt().Format(time.RFC3339),
-		"updated_at": resp.Client.UpdatedAt().Format(time.RFC3339),
-	})
+	h.executeUpdateClient(w, r, updateReq)
 }
 
 // AddClientMemberHandler handles POST /api/v1/clients/{id}/members
 func (h *Handlers) AddClientMemberHandler(w http.ResponseWriter, r *http.Request) {
 	clientID := chi.URLParam(r, "id")
 	if clientID == "" {
-		http.Error(w, "client ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "client_id", "client ID is required")
 		return
 	}
 
 	id, err := parseUUID(clientID)
 	if err != nil {
-		http.Error(w, "invalid client ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
 		return
 	}
 
@@ -1226,13 +1117,13 @@ func (h *Handlers) AddClientMemberHandler(w http.ResponseWriter, r *http.Request
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	userUUID, err := parseUUID(req.UserID)
 	if err != nil {
-		http.Error(w, "invalid user ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "user_id", "invalid user ID")
 		return
 	}
 
@@ -1240,7 +1131,7 @@ func (h *Handlers) AddClientMemberHandler(w http.ResponseWriter, r *http.Request
 	if req.LocationID != nil {
 		locUUID, err := parseUUID(*req.LocationID)
 		if err != nil {
-			http.Error(w, "invalid location ID", http.StatusBadRequest)
+			httpserver.WriteInvalidField(w, r, "location_id", "invalid location ID")
 			return
 		}
 		locationUUID = &locUUID
@@ -1255,16 +1146,7 @@ func (h *Handlers) AddClientMemberHandler(w http.ResponseWriter, r *http.Request
 
 	resp, err := h.addClientMember.Execute(r.Context(), addReq)
 	if err != nil {
-		if err == domain.ErrMemberAlreadyExists {
-			http.Error(w, err.Error(), http.StatusConflict)
-			return
-		}
-		if err == domain.ErrInvalidRole || err == domain.ErrClientSeatLimitExceeded {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to add client member")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to add client member")
 		return
 	}
 
@@ -1291,13 +1173,13 @@ func (h *Handlers) AddClientMemberHandler(w http.ResponseWriter, r *http.Request
 func (h *Handlers) ListClientMembersHandler(w http.ResponseWriter, r *http.Request) {
 	clientID := chi.URLParam(r, "id")
 	if clientID == "" {
-		http.Error(w, "client ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "client_id", "client ID is required")
 		return
 	}
 
 	id, err := parseUUID(clientID)
 	if err != nil {
-		http.Error(w, "invalid client ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
 		return
 	}
 
@@ -1317,8 +1199,7 @@ func (h *Handlers) ListClientMembersHandler(w http.ResponseWriter, r *http.Reque
 
 	resp, err := h.listClientMembers.Execute(r.Context(), listReq)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to list client members")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list client members")
 		return
 	}
 
@@ -1350,13 +1231,13 @@ func (h *Handlers) ListClientMembersHandler(w http.ResponseWriter, r *http.Reque
 func (h *Handlers) RemoveClientMemberHandler(w http.ResponseWriter, r *http.Request) {
 	memberID := chi.URLParam(r, "memberId")
 	if memberID == "" {
-		http.Error(w, "member ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "member_id", "member ID is required")
 		return
 	}
 
 	id, err := parseUUID(memberID)
 	if err != nil {
-		http.Error(w, "invalid member ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "member_id", "invalid member ID")
 		return
 	}
 
@@ -1366,12 +1247,7 @@ func (h *Handlers) RemoveClientMemberHandler(w http.ResponseWriter, r *http.Requ
 
 	resp, err := h.removeClientMember.Execute(r.Context(), removeReq)
 	if err != nil {
-		if err == domain.ErrClientMemberNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to remove client member")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to remove client member")
 		return
 	}
 
@@ -1385,13 +1261,13 @@ func (h *Handlers) RemoveClientMemberHandler(w http.ResponseWriter, r *http.Requ
 func (h *Handlers) CreateLocationHandler(w http.ResponseWriter, r *http.Request) {
 	clientID := chi.URLParam(r, "id")
 	if clientID == "" {
-		http.Error(w, "client ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "client_id", "client ID is required")
 		return
 	}
 
 	id, err := parseUUID(clientID)
 	if err != nil {
-		http.Error(w, "invalid client ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
 		return
 	}
 
@@ -1400,7 +1276,7 @@ func (h *Handlers) CreateLocationHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
@@ -1411,42 +1287,26 @@ func (h *Handlers) CreateLocationHandler(w http.ResponseWriter, r *http.Request)
 
 	resp, err := h.createLocation.Execute(r.Context(), createReq)
 	if err != nil {
-		if err == domain.ErrClientNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to create location")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to create location")
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":             resp.Location.ID().String(),
-		"client_id":      resp.Location.ClientID().String(),
-		"name":           resp.Location.Name(),
-		"address":        resp.Location.Address(),
-		"phone":          resp.Location.Phone(),
-		"business_hours": resp.Location.BusinessHours(),
-		"categories":     resp.Location.Categories(),
-		"is_active":      resp.Location.IsActive(),
-		"created_at":     resp.Location.CreatedAt().Format(time.RFC3339),
-		"updated_at":     resp.Location.UpdatedAt().Format(time.RFC3339),
-	})
+	json.NewEncoder(w).Encode(buildLocationResponse(resp.Location))
 }
 
 // ListLocationsHandler handles GET /api/v1/clients/{id}/locations
 func (h *Handlers) ListLocationsHandler(w http.ResponseWriter, r *http.Request) {
 	clientID := chi.URLParam(r, "id")
 	if clientID == "" {
-		http.Error(w, "client ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "client_id", "client ID is required")
 		return
 	}
 
 	id, err := parseUUID(clientID)
 	if err != nil {
-		http.Error(w, "invalid client ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
 		return
 	}
 
@@ -1456,25 +1316,13 @@ func (h *Handlers) ListLocationsHandler(w http.ResponseWriter, r *http.Request)
 
 	resp, err := h.listLocations.Execute(r.Context(), listReq)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to list locations")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list locations")
 		return
 	}
 
 	locations := make([]map[string]interface{}, len(resp.Locations))
 	for i, location := range resp.Locations {
-		locations[i] = map[string]interface{}{
-			"id":             location.ID().String(),
-			"client_id":      location.ClientID().String(),
-			"name":           location.Name(),
-			"address":        location.Address(),
-			"phone":          location.Phone(),
-			"business_hours": location.BusinessHours(),
-			"categories":     location.Categories(),
-			"is_active":      location.IsActive(),
-			"created_at":     location.CreatedAt().Format(time.RFC3339),
-			"updated_at":     location.UpdatedAt().Format(time.RFC3339),
-		}
+		locations[i] = buildLocationResponse(location)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -1483,17 +1331,51 @@ func (h *Handlers) ListLocationsHandler(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// GetLocationHandler handles GET /api/v1/locations/{id}
+func (h *Handlers) GetLocationHandler(w http.ResponseWriter, r *http.Request) {
+	locationID := chi.URLParam(r, "id")
+	if locationID == "" {
+		httpserver.WriteMissingField(w, r, "location_id", "location ID is required")
+		return
+	}
+
+	id, err := parseUUID(locationID)
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "location_id", "invalid location ID")
+		return
+	}
+
+	resp, err := h.getLocation.Execute(r.Context(), &usecases.GetLocationRequest{
+		LocationID: id,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get location")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Location.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildLocationResponse(resp.Location))
+}
+
 // UpdateLocationHandler handles PUT /api/v1/locations/{id}
+// Requires If-Match with the location's current ETag
 func (h *Handlers) UpdateLocationHandler(w http.ResponseWriter, r *http.Request) {
 	locationID := chi.URLParam(r, "id")
 	if locationID == "" {
-		http.Error(w, "location ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "location_id", "location ID is required")
 		return
 	}
 
 	id, err := parseUUID(locationID)
 	if err != nil {
-		http.Error(w, "invalid location ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "location_id", "invalid location ID")
+		return
+	}
+
+	expectedVersion, err := httpserver.IfMatchVersion(r)
+	if err != nil {
+		httpserver.WritePreconditionRequired(w, r)
 		return
 	}
 
@@ -1507,57 +1389,35 @@ func (h *Handlers) UpdateLocationHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	updateReq := &usecases.UpdateLocationRequest{
-		LocationID:    id,
-		Name:          req.Name,
-		Address:       req.Address,
-		Phone:         req.Phone,
-		BusinessHours: req.BusinessHours,
-		Categories:    req.Categories,
-		IsActive:      req.IsActive,
-	}
-
-	resp, err := h.updateLocation.Execute(r.Context(), updateReq)
-	if err != nil {
-		if err == domain.ErrLocationNotFound {
-			http.Error(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to update location")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-		return
+		LocationID:      id,
+		Name:            req.Name,
+		Address:         req.Address,
+		Phone:           req.Phone,
+		BusinessHours:   req.BusinessHours,
+		Categories:      req.Categories,
+		IsActive:        req.IsActive,
+		ExpectedVersion: expectedVersion,
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"id":             resp.Location.ID().String(),
-		"client_id":      resp.Location.ClientID().String(),
-		"name":           resp.Location.Name(),
-		"address":        resp.Location.Address(),
-		"phone":          resp.Location.Phone(),
-		"business_hours": resp.Location.BusinessHours(),
-		"categories":     resp.Location.Categories(),
-		"is_active":      resp.Location.IsActive(),
-		"created_at":     resp.Location.CreatedAt().Format(time.RFC3339),
-		"updated_at":     resp.Location.UpdatedAt().Format(time.RFC3339),
-	})
+	h.executeUpdateLocation(w, r, updateReq)
 }
 
 // GetSeatUsageHandler handles GET /api/v1/tenants/{id}/seat-usage
 func (h *Handlers) GetSeatUsageHandler(w http.ResponseWriter, r *http.Request) {
 	agencyID := chi.URLParam(r, "id")
 	if agencyID == "" {
-		http.Error(w, "agency ID is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "agency_id", "agency ID is required")
 		return
 	}
 
 	id, err := parseUUID(agencyID)
 	if err != nil {
-		http.Error(w, "invalid agency ID", http.StatusBadRequest)
+		httpserver.WriteInvalidField(w, r, "agency_id", "invalid agency ID")
 		return
 	}
 
@@ -1567,8 +1427,7 @@ func (h *Handlers) GetSeatUsageHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.getSeatUsage.Execute(r.Context(), getReq)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to get seat usage")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to get seat usage")
 		return
 	}
 
@@ -1601,7 +1460,7 @@ func (h *Handlers) OnboardTenantHandler(w http.ResponseWriter, r *http.Request)
 	// Get Clerk user ID from context (set by auth middleware)
 	clerkUserID, ok := r.Context().Value("user_id").(string)
 	if !ok {
-		http.Error(w, "user ID required", http.StatusUnauthorized)
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "user ID required")
 		return
 	}
 
@@ -1609,7 +1468,7 @@ func (h *Handlers) OnboardTenantHandler(w http.ResponseWriter, r *http.Request)
 	user, err := h.userRepo.FindByClerkUserID(r.Context(), clerkUserID)
 	if err != nil {
 		h.logger.Error().Err(err).Str("clerk_user_id", clerkUserID).Msg("Failed to find user by Clerk user ID")
-		http.Error(w, "User not found", http.StatusNotFound)
+		httpserver.WriteNotFound(w, r, httpserver.TenantCodeUserNotFound, "User not found")
 		return
 	}
 	userID := user.ID()
@@ -1623,7 +1482,7 @@ func (h *Handlers) OnboardTenantHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
@@ -1639,16 +1498,7 @@ func (h *Handlers) OnboardTenantHandler(w http.ResponseWriter, r *http.Request)
 
 	resp, err := h.onboardTenant.Execute(r.Context(), onboardReq)
 	if err != nil {
-		if err == domain.ErrTenantAlreadyExists {
-			http.Error(w, err.Error(), http.StatusConflict)
-			return
-		}
-		if err == domain.ErrInvalidTenantName || err == domain.ErrInvalidTenantSlug {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		h.logger.Error().Err(err).Msg("Failed to onboard tenant")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to onboard tenant")
 		return
 	}
 
@@ -1673,7 +1523,7 @@ func (h *Handlers) ListTenantsByUserHandler(w http.ResponseWriter, r *http.Reque
 	// Get Clerk user ID from context (set by auth middleware)
 	clerkUserID, ok := r.Context().Value("user_id").(string)
 	if !ok {
-		http.Error(w, "user ID required", http.StatusUnauthorized)
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "user ID required")
 		return
 	}
 
@@ -1692,7 +1542,7 @@ func (h *Handlers) ListTenantsByUserHandler(w http.ResponseWriter, r *http.Reque
 			return
 		}
 		h.logger.Error().Err(err).Str("clerk_user_id", clerkUserID).Msg("Failed to find user by Clerk user ID")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		httpserver.WriteInternalError(w, r)
 		return
 	}
 
@@ -1702,8 +1552,7 @@ func (h *Handlers) ListTenantsByUserHandler(w http.ResponseWriter, r *http.Reque
 
 	resp, err := h.listTenantsByUser.Execute(r.Context(), listReq)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to list tenants by user")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to list tenants by user")
 		return
 	}
 
@@ -1729,7 +1578,7 @@ func (h *Handlers) ListTenantsByUserHandler(w http.ResponseWriter, r *http.Reque
 func (h *Handlers) ValidateSlugHandler(w http.ResponseWriter, r *http.Request) {
 	slug := r.URL.Query().Get("slug")
 	if slug == "" {
-		http.Error(w, "slug parameter is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "slug", "slug parameter is required")
 		return
 	}
 
@@ -1739,8 +1588,7 @@ func (h *Handlers) ValidateSlugHandler(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.validateSlug.Execute(r.Context(), validateReq)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to validate slug")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to validate slug")
 		return
 	}
 
@@ -1751,6 +1599,53 @@ func (h *Handlers) ValidateSlugHandler(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// buildTenantResponse builds the tenant representation returned by tenant endpoints
+func buildTenantResponse(tenant *model.Tenant) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         tenant.ID().String(),
+		"name":       tenant.Name(),
+		"slug":       tenant.Slug(),
+		"status":     string(tenant.Status()),
+		"suspension": buildSuspensionResponse(tenant.Suspension()),
+		"closure":    buildClosureResponse(tenant.Closure()),
+		"version":    tenant.Version(),
+		"created_at": tenant.CreatedAt().Format(time.RFC3339),
+	}
+}
+
+// buildClientResponse builds the client representation returned by client endpoints
+func buildClientResponse(client *model.Client) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         client.ID().String(),
+		"agency_id":  client.AgencyID().String(),
+		"name":       client.Name(),
+		"slug":       client.Slug(),
+		"tier":       client.Tier().String(),
+		"status":     string(client.Status()),
+		"suspension": buildSuspensionResponse(client.Suspension()),
+		"version":    client.Version(),
+		"created_at": client.CreatedAt().Format(time.RFC3339),
+		"updated_at": client.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+// buildLocationResponse builds the location representation returned by location endpoints
+func buildLocationResponse(location *model.Location) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             location.ID().String(),
+		"client_id":      location.ClientID().String(),
+		"name":           location.Name(),
+		"address":        location.Address(),
+		"phone":          location.Phone(),
+		"business_hours": location.BusinessHours(),
+		"categories":     location.Categories(),
+		"is_active":      location.IsActive(),
+		"version":        location.Version(),
+		"created_at":     location.CreatedAt().Format(time.RFC3339),
+		"updated_at":     location.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
 // parseUUID parses a UUID string
 func parseUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
@@ -0,0 +1,254 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"farohq-core-app/internal/domains/tenants/app/usecases"
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// tenantPatchDocument is the editable part of a tenant that PATCH merges into
+type tenantPatchDocument struct {
+	Name   string `json:"name"`
+	Slug   string `json:"slug"`
+	Status string `json:"status"`
+}
+
+// clientPatchDocument is the editable part of a client that PATCH merges into
+type clientPatchDocument struct {
+	Name   string `json:"name"`
+	Slug   string `json:"slug"`
+	Status string `json:"status"`
+}
+
+// locationPatchDocument is the editable part of a location that PATCH merges into
+type locationPatchDocument struct {
+	Name          string                 `json:"name"`
+	Address       map[string]interface{} `json:"address"`
+	Phone         string                 `json:"phone"`
+	BusinessHours map[string]interface{} `json:"business_hours"`
+	Categories    []string               `json:"categories"`
+	IsActive      bool                   `json:"is_active"`
+}
+
+// PatchTenantHandler handles PATCH /api/v1/tenants/{id} (JSON Merge Patch)
+// Requires If-Match with the tenant's current ETag
+func (h *Handlers) PatchTenantHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "invalid tenant ID")
+		return
+	}
+
+	patch, ok := httpserver.ReadConditionalMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	current, err := h.getTenant.Execute(r.Context(), &usecases.GetTenantRequest{TenantID: id})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get tenant")
+		return
+	}
+
+	var doc tenantPatchDocument
+	expectedVersion, ok := patch.Apply(w, r, tenantPatchDocument{
+		Name:   current.Tenant.Name(),
+		Slug:   current.Tenant.Slug(),
+		Status: string(current.Tenant.Status()),
+	}, current.Tenant.Version(), &doc)
+	if !ok {
+		return
+	}
+	if doc.Status == "" {
+		httpserver.WriteMissingField(w, r, "status", "status cannot be removed")
+		return
+	}
+
+	status := model.TenantStatus(doc.Status)
+	h.executeUpdateTenant(w, r, &usecases.UpdateTenantRequest{
+		TenantID:        id,
+		Name:            &doc.Name,
+		Slug:            &doc.Slug,
+		Status:          &status,
+		ExpectedVersion: expectedVersion,
+	})
+}
+
+// PatchClientHandler handles PATCH /api/v1/clients/{id} (JSON Merge Patch)
+// Requires If-Match with the client's current ETag
+func (h *Handlers) PatchClientHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "client_id", "invalid client ID")
+		return
+	}
+
+	patch, ok := httpserver.ReadConditionalMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	current, err := h.getClient.Execute(r.Context(), &usecases.GetClientRequest{ClientID: id})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get client")
+		return
+	}
+
+	var doc clientPatchDocument
+	expectedVersion, ok := patch.Apply(w, r, clientPatchDocument{
+		Name:   current.Client.Name(),
+		Slug:   current.Client.Slug(),
+		Status: string(current.Client.Status()),
+	}, current.Client.Version(), &doc)
+	if !ok {
+		return
+	}
+	if doc.Slug == "" || doc.Status == "" {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusBadRequest, httpserver.ProblemCodeValidation, "slug and status cannot be removed").
+			WithField("slug", httpserver.FieldCodeRequired, "slug cannot be removed").
+			WithField("status", httpserver.FieldCodeRequired, "status cannot be removed"))
+		return
+	}
+
+	status := model.ClientStatus(doc.Status)
+	h.executeUpdateClient(w, r, &usecases.UpdateClientRequest{
+		ClientID:        id,
+		Name:            &doc.Name,
+		Slug:            &doc.Slug,
+		Status:          &status,
+		ExpectedVersion: expectedVersion,
+	})
+}
+
+// PatchLocationHandler handles PATCH /api/v1/locations/{id} (JSON Merge Patch)
+// Requires If-Match with the location's current ETag
+func (h *Handlers) PatchLocationHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUID(chi.URLParam(r, "id"))
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "location_id", "invalid location ID")
+		return
+	}
+
+	patch, ok := httpserver.ReadConditionalMergePatch(w, r)
+	if !ok {
+		return
+	}
+
+	current, err := h.getLocation.Execute(r.Context(), &usecases.GetLocationRequest{LocationID: id})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to get location")
+		return
+	}
+
+	var doc locationPatchDocument
+	expectedVersion, ok := patch.Apply(w, r, locationPatchDocument{
+		Name:          current.Location.Name(),
+		Address:       current.Location.Address(),
+		Phone:         current.Location.Phone(),
+		BusinessHours: current.Location.BusinessHours(),
+		Categories:    current.Location.Categories(),
+		IsActive:      current.Location.IsActive(),
+	}, current.Location.Version(), &doc)
+	if !ok {
+		return
+	}
+
+	h.executeUpdateLocation(w, r, &usecases.UpdateLocationRequest{
+		LocationID:      id,
+		Name:            &doc.Name,
+		Address:         &doc.Address,
+		Phone:           &doc.Phone,
+		BusinessHours:   &doc.BusinessHours,
+		Categories:      &doc.Categories,
+		IsActive:        &doc.IsActive,
+		ExpectedVersion: expectedVersion,
+	})
+}
+
+// executeUpdateTenant runs a tenant update for PUT and PATCH and writes the response
+func (h *Handlers) executeUpdateTenant(w http.ResponseWriter, r *http.Request, updateReq *usecases.UpdateTenantRequest) {
+	resp, err := h.updateTenant.Execute(r.Context(), updateReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			h.writeTenantConflict(w, r, updateReq.TenantID)
+			return
+		}
+		h.writeError(w, r, err, "Failed to update tenant")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Tenant.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildTenantResponse(resp.Tenant))
+}
+
+// executeUpdateClient runs a client update for PUT and PATCH and writes the response
+func (h *Handlers) executeUpdateClient(w http.ResponseWriter, r *http.Request, updateReq *usecases.UpdateClientRequest) {
+	resp, err := h.updateClient.Execute(r.Context(), updateReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			h.writeClientConflict(w, r, updateReq.ClientID)
+			return
+		}
+		h.writeError(w, r, err, "Failed to update client")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Client.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildClientResponse(resp.Client))
+}
+
+// executeUpdateLocation runs a location update for PUT and PATCH and writes the response
+func (h *Handlers) executeUpdateLocation(w http.ResponseWriter, r *http.Request, updateReq *usecases.UpdateLocationRequest) {
+	resp, err := h.updateLocation.Execute(r.Context(), updateReq)
+	if err != nil {
+		if err == domain.ErrVersionConflict {
+			h.writeLocationConflict(w, r, updateReq.LocationID)
+			return
+		}
+		h.writeError(w, r, err, "Failed to update location")
+		return
+	}
+
+	httpserver.SetETag(w, resp.Location.Version())
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildLocationResponse(resp.Location))
+}
+
+// writeTenantConflict writes 412 with the tenant's current representation
+func (h *Handlers) writeTenantConflict(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	current, err := h.getTenant.Execute(r.Context(), &usecases.GetTenantRequest{TenantID: id})
+	if err != nil {
+		httpserver.WriteProblem(w, r, problems.Problem(domain.ErrTenantNotFound))
+		return
+	}
+	httpserver.WritePreconditionFailed(w, r, current.Tenant.Version(), buildTenantResponse(current.Tenant))
+}
+
+// writeClientConflict writes 412 with the client's current representation
+func (h *Handlers) writeClientConflict(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	current, err := h.getClient.Execute(r.Context(), &usecases.GetClientRequest{ClientID: id})
+	if err != nil {
+		httpserver.WriteProblem(w, r, problems.Problem(domain.ErrClientNotFound))
+		return
+	}
+	httpserver.WritePreconditionFailed(w, r, current.Client.Version(), buildClientResponse(current.Client))
+}
+
+// writeLocationConflict writes 412 with the location's current representation
+func (h *Handlers) writeLocationConflict(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
+	current, err := h.getLocation.Execute(r.Context(), &usecases.GetLocationRequest{LocationID: id})
+	if err != nil {
+		httpserver.WriteProblem(w, r, problems.Problem(domain.ErrLocationNotFound))
+		return
+	}
+	httpserver.WritePreconditionFailed(w, r, current.Location.Version(), buildLocationResponse(current.Location))
+}
@@ -0,0 +1,238 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"farohq-core-app/internal/domains/tenants/app/usecases"
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/domains/tenants/domain/model"
+	"farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+)
+
+// memoryTenantRepository is an in-memory outbound.TenantRepository that compares versions on update like the postgres repository
+type memoryTenantRepository struct {
+	outbound.TenantRepository
+	tenants map[uuid.UUID]model.Tenant
+}
+
+func (r *memoryTenantRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Tenant, error) {
+	tenant, ok := r.tenants[id]
+	if !ok {
+		return nil, domain.ErrTenantNotFound
+	}
+	return &tenant, nil
+}
+
+func (r *memoryTenantRepository) FindBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
+	for _, tenant := range r.tenants {
+		if tenant.Slug() == slug {
+			return &tenant, nil
+		}
+	}
+	return nil, domain.ErrTenantNotFound
+}
+
+func (r *memoryTenantRepository) Update(ctx context.Context, tenant *model.Tenant) error {
+	stored := r.tenants[tenant.ID()]
+	if stored.Version() != tenant.Version() {
+		return domain.ErrVersionConflict
+	}
+	tenant.SetVersion(tenant.Version() + 1)
+	r.tenants[tenant.ID()] = *tenant
+	return nil
+}
+
+// memoryClientRepository is an in-memory outbound.ClientRepository that compares versions on save
+type memoryClientRepository struct {
+	outbound.ClientRepository
+	clients map[uuid.UUID]model.Client
+}
+
+func (r *memoryClientRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Client, error) {
+	client, ok := r.clients[id]
+	if !ok {
+		return nil, domain.ErrClientNotFound
+	}
+	return &client, nil
+}
+
+func (r *memoryClientRepository) Save(ctx context.Context, client *model.Client) error {
+	stored := r.clients[client.ID()]
+	if stored.Version() != client.Version() {
+		return domain.ErrVersionConflict
+	}
+	client.SetVersion(client.Version() + 1)
+	r.clients[client.ID()] = *client
+	return nil
+}
+
+// memoryLocationRepository is an in-memory outbound.LocationRepository that compares versions on save
+type memoryLocationRepository struct {
+	outbound.LocationRepository
+	locations map[uuid.UUID]model.Location
+}
+
+func (r *memoryLocationRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.Location, error) {
+	location, ok := r.locations[id]
+	if !ok {
+		return nil, domain.ErrLocationNotFound
+	}
+	return &location, nil
+}
+
+func (r *memoryLocationRepository) Save(ctx context.Context, location *model.Location) error {
+	stored := r.locations[location.ID()]
+	if stored.Version() != location.Version() {
+		return domain.ErrVersionConflict
+	}
+	location.SetVersion(location.Version() + 1)
+	r.locations[location.ID()] = *location
+	return nil
+}
+
+// patchFixture routes PATCH requests to handlers backed by one tenant, client and location at version 1
+type patchFixture struct {
+	router   chi.Router
+	tenant   *model.Tenant
+	client   *model.Client
+	location *model.Location
+}
+
+func newPatchFixture() *patchFixture {
+	tier := model.TierGrowth
+	tenant := model.NewTenant("Acme", "acme", &tier, 5, nil)
+	client := model.NewClient(tenant.ID(), "Bistro", "bistro", model.TierGrowth)
+	location := model.NewLocation(client.ID(), "Downtown")
+
+	tenantRepo := &memoryTenantRepository{tenants: map[uuid.UUID]model.Tenant{tenant.ID(): *tenant}}
+	clientRepo := &memoryClientRepository{clients: map[uuid.UUID]model.Client{client.ID(): *client}}
+	locationRepo := &memoryLocationRepository{locations: map[uuid.UUID]model.Location{location.ID(): *location}}
+
+	h := &Handlers{
+		logger:         zerolog.Nop(),
+		getTenant:      usecases.NewGetTenant(tenantRepo),
+		updateTenant:   usecases.NewUpdateTenant(tenantRepo),
+		getClient:      usecases.NewGetClient(clientRepo),
+		updateClient:   usecases.NewUpdateClient(clientRepo),
+		getLocation:    usecases.NewGetLocation(locationRepo),
+		updateLocation: usecases.NewUpdateLocation(locationRepo),
+	}
+
+	router := chi.NewRouter()
+	router.Patch("/tenants/{id}", h.PatchTenantHandler)
+	router.Patch("/clients/{id}", h.PatchClientHandler)
+	router.Patch("/locations/{id}", h.PatchLocationHandler)
+
+	return &patchFixture{router: router, tenant: tenant, client: client, location: location}
+}
+
+func (f *patchFixture) patch(path, ifMatch, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	f.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeBody(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+func TestPatchHandlers_MergePatch(t *testing.T) {
+	f := newPatchFixture()
+
+	t.Run("tenant", func(t *testing.T) {
+		rec := f.patch("/tenants/"+f.tenant.ID().String(), `"1"`, `{"name":"Acme Agency"}`)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		body := decodeBody(t, rec)
+		assert.Equal(t, "Acme Agency", body["name"])
+		assert.Equal(t, "acme", body["slug"], "members absent from the patch are kept")
+		assert.Equal(t, "active", body["status"])
+	})
+
+	t.Run("client", func(t *testing.T) {
+		rec := f.patch("/clients/"+f.client.ID().String(), `"1"`, `{"slug":"bistro-north"}`)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		body := decodeBody(t, rec)
+		assert.Equal(t, "bistro-north", body["slug"])
+		assert.Equal(t, "Bistro", body["name"])
+	})
+
+	t.Run("location", func(t *testing.T) {
+		rec := f.patch("/locations/"+f.location.ID().String(), `"1"`, `{"phone":"+1 555 0100","address":{"city":"Springfield"}}`)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		body := decodeBody(t, rec)
+		assert.Equal(t, "+1 555 0100", body["phone"])
+		assert.Equal(t, map[string]interface{}{"city": "Springfield"}, body["address"])
+		assert.Equal(t, "Downtown", body["name"])
+		assert.Equal(t, true, body["is_active"])
+	})
+
+	t.Run("removing a required member is rejected", func(t *testing.T) {
+		rec := f.patch("/clients/"+f.client.ID().String(), `"2"`, `{"slug":null}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPatchHandlers_RequireIfMatch(t *testing.T) {
+	f := newPatchFixture()
+
+	for _, path := range []string{
+		"/tenants/" + f.tenant.ID().String(),
+		"/clients/" + f.client.ID().String(),
+		"/locations/" + f.location.ID().String(),
+	} {
+		rec := f.patch(path, "", `{"name":"Renamed"}`)
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code, path)
+	}
+
+	// Nothing was written, so version 1 is still current
+	rec := f.patch("/tenants/"+f.tenant.ID().String(), `"1"`, `{}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Acme", decodeBody(t, rec)["name"])
+}
+
+func TestPatchHandlers_StaleVersionReturnsCurrent(t *testing.T) {
+	f := newPatchFixture()
+
+	for _, tc := range []struct {
+		path  string
+		patch string
+	}{
+		{"/tenants/" + f.tenant.ID().String(), `{"name":"Acme Agency"}`},
+		{"/clients/" + f.client.ID().String(), `{"name":"Bistro & Bar"}`},
+		{"/locations/" + f.location.ID().String(), `{"name":"Uptown"}`},
+	} {
+		require.Equal(t, http.StatusOK, f.patch(tc.path, `"1"`, tc.patch).Code, tc.path)
+
+		rec := f.patch(tc.path, `"1"`, `{"name":"Stale"}`)
+		require.Equal(t, http.StatusPreconditionFailed, rec.Code, tc.path)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"), tc.path)
+
+		current, ok := decodeBody(t, rec)["current"].(map[string]interface{})
+		require.True(t, ok, "412 carries the current representation")
+		var expected map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(tc.patch), &expected))
+		assert.Equal(t, expected["name"], current["name"], tc.path)
+		assert.Equal(t, float64(2), current["version"], tc.path)
+	}
+}
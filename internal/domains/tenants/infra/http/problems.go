@@ -0,0 +1,57 @@
+package http
+
+import (
+	"net/http"
+
+	"farohq-core-app/internal/domains/tenants/domain"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// problems maps tenants domain errors to problem responses
+var problems = httpserver.NewErrorMapper(
+	httpserver.ErrorMapping{Err: domain.ErrTenantNotFound, Status: http.StatusNotFound, Code: "tenant_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrClientNotFound, Status: http.StatusNotFound, Code: "client_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrLocationNotFound, Status: http.StatusNotFound, Code: "location_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrMemberNotFound, Status: http.StatusNotFound, Code: "member_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrClientMemberNotFound, Status: http.StatusNotFound, Code: "client_member_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrInviteNotFound, Status: http.StatusNotFound, Code: "invite_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrExportNotFound, Status: http.StatusNotFound, Code: "export_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrTenantAlreadyExists, Status: http.StatusConflict, Code: "tenant_already_exists"},
+	httpserver.ErrorMapping{Err: domain.ErrClientAlreadyExists, Status: http.StatusConflict, Code: "client_already_exists"},
+	httpserver.ErrorMapping{Err: domain.ErrMemberAlreadyExists, Status: http.StatusConflict, Code: "member_already_exists"},
+	httpserver.ErrorMapping{Err: domain.ErrPendingInviteExists, Status: http.StatusConflict, Code: "pending_invite_exists"},
+	httpserver.ErrorMapping{Err: domain.ErrInviteAlreadyAccepted, Status: http.StatusConflict, Code: "invite_already_accepted"},
+	httpserver.ErrorMapping{Err: domain.ErrLastOwner, Status: http.StatusConflict, Code: "last_owner"},
+	httpserver.ErrorMapping{Err: domain.ErrExportInProgress, Status: http.StatusConflict, Code: "export_in_progress"},
+	httpserver.ErrorMapping{Err: domain.ErrClosureAlreadyRequested, Status: http.StatusConflict, Code: "closure_already_requested"},
+	httpserver.ErrorMapping{Err: domain.ErrTenantNotClosing, Status: http.StatusConflict, Code: "tenant_not_closing"},
+	httpserver.ErrorMapping{Err: domain.ErrTenantNotSuspended, Status: http.StatusConflict, Code: "tenant_not_suspended"},
+	httpserver.ErrorMapping{Err: domain.ErrClientNotSuspended, Status: http.StatusConflict, Code: "client_not_suspended"},
+	httpserver.ErrorMapping{Err: domain.ErrVersionConflict, Status: http.StatusPreconditionFailed, Code: httpserver.PreconditionCodeFailed},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidTenantName, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "name"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidTenantSlug, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "slug"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidRole, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "role"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidEmail, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "email"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidSuspensionReason, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "reason"},
+	httpserver.ErrorMapping{Err: domain.ErrClosureConfirmationMismatch, Status: http.StatusBadRequest, Code: httpserver.ProblemCodeValidation, Field: "confirm_slug"},
+	httpserver.ErrorMapping{Err: domain.ErrInviteExpired, Status: http.StatusBadRequest, Code: "invite_expired"},
+	httpserver.ErrorMapping{Err: domain.ErrInviteRevoked, Status: http.StatusBadRequest, Code: "invite_revoked"},
+	httpserver.ErrorMapping{Err: domain.ErrAgencySeatLimitExceeded, Status: http.StatusBadRequest, Code: "agency_seat_limit_exceeded"},
+	httpserver.ErrorMapping{Err: domain.ErrClientSeatLimitExceeded, Status: http.StatusBadRequest, Code: "client_seat_limit_exceeded"},
+	httpserver.ErrorMapping{Err: domain.ErrUnauthorized, Status: http.StatusForbidden, Code: httpserver.ProblemCodeForbidden},
+	httpserver.ErrorMapping{Err: domain.ErrSuspensionStatusReadOnly, Status: http.StatusForbidden, Code: "suspension_status_read_only"},
+	httpserver.ErrorMapping{Err: domain.ErrTenantSuspended, Status: http.StatusForbidden, Code: httpserver.SuspensionCodeTenantSuspended},
+	httpserver.ErrorMapping{Err: domain.ErrClientSuspended, Status: http.StatusForbidden, Code: httpserver.SuspensionCodeClientSuspended},
+	httpserver.ErrorMapping{Err: domain.ErrTenantClosing, Status: http.StatusForbidden, Code: httpserver.SuspensionCodeTenantClosing},
+)
+
+// writeError writes the problem mapped from a domain error
+// Unmapped errors are logged with msg and reported as a 500 without exposing the cause.
+func (h *Handlers) writeError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	if problem := problems.Problem(err); problem != nil {
+		httpserver.WriteProblem(w, r, problem)
+		return
+	}
+	h.logger.Error().Err(err).Msg(msg)
+	httpserver.WriteInternalError(w, r)
+}
@@ -26,6 +26,7 @@ func (h *Handlers) RegisterRoutes(r chi.Router) {
 		r.Get("/validate-slug", h.ValidateSlugHandler)
 		r.Get("/{id}", h.GetTenantHandler)
 		r.Put("/{id}", h.UpdateTenantHandler)
+		r.Patch("/{id}", h.PatchTenantHandler)
 		r.Post("/{id}/invites", h.InviteMemberHandler)
 		r.Get("/{id}/invites", h.ListInvitesHandler)
 		r.Delete("/{id}/invites/{invite_id}", h.RevokeInviteHandler)
@@ -33,6 +34,12 @@ func (h *Handlers) RegisterRoutes(r chi.Router) {
 		r.Delete("/{id}/members/{user_id}", h.RemoveMemberHandler)
 		r.Get("/{id}/roles", h.ListRolesHandler)
 		r.Get("/{id}/seat-usage", h.GetSeatUsageHandler)
+		r.Post("/{id}/exports", h.StartTenantExportHandler)
+		r.Get("/{id}/exports", h.ListTenantExportsHandler)
+		r.Get("/{id}/exports/{export_id}", h.GetTenantExportHandler)
+		r.Post("/{id}/closure", h.RequestTenantClosureHandler)
+		r.Get("/{id}/closure", h.GetTenantClosureHandler)
+		r.Delete("/{id}/closure", h.CancelTenantClosureHandler)
 		// Client routes
 		r.Post("/{id}/clients", h.CreateClientHandler)
 		r.Get("/{id}/clients", h.ListClientsHandler)
@@ -42,6 +49,7 @@ func (h *Handlers) RegisterRoutes(r chi.Router) {
 	r.Route("/clients", func(r chi.Router) {
 		r.Get("/{id}", h.GetClientHandler)
 		r.Put("/{id}", h.UpdateClientHandler)
+		r.Patch("/{id}", h.PatchClientHandler)
 		r.Post("/{id}/members", h.AddClientMemberHandler)
 		r.Get("/{id}/members", h.ListClientMembersHandler)
 		r.Delete("/{id}/members/{memberId}", h.RemoveClientMemberHandler)
@@ -51,6 +59,20 @@ func (h *Handlers) RegisterRoutes(r chi.Router) {
 
 	// Locations routes
 	r.Route("/locations", func(r chi.Router) {
+		r.Get("/{id}", h.GetLocationHandler)
 		r.Put("/{id}", h.UpdateLocationHandler)
+		r.Patch("/{id}", h.PatchLocationHandler)
+	})
+}
+
+// RegisterAdminRoutes registers platform admin routes (suspension management)
+// Must be mounted behind RequireAuth and RequirePlatformAdmin
+func (h *Handlers) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/admin", func(r chi.Router) {
+		r.Post("/tenants/{id}/suspend", h.SuspendTenantHandler)
+		r.Post("/tenants/{id}/reinstate", h.ReinstateTenantHandler)
+		r.Get("/tenants/{id}/suspension-events", h.ListSuspensionEventsHandler)
+		r.Post("/clients/{id}/suspend", h.SuspendClientHandler)
+		r.Post("/clients/{id}/reinstate", h.ReinstateClientHandler)
 	})
 }
@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DeleteUserUseCase implements the DeleteUser use case
+type DeleteUserUseCase struct {
+	userRepo          outbound.UserRepository
+	membershipRemover outbound.TenantMembershipRemover
+}
+
+// NewDeleteUser creates a new delete user use case
+func NewDeleteUser(userRepo outbound.UserRepository, membershipRemover outbound.TenantMembershipRemover) inbound.DeleteUser {
+	return &DeleteUserUseCase{
+		userRepo:          userRepo,
+		membershipRemover: membershipRemover,
+	}
+}
+
+// Execute removes the user from every tenant and then anonymizes their personal data
+// Memberships are removed first so a last-owner rejection leaves the user untouched. Safe to repeat.
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, req *inbound.DeleteUserRequest) (*inbound.DeleteUserResponse, error) {
+	user, err := uc.userRepo.FindByClerkUserID(ctx, req.ClerkUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	removal, err := uc.membershipRemover.RemoveUserFromTenants(ctx, user.ID(), req.KeepLastOwnerships)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsDeleted() {
+		user.Anonymize()
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info().
+		Str("user_id", user.ID().String()).
+		Int("removed_tenants", len(removal.RemovedTenantIDs)).
+		Int("retained_tenants", len(removal.RetainedTenantIDs)).
+		Int("revoked_invites", removal.RevokedInvites).
+		Msg("User deleted and anonymized")
+
+	return &inbound.DeleteUserResponse{
+		User:              user,
+		RemovedTenantIDs:  removal.RemovedTenantIDs,
+		RetainedTenantIDs: removal.RetainedTenantIDs,
+		RevokedInvites:    removal.RevokedInvites,
+	}, nil
+}
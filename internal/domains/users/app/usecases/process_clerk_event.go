@@ -0,0 +1,258 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"farohq-core-app/internal/domains/users/domain"
+	"farohq-core-app/internal/domains/users/domain/model"
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// clerkEvent is the Clerk webhook envelope
+type clerkEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// clerkUser is the data of user.* events (user.deleted only carries the ID)
+type clerkUser struct {
+	ID                    string `json:"id"`
+	FirstName             string `json:"first_name"`
+	LastName              string `json:"last_name"`
+	ImageURL              string `json:"image_url"`
+	PrimaryEmailAddressID string `json:"primary_email_address_id"`
+	EmailAddresses        []struct {
+		ID           string `json:"id"`
+		EmailAddress string `json:"email_address"`
+	} `json:"email_addresses"`
+	PhoneNumbers []struct {
+		PhoneNumber string `json:"phone_number"`
+	} `json:"phone_numbers"`
+	LastSignInAt *int64 `json:"last_sign_in_at"` // Unix milliseconds
+}
+
+// clerkMembership is the data of organizationMembership.* events
+type clerkMembership struct {
+	Role         string `json:"role"`
+	Organization struct {
+		ID             string                 `json:"id"`
+		PublicMetadata map[string]interface{} `json:"public_metadata"`
+	} `json:"organization"`
+	PublicUserData struct {
+		UserID     string `json:"user_id"`
+		Identifier string `json:"identifier"`
+		FirstName  string `json:"first_name"`
+		LastName   string `json:"last_name"`
+		ImageURL   string `json:"image_url"`
+	} `json:"public_user_data"`
+}
+
+// ProcessClerkEventUseCase implements the ProcessClerkEvent use case
+type ProcessClerkEventUseCase struct {
+	eventRepo      outbound.WebhookEventRepository
+	userRepo       outbound.UserRepository
+	syncUser       inbound.SyncUser
+	deleteUser     inbound.DeleteUser
+	membershipSync outbound.TenantMembershipSync
+}
+
+// NewProcessClerkEvent creates a new process Clerk event use case
+func NewProcessClerkEvent(
+	eventRepo outbound.WebhookEventRepository,
+	userRepo outbound.UserRepository,
+	syncUser inbound.SyncUser,
+	deleteUser inbound.DeleteUser,
+	membershipSync outbound.TenantMembershipSync,
+) inbound.ProcessClerkEvent {
+	return &ProcessClerkEventUseCase{
+		eventRepo:      eventRepo,
+		userRepo:       userRepo,
+		syncUser:       syncUser,
+		deleteUser:     deleteUser,
+		membershipSync: membershipSync,
+	}
+}
+
+// Execute applies the event and records the outcome on the stored event
+// Every handler is idempotent, so reprocessing a redelivered or retried event is safe.
+func (uc *ProcessClerkEventUseCase) Execute(ctx context.Context, req *inbound.ProcessClerkEventRequest) (*inbound.ProcessClerkEventResponse, error) {
+	event := req.Event
+	if !event.NeedsProcessing() {
+		return &inbound.ProcessClerkEventResponse{Event: event}, nil
+	}
+
+	ignoreReason, err := uc.apply(ctx, event)
+	switch {
+	case err != nil:
+		event.MarkFailed(err)
+	case ignoreReason != "":
+		event.MarkIgnored(ignoreReason)
+	default:
+		event.MarkProcessed()
+	}
+
+	if updateErr := uc.eventRepo.Update(ctx, event); updateErr != nil {
+		return nil, updateErr
+	}
+
+	log.Info().
+		Str("event_id", event.ID()).
+		Str("event_type", event.Type()).
+		Str("status", string(event.Status())).
+		Str("note", event.LastError()).
+		Msg("Processed Clerk event")
+
+	if err != nil {
+		return nil, err
+	}
+	return &inbound.ProcessClerkEventResponse{Event: event}, nil
+}
+
+// apply dispatches the event and returns a non-empty reason when there was nothing to apply
+func (uc *ProcessClerkEventUseCase) apply(ctx context.Context, event *model.WebhookEvent) (string, error) {
+	var envelope clerkEvent
+	if err := json.Unmarshal(event.Payload(), &envelope); err != nil {
+		return "invalid payload", nil
+	}
+
+	switch envelope.Type {
+	case "user.created", "user.updated":
+		var user clerkUser
+		if err := json.Unmarshal(envelope.Data, &user); err != nil || user.ID == "" {
+			return "invalid user payload", nil
+		}
+		return uc.syncClerkUser(ctx, &user)
+
+	case "user.deleted":
+		var user clerkUser
+		if err := json.Unmarshal(envelope.Data, &user); err != nil || user.ID == "" {
+			return "invalid user payload", nil
+		}
+		// The account is already gone in Clerk, so sole ownerships are kept rather than blocking the deletion
+		_, err := uc.deleteUser.Execute(ctx, &inbound.DeleteUserRequest{
+			ClerkUserID:        user.ID,
+			KeepLastOwnerships: true,
+		})
+		if err == domain.ErrUserNotFound {
+			return "user was never synced", nil
+		}
+		return "", err
+
+	case "organizationMembership.created", "organizationMembership.updated", "organizationMembership.deleted":
+		var membership clerkMembership
+		if err := json.Unmarshal(envelope.Data, &membership); err != nil || membership.PublicUserData.UserID == "" {
+			return "invalid membership payload", nil
+		}
+		return uc.syncMembership(ctx, &membership, envelope.Type == "organizationMembership.deleted")
+	}
+
+	return "unsupported event type", nil
+}
+
+// syncClerkUser creates or updates the local user from a user.created/updated event
+func (uc *ProcessClerkEventUseCase) syncClerkUser(ctx context.Context, user *clerkUser) (string, error) {
+	phoneNumbers := make([]string, 0, len(user.PhoneNumbers))
+	for _, phone := range user.PhoneNumbers {
+		phoneNumbers = append(phoneNumbers, phone.PhoneNumber)
+	}
+
+	var lastSignInAt *int64
+	if user.LastSignInAt != nil {
+		seconds := *user.LastSignInAt / 1000
+		lastSignInAt = &seconds
+	}
+
+	_, err := uc.syncUser.Execute(ctx, &inbound.SyncUserRequest{
+		ClerkUserID:  user.ID,
+		Email:        user.primaryEmail(),
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		FullName:     strings.TrimSpace(user.FirstName + " " + user.LastName),
+		ImageURL:     user.ImageURL,
+		PhoneNumbers: phoneNumbers,
+		LastSignInAt: lastSignInAt,
+	})
+	if err == domain.ErrUserDeleted {
+		return "user has been deleted", nil
+	}
+	return "", err
+}
+
+// syncMembership applies an organization membership change to the matching tenant
+func (uc *ProcessClerkEventUseCase) syncMembership(ctx context.Context, membership *clerkMembership, removed bool) (string, error) {
+	user, err := uc.userRepo.FindByClerkUserID(ctx, membership.PublicUserData.UserID)
+	if err != nil {
+		if err != domain.ErrUserNotFound {
+			return "", err
+		}
+		if removed {
+			return "user was never synced", nil
+		}
+
+		// Membership events can arrive before user.created; create the user from the public user data
+		resp, err := uc.syncUser.Execute(ctx, &inbound.SyncUserRequest{
+			ClerkUserID: membership.PublicUserData.UserID,
+			Email:       membership.PublicUserData.Identifier,
+			FirstName:   membership.PublicUserData.FirstName,
+			LastName:    membership.PublicUserData.LastName,
+			FullName:    strings.TrimSpace(membership.PublicUserData.FirstName + " " + membership.PublicUserData.LastName),
+			ImageURL:    membership.PublicUserData.ImageURL,
+		})
+		if err != nil {
+			return "", fmt.Errorf("sync membership user: %w", err)
+		}
+		user = resp.User
+	}
+	if user.IsDeleted() {
+		return "user has been deleted", nil
+	}
+
+	orgMembership := outbound.OrganizationMembership{
+		TenantID: membership.linkedTenantID(),
+		UserID:   user.ID(),
+		Role:     membership.Role,
+	}
+
+	if removed {
+		err = uc.membershipSync.RemoveMembership(ctx, orgMembership)
+	} else {
+		err = uc.membershipSync.SyncMembership(ctx, orgMembership)
+	}
+	if err == domain.ErrTenantNotLinked {
+		return "organization is not linked to a tenant", nil
+	}
+	return "", err
+}
+
+// primaryEmail returns the primary email address, falling back to the first one
+func (u *clerkUser) primaryEmail() string {
+	for _, email := range u.EmailAddresses {
+		if email.ID == u.PrimaryEmailAddressID {
+			return email.EmailAddress
+		}
+	}
+	if len(u.EmailAddresses) > 0 {
+		return u.EmailAddresses[0].EmailAddress
+	}
+	return ""
+}
+
+// linkedTenantID returns the tenant ID stored in the organization's public metadata ("tenant_id"), if any
+func (m *clerkMembership) linkedTenantID() *uuid.UUID {
+	value, ok := m.Organization.PublicMetadata["tenant_id"].(string)
+	if !ok {
+		return nil
+	}
+	tenantID, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &tenantID
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+
+	"farohq-core-app/internal/domains/users/domain"
+	"farohq-core-app/internal/domains/users/domain/model"
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+
+	"github.com/rs/zerolog/log"
+)
+
+// clerkWebhookSource identifies Clerk events in the webhook event store
+const clerkWebhookSource = "clerk"
+
+// ReceiveClerkWebhookUseCase implements the ReceiveClerkWebhook use case
+type ReceiveClerkWebhookUseCase struct {
+	eventRepo    outbound.WebhookEventRepository
+	processEvent inbound.ProcessClerkEvent
+}
+
+// NewReceiveClerkWebhook creates a new receive Clerk webhook use case
+func NewReceiveClerkWebhook(eventRepo outbound.WebhookEventRepository, processEvent inbound.ProcessClerkEvent) inbound.ReceiveClerkWebhook {
+	return &ReceiveClerkWebhookUseCase{
+		eventRepo:    eventRepo,
+		processEvent: processEvent,
+	}
+}
+
+// Execute stores the event (deduplicated by event ID) and processes it in the background
+// Redeliveries of an event that previously failed are processed again.
+func (uc *ReceiveClerkWebhookUseCase) Execute(ctx context.Context, req *inbound.ReceiveClerkWebhookRequest) (*inbound.ReceiveClerkWebhookResponse, error) {
+	var envelope clerkEvent
+	if err := json.Unmarshal(req.Payload, &envelope); err != nil || envelope.Type == "" || req.EventID == "" {
+		return nil, domain.ErrInvalidWebhookPayload
+	}
+
+	event := model.NewWebhookEvent(req.EventID, clerkWebhookSource, envelope.Type, req.Payload)
+	created, err := uc.eventRepo.Create(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if !created {
+		existing, err := uc.eventRepo.FindByID(ctx, req.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if existing.Status() != model.WebhookEventFailed {
+			return &inbound.ReceiveClerkWebhookResponse{
+				Event:     existing,
+				Duplicate: true,
+			}, nil
+		}
+		event = existing
+	}
+
+	// Process asynchronously so Clerk gets a fast acknowledgement; failures are retried by the background job.
+	// The job reloads the event so it never shares the returned entity with the caller.
+	eventID := event.ID()
+	go func() {
+		jobCtx := context.Background()
+		stored, err := uc.eventRepo.FindByID(jobCtx, eventID)
+		if err != nil {
+			log.Error().Err(err).Str("event_id", eventID).Msg("Failed to load Clerk event for processing")
+			return
+		}
+		if _, err := uc.processEvent.Execute(jobCtx, &inbound.ProcessClerkEventRequest{Event: stored}); err != nil {
+			log.Error().Err(err).Str("event_id", eventID).Str("event_type", stored.Type()).Msg("Failed to process Clerk event")
+		}
+	}()
+
+	return &inbound.ReceiveClerkWebhookResponse{
+		Event:     event,
+		Duplicate: !created,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+)
+
+// RetryClerkEventsUseCase implements the RetryClerkEvents use case
+type RetryClerkEventsUseCase struct {
+	eventRepo    outbound.WebhookEventRepository
+	processEvent inbound.ProcessClerkEvent
+}
+
+// NewRetryClerkEvents creates a new retry Clerk events use case
+func NewRetryClerkEvents(eventRepo outbound.WebhookEventRepository, processEvent inbound.ProcessClerkEvent) inbound.RetryClerkEvents {
+	return &RetryClerkEventsUseCase{
+		eventRepo:    eventRepo,
+		processEvent: processEvent,
+	}
+}
+
+// Execute reprocesses events that failed or were never processed (e.g. the instance stopped mid-flight)
+func (uc *RetryClerkEventsUseCase) Execute(ctx context.Context, req *inbound.RetryClerkEventsRequest) (*inbound.RetryClerkEventsResponse, error) {
+	events, err := uc.eventRepo.ListRetryable(ctx, req.Before, req.MaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &inbound.RetryClerkEventsResponse{}
+	for _, event := range events {
+		resp.Retried++
+		if _, err := uc.processEvent.Execute(ctx, &inbound.ProcessClerkEventRequest{Event: event}); err != nil {
+			resp.Failed++
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/users/domain"
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+)
+
+// SwitchTenantUseCase implements the SwitchTenant use case
+type SwitchTenantUseCase struct {
+	userRepo        outbound.UserRepository
+	preferencesRepo outbound.TenantPreferencesRepository
+	access          outbound.TenantAccessChecker
+}
+
+// NewSwitchTenant creates a new switch tenant use case
+func NewSwitchTenant(userRepo outbound.UserRepository, preferencesRepo outbound.TenantPreferencesRepository, access outbound.TenantAccessChecker) inbound.SwitchTenant {
+	return &SwitchTenantUseCase{
+		userRepo:        userRepo,
+		preferencesRepo: preferencesRepo,
+		access:          access,
+	}
+}
+
+// Execute records the tenant as last used (and optionally default) after checking the user belongs to it
+func (uc *SwitchTenantUseCase) Execute(ctx context.Context, req *inbound.SwitchTenantRequest) (*inbound.SwitchTenantResponse, error) {
+	user, err := uc.userRepo.FindByClerkUserID(ctx, req.ClerkUserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDeleted() {
+		return nil, domain.ErrUserDeleted
+	}
+
+	isMember, err := uc.access.IsMember(ctx, req.TenantID, user.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, domain.ErrNotTenantMember
+	}
+
+	preferences, err := uc.preferencesRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	preferences.RecordSwitch(req.TenantID, req.MakeDefault)
+	if err := uc.preferencesRepo.Save(ctx, preferences); err != nil {
+		return nil, err
+	}
+
+	return &inbound.SwitchTenantResponse{
+		Preferences: preferences,
+	}, nil
+}
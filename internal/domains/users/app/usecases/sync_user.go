@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"farohq-core-app/internal/domains/users/domain"
 	"farohq-core-app/internal/domains/users/domain/model"
 	"farohq-core-app/internal/domains/users/domain/ports/inbound"
 	"farohq-core-app/internal/domains/users/domain/ports/outbound"
@@ -66,6 +67,11 @@ func (uc *SyncUserUseCase) Execute(ctx context.Context, req *inbound.SyncUserReq
 		}, nil
 	}
 
+	// Deleted users are not resurrected with fresh personal data
+	if existingUser.IsDeleted() {
+		return nil, domain.ErrUserDeleted
+	}
+
 	// User exists, update it
 	existingUser.UpdateFromClerk(
 		req.Email,
@@ -4,4 +4,22 @@ import "errors"
 
 var (
 	ErrUserNotFound = errors.New("user not found")
+
+	// ErrUserDeleted is returned when syncing a user that has been deleted and anonymized
+	ErrUserDeleted = errors.New("user has been deleted")
+
+	// ErrLastOwner is returned when a deletion or membership change would leave a tenant without an owner
+	ErrLastOwner = errors.New("user is the last owner of a tenant; transfer ownership first")
+
+	// ErrNotTenantMember is returned when switching to a tenant the user does not belong to
+	ErrNotTenantMember = errors.New("user is not a member of this tenant")
+
+	// ErrWebhookEventNotFound is returned when a webhook event is not found
+	ErrWebhookEventNotFound = errors.New("webhook event not found")
+
+	// ErrTenantNotLinked is returned when an organization event does not match any tenant
+	ErrTenantNotLinked = errors.New("organization is not linked to a tenant")
+
+	// ErrInvalidWebhookPayload is returned when a webhook body cannot be parsed
+	ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
 )
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenantPreferences records which tenant a user lands in when a request does not identify one
+type TenantPreferences struct {
+	userID           uuid.UUID
+	defaultTenantID  *uuid.UUID
+	lastUsedTenantID *uuid.UUID
+	updatedAt        time.Time
+}
+
+// NewTenantPreferences creates empty tenant preferences for a user
+func NewTenantPreferences(userID uuid.UUID) *TenantPreferences {
+	return &TenantPreferences{
+		userID:    userID,
+		updatedAt: time.Now(),
+	}
+}
+
+// NewTenantPreferencesWithID creates tenant preferences with all fields (used for reconstruction from database)
+func NewTenantPreferencesWithID(userID uuid.UUID, defaultTenantID, lastUsedTenantID *uuid.UUID, updatedAt time.Time) *TenantPreferences {
+	return &TenantPreferences{
+		userID:           userID,
+		defaultTenantID:  defaultTenantID,
+		lastUsedTenantID: lastUsedTenantID,
+		updatedAt:        updatedAt,
+	}
+}
+
+// UserID returns the user ID
+func (p *TenantPreferences) UserID() uuid.UUID {
+	return p.userID
+}
+
+// DefaultTenantID returns the tenant the user chose as default, if any
+func (p *TenantPreferences) DefaultTenantID() *uuid.UUID {
+	return p.defaultTenantID
+}
+
+// LastUsedTenantID returns the tenant the user last switched to, if any
+func (p *TenantPreferences) LastUsedTenantID() *uuid.UUID {
+	return p.lastUsedTenantID
+}
+
+// UpdatedAt returns the last update timestamp
+func (p *TenantPreferences) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// RecordSwitch records a switch to tenantID, optionally making it the default
+func (p *TenantPreferences) RecordSwitch(tenantID uuid.UUID, makeDefault bool) {
+	p.lastUsedTenantID = &tenantID
+	if makeDefault {
+		p.defaultTenantID = &tenantID
+	}
+	p.updatedAt = time.Now()
+}
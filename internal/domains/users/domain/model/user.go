@@ -19,6 +19,7 @@ type User struct {
 	createdAt     time.Time
 	updatedAt     time.Time
 	lastSignInAt  *time.Time
+	deletedAt     *time.Time
 }
 
 // NewUser creates a new user entity
@@ -43,7 +44,7 @@ func NewUser(clerkUserID, email, firstName, lastName, fullName, imageURL string,
 }
 
 // NewUserWithID creates a user entity with a specific ID (used for reconstruction from database)
-func NewUserWithID(id uuid.UUID, clerkUserID, email, firstName, lastName, fullName, imageURL string, phoneNumbers []string, createdAt, updatedAt time.Time, lastSignInAt *time.Time) *User {
+func NewUserWithID(id uuid.UUID, clerkUserID, email, firstName, lastName, fullName, imageURL string, phoneNumbers []string, createdAt, updatedAt time.Time, lastSignInAt, deletedAt *time.Time) *User {
 	if phoneNumbers == nil {
 		phoneNumbers = []string{}
 	}
@@ -59,6 +60,7 @@ func NewUserWithID(id uuid.UUID, clerkUserID, email, firstName, lastName, fullNa
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
 		lastSignInAt: lastSignInAt,
+		deletedAt:    deletedAt,
 	}
 }
 
@@ -117,6 +119,31 @@ func (u *User) LastSignInAt() *time.Time {
 	return u.lastSignInAt
 }
 
+// DeletedAt returns when the user was deleted and anonymized
+func (u *User) DeletedAt() *time.Time {
+	return u.deletedAt
+}
+
+// IsDeleted returns true if the user has been deleted and anonymized
+func (u *User) IsDeleted() bool {
+	return u.deletedAt != nil
+}
+
+// Anonymize erases the user's personal data and marks the user as deleted
+// The ID and Clerk user ID are kept so audit references and webhook redeliveries still resolve.
+func (u *User) Anonymize() {
+	now := time.Now()
+	u.email = ""
+	u.firstName = ""
+	u.lastName = ""
+	u.fullName = ""
+	u.imageURL = ""
+	u.phoneNumbers = []string{}
+	u.lastSignInAt = nil
+	u.deletedAt = &now
+	u.updatedAt = now
+}
+
 // UpdateFromClerk updates user data from Clerk
 func (u *User) UpdateFromClerk(email, firstName, lastName, fullName, imageURL string, phoneNumbers []string, lastSignInAt *time.Time) {
 	u.email = email
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"time"
+)
+
+// WebhookEventStatus represents the processing state of a received webhook event
+type WebhookEventStatus string
+
+const (
+	WebhookEventReceived  WebhookEventStatus = "received"
+	WebhookEventProcessed WebhookEventStatus = "processed"
+	WebhookEventIgnored   WebhookEventStatus = "ignored"
+	WebhookEventFailed    WebhookEventStatus = "failed"
+)
+
+// WebhookEvent represents an identity provider webhook delivery, keyed by the provider's event ID for deduplication
+type WebhookEvent struct {
+	id          string
+	source      string
+	eventType   string
+	payload     []byte
+	status      WebhookEventStatus
+	attempts    int
+	lastError   string
+	receivedAt  time.Time
+	processedAt *time.Time
+}
+
+// NewWebhookEvent creates a new received webhook event
+func NewWebhookEvent(id, source, eventType string, payload []byte) *WebhookEvent {
+	return &WebhookEvent{
+		id:         id,
+		source:     source,
+		eventType:  eventType,
+		payload:    payload,
+		status:     WebhookEventReceived,
+		receivedAt: time.Now(),
+	}
+}
+
+// NewWebhookEventWithID creates a webhook event entity with all fields (used for reconstruction from database)
+func NewWebhookEventWithID(id, source, eventType string, payload []byte, status WebhookEventStatus, attempts int, lastError string, receivedAt time.Time, processedAt *time.Time) *WebhookEvent {
+	return &WebhookEvent{
+		id:          id,
+		source:      source,
+		eventType:   eventType,
+		payload:     payload,
+		status:      status,
+		attempts:    attempts,
+		lastError:   lastError,
+		receivedAt:  receivedAt,
+		processedAt: processedAt,
+	}
+}
+
+// ID returns the provider's event ID
+func (e *WebhookEvent) ID() string {
+	return e.id
+}
+
+// Source returns the webhook source (e.g. "clerk")
+func (e *WebhookEvent) Source() string {
+	return e.source
+}
+
+// Type returns the event type (e.g. "user.created")
+func (e *WebhookEvent) Type() string {
+	return e.eventType
+}
+
+// Payload returns the raw event body
+func (e *WebhookEvent) Payload() []byte {
+	return e.payload
+}
+
+// Status returns the processing status
+func (e *WebhookEvent) Status() WebhookEventStatus {
+	return e.status
+}
+
+// Attempts returns how many times processing was attempted
+func (e *WebhookEvent) Attempts() int {
+	return e.attempts
+}
+
+// LastError returns the error from the last failed attempt, or why the event was ignored
+func (e *WebhookEvent) LastError() string {
+	return e.lastError
+}
+
+// ReceivedAt returns when the event was first received
+func (e *WebhookEvent) ReceivedAt() time.Time {
+	return e.receivedAt
+}
+
+// ProcessedAt returns when processing finished
+func (e *WebhookEvent) ProcessedAt() *time.Time {
+	return e.processedAt
+}
+
+// NeedsProcessing returns true if the event has not been handled successfully yet
+func (e *WebhookEvent) NeedsProcessing() bool {
+	return e.status == WebhookEventReceived || e.status == WebhookEventFailed
+}
+
+// MarkProcessed marks the event as handled
+func (e *WebhookEvent) MarkProcessed() {
+	e.finish(WebhookEventProcessed, "")
+}
+
+// MarkIgnored marks the event as intentionally skipped (unsupported type or nothing to apply)
+func (e *WebhookEvent) MarkIgnored(reason string) {
+	e.finish(WebhookEventIgnored, reason)
+}
+
+// MarkFailed records a failed processing attempt so the event can be retried
+func (e *WebhookEvent) MarkFailed(err error) {
+	e.attempts++
+	e.status = WebhookEventFailed
+	e.lastError = err.Error()
+}
+
+func (e *WebhookEvent) finish(status WebhookEventStatus, note string) {
+	now := time.Now()
+	e.attempts++
+	e.status = status
+	e.lastError = note
+	e.processedAt = &now
+}
@@ -0,0 +1,57 @@
+package inbound
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/users/domain/model"
+)
+
+// ReceiveClerkWebhookRequest represents a verified Clerk webhook delivery
+type ReceiveClerkWebhookRequest struct {
+	EventID string // svix-id header
+	Payload []byte
+}
+
+// ReceiveClerkWebhookResponse represents the response from receiving a Clerk webhook
+type ReceiveClerkWebhookResponse struct {
+	Event     *model.WebhookEvent
+	Duplicate bool
+}
+
+// ReceiveClerkWebhook defines the interface for storing a Clerk webhook and scheduling its processing
+type ReceiveClerkWebhook interface {
+	Execute(ctx context.Context, req *ReceiveClerkWebhookRequest) (*ReceiveClerkWebhookResponse, error)
+}
+
+// ProcessClerkEventRequest represents the request to apply a stored Clerk event
+type ProcessClerkEventRequest struct {
+	Event *model.WebhookEvent
+}
+
+// ProcessClerkEventResponse represents the response from processing a Clerk event
+type ProcessClerkEventResponse struct {
+	Event *model.WebhookEvent
+}
+
+// ProcessClerkEvent defines the interface for applying a Clerk event to users and tenant memberships
+type ProcessClerkEvent interface {
+	Execute(ctx context.Context, req *ProcessClerkEventRequest) (*ProcessClerkEventResponse, error)
+}
+
+// RetryClerkEventsRequest represents the request to retry unprocessed Clerk events
+type RetryClerkEventsRequest struct {
+	Before      time.Time
+	MaxAttempts int
+}
+
+// RetryClerkEventsResponse represents the response from retrying Clerk events
+type RetryClerkEventsResponse struct {
+	Retried int
+	Failed  int
+}
+
+// RetryClerkEvents defines the interface for reprocessing Clerk events that were not handled successfully
+type RetryClerkEvents interface {
+	Execute(ctx context.Context, req *RetryClerkEventsRequest) (*RetryClerkEventsResponse, error)
+}
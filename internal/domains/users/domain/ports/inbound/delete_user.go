@@ -0,0 +1,30 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/users/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// DeleteUserRequest represents the request to delete and anonymize a user
+type DeleteUserRequest struct {
+	ClerkUserID string
+	// KeepLastOwnerships skips the last-owner check by leaving the user as owner of tenants they solely own.
+	// Set when the identity provider has already deleted the account (user.deleted webhook).
+	KeepLastOwnerships bool
+}
+
+// DeleteUserResponse represents the response from deleting a user
+type DeleteUserResponse struct {
+	User              *model.User
+	RemovedTenantIDs  []uuid.UUID
+	RetainedTenantIDs []uuid.UUID
+	RevokedInvites    int
+}
+
+// DeleteUser defines the interface for deleting a user and anonymizing their personal data
+type DeleteUser interface {
+	Execute(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error)
+}
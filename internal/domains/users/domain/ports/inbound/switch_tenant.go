@@ -0,0 +1,26 @@
+package inbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/users/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// SwitchTenantRequest represents the request to switch the user's current organization
+type SwitchTenantRequest struct {
+	ClerkUserID string
+	TenantID    uuid.UUID
+	MakeDefault bool
+}
+
+// SwitchTenantResponse represents the response from switching organization
+type SwitchTenantResponse struct {
+	Preferences *model.TenantPreferences
+}
+
+// SwitchTenant defines the interface for recording an organization switch
+type SwitchTenant interface {
+	Execute(ctx context.Context, req *SwitchTenantRequest) (*SwitchTenantResponse, error)
+}
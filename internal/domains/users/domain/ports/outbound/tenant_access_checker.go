@@ -0,0 +1,12 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TenantAccessChecker defines the interface for checking tenant membership (implemented by the tenants domain)
+type TenantAccessChecker interface {
+	IsMember(ctx context.Context, tenantID, userID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,20 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TenantMembershipRemoval describes the memberships removed for a deleted user
+type TenantMembershipRemoval struct {
+	RemovedTenantIDs  []uuid.UUID
+	RetainedTenantIDs []uuid.UUID
+	RevokedInvites    int
+}
+
+// TenantMembershipRemover defines the interface for removing a user from every tenant (implemented by the tenants domain)
+type TenantMembershipRemover interface {
+	// RemoveUserFromTenants returns domain.ErrLastOwner when the user solely owns a tenant and keepLastOwnerships is false
+	RemoveUserFromTenants(ctx context.Context, userID uuid.UUID, keepLastOwnerships bool) (*TenantMembershipRemoval, error)
+}
@@ -0,0 +1,22 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationMembership describes an identity provider organization membership for a local user
+// TenantID is set when the organization's public metadata (written only by our backend) links it to a tenant.
+type OrganizationMembership struct {
+	TenantID *uuid.UUID
+	UserID   uuid.UUID
+	Role     string
+}
+
+// TenantMembershipSync defines the interface for applying organization membership changes to tenants (implemented by the tenants domain)
+type TenantMembershipSync interface {
+	// SyncMembership returns domain.ErrTenantNotLinked when no tenant matches the organization
+	SyncMembership(ctx context.Context, membership OrganizationMembership) error
+	RemoveMembership(ctx context.Context, membership OrganizationMembership) error
+}
@@ -0,0 +1,17 @@
+package outbound
+
+import (
+	"context"
+
+	"farohq-core-app/internal/domains/users/domain/model"
+
+	"github.com/google/uuid"
+)
+
+// TenantPreferencesRepository defines the interface for tenant preferences data access
+type TenantPreferencesRepository interface {
+	// FindByUserID returns empty preferences when the user has none yet
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*model.TenantPreferences, error)
+	// Save creates or replaces the user's preferences
+	Save(ctx context.Context, preferences *model.TenantPreferences) error
+}
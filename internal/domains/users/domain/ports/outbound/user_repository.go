@@ -4,11 +4,14 @@ import (
 	"context"
 
 	"farohq-core-app/internal/domains/users/domain/model"
+
+	"github.com/google/uuid"
 )
 
 // UserRepository defines the interface for user data access
 type UserRepository interface {
 	FindByClerkUserID(ctx context.Context, clerkUserID string) (*model.User, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*model.User, error)
 	Save(ctx context.Context, user *model.User) error
 	Update(ctx context.Context, user *model.User) error
 }
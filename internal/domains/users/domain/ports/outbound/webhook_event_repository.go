@@ -0,0 +1,18 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/users/domain/model"
+)
+
+// WebhookEventRepository defines the interface for webhook event data access
+type WebhookEventRepository interface {
+	// Create stores the event and returns false if an event with the same ID was already received
+	Create(ctx context.Context, event *model.WebhookEvent) (bool, error)
+	FindByID(ctx context.Context, id string) (*model.WebhookEvent, error)
+	Update(ctx context.Context, event *model.WebhookEvent) error
+	// ListRetryable lists received or failed events last touched before the cutoff with fewer than maxAttempts attempts
+	ListRetryable(ctx context.Context, before time.Time, maxAttempts int) ([]*model.WebhookEvent, error)
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/users/domain/model"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TenantPreferencesRepository implements the outbound.TenantPreferencesRepository interface
+type TenantPreferencesRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewTenantPreferencesRepository creates a new PostgreSQL tenant preferences repository
+func NewTenantPreferencesRepository(db *pgxpool.Pool) outbound.TenantPreferencesRepository {
+	return &TenantPreferencesRepository{
+		db: db,
+	}
+}
+
+// FindByUserID finds the user's tenant preferences, returning empty preferences when none are stored
+func (r *TenantPreferencesRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*model.TenantPreferences, error) {
+	query := `
+		SELECT default_tenant_id, last_used_tenant_id, updated_at
+		FROM user_tenant_preferences
+		WHERE user_id = $1
+	`
+
+	var (
+		defaultTenantID  *uuid.UUID
+		lastUsedTenantID *uuid.UUID
+		updatedAt        time.Time
+	)
+
+	err := r.db.QueryRow(ctx, query, userID).Scan(&defaultTenantID, &lastUsedTenantID, &updatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.NewTenantPreferences(userID), nil
+		}
+		return nil, err
+	}
+
+	return model.NewTenantPreferencesWithID(userID, defaultTenantID, lastUsedTenantID, updatedAt), nil
+}
+
+// Save creates or replaces the user's tenant preferences
+func (r *TenantPreferencesRepository) Save(ctx context.Context, preferences *model.TenantPreferences) error {
+	query := `
+		INSERT INTO user_tenant_preferences (user_id, default_tenant_id, last_used_tenant_id, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			default_tenant_id = EXCLUDED.default_tenant_id,
+			last_used_tenant_id = EXCLUDED.last_used_tenant_id,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		preferences.UserID(),
+		preferences.DefaultTenantID(),
+		preferences.LastUsedTenantID(),
+		preferences.UpdatedAt(),
+	)
+	return err
+}
@@ -29,26 +29,43 @@ func NewUserRepository(db *pgxpool.Pool) outbound.UserRepository {
 // FindByClerkUserID finds a user by Clerk user ID
 func (r *UserRepository) FindByClerkUserID(ctx context.Context, clerkUserID string) (*model.User, error) {
 	query := `
-		SELECT id, clerk_user_id, email, first_name, last_name, full_name, image_url, phone_numbers, created_at, updated_at, last_sign_in_at
+		SELECT id, clerk_user_id, email, first_name, last_name, full_name, image_url, phone_numbers, created_at, updated_at, last_sign_in_at, deleted_at
 		FROM users
 		WHERE clerk_user_id = $1
 	`
 
+	return r.scanUser(r.db.QueryRow(ctx, query, clerkUserID))
+}
+
+// FindByID finds a user by database ID
+func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	query := `
+		SELECT id, clerk_user_id, email, first_name, last_name, full_name, image_url, phone_numbers, created_at, updated_at, last_sign_in_at, deleted_at
+		FROM users
+		WHERE id = $1
+	`
+
+	return r.scanUser(r.db.QueryRow(ctx, query, id))
+}
+
+// scanUser scans a single user row into a domain user
+func (r *UserRepository) scanUser(row pgx.Row) (*model.User, error) {
 	var (
-		id            string
-		dbClerkUserID string
-		email         *string
-		firstName     *string
-		lastName      *string
-		fullName      *string
-		imageURL      *string
+		id               string
+		dbClerkUserID    string
+		email            *string
+		firstName        *string
+		lastName         *string
+		fullName         *string
+		imageURL         *string
 		phoneNumbersJSON []byte
-		createdAt     time.Time
-		updatedAt     time.Time
-		lastSignInAt  *time.Time
+		createdAt        time.Time
+		updatedAt        time.Time
+		lastSignInAt     *time.Time
+		deletedAt        *time.Time
 	)
 
-	err := r.db.QueryRow(ctx, query, clerkUserID).Scan(
+	err := row.Scan(
 		&id,
 		&dbClerkUserID,
 		&email,
@@ -60,6 +77,7 @@ func (r *UserRepository) FindByClerkUserID(ctx context.Context, clerkUserID stri
 		&createdAt,
 		&updatedAt,
 		&lastSignInAt,
+		&deletedAt,
 	)
 
 	if err != nil {
@@ -95,6 +113,7 @@ func (r *UserRepository) FindByClerkUserID(ctx context.Context, clerkUserID stri
 		createdAt,
 		updatedAt,
 		lastSignInAt,
+		deletedAt,
 	), nil
 }
 
@@ -131,7 +150,7 @@ func (r *UserRepository) Save(ctx context.Context, user *model.User) error {
 func (r *UserRepository) Update(ctx context.Context, user *model.User) error {
 	query := `
 		UPDATE users
-		SET email = $2, first_name = $3, last_name = $4, full_name = $5, image_url = $6, phone_numbers = $7, updated_at = $8, last_sign_in_at = $9
+		SET email = $2, first_name = $3, last_name = $4, full_name = $5, image_url = $6, phone_numbers = $7, updated_at = $8, last_sign_in_at = $9, deleted_at = $10
 		WHERE clerk_user_id = $1
 	`
 
@@ -150,6 +169,7 @@ func (r *UserRepository) Update(ctx context.Context, user *model.User) error {
 		phoneNumbersJSON,
 		user.UpdatedAt(),
 		user.LastSignInAt(),
+		user.DeletedAt(),
 	)
 
 	return err
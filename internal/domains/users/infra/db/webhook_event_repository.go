@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"farohq-core-app/internal/domains/users/domain"
+	"farohq-core-app/internal/domains/users/domain/model"
+	"farohq-core-app/internal/domains/users/domain/ports/outbound"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const webhookEventColumns = `id, source, event_type, payload, status, attempts, last_error, received_at, processed_at`
+
+// WebhookEventRepository implements the outbound.WebhookEventRepository interface
+type WebhookEventRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewWebhookEventRepository creates a new PostgreSQL webhook event repository
+func NewWebhookEventRepository(db *pgxpool.Pool) outbound.WebhookEventRepository {
+	return &WebhookEventRepository{
+		db: db,
+	}
+}
+
+// Create stores the event unless one with the same ID already exists
+func (r *WebhookEventRepository) Create(ctx context.Context, event *model.WebhookEvent) (bool, error) {
+	query := `
+		INSERT INTO webhook_events (` + webhookEventColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		event.ID(),
+		event.Source(),
+		event.Type(),
+		event.Payload(),
+		string(event.Status()),
+		event.Attempts(),
+		nullString(event.LastError()),
+		event.ReceivedAt(),
+		event.ProcessedAt(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// FindByID finds a webhook event by the provider's event ID
+func (r *WebhookEventRepository) FindByID(ctx context.Context, id string) (*model.WebhookEvent, error) {
+	query := `SELECT ` + webhookEventColumns + ` FROM webhook_events WHERE id = $1`
+
+	return r.scanEvent(r.db.QueryRow(ctx, query, id))
+}
+
+// Update persists the processing status of an event
+func (r *WebhookEventRepository) Update(ctx context.Context, event *model.WebhookEvent) error {
+	query := `
+		UPDATE webhook_events
+		SET status = $2, attempts = $3, last_error = $4, processed_at = $5, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query,
+		event.ID(),
+		string(event.Status()),
+		event.Attempts(),
+		nullString(event.LastError()),
+		event.ProcessedAt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrWebhookEventNotFound
+	}
+
+	return nil
+}
+
+// ListRetryable lists received or failed events last touched before the cutoff, oldest first
+func (r *WebhookEventRepository) ListRetryable(ctx context.Context, before time.Time, maxAttempts int) ([]*model.WebhookEvent, error) {
+	query := `
+		SELECT ` + webhookEventColumns + `
+		FROM webhook_events
+		WHERE status IN ('received', 'failed') AND updated_at < $1 AND attempts < $2
+		ORDER BY received_at ASC
+		LIMIT 100
+	`
+
+	rows, err := r.db.Query(ctx, query, before, maxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*model.WebhookEvent
+	for rows.Next() {
+		event, err := r.scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// scanEvent scans a single webhook event row into a domain event
+func (r *WebhookEventRepository) scanEvent(row pgx.Row) (*model.WebhookEvent, error) {
+	var (
+		id          string
+		source      string
+		eventType   string
+		payload     []byte
+		status      string
+		attempts    int
+		lastError   *string
+		receivedAt  time.Time
+		processedAt *time.Time
+	)
+
+	err := row.Scan(&id, &source, &eventType, &payload, &status, &attempts, &lastError, &receivedAt, &processedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrWebhookEventNotFound
+		}
+		return nil, err
+	}
+
+	return model.NewWebhookEventWithID(
+		id,
+		source,
+		eventType,
+		payload,
+		model.WebhookEventStatus(status),
+		attempts,
+		stringPtr(lastError),
+		receivedAt,
+		processedAt,
+	), nil
+}
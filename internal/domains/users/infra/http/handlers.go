@@ -5,52 +5,67 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog"
 
 	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/svix"
 )
 
 // Handlers provides HTTP handlers for the users domain
 type Handlers struct {
-	logger    zerolog.Logger
-	syncUser  inbound.SyncUser
+	logger              zerolog.Logger
+	syncUser            inbound.SyncUser
+	deleteUser          inbound.DeleteUser
+	receiveClerkWebhook inbound.ReceiveClerkWebhook
+	switchTenant        inbound.SwitchTenant
+	webhookVerifier     *svix.Verifier
 }
 
 // NewHandlers creates new user HTTP handlers
+// webhookVerifier may be nil, in which case the Clerk webhook endpoint responds 503.
 func NewHandlers(
 	logger zerolog.Logger,
 	syncUser inbound.SyncUser,
+	deleteUser inbound.DeleteUser,
+	receiveClerkWebhook inbound.ReceiveClerkWebhook,
+	switchTenant inbound.SwitchTenant,
+	webhookVerifier *svix.Verifier,
 ) *Handlers {
 	return &Handlers{
-		logger:   logger,
-		syncUser: syncUser,
+		logger:              logger,
+		syncUser:            syncUser,
+		deleteUser:          deleteUser,
+		receiveClerkWebhook: receiveClerkWebhook,
+		switchTenant:        switchTenant,
+		webhookVerifier:     webhookVerifier,
 	}
 }
 
 // SyncUserHandler handles POST /api/v1/users/sync
 func (h *Handlers) SyncUserHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		httpserver.MethodNotAllowedHandler(w, r)
 		return
 	}
 
 	var req inbound.SyncUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Error().Err(err).Msg("Failed to decode sync user request")
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		httpserver.WriteInvalidJSON(w, r)
 		return
 	}
 
 	// Validate required fields
 	if req.ClerkUserID == "" {
-		http.Error(w, "clerk_user_id is required", http.StatusBadRequest)
+		httpserver.WriteMissingField(w, r, "clerk_user_id", "clerk_user_id is required")
 		return
 	}
 
 	resp, err := h.syncUser.Execute(r.Context(), &req)
 	if err != nil {
-		h.logger.Error().Err(err).Msg("Failed to sync user")
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		h.writeError(w, r, err, "Failed to sync user")
 		return
 	}
 
@@ -76,3 +91,45 @@ func (h *Handlers) SyncUserHandler(w http.ResponseWriter, r *http.Request) {
 		}(),
 	})
 }
+
+// AdminDeleteUserHandler handles DELETE /api/v1/admin/users/{clerk_user_id}
+// Removes the user from every tenant and anonymizes their personal data; rejected if they are the last owner of a tenant
+func (h *Handlers) AdminDeleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	clerkUserID := chi.URLParam(r, "clerk_user_id")
+	if clerkUserID == "" {
+		httpserver.WriteMissingField(w, r, "clerk_user_id", "clerk_user_id is required")
+		return
+	}
+
+	resp, err := h.deleteUser.Execute(r.Context(), &inbound.DeleteUserRequest{
+		ClerkUserID: clerkUserID,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to delete user")
+		return
+	}
+
+	h.logger.Info().
+		Str("user_id", resp.User.ID().String()).
+		Interface("actor", r.Context().Value("user_id")).
+		Msg("User deleted by platform admin")
+
+	removed := make([]string, len(resp.RemovedTenantIDs))
+	for i, id := range resp.RemovedTenantIDs {
+		removed[i] = id.String()
+	}
+	retained := make([]string, len(resp.RetainedTenantIDs))
+	for i, id := range resp.RetainedTenantIDs {
+		retained[i] = id.String()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":                  resp.User.ID().String(),
+		"clerk_user_id":       resp.User.ClerkUserID(),
+		"deleted_at":          resp.User.DeletedAt().Format(time.RFC3339),
+		"removed_tenant_ids":  removed,
+		"retained_tenant_ids": retained,
+		"revoked_invites":     resp.RevokedInvites,
+	})
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"farohq-core-app/internal/domains/users/domain"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// problems maps users domain errors to problem responses
+var problems = httpserver.NewErrorMapper(
+	httpserver.ErrorMapping{Err: domain.ErrUserNotFound, Status: http.StatusNotFound, Code: "user_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrUserDeleted, Status: http.StatusGone, Code: "user_deleted"},
+	httpserver.ErrorMapping{Err: domain.ErrLastOwner, Status: http.StatusConflict, Code: "last_owner"},
+	httpserver.ErrorMapping{Err: domain.ErrNotTenantMember, Status: http.StatusForbidden, Code: "not_tenant_member"},
+	httpserver.ErrorMapping{Err: domain.ErrWebhookEventNotFound, Status: http.StatusNotFound, Code: "webhook_event_not_found"},
+	httpserver.ErrorMapping{Err: domain.ErrTenantNotLinked, Status: http.StatusNotFound, Code: "tenant_not_linked"},
+	httpserver.ErrorMapping{Err: domain.ErrInvalidWebhookPayload, Status: http.StatusBadRequest, Code: "invalid_webhook_payload"},
+)
+
+// writeError writes the problem mapped from a domain error
+// Unmapped errors are logged with msg and reported as a 500 without exposing the cause.
+func (h *Handlers) writeError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	if problem := problems.Problem(err); problem != nil {
+		httpserver.WriteProblem(w, r, problem)
+		return
+	}
+	h.logger.Error().Err(err).Msg(msg)
+	httpserver.WriteInternalError(w, r)
+}
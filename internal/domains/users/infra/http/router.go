@@ -8,5 +8,16 @@ import (
 func (h *Handlers) RegisterRoutes(r chi.Router) {
 	r.Route("/users", func(r chi.Router) {
 		r.Post("/sync", h.SyncUserHandler)
+		r.Post("/me/switch-tenant", h.SwitchTenantHandler)
 	})
 }
+
+// RegisterPublicRoutes registers public routes (authenticated by webhook signature, not by session)
+func (h *Handlers) RegisterPublicRoutes(r chi.Router) {
+	r.Post("/webhooks/clerk", h.ClerkWebhookHandler)
+}
+
+// RegisterAdminRoutes registers platform admin routes (caller must apply RequirePlatformAdmin)
+func (h *Handlers) RegisterAdminRoutes(r chi.Router) {
+	r.Delete("/admin/users/{clerk_user_id}", h.AdminDeleteUserHandler)
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+// SwitchTenantHandler handles POST /api/v1/users/me/switch-tenant
+// Records the organization as last used (and as default when make_default is set) for tenant resolution fallback
+func (h *Handlers) SwitchTenantHandler(w http.ResponseWriter, r *http.Request) {
+	clerkUserID, ok := r.Context().Value("user_id").(string)
+	if !ok || clerkUserID == "" {
+		httpserver.WriteUnauthorized(w, r, httpserver.AuthCodeTokenRequired, "Unauthorized")
+		return
+	}
+
+	var req struct {
+		TenantID    string `json:"tenant_id"`
+		MakeDefault bool   `json:"make_default"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpserver.WriteInvalidJSON(w, r)
+		return
+	}
+
+	tenantID, err := uuid.Parse(req.TenantID)
+	if err != nil {
+		httpserver.WriteInvalidField(w, r, "tenant_id", "Invalid tenant ID")
+		return
+	}
+
+	resp, err := h.switchTenant.Execute(r.Context(), &inbound.SwitchTenantRequest{
+		ClerkUserID: clerkUserID,
+		TenantID:    tenantID,
+		MakeDefault: req.MakeDefault,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to switch tenant")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(buildTenantPreferencesResponse(resp.Preferences.DefaultTenantID(), resp.Preferences.LastUsedTenantID(), resp.Preferences.UpdatedAt()))
+}
+
+func buildTenantPreferencesResponse(defaultTenantID, lastUsedTenantID *uuid.UUID, updatedAt time.Time) map[string]interface{} {
+	response := map[string]interface{}{
+		"default_tenant_id":   nil,
+		"last_used_tenant_id": nil,
+		"updated_at":          updatedAt.Format(time.RFC3339),
+	}
+	if defaultTenantID != nil {
+		response["default_tenant_id"] = defaultTenantID.String()
+	}
+	if lastUsedTenantID != nil {
+		response["last_used_tenant_id"] = lastUsedTenantID.String()
+	}
+	return response
+}
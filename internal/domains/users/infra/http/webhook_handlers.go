@@ -0,0 +1,51 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"farohq-core-app/internal/domains/users/domain/ports/inbound"
+	"farohq-core-app/internal/platform/httpserver"
+	"farohq-core-app/internal/platform/svix"
+)
+
+// maxWebhookBodyBytes bounds the size of an accepted webhook body
+const maxWebhookBodyBytes = 1 << 20
+
+// ClerkWebhookHandler handles POST /api/v1/webhooks/clerk
+// Verifies the Svix signature, stores the event and acknowledges before processing it asynchronously.
+func (h *Handlers) ClerkWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	if h.webhookVerifier == nil {
+		httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusServiceUnavailable, httpserver.ProblemCodeUnavailable, "Clerk webhooks are not configured"))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes+1))
+	if err != nil || len(body) > maxWebhookBodyBytes {
+		httpserver.WriteBadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	if err := h.webhookVerifier.Verify(r.Header, body); err != nil {
+		h.logger.Warn().Err(err).Str("svix_id", r.Header.Get(svix.HeaderID)).Msg("Rejected Clerk webhook")
+		httpserver.WriteUnauthorized(w, r, "invalid_webhook_signature", "Invalid webhook signature")
+		return
+	}
+
+	resp, err := h.receiveClerkWebhook.Execute(r.Context(), &inbound.ReceiveClerkWebhookRequest{
+		EventID: r.Header.Get(svix.HeaderID),
+		Payload: body,
+	})
+	if err != nil {
+		h.writeError(w, r, err, "Failed to receive Clerk webhook")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":        resp.Event.ID(),
+		"type":      resp.Event.Type(),
+		"duplicate": resp.Duplicate,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -18,7 +19,8 @@ type Config struct {
 	DBSSLMode  string
 
 	// Clerk
-	ClerkJWKSURL string
+	ClerkJWKSURL       string
+	ClerkWebhookSecret string // Svix signing secret ("whsec_..."); webhooks are rejected when empty
 
 	// Web
 	WebURL string
@@ -33,14 +35,34 @@ type Config struct {
 	GCSBucketName string
 	GCSProjectID  string
 
+	// Public base URL of stored files (CDN or bucket URL); defaults to the bucket's public URL
+	StoragePublicURL string
+
+	// Shared secret of storage object-created notifications ("?token=" or bearer); rejected when empty
+	StorageNotificationToken string
+
+	// Custom domain provider: "vercel", "cloudflare" or "fake" (in-memory, refused in production); defaults to
+	// vercel when VERCEL_API_TOKEN is set, otherwise required
+	DomainProvider string
+
 	// Vercel API (for custom domain management)
 	VercelAPIToken  string
 	VercelProjectID string
 	VercelTeamID    string
 
+	// Cloudflare for SaaS (custom hostnames on the zone serving the portal)
+	CloudflareAPIToken    string
+	CloudflareZoneID      string
+	CloudflareCNAMETarget string // Fallback origin customers point their CNAME at
+
 	// DNS (optional, for UX feedback only)
 	DNSLookupEnabled bool
 
+	// Domain ownership (_faro-verify TXT record) checks: enabled unless "false"
+	DomainOwnershipCheck  string
+	DNSResolvers          []string // UDP/TCP host[:port] or DNS-over-HTTPS URLs; public resolvers when empty
+	DNSAuthoritativeCheck bool     // Also query the domain's authoritative nameservers
+
 	// Email Service Configuration
 	PostmarkAPIToken  string
 	PostmarkFromEmail string
@@ -48,11 +70,29 @@ type Config struct {
 	MailhogPort       string
 	Environment       string
 
+	// Agency sending domains: "postmark" or "fake" (in-memory, refused in production); defaults to postmark
+	// when POSTMARK_ACCOUNT_TOKEN is set, otherwise required
+	SendingDomainProvider string
+	PostmarkAccountToken  string // Account API token (manages sender domains; the server token only sends)
+
 	// Server
-	Port string
+	Port           string
+	TrustedProxies []string // CIDRs or IPs of reverse proxies whose X-Forwarded-For is honored; none when empty
 
 	// Redis/Dragonfly Cache
 	RedisURL string
+
+	// Platform admins (Clerk user IDs allowed to call /admin endpoints)
+	PlatformAdminUserIDs []string
+
+	// Tenant closure: days between an owner's closure request and the hard purge
+	TenantClosureRetentionDays int
+
+	// Rate limits in requests per minute per route class (0 disables the class)
+	RateLimitPublicPerMinute int // public routes, per client IP
+	RateLimitUserPerMinute   int // authenticated routes, per user
+	RateLimitWritePerMinute  int // authenticated writes, per user
+	RateLimitTenantPerMinute int // tenant routes, per tenant (scaled by tier)
 }
 
 // NewConfig creates a new configuration from environment variables
@@ -67,7 +107,8 @@ func NewConfig() *Config {
 		DBSSLMode:  getEnv("DB_SSLMODE", "disable"),
 
 		// Clerk
-		ClerkJWKSURL: getEnv("CLERK_JWKS_URL", ""),
+		ClerkJWKSURL:       getEnv("CLERK_JWKS_URL", ""),
+		ClerkWebhookSecret: getEnv("CLERK_WEBHOOK_SECRET", ""),
 
 		// Web
 		WebURL: getEnv("WEB_URL", "http://localhost:3000"),
@@ -82,14 +123,33 @@ func NewConfig() *Config {
 		GCSBucketName: getEnv("GCS_BUCKET_NAME", "farohq-files"),
 		GCSProjectID:  getEnv("GCS_PROJECT_ID", ""),
 
+		// Public file URLs
+		StoragePublicURL: getEnv("STORAGE_PUBLIC_URL", ""),
+
+		// Storage notifications
+		StorageNotificationToken: getEnv("STORAGE_NOTIFICATION_TOKEN", ""),
+
+		// Custom domain provider
+		DomainProvider: getEnv("DOMAIN_PROVIDER", ""),
+
 		// Vercel API
 		VercelAPIToken:  getEnv("VERCEL_API_TOKEN", ""),
 		VercelProjectID: getEnv("VERCEL_PROJECT_ID", ""),
 		VercelTeamID:    getEnv("VERCEL_TEAM_ID", ""),
 
+		// Cloudflare for SaaS
+		CloudflareAPIToken:    getEnv("CLOUDFLARE_API_TOKEN", ""),
+		CloudflareZoneID:      getEnv("CLOUDFLARE_ZONE_ID", ""),
+		CloudflareCNAMETarget: getEnv("CLOUDFLARE_CNAME_TARGET", ""),
+
 		// DNS (optional)
 		DNSLookupEnabled: getEnv("DNS_LOOKUP_ENABLED", "false") == "true",
 
+		// Domain ownership
+		DomainOwnershipCheck:  getEnv("DOMAIN_OWNERSHIP_CHECK", ""),
+		DNSResolvers:          getEnvList("DNS_RESOLVERS"),
+		DNSAuthoritativeCheck: getEnv("DNS_AUTHORITATIVE_CHECK", "true") == "true",
+
 		// Email Service
 		PostmarkAPIToken:  getEnv("POSTMARK_API_TOKEN", ""),
 		PostmarkFromEmail: getEnv("POSTMARK_FROM_EMAIL", ""),
@@ -97,11 +157,28 @@ func NewConfig() *Config {
 		MailhogPort:       getEnv("MAILHOG_PORT", "8025"),
 		Environment:       getEnv("ENVIRONMENT", "development"),
 
+		// Agency sending domains
+		SendingDomainProvider: getEnv("SENDING_DOMAIN_PROVIDER", ""),
+		PostmarkAccountToken:  getEnv("POSTMARK_ACCOUNT_TOKEN", ""),
+
 		// Server
-		Port: getEnv("PORT", "8080"),
+		Port:           getEnv("PORT", "8080"),
+		TrustedProxies: getEnvList("TRUSTED_PROXIES"),
 
 		// Redis/Dragonfly Cache
 		RedisURL: getEnv("REDIS_URL", "redis://localhost:6379"),
+
+		// Platform admins (comma-separated Clerk user IDs)
+		PlatformAdminUserIDs: getEnvList("PLATFORM_ADMIN_USER_IDS"),
+
+		// Tenant closure retention window
+		TenantClosureRetentionDays: getEnvInt("TENANT_CLOSURE_RETENTION_DAYS", 30),
+
+		// Rate limits (requests per minute)
+		RateLimitPublicPerMinute: getEnvInt("RATE_LIMIT_PUBLIC_PER_MINUTE", 60),
+		RateLimitUserPerMinute:   getEnvInt("RATE_LIMIT_USER_PER_MINUTE", 600),
+		RateLimitWritePerMinute:  getEnvInt("RATE_LIMIT_WRITE_PER_MINUTE", 120),
+		RateLimitTenantPerMinute: getEnvInt("RATE_LIMIT_TENANT_PER_MINUTE", 1200),
 	}
 
 	return cfg
@@ -159,3 +236,23 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvList gets a comma-separated environment variable as a list (empty entries are dropped)
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvInt gets an integer environment variable with a default value (invalid values use the default)
+func getEnvInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
@@ -12,11 +12,25 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// Machine-readable problem codes returned when authentication fails
+const (
+	AuthCodeTokenRequired      = "authentication_required"
+	AuthCodeInvalidToken       = "invalid_token"
+	AuthCodeTokenRevoked       = "token_revoked"
+	AuthCodeVerificationFailed = "token_verification_failed"
+)
+
+// TokenRevocationChecker looks up a user's "tokens issued before" watermark
+type TokenRevocationChecker interface {
+	RevokedBefore(ctx context.Context, clerkUserID string) (time.Time, bool)
+}
+
 // RequireAuth middleware that validates Clerk JWT tokens via JWKS
 type RequireAuth struct {
-	jwksURL string
-	cache   *jwk.Cache
-	logger  zerolog.Logger
+	jwksURL     string
+	cache       *jwk.Cache
+	logger      zerolog.Logger
+	revocations TokenRevocationChecker
 }
 
 // NewRequireAuth creates a new Clerk authentication middleware with JWKS verification
@@ -49,6 +63,23 @@ func NewRequireAuth(jwksURL string, logger zerolog.Logger) (*RequireAuth, error)
 	}, nil
 }
 
+// SetRevocationChecker enables rejecting tokens issued before a user's revocation watermark
+func (ra *RequireAuth) SetRevocationChecker(checker TokenRevocationChecker) {
+	ra.revocations = checker
+}
+
+// isRevoked reports whether the token was issued before the subject's revocation watermark
+func (ra *RequireAuth) isRevoked(ctx context.Context, token jwt.Token) bool {
+	if ra.revocations == nil || token.Subject() == "" {
+		return false
+	}
+	watermark, found := ra.revocations.RevokedBefore(ctx, token.Subject())
+	if !found {
+		return false
+	}
+	return token.IssuedAt().Before(watermark)
+}
+
 // TokenSource represents where the token was extracted from
 type TokenSource string
 
@@ -122,7 +153,7 @@ func (ra *RequireAuth) RequireAuth(next http.Handler) http.Handler {
 				Str("auth_result", "failure").
 				Str("auth_failure_reason", "missing_token").
 				Msg("401 Unauthorized: No authentication token found in any header")
-			http.Error(w, "Authorization header required", http.StatusUnauthorized)
+			WriteUnauthorized(w, r, AuthCodeTokenRequired, "Authorization header required")
 			return
 		}
 
@@ -136,7 +167,7 @@ func (ra *RequireAuth) RequireAuth(next http.Handler) http.Handler {
 				Str("auth_result", "failure").
 				Str("auth_failure_reason", "empty_token").
 				Msg("401 Unauthorized: Empty token string")
-			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			WriteUnauthorized(w, r, AuthCodeInvalidToken, "Invalid token")
 			return
 		}
 
@@ -163,7 +194,7 @@ func (ra *RequireAuth) RequireAuth(next http.Handler) http.Handler {
 					Str("auth_failure_reason", "jwks_refresh_failed").
 					Err(refreshErr).
 					Msg("Failed to refresh JWKS cache")
-				http.Error(w, "Failed to verify token", http.StatusInternalServerError)
+				WriteProblem(w, r, NewProblem(http.StatusInternalServerError, AuthCodeVerificationFailed, "Failed to verify token"))
 				return
 			} else {
 				ra.logger.Debug().
@@ -184,7 +215,7 @@ func (ra *RequireAuth) RequireAuth(next http.Handler) http.Handler {
 					Str("auth_failure_reason", "jwks_unavailable").
 					Err(err).
 					Msg("401 Unauthorized: Failed to get JWKS after refresh")
-				http.Error(w, "Failed to verify token", http.StatusUnauthorized)
+				WriteUnauthorized(w, r, AuthCodeVerificationFailed, "Failed to verify token")
 				return
 			}
 		} else {
@@ -225,7 +256,7 @@ func (ra *RequireAuth) RequireAuth(next http.Handler) http.Handler {
 				Dur("verify_duration_ms", verifyDuration).
 				Err(err).
 				Msg("401 Unauthorized: Token verification failed")
-			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			WriteUnauthorized(w, r, AuthCodeInvalidToken, "Invalid token")
 			return
 		}
 
@@ -235,6 +266,21 @@ func (ra *RequireAuth) RequireAuth(next http.Handler) http.Handler {
 			Dur("verify_duration_ms", verifyDuration).
 			Msg("Token verified successfully")
 
+		// Reject tokens issued before the user's access was revoked (e.g. removed from a tenant)
+		if ra.isRevoked(ctx, verifiedToken) {
+			ra.logger.Warn().
+				Str("method", r.Method).
+				Str("path", r.URL.Path).
+				Str("remote_addr", r.RemoteAddr).
+				Str("token_source", string(tokenSource)).
+				Str("auth_result", "failure").
+				Str("auth_failure_reason", "token_revoked").
+				Str("user_id", verifiedToken.Subject()).
+				Msg("401 Unauthorized: Token issued before revocation watermark")
+			WriteUnauthorized(w, r, AuthCodeTokenRevoked, "Token has been revoked")
+			return
+		}
+
 		// Extract claims
 		userID, _ := verifiedToken.Get("sub")
 		email, _ := verifiedToken.Get("email")
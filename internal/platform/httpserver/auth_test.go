@@ -1,9 +1,13 @@
 package httpserver
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"farohq-core-app/internal/platform/revocation"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -351,6 +355,60 @@ func TestRequireAuth_EmptyTokenString(t *testing.T) {
 	assert.Contains(t, rr.Body.String(), "Invalid token")
 }
 
+func TestRequireAuth_RevokedToken(t *testing.T) {
+	keyPair, err := GenerateTestKeyPair()
+	require.NoError(t, err)
+
+	auth, jwksServer, err := CreateTestRequireAuth(keyPair)
+	require.NoError(t, err)
+	defer jwksServer.Close()
+
+	revocations := revocation.NewStore(nil, 0, zerolog.Nop())
+	require.NoError(t, revocations.Revoke(context.Background(), "user-removed", time.Now()))
+	require.NoError(t, revocations.Revoke(context.Background(), "user-reauthenticated", time.Now().Add(-time.Hour)))
+	auth.SetRevocationChecker(revocations)
+
+	tests := []struct {
+		name         string
+		sub          string
+		expectedCode int
+	}{
+		{
+			name:         "rejects tokens issued before the watermark",
+			sub:          "user-removed",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "accepts tokens issued after the watermark",
+			sub:          "user-reauthenticated",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "accepts tokens for users without a watermark",
+			sub:          "user-unaffected",
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := CreateMockJWT(keyPair, map[string]interface{}{"sub": tt.sub})
+			require.NoError(t, err)
+
+			handler := auth.RequireAuth(CreateTestHandler())
+			req := MakeAuthenticatedRequest("GET", "/test", token, TokenSourceAuthorization)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedCode, rr.Code)
+			if tt.expectedCode == http.StatusUnauthorized {
+				assert.Contains(t, rr.Body.String(), "Token has been revoked")
+			}
+		})
+	}
+}
+
 func TestRequireAuth_JWKSCacheRefresh(t *testing.T) {
 	keyPair, err := GenerateTestKeyPair()
 	require.NoError(t, err)
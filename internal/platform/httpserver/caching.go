@@ -0,0 +1,47 @@
+package httpserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// ContentETag derives a strong entity tag from the bytes of a representation
+func ContentETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// IfNoneMatch reports whether the request's If-None-Match header lists etag (or is "*"),
+// meaning the client's cached copy is still current
+func IfNoneMatch(r *http.Request, etag string) bool {
+	header := r.Header.Get("If-None-Match")
+	if header == "" {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteCacheable writes a cacheable representation with its ETag and Cache-Control headers
+// Requests whose If-None-Match matches get 304 Not Modified without a body.
+// Callers set Vary for any request header the representation depends on.
+func WriteCacheable(w http.ResponseWriter, r *http.Request, contentType, cacheControl string, body []byte) {
+	etag := ContentETag(body)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+
+	if IfNoneMatch(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
@@ -0,0 +1,40 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteCacheable(t *testing.T) {
+	body := []byte(`{"a":1}`)
+	etag := ContentETag(body)
+	assert.Equal(t, etag, ContentETag([]byte(`{"a":1}`)))
+	assert.NotEqual(t, etag, ContentETag([]byte(`{"a":2}`)))
+
+	rr := httptest.NewRecorder()
+	WriteCacheable(rr, httptest.NewRequest(http.MethodGet, "/", nil), "application/json", "public, max-age=60", body)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, etag, rr.Header().Get("ETag"))
+	assert.Equal(t, "public, max-age=60", rr.Header().Get("Cache-Control"))
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, string(body), rr.Body.String())
+
+	for _, ifNoneMatch := range []string{etag, `"other", ` + etag, "W/" + etag, "*"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		rr = httptest.NewRecorder()
+		WriteCacheable(rr, req, "application/json", "public, max-age=60", body)
+		assert.Equal(t, http.StatusNotModified, rr.Code, ifNoneMatch)
+		assert.Equal(t, etag, rr.Header().Get("ETag"))
+		assert.Empty(t, rr.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", `"stale"`)
+	rr = httptest.NewRecorder()
+	WriteCacheable(rr, req, "application/json", "public, max-age=60", body)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
@@ -0,0 +1,192 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MergePatchContentType is the media type of JSON Merge Patch (RFC 7396) request bodies
+const MergePatchContentType = "application/merge-patch+json"
+
+// Machine-readable error codes returned for conditional requests
+const (
+	PreconditionCodeRequired = "precondition_required"
+	PreconditionCodeFailed   = "version_conflict"
+)
+
+// ErrPreconditionRequired is returned when a conditional write is sent without If-Match
+var ErrPreconditionRequired = errors.New("If-Match header is required")
+
+// ETag formats a resource version as a strong entity tag
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// SetETag sets the ETag response header for a resource version
+func SetETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", ETag(version))
+}
+
+// IfMatchVersion returns the resource version the request's If-Match header expects
+// Returns nil for "*" (any current version) and ErrPreconditionRequired when the header is missing.
+// A tag that is not a version issued by ETag expects version 0, which never exists, so the write fails with 412.
+func IfMatchVersion(r *http.Request) (*int64, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		return nil, ErrPreconditionRequired
+	}
+	if header == "*" {
+		return nil, nil
+	}
+
+	var expected int64
+	tag := strings.TrimSpace(strings.Split(header, ",")[0])
+	if strings.HasPrefix(tag, `"`) && strings.HasSuffix(tag, `"`) && len(tag) > 2 {
+		if version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64); err == nil && version > 0 {
+			expected = version
+		}
+	}
+	return &expected, nil
+}
+
+// IsMergePatch reports whether the request body is a JSON Merge Patch document
+func IsMergePatch(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == MergePatchContentType
+}
+
+// MergePatch applies a JSON Merge Patch (RFC 7396) to a JSON document
+// Object members set to null in the patch are removed; any non-object patch replaces the target.
+func MergePatch(target, patch []byte) ([]byte, error) {
+	var patchValue interface{}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, err
+	}
+
+	var targetValue interface{}
+	if len(bytes.TrimSpace(target)) > 0 {
+		if err := json.Unmarshal(target, &targetValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(mergePatchValue(targetValue, patchValue))
+}
+
+// mergePatchValue implements the RFC 7396 MergePatch algorithm on decoded JSON values
+func mergePatchValue(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatchValue(targetObject[name], value)
+	}
+	return targetObject
+}
+
+// ApplyMergePatch merges a JSON Merge Patch into the JSON form of current and decodes the result into result
+func ApplyMergePatch(current interface{}, patch []byte, result interface{}) error {
+	document, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	merged, err := MergePatch(document, patch)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(merged, result)
+}
+
+// ConditionalMergePatch is a JSON Merge Patch document read from a PATCH request carrying If-Match
+type ConditionalMergePatch struct {
+	patch           []byte
+	members         map[string]json.RawMessage
+	expectedVersion *int64
+}
+
+// ReadConditionalMergePatch reads a JSON Merge Patch object and the version its If-Match header expects
+// Writes a 415, 428 or 400 problem and returns false if the request is not a conditional merge patch.
+func ReadConditionalMergePatch(w http.ResponseWriter, r *http.Request) (*ConditionalMergePatch, bool) {
+	if !IsMergePatch(r) {
+		WriteUnsupportedPatch(w, r)
+		return nil, false
+	}
+
+	expectedVersion, err := IfMatchVersion(r)
+	if err != nil {
+		WritePreconditionRequired(w, r)
+		return nil, false
+	}
+
+	patch, err := io.ReadAll(r.Body)
+	if err != nil {
+		WriteInvalidJSON(w, r)
+		return nil, false
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &members); err != nil || members == nil {
+		WriteBadRequest(w, r, "Invalid merge patch: expected a JSON object")
+		return nil, false
+	}
+
+	return &ConditionalMergePatch{patch: patch, members: members, expectedVersion: expectedVersion}, true
+}
+
+// Has reports whether the patch sets or removes the top-level member
+func (p *ConditionalMergePatch) Has(member string) bool {
+	_, ok := p.members[member]
+	return ok
+}
+
+// Apply merges the patch into current, the editable document of the resource at currentVersion, and decodes it into result
+// Returns the version the update must still find: the If-Match version, or currentVersion for "If-Match: *",
+// because the patch was applied to the version just read. Writes a 400 problem and returns false if the merge fails.
+func (p *ConditionalMergePatch) Apply(w http.ResponseWriter, r *http.Request, current interface{}, currentVersion int64, result interface{}) (*int64, bool) {
+	if err := ApplyMergePatch(current, p.patch, result); err != nil {
+		WriteBadRequest(w, r, "Invalid merge patch")
+		return nil, false
+	}
+
+	if p.expectedVersion != nil {
+		return p.expectedVersion, true
+	}
+	return &currentVersion, true
+}
+
+// WritePreconditionRequired writes a 428 problem for a conditional write sent without If-Match
+func WritePreconditionRequired(w http.ResponseWriter, r *http.Request) {
+	WriteProblem(w, r, NewProblem(http.StatusPreconditionRequired, PreconditionCodeRequired,
+		"This request must be conditional. Send If-Match with the ETag of the resource you read."))
+}
+
+// WritePreconditionFailed writes a 412 problem carrying the current version and representation of the resource
+func WritePreconditionFailed(w http.ResponseWriter, r *http.Request, version int64, current interface{}) {
+	SetETag(w, version)
+	WriteProblem(w, r, NewProblem(http.StatusPreconditionFailed, PreconditionCodeFailed,
+		"The resource was modified since you read it. Reapply your changes to the current version.").
+		With("current", current))
+}
+
+// WriteUnsupportedPatch writes a 415 problem for a PATCH body that is not a JSON Merge Patch document
+func WriteUnsupportedPatch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Accept-Patch", MergePatchContentType)
+	WriteProblem(w, r, NewProblem(http.StatusUnsupportedMediaType, ProblemCodeUnsupportedMediaType,
+		"PATCH requires Content-Type "+MergePatchContentType))
+}
@@ -0,0 +1,135 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	// Cases from RFC 7396 Appendix A
+	tests := []struct {
+		target string
+		patch  string
+		want   string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		got, err := MergePatch([]byte(tt.target), []byte(tt.patch))
+		require.NoError(t, err)
+		assert.JSONEq(t, tt.want, string(got), "target %s patch %s", tt.target, tt.patch)
+	}
+
+	_, err := MergePatch([]byte(`{}`), []byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestIfMatchVersion(t *testing.T) {
+	newRequest := func(ifMatch string) *http.Request {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/clients/123", nil)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		return req
+	}
+
+	_, err := IfMatchVersion(newRequest(""))
+	assert.ErrorIs(t, err, ErrPreconditionRequired)
+
+	version, err := IfMatchVersion(newRequest("*"))
+	require.NoError(t, err)
+	assert.Nil(t, version)
+
+	version, err = IfMatchVersion(newRequest(ETag(7)))
+	require.NoError(t, err)
+	require.NotNil(t, version)
+	assert.Equal(t, int64(7), *version)
+
+	version, err = IfMatchVersion(newRequest(`W/"7"`))
+	require.NoError(t, err)
+	require.NotNil(t, version)
+	assert.Equal(t, int64(0), *version)
+}
+
+func TestConditionalMergePatch(t *testing.T) {
+	type document struct {
+		Name  string `json:"name"`
+		Phone string `json:"phone"`
+	}
+	current := document{Name: "Downtown", Phone: "555"}
+
+	newRequest := func(contentType, ifMatch, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/locations/123", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		return req
+	}
+
+	rejected := []struct {
+		name     string
+		req      *http.Request
+		expected int
+	}{
+		{"plain JSON", newRequest("application/json", ETag(3), `{}`), http.StatusUnsupportedMediaType},
+		{"no If-Match", newRequest(MergePatchContentType, "", `{}`), http.StatusPreconditionRequired},
+		{"not an object", newRequest(MergePatchContentType, ETag(3), `["name"]`), http.StatusBadRequest},
+		{"invalid JSON", newRequest(MergePatchContentType, ETag(3), `{`), http.StatusBadRequest},
+	}
+	for _, tt := range rejected {
+		rr := httptest.NewRecorder()
+		_, ok := ReadConditionalMergePatch(rr, tt.req)
+		assert.False(t, ok, tt.name)
+		assert.Equal(t, tt.expected, rr.Code, tt.name)
+	}
+
+	rr := httptest.NewRecorder()
+	req := newRequest(MergePatchContentType, ETag(3), `{"phone":null,"name":"Uptown"}`)
+	patch, ok := ReadConditionalMergePatch(rr, req)
+	require.True(t, ok)
+	assert.True(t, patch.Has("phone"), "removed members are present in the patch")
+	assert.False(t, patch.Has("address"))
+
+	var merged document
+	expected, ok := patch.Apply(rr, req, current, 4, &merged)
+	require.True(t, ok)
+	assert.Equal(t, document{Name: "Uptown"}, merged)
+	assert.Equal(t, int64(3), *expected, "the If-Match version is expected, not the version read")
+
+	// "If-Match: *" still pins the update to the version the patch was applied to
+	req = newRequest(MergePatchContentType, "*", `{"name":"Uptown"}`)
+	patch, ok = ReadConditionalMergePatch(rr, req)
+	require.True(t, ok)
+	expected, ok = patch.Apply(rr, req, current, 4, &merged)
+	require.True(t, ok)
+	assert.Equal(t, int64(4), *expected)
+
+	rr = httptest.NewRecorder()
+	req = newRequest(MergePatchContentType, ETag(4), `{"name":{"first":"Up"}}`)
+	patch, ok = ReadConditionalMergePatch(rr, req)
+	require.True(t, ok)
+	_, ok = patch.Apply(rr, req, current, 4, &merged)
+	assert.False(t, ok, "a patch that does not fit the document is rejected")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
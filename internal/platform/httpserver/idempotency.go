@@ -0,0 +1,189 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog"
+
+	"farohq-core-app/internal/platform/idempotency"
+	"farohq-core-app/internal/platform/tenant"
+)
+
+// IdempotencyKeyHeader is the request header carrying the client's idempotency key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses replayed from a previous request with the same key
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// Machine-readable error codes returned when an idempotency key cannot be honored
+const (
+	IdempotencyCodeInvalidKey = "idempotency_key_invalid"
+	IdempotencyCodeKeyReused  = "idempotency_key_reused"
+	IdempotencyCodeInProgress = "idempotency_key_in_progress"
+)
+
+const (
+	// idempotencyKeyTTL is how long a key and its response are kept for replay
+	idempotencyKeyTTL = 24 * time.Hour
+
+	// idempotencyLease bounds how long a claim blocks retries when its request never finishes (e.g. a crash)
+	idempotencyLease = 5 * time.Minute
+
+	// maxIdempotencyKeyLength bounds the accepted key size
+	maxIdempotencyKeyLength = 255
+
+	// maxIdempotentBodySize is the largest request body fingerprinted; larger requests are not deduplicated
+	maxIdempotentBodySize = 1 << 20
+)
+
+// replayedHeaders are the response headers stored and replayed with an idempotent response
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// Idempotency middleware honors the Idempotency-Key header on POST requests
+// The first request with a key stores its fingerprint and response (per tenant and user); retries with the same
+// key replay the stored response, and reusing the key for a different request returns 409.
+// Server errors and panics release the key so the request can be retried; a claim left behind by a crashed
+// process is taken over once its lease lapses.
+// Must run after TenantResolutionWithAuth (requires tenant context).
+func Idempotency(store idempotency.Store, logger zerolog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writeIdempotencyError(w, r, http.StatusBadRequest, IdempotencyCodeInvalidKey, "Idempotency-Key must be at most 255 characters.")
+				return
+			}
+
+			tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+			userID, _ := r.Context().Value("user_id").(string)
+			if !ok || userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentBodySize+1))
+			if err != nil {
+				WriteBadRequest(w, r, "Failed to read request body")
+				return
+			}
+			if len(body) > maxIdempotentBodySize {
+				r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+				next.ServeHTTP(w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			scope := idempotency.Scope{TenantID: tenantID, UserID: userID, Key: key}
+			fingerprint := requestFingerprint(r, body)
+
+			existing, err := store.Begin(r.Context(), scope, fingerprint, idempotencyLease, idempotencyKeyTTL)
+			if err != nil {
+				logger.Error().
+					Err(err).
+					Str("tenant_id", tenantID.String()).
+					Str("path", r.URL.Path).
+					Msg("Failed to claim idempotency key, processing request without it")
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if existing != nil {
+				switch {
+				case existing.Fingerprint != fingerprint:
+					writeIdempotencyError(w, r, http.StatusConflict, IdempotencyCodeKeyReused, "Idempotency-Key was already used for a different request.")
+				case existing.Response == nil:
+					w.Header().Set("Retry-After", "1")
+					writeIdempotencyError(w, r, http.StatusConflict, IdempotencyCodeInProgress, "A request with this Idempotency-Key is still being processed.")
+				default:
+					logger.Debug().
+						Str("tenant_id", tenantID.String()).
+						Str("path", r.URL.Path).
+						Int("status", existing.Response.StatusCode).
+						Msg("Replaying idempotent response")
+					replayResponse(w, existing.Response)
+				}
+				return
+			}
+
+			// Store the outcome even if the client went away, so its retry gets the same response
+			storeCtx := context.WithoutCancel(r.Context())
+
+			// Release the key unless a response is stored; deferred so it also runs when the handler panics
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := store.Release(storeCtx, scope); err != nil {
+					logger.Error().Err(err).Str("path", r.URL.Path).Msg("Failed to release idempotency key")
+				}
+			}()
+
+			var captured bytes.Buffer
+			ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&captured)
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			if status >= http.StatusInternalServerError {
+				return
+			}
+
+			header := http.Header{}
+			for _, name := range replayedHeaders {
+				if value := ww.Header().Get(name); value != "" {
+					header.Set(name, value)
+				}
+			}
+			completed = true
+			if err := store.Complete(storeCtx, scope, &idempotency.Response{
+				StatusCode: status,
+				Header:     header,
+				Body:       captured.Bytes(),
+			}); err != nil {
+				logger.Error().Err(err).Str("path", r.URL.Path).Msg("Failed to store idempotent response")
+			}
+		})
+	}
+}
+
+// requestFingerprint hashes what makes two requests the same: method, path, query and body
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, r.Method+"\n"+r.URL.Path+"?"+r.URL.RawQuery+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// replayResponse writes a stored response
+func replayResponse(w http.ResponseWriter, response *idempotency.Response) {
+	for name, values := range response.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.StatusCode)
+	w.Write(response.Body)
+}
+
+// writeIdempotencyError writes an idempotency problem
+func writeIdempotencyError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	WriteProblem(w, r, NewProblem(status, code, message))
+}
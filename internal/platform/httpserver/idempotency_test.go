@@ -0,0 +1,151 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"farohq-core-app/internal/platform/idempotency"
+)
+
+type fakeIdempotencyStore struct {
+	records map[idempotency.Scope]*idempotency.Record
+}
+
+func (f *fakeIdempotencyStore) Begin(ctx context.Context, scope idempotency.Scope, fingerprint string, lease, ttl time.Duration) (*idempotency.Record, error) {
+	if record, ok := f.records[scope]; ok {
+		return record, nil
+	}
+	f.records[scope] = &idempotency.Record{Fingerprint: fingerprint}
+	return nil, nil
+}
+
+func (f *fakeIdempotencyStore) Complete(ctx context.Context, scope idempotency.Scope, response *idempotency.Response) error {
+	f.records[scope].Response = response
+	return nil
+}
+
+func (f *fakeIdempotencyStore) Release(ctx context.Context, scope idempotency.Scope) error {
+	delete(f.records, scope)
+	return nil
+}
+
+func (f *fakeIdempotencyStore) PurgeExpired(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestIdempotency(t *testing.T) {
+	tenantID := uuid.New()
+
+	newRequest := func(key, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/clients/123/locations", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		ctx := context.WithValue(req.Context(), "tenant_id", tenantID.String())
+		ctx = context.WithValue(ctx, "user_id", "user_123")
+		return req.WithContext(ctx)
+	}
+
+	t.Run("replays the stored response for a retry", func(t *testing.T) {
+		store := &fakeIdempotencyStore{records: map[idempotency.Scope]*idempotency.Record{}}
+		calls := 0
+		handler := Idempotency(store, zerolog.Nop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"loc-1"}`))
+		}))
+
+		first := httptest.NewRecorder()
+		handler.ServeHTTP(first, newRequest("key-1", `{"name":"Main St"}`))
+		retry := httptest.NewRecorder()
+		handler.ServeHTTP(retry, newRequest("key-1", `{"name":"Main St"}`))
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusCreated, retry.Code)
+		assert.Equal(t, first.Body.String(), retry.Body.String())
+		assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+		assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+		assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+	})
+
+	t.Run("rejects a reused key with a different body", func(t *testing.T) {
+		store := &fakeIdempotencyStore{records: map[idempotency.Scope]*idempotency.Record{}}
+		handler := Idempotency(store, zerolog.Nop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest("key-1", `{"name":"Main St"}`))
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, newRequest("key-1", `{"name":"Elm St"}`))
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Contains(t, rr.Body.String(), IdempotencyCodeKeyReused)
+	})
+
+	t.Run("releases the key after a server error", func(t *testing.T) {
+		store := &fakeIdempotencyStore{records: map[idempotency.Scope]*idempotency.Record{}}
+		status := http.StatusInternalServerError
+		calls := 0
+		handler := Idempotency(store, zerolog.Nop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(status)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest("key-1", `{}`))
+		status = http.StatusCreated
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, newRequest("key-1", `{}`))
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("releases the key when the handler panics", func(t *testing.T) {
+		store := &fakeIdempotencyStore{records: map[idempotency.Scope]*idempotency.Record{}}
+		panics := true
+		calls := 0
+		handler := chimw.Recoverer(Idempotency(store, zerolog.Nop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if panics {
+				panic("boom")
+			}
+			w.WriteHeader(http.StatusCreated)
+		})))
+
+		first := httptest.NewRecorder()
+		handler.ServeHTTP(first, newRequest("key-1", `{}`))
+		assert.Equal(t, http.StatusInternalServerError, first.Code)
+
+		panics = false
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, newRequest("key-1", `{}`))
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("passes through requests without a key", func(t *testing.T) {
+		store := &fakeIdempotencyStore{records: map[idempotency.Scope]*idempotency.Record{}}
+		calls := 0
+		handler := Idempotency(store, zerolog.Nop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest("", `{}`))
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest("", `{}`))
+
+		assert.Equal(t, 2, calls)
+		assert.Empty(t, store.records)
+	})
+}
@@ -0,0 +1,187 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/httplog"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	auth_usecases "farohq-core-app/internal/domains/auth/app/usecases"
+	auth_domain "farohq-core-app/internal/domains/auth/domain"
+)
+
+// ImpersonationHeader carries the impersonation session ID on requests made while impersonating
+const ImpersonationHeader = "X-Impersonation-Session"
+
+// impersonationManagementPath is excluded from impersonation so sessions are always started and ended as the real user
+const impersonationManagementPath = "/api/v1/auth/impersonation"
+
+// Machine-readable problem codes returned when an impersonated request is rejected
+const (
+	ImpersonationCodeInvalidSession = "impersonation_session_invalid"
+	ImpersonationCodeActionBlocked  = "impersonation_action_blocked"
+)
+
+// Impersonation describes the impersonation state of a request
+type Impersonation struct {
+	SessionID         string
+	ActorClerkUserID  string
+	TargetClerkUserID string
+	TenantID          string
+	TargetRole        string
+	ExpiresAt         time.Time
+}
+
+// GetImpersonationFromContext returns the impersonation state if the request is impersonated
+func GetImpersonationFromContext(ctx context.Context) (*Impersonation, bool) {
+	impersonation, ok := ctx.Value("impersonation").(*Impersonation)
+	return impersonation, ok && impersonation != nil
+}
+
+// Impersonate middleware swaps the effective principal when a valid impersonation session header is present
+// The target's Clerk user ID, profile and tenant role replace the actor's, the tenant is pinned to the session's,
+// dangerous operations are rejected, and every request is audit-logged with the real actor.
+// Must run after RequireAuth and before TenantResolutionWithAuth.
+func Impersonate(
+	resolve *auth_usecases.ResolveImpersonation,
+	record *auth_usecases.RecordImpersonationActivity,
+	logger zerolog.Logger,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sessionHeader := r.Header.Get(ImpersonationHeader)
+			if sessionHeader == "" || strings.HasPrefix(r.URL.Path, impersonationManagementPath) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			actorClerkUserID, _ := r.Context().Value("user_id").(string)
+			if actorClerkUserID == "" {
+				WriteUnauthorized(w, r, ProblemCodeUnauthorized, "Unauthorized")
+				return
+			}
+
+			sessionID, err := uuid.Parse(sessionHeader)
+			if err != nil {
+				WriteForbidden(w, r, ImpersonationCodeInvalidSession, "Invalid impersonation session")
+				return
+			}
+
+			resp, err := resolve.Execute(r.Context(), &auth_usecases.ResolveImpersonationRequest{
+				SessionID:        sessionID,
+				ActorClerkUserID: actorClerkUserID,
+			})
+			if err != nil {
+				switch err {
+				case auth_domain.ErrImpersonationSessionNotFound,
+					auth_domain.ErrImpersonationSessionInactive,
+					auth_domain.ErrImpersonationNotAllowed,
+					auth_domain.ErrNotTenantMember,
+					auth_domain.ErrUserNotFound:
+					logger.Warn().
+						Err(err).
+						Str("actor_clerk_user_id", actorClerkUserID).
+						Str("session_id", sessionID.String()).
+						Msg("Rejected impersonated request")
+					WriteForbidden(w, r, ImpersonationCodeInvalidSession, err.Error())
+				default:
+					logger.Error().Err(err).Str("session_id", sessionID.String()).Msg("Failed to resolve impersonation session")
+					WriteInternalError(w, r)
+				}
+				return
+			}
+
+			session := resp.Session
+			audit := func(statusCode int, blocked bool) {
+				// Recorded even if the client disconnected
+				err := record.Execute(context.WithoutCancel(r.Context()), &auth_usecases.RecordImpersonationActivityRequest{
+					Session:    session,
+					Method:     r.Method,
+					Path:       r.URL.Path,
+					StatusCode: statusCode,
+					Blocked:    blocked,
+				})
+				if err != nil {
+					logger.Error().Err(err).Str("session_id", session.ID().String()).Msg("Failed to record impersonation audit entry")
+				}
+			}
+
+			httplog.LogEntrySetField(r.Context(), "impersonator_user_id", actorClerkUserID)
+			httplog.LogEntrySetField(r.Context(), "impersonation_session_id", session.ID().String())
+
+			if isBlockedWhileImpersonating(r) {
+				audit(http.StatusForbidden, true)
+				logger.Warn().
+					Str("session_id", session.ID().String()).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Msg("Blocked dangerous operation during impersonation")
+				WriteForbidden(w, r, ImpersonationCodeActionBlocked, "This action is not allowed while impersonating another user")
+				return
+			}
+
+			impersonation := &Impersonation{
+				SessionID:         session.ID().String(),
+				ActorClerkUserID:  actorClerkUserID,
+				TargetClerkUserID: session.TargetClerkUserID(),
+				TenantID:          session.TenantID().String(),
+				TargetRole:        resp.TargetRole,
+				ExpiresAt:         session.ExpiresAt(),
+			}
+
+			// Swap the principal; organization claims of the actor's token do not apply to the target
+			ctx := r.Context()
+			ctx = context.WithValue(ctx, "impersonation", impersonation)
+			ctx = context.WithValue(ctx, "impersonator_user_id", actorClerkUserID)
+			ctx = context.WithValue(ctx, "user_id", session.TargetClerkUserID())
+			ctx = context.WithValue(ctx, "email", resp.Target.Email)
+			ctx = context.WithValue(ctx, "first_name", resp.Target.FirstName)
+			ctx = context.WithValue(ctx, "last_name", resp.Target.LastName)
+			ctx = context.WithValue(ctx, "name", resp.Target.FullName)
+			ctx = context.WithValue(ctx, "org_role", resp.TargetRole)
+			ctx = context.WithValue(ctx, "org_id", nil)
+			ctx = context.WithValue(ctx, "agency_id", nil)
+			ctx = context.WithValue(ctx, "org_slug", nil)
+			r = r.WithContext(ctx)
+			r.Header.Set("X-Tenant-ID", impersonation.TenantID)
+
+			ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			statusCode := ww.Status()
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			audit(statusCode, false)
+		})
+	}
+}
+
+// isBlockedWhileImpersonating reports whether the request is a dangerous operation:
+// any deletion, tenant closure, granting access (invites and memberships, through which ownership changes),
+// user sync and platform admin routes
+func isBlockedWhileImpersonating(r *http.Request) bool {
+	if r.Method == http.MethodDelete {
+		return true
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if strings.Contains(path, "/admin/") {
+		return true
+	}
+
+	if r.Method == http.MethodPost {
+		for _, suffix := range []string{"/closure", "/invites", "/invites/accept", "/members", "/users/sync"} {
+			if strings.HasSuffix(path, suffix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,244 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	auth_usecases "farohq-core-app/internal/domains/auth/app/usecases"
+	auth_domain "farohq-core-app/internal/domains/auth/domain"
+	"farohq-core-app/internal/domains/auth/domain/model"
+	auth_outbound "farohq-core-app/internal/domains/auth/domain/ports/outbound"
+)
+
+type fakeImpersonationSessions struct {
+	sessions map[uuid.UUID]*model.ImpersonationSession
+}
+
+func (f *fakeImpersonationSessions) Save(ctx context.Context, session *model.ImpersonationSession) error {
+	f.sessions[session.ID()] = session
+	return nil
+}
+
+func (f *fakeImpersonationSessions) Update(ctx context.Context, session *model.ImpersonationSession) error {
+	f.sessions[session.ID()] = session
+	return nil
+}
+
+func (f *fakeImpersonationSessions) FindByID(ctx context.Context, id uuid.UUID) (*model.ImpersonationSession, error) {
+	session, ok := f.sessions[id]
+	if !ok {
+		return nil, auth_domain.ErrImpersonationSessionNotFound
+	}
+	return session, nil
+}
+
+func (f *fakeImpersonationSessions) ListActiveByActor(ctx context.Context, actorUserID uuid.UUID) ([]*model.ImpersonationSession, error) {
+	return nil, nil
+}
+
+type fakeImpersonationAudit struct {
+	entries []*model.ImpersonationAuditEntry
+}
+
+func (f *fakeImpersonationAudit) Save(ctx context.Context, entry *model.ImpersonationAuditEntry) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+type fakeUserDirectory struct {
+	users map[uuid.UUID]*auth_outbound.UserIdentity
+}
+
+func (f *fakeUserDirectory) FindByID(ctx context.Context, id uuid.UUID) (*auth_outbound.UserIdentity, error) {
+	user, ok := f.users[id]
+	if !ok {
+		return nil, auth_domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (f *fakeUserDirectory) FindByClerkUserID(ctx context.Context, clerkUserID string) (*auth_outbound.UserIdentity, error) {
+	for _, user := range f.users {
+		if user.ClerkUserID == clerkUserID {
+			return user, nil
+		}
+	}
+	return nil, auth_domain.ErrUserNotFound
+}
+
+type fakeMembershipReader struct {
+	roles map[uuid.UUID]string
+}
+
+func (f *fakeMembershipReader) MemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error) {
+	role, ok := f.roles[userID]
+	if !ok {
+		return "", auth_domain.ErrNotTenantMember
+	}
+	return role, nil
+}
+
+func setupImpersonation(t *testing.T) (http.Handler, *model.ImpersonationSession, *fakeImpersonationAudit, *map[string]interface{}, *fakeMembershipReader) {
+	t.Helper()
+
+	actorID := uuid.New()
+	targetID := uuid.New()
+	tenantID := uuid.New()
+	session := model.NewImpersonationSession(actorID, "user_actor", targetID, "user_target", tenantID, "support ticket 42", 30*time.Minute)
+
+	sessions := &fakeImpersonationSessions{sessions: map[uuid.UUID]*model.ImpersonationSession{session.ID(): session}}
+	audit := &fakeImpersonationAudit{}
+	users := &fakeUserDirectory{users: map[uuid.UUID]*auth_outbound.UserIdentity{
+		targetID: {ID: targetID, ClerkUserID: "user_target", Email: "client@example.com", FirstName: "Cli", LastName: "Ent", FullName: "Cli Ent"},
+	}}
+	memberships := &fakeMembershipReader{roles: map[uuid.UUID]string{actorID: RoleOwner, targetID: RoleClientViewer}}
+
+	seen := map[string]interface{}{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, key := range []string{"user_id", "email", "org_role", "org_id"} {
+			seen[key] = r.Context().Value(key)
+		}
+		seen["tenant_header"] = r.Header.Get("X-Tenant-ID")
+		if impersonation, ok := GetImpersonationFromContext(r.Context()); ok {
+			seen["actor"] = impersonation.ActorClerkUserID
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	middleware := Impersonate(
+		auth_usecases.NewResolveImpersonation(sessions, audit, users, memberships, nil),
+		auth_usecases.NewRecordImpersonationActivity(audit),
+		zerolog.Nop(),
+	)
+	return middleware(next), session, audit, &seen, memberships
+}
+
+func newImpersonatedRequest(method, path, actor, sessionID string) *http.Request {
+	ctx := context.WithValue(context.Background(), "user_id", actor)
+	ctx = context.WithValue(ctx, "org_role", "owner")
+	ctx = context.WithValue(ctx, "org_id", "org_actor")
+	req := httptest.NewRequest(method, path, nil).WithContext(ctx)
+	if sessionID != "" {
+		req.Header.Set(ImpersonationHeader, sessionID)
+	}
+	return req
+}
+
+func TestImpersonate_SwapsPrincipal(t *testing.T) {
+	handler, session, audit, seen, _ := setupImpersonation(t)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", "user_actor", session.ID().String()))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "user_target", (*seen)["user_id"])
+	assert.Equal(t, "client@example.com", (*seen)["email"])
+	assert.Equal(t, RoleClientViewer, (*seen)["org_role"])
+	assert.Nil(t, (*seen)["org_id"])
+	assert.Equal(t, session.TenantID().String(), (*seen)["tenant_header"])
+	assert.Equal(t, "user_actor", (*seen)["actor"])
+
+	require.Len(t, audit.entries, 1)
+	assert.Equal(t, model.ImpersonationActionRequest, audit.entries[0].Action())
+	assert.Equal(t, http.StatusOK, audit.entries[0].StatusCode())
+}
+
+func TestImpersonate_WithoutHeaderPassesThrough(t *testing.T) {
+	handler, _, audit, seen, _ := setupImpersonation(t)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", "user_actor", ""))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "user_actor", (*seen)["user_id"])
+	assert.Empty(t, audit.entries)
+}
+
+func TestImpersonate_BlocksDangerousOperations(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodDelete, "/api/v1/tenants/abc/members/def"},
+		{http.MethodPost, "/api/v1/tenants/abc/closure"},
+		{http.MethodPost, "/api/v1/tenants/abc/invites"},
+		{http.MethodPost, "/api/v1/clients/abc/members"},
+		{http.MethodPost, "/api/v1/users/sync"},
+		{http.MethodPut, "/api/v1/admin/tenants/abc/suspension"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			handler, session, audit, seen, _ := setupImpersonation(t)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newImpersonatedRequest(tt.method, tt.path, "user_actor", session.ID().String()))
+
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+			assert.Empty(t, *seen)
+			require.Len(t, audit.entries, 1)
+			assert.Equal(t, model.ImpersonationActionBlocked, audit.entries[0].Action())
+		})
+	}
+}
+
+func TestImpersonate_RejectsInvalidSessions(t *testing.T) {
+	handler, session, _, _, _ := setupImpersonation(t)
+
+	tests := []struct {
+		name      string
+		actor     string
+		sessionID string
+	}{
+		{"malformed", "user_actor", "not-a-uuid"},
+		{"unknown", "user_actor", uuid.New().String()},
+		{"other_actor", "user_other", session.ID().String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", tt.actor, tt.sessionID))
+			assert.Equal(t, http.StatusForbidden, rr.Code)
+		})
+	}
+
+	t.Run("ended", func(t *testing.T) {
+		session.End()
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", "user_actor", session.ID().String()))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestImpersonate_EndsSessionWhenActorLosesAuthority(t *testing.T) {
+	handler, session, audit, seen, memberships := setupImpersonation(t)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", "user_actor", session.ID().String()))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	// The owner is demoted mid-session
+	memberships.roles[session.ActorUserID()] = "admin"
+	*seen = map[string]interface{}{}
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", "user_actor", session.ID().String()))
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+	assert.Empty(t, *seen)
+	assert.False(t, session.IsActive(), "the session is ended")
+	require.Len(t, audit.entries, 2)
+	assert.Equal(t, model.ImpersonationActionEnd, audit.entries[1].Action())
+
+	// Restoring the role does not revive the session
+	memberships.roles[session.ActorUserID()] = RoleOwner
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, newImpersonatedRequest(http.MethodGet, "/api/v1/clients/123", "user_actor", session.ID().String()))
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
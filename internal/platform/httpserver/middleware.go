@@ -10,13 +10,14 @@ import (
 )
 
 // CommonMiddleware sets up common HTTP middleware
-func CommonMiddleware(logger zerolog.Logger) []func(http.Handler) http.Handler {
+// Forwarded client IP headers are honored only from trustedProxies.
+func CommonMiddleware(logger zerolog.Logger, trustedProxies TrustedProxies) []func(http.Handler) http.Handler {
 	return []func(http.Handler) http.Handler{
 		httplog.RequestLogger(logger),
 		chimw.Recoverer,
 		chimw.Timeout(60 * time.Second),
 		chimw.RequestID,
-		chimw.RealIP,
+		RealIP(trustedProxies),
 	}
 }
 
@@ -0,0 +1,211 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details responses
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix namespaces problem type URIs; the code that follows it is stable
+const problemTypePrefix = "urn:farohq:problem:"
+
+// Generic problem codes for errors that have no more specific code
+const (
+	ProblemCodeBadRequest           = "bad_request"
+	ProblemCodeInvalidJSON          = "invalid_json"
+	ProblemCodeValidation           = "validation_failed"
+	ProblemCodeUnauthorized         = "unauthorized"
+	ProblemCodeForbidden            = "forbidden"
+	ProblemCodeNotFound             = "not_found"
+	ProblemCodeMethodNotAllowed     = "method_not_allowed"
+	ProblemCodeUnsupportedMediaType = "unsupported_media_type"
+	ProblemCodeInternal             = "internal_error"
+	ProblemCodeUnavailable          = "service_unavailable"
+)
+
+// Field error codes reported in a problem's errors list
+const (
+	FieldCodeRequired = "required"
+	FieldCodeInvalid  = "invalid"
+)
+
+// FieldError describes a problem with a single request field
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Problem is an RFC 7807 problem details response
+// Code is the stable machine-readable identifier (also the last segment of Type); Extensions carries
+// problem-specific members such as retry_after.
+type Problem struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Code       string
+	RequestID  string
+	Errors     []FieldError
+	Extensions map[string]interface{}
+}
+
+// NewProblem creates a problem with the given status, stable code and human-readable detail
+func NewProblem(status int, code, detail string) *Problem {
+	return &Problem{
+		Type:   problemTypePrefix + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// WithField adds a field-level error
+func (p *Problem) WithField(field, code, message string) *Problem {
+	p.Errors = append(p.Errors, FieldError{Field: field, Code: code, Message: message})
+	return p
+}
+
+// With adds a problem-specific extension member
+func (p *Problem) With(key string, value interface{}) *Problem {
+	if p.Extensions == nil {
+		p.Extensions = make(map[string]interface{})
+	}
+	p.Extensions[key] = value
+	return p
+}
+
+// MarshalJSON encodes the problem with its extension members inlined
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	body := make(map[string]interface{}, len(p.Extensions)+8)
+	for key, value := range p.Extensions {
+		body[key] = value
+	}
+	body["type"] = p.Type
+	body["title"] = p.Title
+	body["status"] = p.Status
+	body["code"] = p.Code
+	if p.Detail != "" {
+		body["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		body["instance"] = p.Instance
+	}
+	if p.RequestID != "" {
+		body["request_id"] = p.RequestID
+	}
+	if len(p.Errors) > 0 {
+		body["errors"] = p.Errors
+	}
+	return json.Marshal(body)
+}
+
+// WriteProblem writes a problem details response for the request
+// The request path and ID are filled in when the problem does not set them.
+func WriteProblem(w http.ResponseWriter, r *http.Request, p *Problem) {
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+	if p.RequestID == "" {
+		p.RequestID = chimw.GetReqID(r.Context())
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// WriteBadRequest writes a 400 problem
+func WriteBadRequest(w http.ResponseWriter, r *http.Request, detail string) {
+	WriteProblem(w, r, NewProblem(http.StatusBadRequest, ProblemCodeBadRequest, detail))
+}
+
+// WriteInvalidJSON writes a 400 problem for a request body that could not be decoded
+func WriteInvalidJSON(w http.ResponseWriter, r *http.Request) {
+	WriteProblem(w, r, NewProblem(http.StatusBadRequest, ProblemCodeInvalidJSON, "Request body is not valid JSON"))
+}
+
+// WriteInvalidField writes a 400 validation problem for a single invalid field
+func WriteInvalidField(w http.ResponseWriter, r *http.Request, field, detail string) {
+	WriteProblem(w, r, NewProblem(http.StatusBadRequest, ProblemCodeValidation, detail).
+		WithField(field, FieldCodeInvalid, detail))
+}
+
+// WriteMissingField writes a 400 validation problem for a single missing field
+func WriteMissingField(w http.ResponseWriter, r *http.Request, field, detail string) {
+	WriteProblem(w, r, NewProblem(http.StatusBadRequest, ProblemCodeValidation, detail).
+		WithField(field, FieldCodeRequired, detail))
+}
+
+// WriteUnauthorized writes a 401 problem
+func WriteUnauthorized(w http.ResponseWriter, r *http.Request, code, detail string) {
+	WriteProblem(w, r, NewProblem(http.StatusUnauthorized, code, detail))
+}
+
+// WriteForbidden writes a 403 problem
+func WriteForbidden(w http.ResponseWriter, r *http.Request, code, detail string) {
+	WriteProblem(w, r, NewProblem(http.StatusForbidden, code, detail))
+}
+
+// WriteNotFound writes a 404 problem
+func WriteNotFound(w http.ResponseWriter, r *http.Request, code, detail string) {
+	WriteProblem(w, r, NewProblem(http.StatusNotFound, code, detail))
+}
+
+// WriteInternalError writes a 500 problem; the cause is never exposed to the client
+func WriteInternalError(w http.ResponseWriter, r *http.Request) {
+	WriteProblem(w, r, NewProblem(http.StatusInternalServerError, ProblemCodeInternal, "Internal server error"))
+}
+
+// NotFoundHandler writes a 404 problem for requests that match no route
+func NotFoundHandler(w http.ResponseWriter, r *http.Request) {
+	WriteNotFound(w, r, ProblemCodeNotFound, "No route matches the request path")
+}
+
+// MethodNotAllowedHandler writes a 405 problem for routes that do not support the request method
+func MethodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	WriteProblem(w, r, NewProblem(http.StatusMethodNotAllowed, ProblemCodeMethodNotAllowed, "Method not allowed"))
+}
+
+// ErrorMapping maps a domain error to a problem
+type ErrorMapping struct {
+	Err    error
+	Status int
+	Code   string
+	Field  string // optional: the request field the error is about, reported in the problem's errors
+}
+
+// ErrorMapper maps domain errors to problems
+type ErrorMapper struct {
+	mappings []ErrorMapping
+}
+
+// NewErrorMapper creates an error mapper; mappings are matched in order with errors.Is
+func NewErrorMapper(mappings ...ErrorMapping) *ErrorMapper {
+	return &ErrorMapper{
+		mappings: mappings,
+	}
+}
+
+// Problem returns the problem for err, or nil if err is not mapped
+func (m *ErrorMapper) Problem(err error) *Problem {
+	for _, mapping := range m.mappings {
+		if !errors.Is(err, mapping.Err) {
+			continue
+		}
+		problem := NewProblem(mapping.Status, mapping.Code, mapping.Err.Error())
+		if mapping.Field != "" {
+			problem.WithField(mapping.Field, mapping.Code, mapping.Err.Error())
+		}
+		return problem
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteProblem(t *testing.T) {
+	handler := chimw.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteProblem(w, r, NewProblem(http.StatusTooManyRequests, RateLimitCodeExceeded, "Too many requests").
+			With("retry_after", 3))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+	req.Header.Set(chimw.RequestIDHeader, "req-123")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, ProblemContentType, rr.Header().Get("Content-Type"))
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(t, "urn:farohq:problem:rate_limited", body["type"])
+	assert.Equal(t, "Too Many Requests", body["title"])
+	assert.Equal(t, float64(http.StatusTooManyRequests), body["status"])
+	assert.Equal(t, RateLimitCodeExceeded, body["code"])
+	assert.Equal(t, "Too many requests", body["detail"])
+	assert.Equal(t, "/api/v1/tenants", body["instance"])
+	assert.Equal(t, "req-123", body["request_id"])
+	assert.Equal(t, float64(3), body["retry_after"])
+	assert.NotContains(t, body, "errors")
+}
+
+func TestErrorMapper(t *testing.T) {
+	errNotFound := errors.New("widget not found")
+	errInvalidName := errors.New("invalid widget name")
+	mapper := NewErrorMapper(
+		ErrorMapping{Err: errNotFound, Status: http.StatusNotFound, Code: "widget_not_found"},
+		ErrorMapping{Err: errInvalidName, Status: http.StatusBadRequest, Code: ProblemCodeValidation, Field: "name"},
+	)
+
+	problem := mapper.Problem(fmt.Errorf("lookup: %w", errNotFound))
+	require.NotNil(t, problem)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, "widget_not_found", problem.Code)
+	assert.Equal(t, "widget not found", problem.Detail)
+	assert.Empty(t, problem.Errors)
+
+	problem = mapper.Problem(errInvalidName)
+	require.NotNil(t, problem)
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, []FieldError{{Field: "name", Code: ProblemCodeValidation, Message: "invalid widget name"}}, problem.Errors)
+
+	assert.Nil(t, mapper.Problem(errors.New("connection refused")))
+}
@@ -0,0 +1,219 @@
+package httpserver
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+	"farohq-core-app/internal/platform/ratelimit"
+	"farohq-core-app/internal/platform/tenant"
+)
+
+// RateLimit response headers (IETF RateLimit header fields draft)
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
+)
+
+// RateLimitCodeExceeded is the machine-readable error code returned with 429 responses
+const RateLimitCodeExceeded = "rate_limited"
+
+// tierCacheTTL bounds how long a tenant's tier is reused for rate limiting before it is reloaded
+const tierCacheTTL = time.Minute
+
+// RateLimitKey selects what a rate limit policy counts requests by
+type RateLimitKey string
+
+const (
+	RateLimitByIP     RateLimitKey = "ip"
+	RateLimitByUser   RateLimitKey = "user"
+	RateLimitByTenant RateLimitKey = "tenant"
+)
+
+// RateLimitPolicy describes the rate limit for a class of routes
+type RateLimitPolicy struct {
+	Name              string // route class, e.g. "public" (part of the bucket key)
+	Key               RateLimitKey
+	RequestsPerMinute int  // zero disables the policy
+	WritesOnly        bool // only count non-read requests
+	TierScaled        bool // scale the limit by the tenant's tier (RateLimitByTenant only)
+}
+
+// RateLimiter enforces rate limit policies on route groups
+type RateLimiter struct {
+	limiter    ratelimit.Limiter
+	tenantRepo tenants_outbound.TenantRepository
+	logger     zerolog.Logger
+
+	mu    sync.Mutex
+	tiers map[uuid.UUID]cachedTier
+}
+
+// cachedTier is a tenant's tier as last loaded for rate limiting
+type cachedTier struct {
+	tier      *tenants_model.Tier
+	expiresAt time.Time
+}
+
+// NewRateLimiter creates a new rate limiter
+// tenantRepo is used to look up tiers for tier-scaled policies
+func NewRateLimiter(limiter ratelimit.Limiter, tenantRepo tenants_outbound.TenantRepository, logger zerolog.Logger) *RateLimiter {
+	return &RateLimiter{
+		limiter:    limiter,
+		tenantRepo: tenantRepo,
+		logger:     logger,
+		tiers:      make(map[uuid.UUID]cachedTier),
+	}
+}
+
+// Limit returns middleware enforcing the policy
+// Requests without the policy's key (e.g. no tenant context) are not counted.
+// Limiter errors fail open so a Redis outage does not take the API down.
+func (rl *RateLimiter) Limit(policy RateLimitPolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if policy.RequestsPerMinute <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if policy.WritesOnly && isSafeMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			subject, ok := rateLimitSubject(r, policy.Key)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			limit := ratelimit.PerMinute(policy.RequestsPerMinute)
+			if policy.TierScaled && policy.Key == RateLimitByTenant {
+				limit = limit.Scale(tenants_model.TierRateLimitMultiplier(rl.tenantTier(r.Context(), subject)))
+			}
+
+			result, err := rl.limiter.Allow(r.Context(), policy.Name+":"+string(policy.Key)+":"+subject, limit)
+			if err != nil {
+				rl.logger.Warn().
+					Err(err).
+					Str("policy", policy.Name).
+					Msg("Rate limiter unavailable, allowing request")
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			setRateLimitHeaders(w, policy, result)
+
+			if !result.Allowed {
+				rl.logger.Warn().
+					Str("policy", policy.Name).
+					Str("key", string(policy.Key)).
+					Str("subject", subject).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Msg("Request rejected: rate limit exceeded")
+				writeRateLimitError(w, r, result)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// tenantTier returns the tenant's tier, cached briefly to avoid a lookup per request
+func (rl *RateLimiter) tenantTier(ctx context.Context, subject string) *tenants_model.Tier {
+	tenantID, err := uuid.Parse(subject)
+	if err != nil || rl.tenantRepo == nil {
+		return nil
+	}
+
+	now := time.Now()
+	rl.mu.Lock()
+	cached, found := rl.tiers[tenantID]
+	rl.mu.Unlock()
+	if found && now.Before(cached.expiresAt) {
+		return cached.tier
+	}
+
+	var tier *tenants_model.Tier
+	if t, err := rl.tenantRepo.FindByID(ctx, tenantID); err == nil {
+		tier = t.Tier()
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	for id, entry := range rl.tiers {
+		if now.After(entry.expiresAt) {
+			delete(rl.tiers, id)
+		}
+	}
+	rl.tiers[tenantID] = cachedTier{tier: tier, expiresAt: now.Add(tierCacheTTL)}
+	return tier
+}
+
+// rateLimitSubject returns the value requests are counted by for the key
+func rateLimitSubject(r *http.Request, key RateLimitKey) (string, bool) {
+	switch key {
+	case RateLimitByIP:
+		// RemoteAddr holds the client IP once RealIP has run (the TCP peer unless it is a trusted proxy)
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		return host, host != ""
+	case RateLimitByUser:
+		userID, ok := r.Context().Value("user_id").(string)
+		return userID, ok && userID != ""
+	case RateLimitByTenant:
+		tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+		if !ok {
+			return "", false
+		}
+		return tenantID.String(), true
+	default:
+		return "", false
+	}
+}
+
+// setRateLimitHeaders reports the policy closest to its limit when several policies apply to a request
+func setRateLimitHeaders(w http.ResponseWriter, policy RateLimitPolicy, result ratelimit.Result) {
+	if existing := w.Header().Get(RateLimitRemainingHeader); existing != "" {
+		if remaining, err := strconv.Atoi(existing); err == nil && remaining <= result.Remaining {
+			return
+		}
+	}
+
+	w.Header().Set(RateLimitLimitHeader, strconv.Itoa(result.Limit))
+	w.Header().Set(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+	w.Header().Set(RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.Reset)))
+	w.Header().Set(RateLimitPolicyHeader, strconv.Itoa(result.Limit)+";w=60;name=\""+policy.Name+"\"")
+}
+
+// writeRateLimitError writes a 429 problem
+func writeRateLimitError(w http.ResponseWriter, r *http.Request, result ratelimit.Result) {
+	retryAfter := ceilSeconds(result.RetryAfter)
+
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	WriteProblem(w, r, NewProblem(http.StatusTooManyRequests, RateLimitCodeExceeded, "Too many requests. Please retry later.").
+		With("retry_after", retryAfter))
+}
+
+// ceilSeconds rounds a duration up to whole seconds (at least 1 for a non-zero duration)
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,82 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"farohq-core-app/internal/platform/ratelimit"
+)
+
+func TestRateLimiter_Limit(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       RateLimitPolicy
+		method       string
+		userID       string
+		expectedCode []int
+	}{
+		{
+			name:         "rejects requests over the limit by IP",
+			policy:       RateLimitPolicy{Name: "public", Key: RateLimitByIP, RequestsPerMinute: 2},
+			method:       http.MethodGet,
+			expectedCode: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:         "counts requests by user",
+			policy:       RateLimitPolicy{Name: "authenticated", Key: RateLimitByUser, RequestsPerMinute: 1},
+			method:       http.MethodGet,
+			userID:       "user_123",
+			expectedCode: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:         "skips requests without the key",
+			policy:       RateLimitPolicy{Name: "authenticated", Key: RateLimitByUser, RequestsPerMinute: 1},
+			method:       http.MethodGet,
+			expectedCode: []int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name:         "write policies ignore reads",
+			policy:       RateLimitPolicy{Name: "write", Key: RateLimitByIP, RequestsPerMinute: 1, WritesOnly: true},
+			method:       http.MethodGet,
+			expectedCode: []int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name:         "zero disables the policy",
+			policy:       RateLimitPolicy{Name: "public", Key: RateLimitByIP},
+			method:       http.MethodPost,
+			expectedCode: []int{http.StatusOK, http.StatusOK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rl := NewRateLimiter(ratelimit.NewMemoryLimiter(), nil, zerolog.Nop())
+			handler := rl.Limit(tt.policy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			for i, expected := range tt.expectedCode {
+				req := httptest.NewRequest(tt.method, "/api/v1/invites/token", nil)
+				req.RemoteAddr = "203.0.113.7:4321"
+				if tt.userID != "" {
+					req = req.WithContext(context.WithValue(req.Context(), "user_id", tt.userID))
+				}
+				rr := httptest.NewRecorder()
+
+				handler.ServeHTTP(rr, req)
+
+				assert.Equal(t, expected, rr.Code, "request %d", i+1)
+				if expected == http.StatusTooManyRequests {
+					assert.Equal(t, "0", rr.Header().Get(RateLimitRemainingHeader))
+					assert.NotEmpty(t, rr.Header().Get("Retry-After"))
+					assert.Contains(t, rr.Body.String(), RateLimitCodeExceeded)
+				}
+			}
+		})
+	}
+}
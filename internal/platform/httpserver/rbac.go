@@ -15,6 +15,13 @@ const (
 	RoleClientViewer = "client_viewer"
 )
 
+// Machine-readable problem codes returned when authorization fails
+const (
+	RBACCodeNoRole                 = "role_required"
+	RBACCodeInsufficientPermission = "insufficient_permissions"
+	RBACCodePlatformAdminRequired  = "platform_admin_required"
+)
+
 // GetRoleFromContext returns the normalized role from request context (set by RequireAuth).
 // Clerk may send "org:admin" or "admin"; we normalize to lowercase and strip "org:" prefix.
 // Returns empty string if no role in context.
@@ -63,11 +70,11 @@ func RequireRole(allowedRoles ...string) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			role := GetRoleFromContext(r)
 			if role == "" {
-				http.Error(w, "Forbidden: no role in context", http.StatusForbidden)
+				WriteForbidden(w, r, RBACCodeNoRole, "Forbidden: no role in context")
 				return
 			}
 			if _, ok := allowed[role]; !ok {
-				http.Error(w, "Forbidden: insufficient permissions", http.StatusForbidden)
+				WriteForbidden(w, r, RBACCodeInsufficientPermission, "Forbidden: insufficient permissions")
 				return
 			}
 			next.ServeHTTP(w, r)
@@ -79,3 +86,25 @@ func RequireRole(allowedRoles ...string) func(http.Handler) http.Handler {
 func RequireOwnerOrAdmin(next http.Handler) http.Handler {
 	return RequireRole(RoleOwner, RoleAdmin)(next)
 }
+
+// RequirePlatformAdmin returns middleware that allows only platform admins (FaroHQ staff) to proceed.
+// Platform admins are identified by Clerk user ID (user_id in context, set by RequireAuth).
+// Should be used after RequireAuth. Responds with 403 if the caller is not a platform admin.
+func RequirePlatformAdmin(adminUserIDs []string) func(http.Handler) http.Handler {
+	admins := make(map[string]struct{}, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userID, _ := r.Context().Value("user_id").(string)
+			if _, ok := admins[userID]; !ok || userID == "" {
+				WriteForbidden(w, r, RBACCodePlatformAdminRequired, "Forbidden: platform admin access required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package httpserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies are the networks of reverse proxies whose forwarded client IP headers are honored
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses CIDRs or single IP addresses
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// contains reports whether ip belongs to a trusted proxy
+func (tp TrustedProxies) contains(ip net.IP) bool {
+	for _, network := range tp {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// RealIP sets RemoteAddr to the client IP
+// Forwarded headers are honored only when the TCP peer is a trusted proxy, so clients cannot choose the IP
+// they are rate limited and logged by. X-Forwarded-For is read right to left, skipping trusted hops.
+func RealIP(trusted TrustedProxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ip := forwardedClientIP(r, trusted); ip != "" {
+				r.RemoteAddr = ip
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedClientIP returns the client IP reported by a trusted proxy, or "" to keep the TCP peer
+func forwardedClientIP(r *http.Request, trusted TrustedProxies) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer := net.ParseIP(host)
+	if peer == nil || !trusted.contains(peer) {
+		return ""
+	}
+
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		client := ""
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				break
+			}
+			client = ip.String()
+			if !trusted.contains(ip) {
+				break
+			}
+		}
+		return client
+	}
+
+	for _, header := range []string{"True-Client-IP", "X-Real-IP"} {
+		if ip := net.ParseIP(strings.TrimSpace(r.Header.Get(header))); ip != nil {
+			return ip.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"farohq-core-app/internal/platform/ratelimit"
+)
+
+func TestRealIP(t *testing.T) {
+	proxies := []string{"10.0.0.0/8", "192.0.2.1"}
+
+	tests := []struct {
+		name       string
+		proxies    []string
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		{
+			name:       "untrusted peers cannot spoof X-Forwarded-For",
+			proxies:    proxies,
+			remoteAddr: "203.0.113.7:4321",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			expected:   "203.0.113.7:4321",
+		},
+		{
+			name:       "untrusted peers cannot spoof X-Real-IP or True-Client-IP",
+			proxies:    proxies,
+			remoteAddr: "203.0.113.7:4321",
+			headers:    map[string]string{"X-Real-IP": "198.51.100.1", "True-Client-IP": "198.51.100.2"},
+			expected:   "203.0.113.7:4321",
+		},
+		{
+			name:       "trusted proxies report the client",
+			proxies:    proxies,
+			remoteAddr: "10.1.2.3:4321",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "client-supplied hops left of the first untrusted address are ignored",
+			proxies:    proxies,
+			remoteAddr: "10.1.2.3:4321",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1, 192.0.2.1"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "trusted proxies may report the client in X-Real-IP",
+			proxies:    proxies,
+			remoteAddr: "192.0.2.1:4321",
+			headers:    map[string]string{"X-Real-IP": "198.51.100.1"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "no trusted proxies keeps the peer",
+			remoteAddr: "10.1.2.3:4321",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			expected:   "10.1.2.3:4321",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trusted, err := ParseTrustedProxies(tt.proxies)
+			require.NoError(t, err)
+
+			var seen string
+			handler := RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = r.RemoteAddr
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.expected, seen)
+		})
+	}
+}
+
+func TestParseTrustedProxies_RejectsInvalidEntries(t *testing.T) {
+	_, err := ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = ParseTrustedProxies([]string{"proxy.internal"})
+	assert.Error(t, err)
+}
+
+func TestRateLimiter_IgnoresSpoofedForwardedHeaders(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	rl := NewRateLimiter(ratelimit.NewMemoryLimiter(), nil, zerolog.Nop())
+	handler := RealIP(trusted)(rl.Limit(RateLimitPolicy{Name: "public", Key: RateLimitByIP, RequestsPerMinute: 2})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})))
+
+	// An untrusted client rotating forwarded headers still shares one bucket
+	for i, expected := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/invites/token", nil)
+		req.RemoteAddr = "203.0.113.7:4321"
+		req.Header.Set("X-Forwarded-For", fmt.Sprintf("198.51.100.%d", i+1))
+		req.Header.Set("X-Real-IP", fmt.Sprintf("198.51.100.%d", i+1))
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, expected, rr.Code, "request %d", i+1)
+	}
+
+	// Nor can it drain another client's bucket
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/invites/token", nil)
+	req.RemoteAddr = "198.51.100.9:4321"
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
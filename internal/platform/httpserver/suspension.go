@@ -0,0 +1,182 @@
+package httpserver
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	tenants_domain "farohq-core-app/internal/domains/tenants/domain"
+	tenants_model "farohq-core-app/internal/domains/tenants/domain/model"
+	tenants_outbound "farohq-core-app/internal/domains/tenants/domain/ports/outbound"
+	"farohq-core-app/internal/platform/tenant"
+)
+
+// Machine-readable error codes returned when a request is rejected due to suspension
+const (
+	SuspensionCodeTenantSuspended = "tenant_suspended"
+	SuspensionCodeClientSuspended = "client_suspended"
+	SuspensionCodeTenantClosing   = "tenant_closing"
+)
+
+// SuspensionAccessHeader is set on allowed (read) requests to a read-only suspended tenant
+// so clients can render a banner without an extra request
+const SuspensionAccessHeader = "X-Suspension-Access"
+
+// EnforceSuspension middleware enforces tenant and client suspension
+// Suspended tenants get read-only or blocked access depending on the suspension reason.
+// Suspended clients are blocked for client viewers and read-only for agency members.
+// Tenants with a pending closure are read-only, except for cancelling the closure and exporting data.
+// Must run after TenantResolutionWithAuth (requires tenant context).
+func EnforceSuspension(
+	tenantRepo tenants_outbound.TenantRepository,
+	clientRepo tenants_outbound.ClientRepository,
+	logger zerolog.Logger,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenantID, ok := tenant.GetTenantUUIDFromContext(r.Context())
+			if !ok {
+				// RequireTenantContext handles missing tenant context
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			t, err := tenantRepo.FindByID(r.Context(), tenantID)
+			if err != nil {
+				if err == tenants_domain.ErrTenantNotFound {
+					next.ServeHTTP(w, r)
+					return
+				}
+				logger.Error().Err(err).Str("tenant_id", tenantID.String()).Msg("Failed to load tenant for suspension check")
+				WriteInternalError(w, r)
+				return
+			}
+
+			if t.IsSuspended() {
+				access := t.SuspensionAccess()
+				if access == tenants_model.SuspensionAccessBlocked || !isSafeMethod(r.Method) {
+					logger.Warn().
+						Str("tenant_id", tenantID.String()).
+						Str("access", string(access)).
+						Str("method", r.Method).
+						Str("path", r.URL.Path).
+						Msg("Request rejected: tenant suspended")
+					writeSuspensionError(w, r, SuspensionCodeTenantSuspended, t.Suspension(), access)
+					return
+				}
+				w.Header().Set(SuspensionAccessHeader, string(access))
+			}
+
+			if t.IsClosing() {
+				if !isSafeMethod(r.Method) && !isClosureExemptPath(r.URL.Path) {
+					logger.Warn().
+						Str("tenant_id", tenantID.String()).
+						Str("method", r.Method).
+						Str("path", r.URL.Path).
+						Msg("Request rejected: tenant closing")
+					writeClosureError(w, r, t.Closure())
+					return
+				}
+				w.Header().Set(SuspensionAccessHeader, string(tenants_model.SuspensionAccessReadOnly))
+			}
+
+			clientID, ok := clientIDFromRequest(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			client, err := clientRepo.FindByID(r.Context(), clientID)
+			if err != nil || client.AgencyID() != tenantID || !client.IsSuspended() {
+				// Unknown clients and clients of other tenants are handled by the route handlers
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Suspended clients are hidden from client viewers; agency members keep read-only access
+			access := tenants_model.SuspensionAccessReadOnly
+			if GetRoleFromContext(r) == RoleClientViewer {
+				access = tenants_model.SuspensionAccessBlocked
+			}
+
+			if access == tenants_model.SuspensionAccessBlocked || !isSafeMethod(r.Method) {
+				logger.Warn().
+					Str("tenant_id", tenantID.String()).
+					Str("client_id", clientID.String()).
+					Str("access", string(access)).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Msg("Request rejected: client suspended")
+				writeSuspensionError(w, r, SuspensionCodeClientSuspended, client.Suspension(), access)
+				return
+			}
+			w.Header().Set(SuspensionAccessHeader, string(access))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIDFromRequest returns the client targeted by the request
+// Prefers the client context (client_id query param or X-Client-ID header), then /clients/{id} paths
+func clientIDFromRequest(r *http.Request) (uuid.UUID, bool) {
+	if clientID, ok := tenant.GetClientUUIDFromContext(r.Context()); ok {
+		return clientID, true
+	}
+	parts := strings.Split(r.URL.Path, "/")
+	for i, part := range parts {
+		if part == "clients" && i+1 < len(parts) {
+			if clientID, err := uuid.Parse(parts[i+1]); err == nil {
+				return clientID, true
+			}
+		}
+	}
+	return uuid.Nil, false
+}
+
+// isClosureExemptPath reports whether a write is still allowed while the tenant is closing
+// (cancelling the closure and exporting data before it is purged)
+func isClosureExemptPath(path string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(path, "/"), "/closure") || strings.Contains(path, "/exports")
+}
+
+// isSafeMethod reports whether the HTTP method is read-only
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// writeSuspensionError writes a 403 problem describing the suspension
+func writeSuspensionError(w http.ResponseWriter, r *http.Request, code string, suspension *tenants_model.Suspension, access tenants_model.SuspensionAccess) {
+	message := "This organization has been suspended. Please contact support."
+	if code == SuspensionCodeClientSuspended {
+		message = "This client account has been suspended. Please contact your agency."
+	}
+	if access == tenants_model.SuspensionAccessReadOnly {
+		message = "This account is suspended and in read-only mode. Changes are not allowed."
+	}
+
+	problem := NewProblem(http.StatusForbidden, code, message).
+		With("reason", "unspecified").
+		With("access", string(access)).
+		With("suspended_at", nil)
+	if suspension != nil {
+		problem.With("reason", suspension.Reason().String())
+		problem.With("suspended_at", suspension.SuspendedAt().Format(time.RFC3339))
+	}
+	WriteProblem(w, r, problem)
+}
+
+// writeClosureError writes a 403 problem for tenants with a pending closure
+func writeClosureError(w http.ResponseWriter, r *http.Request, closure *tenants_model.TenantClosure) {
+	problem := NewProblem(http.StatusForbidden, SuspensionCodeTenantClosing,
+		"This organization is scheduled for closure and is read-only. Cancel the closure to make changes.").
+		With("access", string(tenants_model.SuspensionAccessReadOnly)).
+		With("purge_after", nil)
+	if closure != nil {
+		problem.With("purge_after", closure.PurgeAfter().Format(time.RFC3339))
+	}
+	WriteProblem(w, r, problem)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -13,6 +14,22 @@ import (
 	users_outbound "farohq-core-app/internal/domains/users/domain/ports/outbound"
 )
 
+// Response headers reporting how the request's tenant was resolved
+const (
+	TenantIDHeader       = "X-Tenant-ID"
+	TenantSourceHeader   = "X-Tenant-Source"
+	TenantFallbackHeader = "X-Tenant-Fallback" // default, last_used or first_accessible; absent when a source identified the tenant
+)
+
+// Machine-readable problem codes returned when tenant resolution fails
+const (
+	TenantCodeRequired       = "tenant_required"
+	TenantCodeNoTenants      = "no_accessible_tenants"
+	TenantCodeAccessDenied   = "tenant_access_denied"
+	TenantCodeUserNotFound   = "user_not_found"
+	TenantCodeOutsideSession = "impersonation_tenant_mismatch"
+)
+
 // TenantResolution middleware that resolves tenant from request and sets RLS context
 func TenantResolution(tenantResolver *tenant.Resolver, db *pgxpool.Pool, logger zerolog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -67,7 +84,7 @@ func TenantResolution(tenantResolver *tenant.Resolver, db *pgxpool.Pool, logger
 			conn, err := db.Acquire(r.Context())
 			if err != nil {
 				logger.Error().Err(err).Msg("Failed to acquire database connection for RLS context")
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				WriteInternalError(w, r)
 				return
 			}
 			defer conn.Release()
@@ -79,7 +96,7 @@ func TenantResolution(tenantResolver *tenant.Resolver, db *pgxpool.Pool, logger
 					Str("tenant_id", tenantID).
 					Err(err).
 					Msg("Failed to set tenant context in database")
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				WriteInternalError(w, r)
 				return
 			}
 
@@ -145,11 +162,16 @@ func TenantResolutionWithAuth(
 				r.URL.Path == "/api/v1/users/sync" ||
 				(r.Method == "POST" && r.URL.Path == "/api/v1/tenants") ||
 				(r.Method == "POST" && r.URL.Path == "/api/v1/tenants/onboard") ||
-				(r.Method == "POST" && r.URL.Path == "/api/v1/invites/accept") {
+				(r.Method == "POST" && r.URL.Path == "/api/v1/invites/accept") ||
+				(r.Method == "POST" && r.URL.Path == "/api/v1/users/me/switch-tenant") ||
+				strings.HasPrefix(r.URL.Path, impersonationManagementPath) {
 				next.ServeHTTP(w, r)
 				return
 			}
 
+			// The session bootstrap works before the user is synced or belongs to any organization
+			tenantOptional := r.URL.Path == "/api/v1/session"
+
 			// Extract user_id from context (set by RequireAuth middleware)
 			clerkUserID, ok := r.Context().Value("user_id").(string)
 			if !ok {
@@ -165,6 +187,10 @@ func TenantResolutionWithAuth(
 
 			// Look up user by Clerk user ID to get database UUID
 			user, err := userRepo.FindByClerkUserID(r.Context(), clerkUserID)
+			if err != nil && tenantOptional {
+				next.ServeHTTP(w, r)
+				return
+			}
 			if err != nil {
 				logger.Error().
 					Str("clerk_user_id", clerkUserID).
@@ -172,7 +198,7 @@ func TenantResolutionWithAuth(
 					Str("path", r.URL.Path).
 					Err(err).
 					Msg("Failed to find user by Clerk user ID for tenant resolution")
-				http.Error(w, "User not found", http.StatusNotFound)
+				WriteNotFound(w, r, TenantCodeUserNotFound, "User not found")
 				return
 			}
 
@@ -198,13 +224,17 @@ func TenantResolutionWithAuth(
 				// Handle specific errors
 				switch err {
 				case tenant.ErrNoAccessibleTenants:
+					if tenantOptional {
+						next.ServeHTTP(w, r)
+						return
+					}
 					logger.Warn().
 						Str("user_id", user.ID().String()).
 						Str("clerk_user_id", clerkUserID).
 						Str("method", r.Method).
 						Str("path", r.URL.Path).
 						Msg("User has no accessible tenants")
-					http.Error(w, "You don't have access to any organizations. Please contact support.", http.StatusForbidden)
+					WriteForbidden(w, r, TenantCodeNoTenants, "You don't have access to any organizations. Please contact support.")
 					return
 				case tenant.ErrTenantAccessDenied:
 					logger.Warn().
@@ -213,7 +243,7 @@ func TenantResolutionWithAuth(
 						Str("method", r.Method).
 						Str("path", r.URL.Path).
 						Msg("User denied access to requested tenant")
-					http.Error(w, "You don't have access to this organization.", http.StatusForbidden)
+					WriteForbidden(w, r, TenantCodeAccessDenied, "You don't have access to this organization.")
 					return
 				default:
 					logger.Error().
@@ -223,11 +253,22 @@ func TenantResolutionWithAuth(
 						Str("path", r.URL.Path).
 						Err(err).
 						Msg("Failed to resolve tenant with validation")
-					http.Error(w, "Failed to resolve tenant", http.StatusInternalServerError)
+					WriteInternalError(w, r)
 					return
 				}
 			}
 
+			// An impersonation session only grants access to its own tenant
+			if impersonation, ok := GetImpersonationFromContext(r.Context()); ok && result.TenantID != impersonation.TenantID {
+				logger.Warn().
+					Str("session_id", impersonation.SessionID).
+					Str("resolved_tenant_id", result.TenantID).
+					Str("session_tenant_id", impersonation.TenantID).
+					Msg("Impersonated request resolved to a tenant outside the session")
+				WriteForbidden(w, r, TenantCodeOutsideSession, "Impersonation session does not cover this organization.")
+				return
+			}
+
 			// Log tenant resolution result
 			logEvent := logger.Info().
 				Str("user_id", user.ID().String()).
@@ -236,6 +277,7 @@ func TenantResolutionWithAuth(
 				Str("resolution_source", string(result.Source)).
 				Bool("validated", result.Validated).
 				Bool("fallback_used", result.FallbackUsed).
+				Str("fallback", string(result.Fallback)).
 				Str("method", r.Method).
 				Str("path", r.URL.Path)
 
@@ -245,8 +287,16 @@ func TenantResolutionWithAuth(
 				logEvent.Msg("Tenant resolved successfully")
 			}
 
+			// Report the resolution decision so clients can tell when they landed in a fallback tenant
+			w.Header().Set(TenantIDHeader, result.TenantID)
+			w.Header().Set(TenantSourceHeader, string(result.Source))
+			if result.Fallback != "" {
+				w.Header().Set(TenantFallbackHeader, string(result.Fallback))
+			}
+
 			// Set tenant context in Go context
 			ctx := tenantResolver.SetTenantContext(r.Context(), result.TenantID)
+			ctx = tenantResolver.SetResolutionContext(ctx, result)
 			r = r.WithContext(ctx)
 
 			// Resolve client (optional - from query param or header)
@@ -264,7 +314,7 @@ func TenantResolutionWithAuth(
 			conn, err := db.Acquire(r.Context())
 			if err != nil {
 				logger.Error().Err(err).Msg("Failed to acquire database connection for RLS context")
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				WriteInternalError(w, r)
 				return
 			}
 			defer conn.Release()
@@ -276,7 +326,7 @@ func TenantResolutionWithAuth(
 					Str("tenant_id", result.TenantID).
 					Err(err).
 					Msg("Failed to set tenant context in database")
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				WriteInternalError(w, r)
 				return
 			}
 
@@ -332,12 +382,12 @@ func RequireTenantContext(next http.Handler) http.Handler {
 			json.NewEncoder(logFile2).Encode(map[string]interface{}{"timestamp": time.Now().UnixMilli(), "location": "tenant.go:125", "message": "RequireTenantContext: tenant context missing, rejecting", "hypothesisId": "H2", "sessionId": "debug-session", "runId": "run1", "data": map[string]interface{}{"path": r.URL.Path, "method": r.Method}})
 			logFile2.Close()
 			// #endregion
-			http.Error(w, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain.", http.StatusBadRequest)
+			WriteProblem(w, r, NewProblem(http.StatusBadRequest, TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
 			return
 		}
 		// Ensure tenantID is not empty
 		if tenantID == "" {
-			http.Error(w, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain.", http.StatusBadRequest)
+			WriteProblem(w, r, NewProblem(http.StatusBadRequest, TenantCodeRequired, "Failed to resolve tenant. Provide X-Tenant-ID header or use a tenant domain."))
 			return
 		}
 		next.ServeHTTP(w, r)
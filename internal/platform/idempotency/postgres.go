@@ -0,0 +1,129 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore implements Store on the idempotency_keys table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a new PostgreSQL idempotency store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{
+		db: db,
+	}
+}
+
+// Begin claims the key, taking over expired keys and in-progress claims whose lease lapsed
+func (s *PostgresStore) Begin(ctx context.Context, scope Scope, fingerprint string, lease, ttl time.Duration) (*Record, error) {
+	claim := `
+		INSERT INTO idempotency_keys (tenant_id, user_id, idempotency_key, fingerprint, locked_until, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, user_id, idempotency_key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			status_code = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			created_at = NOW(),
+			completed_at = NULL,
+			locked_until = EXCLUDED.locked_until,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+			OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until <= NOW())
+		RETURNING fingerprint
+	`
+
+	now := time.Now()
+	var claimed string
+	err := s.db.QueryRow(ctx, claim, scope.TenantID, scope.UserID, scope.Key, fingerprint, now.Add(lease), now.Add(ttl)).Scan(&claimed)
+	if err == nil {
+		return nil, nil
+	}
+	if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	// The key is held by an unexpired request
+	existing := `
+		SELECT fingerprint, status_code, response_headers, response_body
+		FROM idempotency_keys
+		WHERE tenant_id = $1 AND user_id = $2 AND idempotency_key = $3
+	`
+
+	var (
+		storedFingerprint string
+		statusCode        *int
+		headers           []byte
+		body              []byte
+	)
+	err = s.db.QueryRow(ctx, existing, scope.TenantID, scope.UserID, scope.Key).Scan(&storedFingerprint, &statusCode, &headers, &body)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// Released concurrently; report it as in progress so the client retries
+			return &Record{Fingerprint: fingerprint}, nil
+		}
+		return nil, err
+	}
+
+	record := &Record{Fingerprint: storedFingerprint}
+	if statusCode != nil {
+		header := http.Header{}
+		if len(headers) > 0 {
+			if err := json.Unmarshal(headers, &header); err != nil {
+				return nil, err
+			}
+		}
+		record.Response = &Response{
+			StatusCode: *statusCode,
+			Header:     header,
+			Body:       body,
+		}
+	}
+
+	return record, nil
+}
+
+// Complete stores the response for a claimed key
+func (s *PostgresStore) Complete(ctx context.Context, scope Scope, response *Response) error {
+	headers, err := json.Marshal(response.Header)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $4, response_headers = $5, response_body = $6, completed_at = NOW(), locked_until = NULL
+		WHERE tenant_id = $1 AND user_id = $2 AND idempotency_key = $3
+	`
+
+	_, err = s.db.Exec(ctx, query, scope.TenantID, scope.UserID, scope.Key, response.StatusCode, headers, response.Body)
+	return err
+}
+
+// Release drops a key that has not completed
+func (s *PostgresStore) Release(ctx context.Context, scope Scope) error {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE tenant_id = $1 AND user_id = $2 AND idempotency_key = $3 AND status_code IS NULL
+	`
+
+	_, err := s.db.Exec(ctx, query, scope.TenantID, scope.UserID, scope.Key)
+	return err
+}
+
+// PurgeExpired deletes keys that expired before the given time
+func (s *PostgresStore) PurgeExpired(ctx context.Context, before time.Time) (int, error) {
+	tag, err := s.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
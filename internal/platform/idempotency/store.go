@@ -0,0 +1,44 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scope identifies who a key belongs to; the same key from another tenant or user is a different key
+type Scope struct {
+	TenantID uuid.UUID
+	UserID   string
+	Key      string
+}
+
+// Response is a stored response replayed to retries
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Record is the stored state of an idempotency key
+type Record struct {
+	Fingerprint string
+	Response    *Response // nil while the first request is in progress
+}
+
+// Store persists idempotency keys
+type Store interface {
+	// Begin claims the key for a request with the given fingerprint
+	// The claim is held for lease while the request runs and the key is kept for ttl; a claim whose lease lapsed
+	// without a response (the process crashed) is taken over.
+	// Returns nil when the key was claimed, or the existing record when it was already used.
+	Begin(ctx context.Context, scope Scope, fingerprint string, lease, ttl time.Duration) (*Record, error)
+	// Complete stores the response for a claimed key
+	Complete(ctx context.Context, scope Scope, response *Response) error
+	// Release drops a claimed key so the request can be retried (e.g. after a server error)
+	Release(ctx context.Context, scope Scope) error
+	// PurgeExpired deletes expired keys and returns how many were removed
+	PurgeExpired(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,248 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	chimw "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+func init() {
+	// PATCH bodies are JSON Merge Patch documents; decode them like any other JSON body
+	openapi3filter.RegisterBodyDecoder(httpserver.MergePatchContentType, openapi3filter.JSONBodyDecoder)
+	// Stylesheets (the brand theme) are validated as plain strings
+	openapi3filter.RegisterBodyDecoder("text/css", openapi3filter.PlainBodyDecoder)
+
+	// Accept exactly the IDs the handlers accept
+	openapi3.DefineStringFormatCallback("uuid", func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	})
+}
+
+// Options configures a Validator
+type Options struct {
+	// ValidateResponses checks responses against the spec and logs mismatches (the response is sent unchanged)
+	ValidateResponses bool
+}
+
+// Operation is a method and path documented in the spec
+type Operation struct {
+	Method string
+	Path   string
+}
+
+// Validator validates requests, and optionally responses, against an OpenAPI document
+type Validator struct {
+	doc               *openapi3.T
+	router            routers.Router
+	validateResponses bool
+	logger            zerolog.Logger
+}
+
+// NewValidator loads and validates an OpenAPI document
+func NewValidator(spec []byte, logger zerolog.Logger, opts Options) (*Validator, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+
+	// Match on the path only, so the spec's server URLs do not constrain the host the API is served from
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+
+	return &Validator{
+		doc:               doc,
+		router:            router,
+		validateResponses: opts.ValidateResponses,
+		logger:            logger,
+	}, nil
+}
+
+// Operations returns every operation documented in the spec, sorted by path and method
+func (v *Validator) Operations() []Operation {
+	var operations []Operation
+	for path, item := range v.doc.Paths.Map() {
+		for method := range item.Operations() {
+			operations = append(operations, Operation{Method: strings.ToUpper(method), Path: path})
+		}
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Path != operations[j].Path {
+			return operations[i].Path < operations[j].Path
+		}
+		return operations[i].Method < operations[j].Method
+	})
+	return operations
+}
+
+// Middleware rejects requests whose parameters or body do not match the spec with a 400 problem
+// Requests the spec does not document are passed through; the router answers them with 404 or 405.
+// Authentication is left to the auth middleware.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+				MultiError:          true,
+				SkipSettingDefaults: true,
+			},
+		}
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			writeRequestError(w, r, err)
+			return
+		}
+
+		if !v.validateResponses {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var captured bytes.Buffer
+		ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+		ww.Tee(&captured)
+
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		responseInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 status,
+			Header:                 ww.Header(),
+			Options:                &openapi3filter.Options{MultiError: true},
+		}
+		responseInput.SetBodyBytes(captured.Bytes())
+		if err := openapi3filter.ValidateResponse(r.Context(), responseInput); err != nil {
+			v.logger.Warn().
+				Err(err).
+				Str("method", r.Method).
+				Str("path", r.URL.Path).
+				Str("route", route.Path).
+				Int("status", status).
+				Msg("Response does not match the OpenAPI spec")
+		}
+	})
+}
+
+// writeRequestError writes the problem for a request that failed validation
+func writeRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	requestErrors := flattenRequestErrors(err)
+
+	for _, requestErr := range requestErrors {
+		if requestErr.RequestBody == nil {
+			continue
+		}
+		if strings.HasPrefix(requestErr.Reason, "header Content-Type has unexpected value") {
+			if r.Method == http.MethodPatch {
+				httpserver.WriteUnsupportedPatch(w, r)
+				return
+			}
+			httpserver.WriteProblem(w, r, httpserver.NewProblem(http.StatusUnsupportedMediaType, httpserver.ProblemCodeUnsupportedMediaType,
+				"Unsupported Content-Type "+r.Header.Get("Content-Type")))
+			return
+		}
+		if requestErr.Reason == "failed to decode request body" {
+			httpserver.WriteInvalidJSON(w, r)
+			return
+		}
+	}
+
+	problem := httpserver.NewProblem(http.StatusBadRequest, httpserver.ProblemCodeValidation, "Request does not match the API schema")
+	for _, requestErr := range requestErrors {
+		problem.Errors = append(problem.Errors, fieldErrors(requestErr)...)
+	}
+	httpserver.WriteProblem(w, r, problem)
+}
+
+// flattenRequestErrors collects the request errors of a (multi) validation error
+func flattenRequestErrors(err error) []*openapi3filter.RequestError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var requestErrors []*openapi3filter.RequestError
+		for _, inner := range e {
+			requestErrors = append(requestErrors, flattenRequestErrors(inner)...)
+		}
+		return requestErrors
+	case *openapi3filter.RequestError:
+		return []*openapi3filter.RequestError{e}
+	default:
+		return []*openapi3filter.RequestError{{Reason: err.Error()}}
+	}
+}
+
+// fieldErrors describes a request error per offending parameter or body field
+func fieldErrors(requestErr *openapi3filter.RequestError) []httpserver.FieldError {
+	if parameter := requestErr.Parameter; parameter != nil {
+		if requestErr.Err == openapi3filter.ErrInvalidRequired {
+			return []httpserver.FieldError{{Field: parameter.Name, Code: httpserver.FieldCodeRequired, Message: parameter.Name + " is required"}}
+		}
+		message := parameter.Name + " is invalid"
+		if schemaErr, ok := requestErr.Err.(*openapi3.SchemaError); ok {
+			message += ": " + schemaErr.Reason
+		}
+		return []httpserver.FieldError{{Field: parameter.Name, Code: httpserver.FieldCodeInvalid, Message: message}}
+	}
+
+	if requestErr.RequestBody != nil {
+		if requestErr.Err == openapi3filter.ErrInvalidRequired {
+			return []httpserver.FieldError{{Field: "body", Code: httpserver.FieldCodeRequired, Message: "request body is required"}}
+		}
+		return schemaFieldErrors(requestErr.Err)
+	}
+
+	return []httpserver.FieldError{{Field: "request", Code: httpserver.FieldCodeInvalid, Message: requestErr.Error()}}
+}
+
+// schemaFieldErrors describes body schema errors by the dotted path of the offending field
+func schemaFieldErrors(err error) []httpserver.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var fieldErrs []httpserver.FieldError
+		for _, inner := range e {
+			fieldErrs = append(fieldErrs, schemaFieldErrors(inner)...)
+		}
+		return fieldErrs
+	case *openapi3.SchemaError:
+		field := strings.Join(e.JSONPointer(), ".")
+		if field == "" {
+			field = "body"
+		}
+		code := httpserver.FieldCodeInvalid
+		if e.SchemaField == "required" {
+			code = httpserver.FieldCodeRequired
+		}
+		return []httpserver.FieldError{{Field: field, Code: code, Message: e.Reason}}
+	case nil:
+		return nil
+	default:
+		return []httpserver.FieldError{{Field: "body", Code: httpserver.FieldCodeInvalid, Message: err.Error()}}
+	}
+}
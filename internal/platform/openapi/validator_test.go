@@ -0,0 +1,175 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"farohq-core-app/api"
+	"farohq-core-app/internal/platform/httpserver"
+)
+
+const testTenantID = "3f5a2b1c-4d6e-4f80-9a1b-2c3d4e5f6a7b"
+
+func newTestValidator(t *testing.T, opts Options, logger zerolog.Logger) *Validator {
+	t.Helper()
+	validator, err := NewValidator(api.Spec, logger, opts)
+	require.NoError(t, err)
+	return validator
+}
+
+func serve(validator *Validator, handler http.HandlerFunc, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	validator.Middleware(handler).ServeHTTP(rr, req)
+	return rr
+}
+
+func decodeProblem(t *testing.T, rr *httptest.ResponseRecorder) httpserver.Problem {
+	t.Helper()
+	assert.Equal(t, httpserver.ProblemContentType, rr.Header().Get("Content-Type"))
+	var body struct {
+		Code   string                  `json:"code"`
+		Errors []httpserver.FieldError `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	return httpserver.Problem{Code: body.Code, Errors: body.Errors}
+}
+
+func TestNewValidator_RejectsInvalidSpec(t *testing.T) {
+	_, err := NewValidator([]byte("openapi: 3.0.3\npaths: {}\n"), zerolog.Nop(), Options{})
+	assert.Error(t, err)
+}
+
+func TestMiddleware_PassesValidRequest(t *testing.T) {
+	validator := newTestValidator(t, Options{}, zerolog.Nop())
+	body := `{"name":"Acme","slug":"acme","tier":"growth"}`
+
+	var received string
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tenants/"+testTenantID+"/clients", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		received = string(data)
+		w.WriteHeader(http.StatusCreated)
+	}, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, body, received, "the handler must still be able to read the body")
+}
+
+func TestMiddleware_RejectsInvalidBody(t *testing.T) {
+	validator := newTestValidator(t, Options{}, zerolog.Nop())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tenants/"+testTenantID+"/clients", strings.NewReader(`{"name":1,"tier":"gold"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rr := serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not run for an invalid request")
+	}, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	problem := decodeProblem(t, rr)
+	assert.Equal(t, httpserver.ProblemCodeValidation, problem.Code)
+	assert.ElementsMatch(t, []string{"name", "slug", "tier"}, fieldNames(problem.Errors))
+	for _, fieldErr := range problem.Errors {
+		if fieldErr.Field == "slug" {
+			assert.Equal(t, httpserver.FieldCodeRequired, fieldErr.Code)
+		} else {
+			assert.Equal(t, httpserver.FieldCodeInvalid, fieldErr.Code)
+		}
+	}
+}
+
+func TestMiddleware_RejectsInvalidParameters(t *testing.T) {
+	validator := newTestValidator(t, Options{}, zerolog.Nop())
+
+	rr := serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not run for an invalid request")
+	}, httptest.NewRequest(http.MethodGet, "/api/v1/tenants/not-a-uuid", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, []httpserver.FieldError{{Field: "id", Code: httpserver.FieldCodeInvalid}}, withoutMessages(decodeProblem(t, rr).Errors))
+
+	rr = serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not run for an invalid request")
+	}, httptest.NewRequest(http.MethodGet, "/api/v1/brand/by-host", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, []httpserver.FieldError{{Field: "host", Code: httpserver.FieldCodeRequired}}, withoutMessages(decodeProblem(t, rr).Errors))
+}
+
+func TestMiddleware_RejectsNonMergePatch(t *testing.T) {
+	validator := newTestValidator(t, Options{}, zerolog.Nop())
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/tenants/"+testTenantID, strings.NewReader(`{"name":"Acme"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rr := serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not run for an invalid request")
+	}, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, rr.Code)
+	assert.Equal(t, httpserver.MergePatchContentType, rr.Header().Get("Accept-Patch"))
+
+	req = httptest.NewRequest(http.MethodPatch, "/api/v1/tenants/"+testTenantID, strings.NewReader(`{"name":null}`))
+	req.Header.Set("Content-Type", httpserver.MergePatchContentType)
+	rr = serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMiddleware_PassesUndocumentedAndLiteralRoutes(t *testing.T) {
+	validator := newTestValidator(t, Options{}, zerolog.Nop())
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) }
+
+	// Undocumented routes are left to the router
+	rr := serve(validator, ok, httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+
+	// A literal segment must not be validated as the {id} of a sibling path
+	rr = serve(validator, ok, httptest.NewRequest(http.MethodGet, "/api/v1/tenants/my-orgs", nil))
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+}
+
+func TestMiddleware_LogsInvalidResponses(t *testing.T) {
+	var logs bytes.Buffer
+	validator := newTestValidator(t, Options{ValidateResponses: true}, zerolog.New(&logs))
+	body := `{"id":"` + testTenantID + `","version":"one"}`
+
+	rr := serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}, httptest.NewRequest(http.MethodGet, "/api/v1/tenants/"+testTenantID, nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, body, rr.Body.String(), "the response is sent unchanged")
+	assert.Contains(t, logs.String(), "Response does not match the OpenAPI spec")
+
+	logs.Reset()
+	serve(validator, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"` + testTenantID + `","version":2}`))
+	}, httptest.NewRequest(http.MethodGet, "/api/v1/tenants/"+testTenantID, nil))
+	assert.Empty(t, logs.String())
+}
+
+func fieldNames(fieldErrs []httpserver.FieldError) []string {
+	names := make([]string, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		names[i] = fieldErr.Field
+	}
+	return names
+}
+
+func withoutMessages(fieldErrs []httpserver.FieldError) []httpserver.FieldError {
+	stripped := make([]httpserver.FieldError, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		stripped[i] = httpserver.FieldError{Field: fieldErr.Field, Code: fieldErr.Code}
+	}
+	return stripped
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+// Limit describes a token bucket: Burst requests at once, refilled at Rate requests per second
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute returns a limit of n requests per minute with a burst of n
+func PerMinute(n int) Limit {
+	return Limit{Rate: float64(n) / 60, Burst: n}
+}
+
+// Scale returns the limit with its rate and burst multiplied by factor
+func (l Limit) Scale(factor int) Limit {
+	return Limit{Rate: l.Rate * float64(factor), Burst: l.Burst * factor}
+}
+
+// Result is the outcome of taking a token from a bucket
+type Result struct {
+	Allowed    bool
+	Limit      int           // bucket capacity
+	Remaining  int           // whole tokens left after this request
+	Reset      time.Duration // time until the bucket is full again
+	RetryAfter time.Duration // time until the next token is available (zero when allowed)
+}
+
+// Limiter takes tokens from named buckets
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// NewLimiter returns a Redis-backed limiter shared by every instance, or an in-memory limiter when client is nil
+func NewLimiter(client *redis.Client, logger zerolog.Logger) Limiter {
+	if client == nil {
+		logger.Info().Msg("Redis not configured, rate limits are enforced per instance")
+		return NewMemoryLimiter()
+	}
+	return NewRedisLimiter(client)
+}
+
+// bucket is the state of a token bucket
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket up to now and takes one token if available
+func (b *bucket) take(now time.Time, limit Limit) Result {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return newResult(allowed, b.tokens, limit)
+}
+
+// newResult builds a Result from the tokens left in a bucket
+func newResult(allowed bool, tokens float64, limit Limit) Result {
+	result := Result{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     refillTime(float64(limit.Burst)-tokens, limit.Rate),
+	}
+	if !allowed {
+		result.RetryAfter = refillTime(1-tokens, limit.Rate)
+	}
+	return result
+}
+
+// refillTime returns how long it takes to refill the given number of tokens
+func refillTime(tokens, rate float64) time.Duration {
+	if tokens <= 0 || rate <= 0 {
+		return 0
+	}
+	return time.Duration(tokens / rate * float64(time.Second))
+}
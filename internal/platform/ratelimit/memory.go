@@ -0,0 +1,58 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// pruneInterval is how often idle buckets are dropped from memory
+const pruneInterval = time.Minute
+
+// MemoryLimiter keeps token buckets in process memory
+// Used when Redis is not configured; limits then apply per instance.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// NewMemoryLimiter creates a new in-memory limiter
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets:   make(map[string]*bucket),
+		lastPrune: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Allow takes a token from the bucket identified by key
+func (l *MemoryLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	return b.take(now, limit), nil
+}
+
+// prune drops buckets that have been idle long enough to be full again
+func (l *MemoryLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.last) > pruneInterval {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return now }
+	limit := PerMinute(2)
+	ctx := context.Background()
+
+	first, err := limiter.Allow(ctx, "user:a", limit)
+	require.NoError(t, err)
+	assert.True(t, first.Allowed)
+	assert.Equal(t, 2, first.Limit)
+	assert.Equal(t, 1, first.Remaining)
+
+	second, _ := limiter.Allow(ctx, "user:a", limit)
+	assert.True(t, second.Allowed)
+	assert.Equal(t, 0, second.Remaining)
+
+	denied, _ := limiter.Allow(ctx, "user:a", limit)
+	assert.False(t, denied.Allowed)
+	assert.Equal(t, 30*time.Second, denied.RetryAfter)
+	assert.Equal(t, time.Minute, denied.Reset)
+
+	// Buckets are independent per key
+	other, _ := limiter.Allow(ctx, "user:b", limit)
+	assert.True(t, other.Allowed)
+
+	// One token is refilled every 30 seconds
+	now = now.Add(30 * time.Second)
+	refilled, _ := limiter.Allow(ctx, "user:a", limit)
+	assert.True(t, refilled.Allowed)
+	assert.Equal(t, 0, refilled.Remaining)
+}
+
+func TestLimit_Scale(t *testing.T) {
+	limit := PerMinute(60).Scale(4)
+
+	assert.Equal(t, 240, limit.Burst)
+	assert.InDelta(t, 4.0, limit.Rate, 0.0001)
+}
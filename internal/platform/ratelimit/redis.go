@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeTokenScript refills and takes a token from a bucket atomically
+// KEYS[1] = bucket key; ARGV = burst, rate (tokens per ms), now (ms)
+// Returns {allowed, tokens left}; tokens are returned as a string to keep the fraction.
+var takeTokenScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) * rate)
+	ts = now
+end
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate) + 1000)
+
+return {allowed, tostring(tokens)}
+`)
+
+// RedisLimiter keeps token buckets in Redis/Dragonfly so limits are shared across instances
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisLimiter creates a new Redis-backed limiter
+func NewRedisLimiter(client *redis.Client) *RedisLimiter {
+	return &RedisLimiter{
+		client: client,
+		prefix: "ratelimit:",
+	}
+}
+
+// Allow takes a token from the bucket identified by key
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now().UnixMilli()
+	ratePerMs := limit.Rate / 1000
+
+	values, err := takeTokenScript.Run(ctx, l.client, []string{l.prefix + key},
+		limit.Burst,
+		strconv.FormatFloat(ratePerMs, 'f', -1, 64),
+		now,
+	).Slice()
+	if err != nil {
+		return Result{}, err
+	}
+
+	allowed, _ := values[0].(int64)
+	tokensValue, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensValue, 64)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return newResult(allowed == 1, math.Max(tokens, 0), limit), nil
+}
@@ -0,0 +1,209 @@
+package revocation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// channel is the Redis pub/sub channel used to push new watermarks to every instance
+	channel = "token_revocation:invalidate"
+
+	// localTTL bounds how long an instance trusts its local copy of a watermark
+	// Pub/sub normally updates it immediately; the TTL covers missed messages.
+	localTTL = 5 * time.Second
+
+	// minPruneThreshold is the local entry count at which writes start pruning entries that are no longer needed
+	minPruneThreshold = 1024
+)
+
+// localEntry is an instance-local copy of a user's watermark; a zero watermark means none is set
+type localEntry struct {
+	watermark time.Time
+	fetchedAt time.Time
+}
+
+// Store keeps a per-user "tokens issued before" watermark
+// Watermarks live in Redis/Dragonfly and are shared across instances; without Redis they are kept in memory only.
+type Store struct {
+	client *redis.Client
+	ttl    time.Duration
+	logger zerolog.Logger
+	prefix string
+
+	mu      sync.RWMutex
+	local   map[string]localEntry
+	pruneAt int // Local entry count at which the next write prunes entries that are no longer needed
+}
+
+// NewStore creates a new revocation store
+// The TTL should be at least the maximum token lifetime; a nil client keeps watermarks in memory.
+func NewStore(client *redis.Client, ttl time.Duration, logger zerolog.Logger) *Store {
+	if ttl == 0 {
+		ttl = 24 * time.Hour // Default TTL
+	}
+	return &Store{
+		client:  client,
+		ttl:     ttl,
+		logger:  logger,
+		prefix:  "token_revocation:",
+		local:   make(map[string]localEntry),
+		pruneAt: minPruneThreshold,
+	}
+}
+
+// key generates a cache key for a Clerk user ID
+func (s *Store) key(clerkUserID string) string {
+	return s.prefix + clerkUserID
+}
+
+// Watermark returns the watermark for a revocation at the given time
+// Token iat claims have second precision, so the watermark is rounded up to the next whole second:
+// a token issued in the same second as the revocation cannot be told apart from one issued before it.
+func Watermark(at time.Time) time.Time {
+	return at.Truncate(time.Second).Add(time.Second)
+}
+
+// Revoke rejects every token for the user issued before the given time
+func (s *Store) Revoke(ctx context.Context, clerkUserID string, at time.Time) error {
+	watermark := Watermark(at)
+	s.setLocal(clerkUserID, watermark)
+
+	if s.client == nil {
+		return nil
+	}
+
+	value := strconv.FormatInt(watermark.Unix(), 10)
+	if err := s.client.Set(ctx, s.key(clerkUserID), value, s.ttl).Err(); err != nil {
+		s.logger.Error().
+			Str("clerk_user_id", clerkUserID).
+			Err(err).
+			Msg("Failed to store token revocation watermark in Redis")
+		return err
+	}
+
+	if err := s.client.Publish(ctx, channel, clerkUserID+" "+value).Err(); err != nil {
+		// Other instances still pick the watermark up once their local copy expires
+		s.logger.Warn().
+			Str("clerk_user_id", clerkUserID).
+			Err(err).
+			Msg("Failed to publish token revocation")
+	}
+
+	s.logger.Info().
+		Str("clerk_user_id", clerkUserID).
+		Time("watermark", watermark).
+		Msg("Revoked tokens issued before watermark")
+
+	return nil
+}
+
+// RevokedBefore returns the user's watermark, if any
+// Redis errors fail open so an outage does not lock every user out.
+func (s *Store) RevokedBefore(ctx context.Context, clerkUserID string) (time.Time, bool) {
+	s.mu.RLock()
+	entry, found := s.local[clerkUserID]
+	s.mu.RUnlock()
+
+	if found && (s.client == nil || time.Since(entry.fetchedAt) < localTTL) {
+		return entry.watermark, !entry.watermark.IsZero()
+	}
+	if s.client == nil {
+		return time.Time{}, false
+	}
+
+	val, err := s.client.Get(ctx, s.key(clerkUserID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			s.setLocal(clerkUserID, time.Time{})
+			return time.Time{}, false
+		}
+		s.logger.Warn().
+			Str("clerk_user_id", clerkUserID).
+			Err(err).
+			Msg("Failed to get token revocation watermark from Redis")
+		return entry.watermark, !entry.watermark.IsZero()
+	}
+
+	seconds, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		s.logger.Warn().
+			Str("clerk_user_id", clerkUserID).
+			Err(err).
+			Msg("Failed to parse token revocation watermark")
+		return time.Time{}, false
+	}
+
+	watermark := time.Unix(seconds, 0)
+	s.setLocal(clerkUserID, watermark)
+	return watermark, true
+}
+
+// Subscribe applies watermarks published by other instances until ctx is cancelled
+func (s *Store) Subscribe(ctx context.Context) {
+	if s.client == nil {
+		return
+	}
+
+	pubsub := s.client.Subscribe(ctx, channel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			clerkUserID, value, found := strings.Cut(msg.Payload, " ")
+			if !found {
+				continue
+			}
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			s.setLocal(clerkUserID, time.Unix(seconds, 0))
+		}
+	}
+}
+
+// setLocal stores the instance-local copy of a watermark, never moving an existing watermark backwards
+// Entries are pruned once the map reaches pruneAt; the threshold then doubles past the kept entries,
+// so writes stay amortized O(1) however many users are revoked.
+func (s *Store) setLocal(clerkUserID string, watermark time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, found := s.local[clerkUserID]; found && existing.watermark.After(watermark) {
+		watermark = existing.watermark
+	}
+	now := time.Now()
+	if len(s.local) >= s.pruneAt {
+		for id, entry := range s.local {
+			if s.prunable(entry, now) {
+				delete(s.local, id)
+			}
+		}
+		s.pruneAt = max(minPruneThreshold, 2*len(s.local))
+	}
+	s.local[clerkUserID] = localEntry{watermark: watermark, fetchedAt: now}
+}
+
+// prunable reports whether a local entry can be dropped
+// Every token issued before a watermark older than the TTL (the maximum token lifetime) has expired anyway.
+// With Redis the local map is only a cache, so stale copies can be dropped too.
+func (s *Store) prunable(entry localEntry, now time.Time) bool {
+	if now.Sub(entry.watermark) >= s.ttl {
+		return true
+	}
+	return s.client != nil && now.Sub(entry.fetchedAt) >= localTTL
+}
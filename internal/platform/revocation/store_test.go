@@ -0,0 +1,78 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatermark(t *testing.T) {
+	at := time.Date(2026, 1, 1, 12, 0, 0, 500*int(time.Millisecond), time.UTC)
+
+	watermark := Watermark(at)
+
+	assert.Equal(t, time.Date(2026, 1, 1, 12, 0, 1, 0, time.UTC), watermark)
+	// A token issued in the same second as the revocation carries that second as its iat
+	assert.True(t, at.Truncate(time.Second).Before(watermark))
+}
+
+func TestStore_InMemory(t *testing.T) {
+	store := NewStore(nil, 0, zerolog.Nop())
+	ctx := context.Background()
+
+	_, found := store.RevokedBefore(ctx, "user-1")
+	assert.False(t, found)
+
+	now := time.Now()
+	require.NoError(t, store.Revoke(ctx, "user-1", now))
+	watermark, found := store.RevokedBefore(ctx, "user-1")
+	assert.True(t, found)
+	assert.Equal(t, Watermark(now), watermark)
+
+	// An older revocation never moves the watermark backwards
+	require.NoError(t, store.Revoke(ctx, "user-1", now.Add(-time.Hour)))
+	watermark, _ = store.RevokedBefore(ctx, "user-1")
+	assert.Equal(t, Watermark(now), watermark)
+
+	_, found = store.RevokedBefore(ctx, "user-2")
+	assert.False(t, found)
+}
+
+func TestStore_SetLocalPrunesAtThreshold(t *testing.T) {
+	store := NewStore(nil, time.Hour, zerolog.Nop())
+	now := time.Now()
+
+	// Without Redis only watermarks older than the token lifetime are dropped
+	for i := 0; i < minPruneThreshold-2; i++ {
+		store.local[fmt.Sprintf("expired-%d", i)] = localEntry{watermark: now.Add(-2 * time.Hour), fetchedAt: now}
+	}
+	store.local["recent"] = localEntry{watermark: now.Add(-time.Minute), fetchedAt: now.Add(-time.Hour)}
+	store.setLocal("user-1", Watermark(now))
+	assert.Len(t, store.local, minPruneThreshold, "writes below the threshold do not scan")
+
+	store.setLocal("user-2", Watermark(now))
+	assert.Len(t, store.local, 3, "the write at the threshold prunes watermarks older than the token lifetime")
+	assert.Equal(t, minPruneThreshold, store.pruneAt)
+
+	watermark, found := store.RevokedBefore(context.Background(), "recent")
+	assert.True(t, found, "watermarks that can still reject live tokens are kept")
+	assert.Equal(t, now.Add(-time.Minute), watermark)
+}
+
+func TestStore_PruneThresholdGrowsWithKeptEntries(t *testing.T) {
+	store := NewStore(nil, time.Hour, zerolog.Nop())
+	now := time.Now()
+
+	for i := 0; i < minPruneThreshold; i++ {
+		store.local[fmt.Sprintf("user-%d", i)] = localEntry{watermark: now, fetchedAt: now}
+	}
+	store.setLocal("user-new", Watermark(now))
+
+	assert.Len(t, store.local, minPruneThreshold+1)
+	assert.Equal(t, 2*minPruneThreshold, store.pruneAt, "kept entries push the next prune out")
+}
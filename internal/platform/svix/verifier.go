@@ -0,0 +1,107 @@
+package svix
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Svix webhook headers
+const (
+	HeaderID        = "svix-id"
+	HeaderTimestamp = "svix-timestamp"
+	HeaderSignature = "svix-signature"
+)
+
+// DefaultTolerance is how far a webhook timestamp may drift from now before it is rejected (replay protection)
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	// ErrMissingHeaders is returned when a Svix header is absent
+	ErrMissingHeaders = errors.New("missing svix headers")
+
+	// ErrInvalidTimestamp is returned when the timestamp is malformed or outside the tolerance
+	ErrInvalidTimestamp = errors.New("invalid svix timestamp")
+
+	// ErrInvalidSignature is returned when no signature matches the payload
+	ErrInvalidSignature = errors.New("invalid svix signature")
+)
+
+// Verifier verifies Svix-signed webhook payloads (used by Clerk)
+type Verifier struct {
+	key       []byte
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewVerifier creates a verifier from a signing secret in the "whsec_<base64>" format shown in the Svix/Clerk dashboard
+func NewVerifier(secret string) (*Verifier, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid svix secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("invalid svix secret: empty key")
+	}
+
+	return &Verifier{
+		key:       key,
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}, nil
+}
+
+// Verify checks the signature and timestamp headers against the raw request body
+func (v *Verifier) Verify(headers http.Header, body []byte) error {
+	id := headers.Get(HeaderID)
+	timestamp := headers.Get(HeaderTimestamp)
+	signatures := headers.Get(HeaderSignature)
+	if id == "" || timestamp == "" || signatures == "" {
+		return ErrMissingHeaders
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	drift := v.now().Sub(time.Unix(seconds, 0))
+	if drift > v.tolerance || drift < -v.tolerance {
+		return ErrInvalidTimestamp
+	}
+
+	expected := v.sign(id, timestamp, body)
+
+	// The header holds space-separated "<version>,<signature>" pairs (several during secret rotation)
+	for _, versioned := range strings.Fields(signatures) {
+		version, signature, ok := strings.Cut(versioned, ",")
+		if !ok || version != "v1" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// sign computes the v1 signature over "{id}.{timestamp}.{body}"
+func (v *Verifier) sign(id, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write([]byte(id))
+	mac.Write([]byte("."))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,91 @@
+package svix
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	secret := "whsec_" + base64.StdEncoding.EncodeToString([]byte("test-signing-key"))
+	verifier, err := NewVerifier(secret)
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	verifier.now = func() time.Time { return now }
+
+	body := []byte(`{"type":"user.created","data":{"id":"user_123"}}`)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	validSignature := "v1," + base64.StdEncoding.EncodeToString(verifier.sign("msg_1", timestamp, body))
+
+	tests := []struct {
+		name          string
+		id            string
+		timestamp     string
+		signature     string
+		body          []byte
+		expectedError error
+	}{
+		{
+			name:      "accepts a valid signature",
+			id:        "msg_1",
+			timestamp: timestamp,
+			signature: validSignature,
+			body:      body,
+		},
+		{
+			name:      "accepts a valid signature among rotated ones",
+			id:        "msg_1",
+			timestamp: timestamp,
+			signature: "v1,c29tZXRoaW5nLWVsc2U= " + validSignature,
+			body:      body,
+		},
+		{
+			name:          "rejects a tampered body",
+			id:            "msg_1",
+			timestamp:     timestamp,
+			signature:     validSignature,
+			body:          []byte(`{"type":"user.deleted","data":{"id":"user_123"}}`),
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "rejects a different message ID",
+			id:            "msg_2",
+			timestamp:     timestamp,
+			signature:     validSignature,
+			body:          body,
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "rejects a stale timestamp",
+			id:            "msg_1",
+			timestamp:     strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10),
+			signature:     validSignature,
+			body:          body,
+			expectedError: ErrInvalidTimestamp,
+		},
+		{
+			name:          "rejects missing headers",
+			id:            "msg_1",
+			timestamp:     timestamp,
+			body:          body,
+			expectedError: ErrMissingHeaders,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := http.Header{}
+			headers.Set(HeaderID, tt.id)
+			headers.Set(HeaderTimestamp, tt.timestamp)
+			headers.Set(HeaderSignature, tt.signature)
+
+			assert.Equal(t, tt.expectedError, verifier.Verify(headers, tt.body))
+		})
+	}
+}
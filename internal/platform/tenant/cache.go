@@ -3,6 +3,7 @@ package tenant
 import (
 	"context"
 	"encoding/json"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,12 +11,38 @@ import (
 	"github.com/rs/zerolog"
 )
 
+const (
+	// invalidationChannel is the Redis pub/sub channel used to drop local entries on every instance
+	invalidationChannel = "tenant_cache:invalidate"
+
+	// invalidateAllMessage asks every instance to drop all local entries
+	invalidateAllMessage = "*"
+
+	// localTTL bounds how long an instance serves an entry from memory
+	// Pub/sub normally drops stale entries immediately; the TTL covers missed messages.
+	localTTL = 10 * time.Second
+
+	// minPruneThreshold is the local entry count at which writes start pruning expired entries
+	minPruneThreshold = 1024
+)
+
+// localTenantEntry is an instance-local copy of a user's cached tenant IDs
+type localTenantEntry struct {
+	tenantIDs []string
+	expiresAt time.Time
+}
+
 // TenantCache caches user's accessible tenants using Dragonfly/Redis
+// A short-lived in-process layer sits in front of Redis; invalidations are broadcast over pub/sub so every instance drops it.
 type TenantCache struct {
 	client *redis.Client
 	ttl    time.Duration
 	logger zerolog.Logger
 	prefix string
+
+	mu      sync.RWMutex
+	local   map[uuid.UUID]localTenantEntry
+	pruneAt int // Local entry count at which the next write prunes expired entries
 }
 
 // NewTenantCache creates a new tenant cache using Redis/Dragonfly
@@ -24,10 +51,12 @@ func NewTenantCache(client *redis.Client, ttl time.Duration, logger zerolog.Logg
 		ttl = 5 * time.Minute // Default TTL
 	}
 	return &TenantCache{
-		client: client,
-		ttl:    ttl,
-		logger: logger,
-		prefix: "tenant_cache:",
+		client:  client,
+		ttl:     ttl,
+		logger:  logger,
+		prefix:  "tenant_cache:",
+		local:   make(map[uuid.UUID]localTenantEntry),
+		pruneAt: minPruneThreshold,
 	}
 }
 
@@ -38,6 +67,10 @@ func (tc *TenantCache) key(userID uuid.UUID) string {
 
 // Get retrieves cached tenant IDs for a user
 func (tc *TenantCache) Get(ctx context.Context, userID uuid.UUID) ([]string, bool) {
+	if tenantIDs, found := tc.getLocal(userID); found {
+		return tenantIDs, true
+	}
+
 	key := tc.key(userID)
 
 	val, err := tc.client.Get(ctx, key).Result()
@@ -61,6 +94,7 @@ func (tc *TenantCache) Get(ctx context.Context, userID uuid.UUID) ([]string, boo
 		return nil, false
 	}
 
+	tc.setLocal(userID, tenantIDs)
 	return tenantIDs, true
 }
 
@@ -86,13 +120,16 @@ func (tc *TenantCache) Set(ctx context.Context, userID uuid.UUID, tenantIDs []st
 		return err
 	}
 
+	tc.setLocal(userID, tenantIDs)
 	return nil
 }
 
-// Invalidate removes cached tenant IDs for a user
+// Invalidate removes cached tenant IDs for a user on every instance
 func (tc *TenantCache) Invalidate(ctx context.Context, userID uuid.UUID) error {
 	key := tc.key(userID)
 
+	tc.dropLocal(userID)
+
 	err := tc.client.Del(ctx, key).Err()
 	if err != nil {
 		tc.logger.Error().
@@ -102,6 +139,8 @@ func (tc *TenantCache) Invalidate(ctx context.Context, userID uuid.UUID) error {
 		return err
 	}
 
+	tc.publish(ctx, userID.String())
+
 	tc.logger.Debug().
 		Str("user_id", userID.String()).
 		Msg("Invalidated tenant cache for user")
@@ -113,6 +152,8 @@ func (tc *TenantCache) Invalidate(ctx context.Context, userID uuid.UUID) error {
 func (tc *TenantCache) InvalidateAll(ctx context.Context) error {
 	pattern := tc.prefix + "*"
 
+	tc.dropAllLocal()
+
 	iter := tc.client.Scan(ctx, 0, pattern, 0).Iterator()
 	keys := []string{}
 	for iter.Next(ctx) {
@@ -135,6 +176,8 @@ func (tc *TenantCache) InvalidateAll(ctx context.Context) error {
 		}
 	}
 
+	tc.publish(ctx, invalidateAllMessage)
+
 	tc.logger.Debug().
 		Int("deleted_keys", len(keys)).
 		Msg("Invalidated all tenant cache entries")
@@ -184,3 +227,89 @@ func (tc *TenantCache) GetWithResolver(
 func (tc *TenantCache) Ping(ctx context.Context) error {
 	return tc.client.Ping(ctx).Err()
 }
+
+// Subscribe drops local entries invalidated by other instances until ctx is cancelled
+func (tc *TenantCache) Subscribe(ctx context.Context) {
+	pubsub := tc.client.Subscribe(ctx, invalidationChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			if msg.Payload == invalidateAllMessage {
+				tc.dropAllLocal()
+				continue
+			}
+			userID, err := uuid.Parse(msg.Payload)
+			if err != nil {
+				continue
+			}
+			tc.dropLocal(userID)
+		}
+	}
+}
+
+// publish broadcasts an invalidation to every instance
+func (tc *TenantCache) publish(ctx context.Context, payload string) {
+	if err := tc.client.Publish(ctx, invalidationChannel, payload).Err(); err != nil {
+		// Other instances still drop the entry once their local copy expires
+		tc.logger.Warn().
+			Str("payload", payload).
+			Err(err).
+			Msg("Failed to publish tenant cache invalidation")
+	}
+}
+
+// getLocal returns the instance-local copy of a user's tenant IDs if it has not expired
+func (tc *TenantCache) getLocal(userID uuid.UUID) ([]string, bool) {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+
+	entry, found := tc.local[userID]
+	if !found || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.tenantIDs, true
+}
+
+// setLocal stores the instance-local copy of a user's tenant IDs
+// Expired entries are pruned once the map reaches pruneAt; the threshold then doubles past the live entries,
+// so writes stay amortized O(1) however many users are cached.
+func (tc *TenantCache) setLocal(userID uuid.UUID, tenantIDs []string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	now := time.Now()
+	if len(tc.local) >= tc.pruneAt {
+		for id, entry := range tc.local {
+			if now.After(entry.expiresAt) {
+				delete(tc.local, id)
+			}
+		}
+		tc.pruneAt = max(minPruneThreshold, 2*len(tc.local))
+	}
+	tc.local[userID] = localTenantEntry{tenantIDs: tenantIDs, expiresAt: now.Add(localTTL)}
+}
+
+// dropLocal removes the instance-local copy of a user's tenant IDs
+func (tc *TenantCache) dropLocal(userID uuid.UUID) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	delete(tc.local, userID)
+}
+
+// dropAllLocal removes every instance-local entry
+func (tc *TenantCache) dropAllLocal() {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.local = make(map[uuid.UUID]localTenantEntry)
+	tc.pruneAt = minPruneThreshold
+}
@@ -0,0 +1,43 @@
+package tenant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantCache_SetLocalPrunesAtThreshold(t *testing.T) {
+	tc := NewTenantCache(nil, 0, zerolog.Nop())
+	expired := time.Now().Add(-time.Second)
+
+	for i := 0; i < minPruneThreshold-1; i++ {
+		tc.local[uuid.New()] = localTenantEntry{expiresAt: expired}
+	}
+	tc.setLocal(uuid.New(), []string{"t1"})
+	assert.Len(t, tc.local, minPruneThreshold, "writes below the threshold do not scan")
+
+	userID := uuid.New()
+	tc.setLocal(userID, []string{"t2"})
+	assert.Len(t, tc.local, 2, "the write at the threshold prunes expired entries")
+	assert.Equal(t, minPruneThreshold, tc.pruneAt)
+
+	tenantIDs, found := tc.getLocal(userID)
+	assert.True(t, found)
+	assert.Equal(t, []string{"t2"}, tenantIDs)
+}
+
+func TestTenantCache_PruneThresholdGrowsWithLiveEntries(t *testing.T) {
+	tc := NewTenantCache(nil, 0, zerolog.Nop())
+	live := time.Now().Add(time.Minute)
+
+	for i := 0; i < minPruneThreshold; i++ {
+		tc.local[uuid.New()] = localTenantEntry{expiresAt: live}
+	}
+	tc.setLocal(uuid.New(), nil)
+
+	assert.Len(t, tc.local, minPruneThreshold+1)
+	assert.Equal(t, 2*minPruneThreshold, tc.pruneAt, "live entries push the next prune out")
+}
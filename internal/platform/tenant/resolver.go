@@ -32,12 +32,22 @@ const (
 	TenantSourceFallback TenantSource = "fallback"
 )
 
+// TenantFallback represents which tenant was chosen when no source identified an accessible one
+type TenantFallback string
+
+const (
+	TenantFallbackDefault  TenantFallback = "default"
+	TenantFallbackLastUsed TenantFallback = "last_used"
+	TenantFallbackFirst    TenantFallback = "first_accessible"
+)
+
 // TenantResolutionResult represents the result of tenant resolution
 type TenantResolutionResult struct {
 	TenantID     string
 	Source       TenantSource
 	Validated    bool
 	FallbackUsed bool
+	Fallback     TenantFallback // set when the tenant was chosen from the user's preferences or memberships
 }
 
 // Resolver handles tenant resolution logic
@@ -117,6 +127,17 @@ func (tr *Resolver) SetClientContext(ctx context.Context, clientID string) conte
 	return context.WithValue(ctx, "client_id", clientID)
 }
 
+// SetResolutionContext stores how the tenant was resolved in the request
+func (tr *Resolver) SetResolutionContext(ctx context.Context, result *TenantResolutionResult) context.Context {
+	return context.WithValue(ctx, "tenant_resolution", result)
+}
+
+// GetResolutionFromContext gets how the tenant was resolved from context
+func GetResolutionFromContext(ctx context.Context) (*TenantResolutionResult, bool) {
+	result, ok := ctx.Value("tenant_resolution").(*TenantResolutionResult)
+	return result, ok && result != nil
+}
+
 // GetTenantFromContext gets tenant ID from context
 func GetTenantFromContext(ctx context.Context) (string, bool) {
 	tenantID, ok := ctx.Value("tenant_id").(string)
@@ -177,13 +198,17 @@ func (tr *Resolver) GetUserTenantIDs(ctx context.Context, userID uuid.UUID) ([]s
 
 // getUserTenantIDsFromDB queries the database for user tenant IDs
 func (tr *Resolver) getUserTenantIDsFromDB(ctx context.Context, userID uuid.UUID) ([]string, error) {
-	// Use DISTINCT ON to get unique tenant_ids ordered by created_at
-	// DISTINCT ON requires the first ORDER BY column to match the DISTINCT ON column
+	// Use DISTINCT ON to get unique tenant_ids with their earliest membership
+	// DISTINCT ON requires the first ORDER BY column to match the DISTINCT ON column,
+	// so the outer query orders by membership age (oldest first is the last-resort fallback)
 	query := `
-		SELECT DISTINCT ON (tenant_id) tenant_id::text, created_at
-		FROM tenant_members
-		WHERE user_id = $1 AND deleted_at IS NULL
-		ORDER BY tenant_id, created_at ASC
+		SELECT tenant_id, created_at FROM (
+			SELECT DISTINCT ON (tenant_id) tenant_id::text, created_at
+			FROM tenant_members
+			WHERE user_id = $1 AND deleted_at IS NULL
+			ORDER BY tenant_id, created_at ASC
+		) memberships
+		ORDER BY created_at ASC, tenant_id ASC
 	`
 
 	rows, err := tr.db.Query(ctx, query, userID)
@@ -218,6 +243,63 @@ func (tr *Resolver) getUserTenantIDsFromDB(ctx context.Context, userID uuid.UUID
 	return tenantIDs, nil
 }
 
+// chooseFallbackTenant picks the tenant for a request that did not identify an accessible one
+// Preferences are read on demand; failing to read them falls back to the oldest membership.
+func (tr *Resolver) chooseFallbackTenant(ctx context.Context, userID uuid.UUID, accessibleTenants []string) (string, TenantFallback) {
+	defaultTenantID, lastUsedTenantID, err := tr.getTenantPreferences(ctx, userID)
+	if err != nil {
+		tr.logger.Warn().
+			Str("user_id", userID.String()).
+			Err(err).
+			Msg("Failed to load tenant preferences, falling back to first accessible tenant")
+	}
+	return pickFallbackTenant(accessibleTenants, defaultTenantID, lastUsedTenantID)
+}
+
+// pickFallbackTenant prefers the default tenant, then the last-used tenant, then the first accessible tenant
+// Preferences pointing at tenants the user can no longer access are skipped.
+func pickFallbackTenant(accessibleTenants []string, defaultTenantID, lastUsedTenantID string) (string, TenantFallback) {
+	isAccessible := func(tenantID string) bool {
+		if tenantID == "" {
+			return false
+		}
+		for _, accessibleTenantID := range accessibleTenants {
+			if accessibleTenantID == tenantID {
+				return true
+			}
+		}
+		return false
+	}
+
+	if isAccessible(defaultTenantID) {
+		return defaultTenantID, TenantFallbackDefault
+	}
+	if isAccessible(lastUsedTenantID) {
+		return lastUsedTenantID, TenantFallbackLastUsed
+	}
+	return accessibleTenants[0], TenantFallbackFirst
+}
+
+// getTenantPreferences queries the user's default and last-used tenant IDs (empty when unset)
+func (tr *Resolver) getTenantPreferences(ctx context.Context, userID uuid.UUID) (string, string, error) {
+	query := `
+		SELECT COALESCE(default_tenant_id::text, ''), COALESCE(last_used_tenant_id::text, '')
+		FROM user_tenant_preferences
+		WHERE user_id = $1
+	`
+
+	var defaultTenantID, lastUsedTenantID string
+	err := tr.db.QueryRow(ctx, query, userID).Scan(&defaultTenantID, &lastUsedTenantID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", "", nil
+		}
+		return "", "", err
+	}
+
+	return defaultTenantID, lastUsedTenantID, nil
+}
+
 // GetUserAccessibleTenants returns all tenant IDs the user has access to (alias for GetUserTenantIDs)
 func (tr *Resolver) GetUserAccessibleTenants(ctx context.Context, userID uuid.UUID) ([]string, error) {
 	return tr.GetUserTenantIDs(ctx, userID)
@@ -356,6 +438,7 @@ func (tr *Resolver) ResolveTenantWithValidation(
 	// Validate resolved tenant against user's accessible tenants
 	validated := false
 	fallbackUsed := false
+	var fallback TenantFallback
 
 	if resolvedTenantID != "" {
 		// Check if resolved tenant is in user's accessible tenants
@@ -375,9 +458,9 @@ func (tr *Resolver) ResolveTenantWithValidation(
 				Strs("accessible_tenants", accessibleTenants).
 				Msg("Invalid tenant access attempt - resolved tenant not in user's accessible tenants")
 
-			// Use fallback: user's first tenant
+			// Use fallback: user's preferred tenant
 			if len(accessibleTenants) > 0 {
-				resolvedTenantID = accessibleTenants[0]
+				resolvedTenantID, fallback = tr.chooseFallbackTenant(ctx, userID, accessibleTenants)
 				source = TenantSourceFallback
 				validated = true
 				fallbackUsed = true
@@ -386,9 +469,9 @@ func (tr *Resolver) ResolveTenantWithValidation(
 			}
 		}
 	} else {
-		// No tenant resolved from any source - use user's first tenant
+		// No tenant resolved from any source - use user's preferred tenant
 		if len(accessibleTenants) > 0 {
-			resolvedTenantID = accessibleTenants[0]
+			resolvedTenantID, fallback = tr.chooseFallbackTenant(ctx, userID, accessibleTenants)
 			source = TenantSourceToken
 			validated = true
 			fallbackUsed = false
@@ -402,5 +485,6 @@ func (tr *Resolver) ResolveTenantWithValidation(
 		Source:       source,
 		Validated:    validated,
 		FallbackUsed: fallbackUsed,
+		Fallback:     fallback,
 	}, nil
 }
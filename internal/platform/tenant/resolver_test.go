@@ -359,3 +359,28 @@ func TestResolver_ResolveTenantWithValidation_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestPickFallbackTenant(t *testing.T) {
+	accessible := []string{"tenant-oldest", "tenant-b", "tenant-c"}
+
+	tests := []struct {
+		name         string
+		defaultID    string
+		lastUsedID   string
+		wantTenantID string
+		wantFallback TenantFallback
+	}{
+		{"no preferences", "", "", "tenant-oldest", TenantFallbackFirst},
+		{"default", "tenant-b", "tenant-c", "tenant-b", TenantFallbackDefault},
+		{"last used", "", "tenant-c", "tenant-c", TenantFallbackLastUsed},
+		{"inaccessible default", "tenant-gone", "tenant-c", "tenant-c", TenantFallbackLastUsed},
+		{"inaccessible preferences", "tenant-gone", "tenant-other", "tenant-oldest", TenantFallbackFirst},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID, fallback := pickFallbackTenant(accessible, tt.defaultID, tt.lastUsedID)
+			assert.Equal(t, tt.wantTenantID, tenantID)
+			assert.Equal(t, tt.wantFallback, fallback)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+-- Rollback tenant and client suspension enforcement
+
+DROP INDEX IF EXISTS idx_suspension_events_client_id;
+DROP INDEX IF EXISTS idx_suspension_events_tenant_id;
+DROP INDEX IF EXISTS idx_agencies_suspended;
+
+DROP TABLE IF EXISTS suspension_events;
+
+ALTER TABLE clients DROP COLUMN IF EXISTS suspended_at;
+ALTER TABLE clients DROP COLUMN IF EXISTS suspended_by;
+ALTER TABLE clients DROP COLUMN IF EXISTS suspension_note;
+ALTER TABLE clients DROP COLUMN IF EXISTS suspension_reason;
+
+ALTER TABLE agencies DROP COLUMN IF EXISTS suspended_at;
+ALTER TABLE agencies DROP COLUMN IF EXISTS suspended_by;
+ALTER TABLE agencies DROP COLUMN IF EXISTS suspension_note;
+ALTER TABLE agencies DROP COLUMN IF EXISTS suspension_reason;
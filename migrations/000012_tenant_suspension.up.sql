@@ -0,0 +1,35 @@
+-- Tenant and client suspension enforcement
+-- Records why an agency or client was suspended so the API can decide between
+-- read-only and fully blocked access, and keeps a history of suspend/reinstate actions.
+
+-- Agencies: suspension details (only set while status = 'suspended')
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS suspension_reason TEXT CHECK (suspension_reason IN ('billing', 'owner_request', 'policy_violation', 'security'));
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS suspension_note TEXT;
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS suspended_by UUID;
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMPTZ;
+
+-- Clients: suspension details (only set while status = 'suspended')
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS suspension_reason TEXT CHECK (suspension_reason IN ('billing', 'owner_request', 'policy_violation', 'security'));
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS suspension_note TEXT;
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS suspended_by UUID;
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMPTZ;
+
+-- Suspension history (audit trail for suspend/reinstate actions and their notes)
+CREATE TABLE IF NOT EXISTS suspension_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES agencies(id) ON DELETE CASCADE,
+    client_id UUID REFERENCES clients(id) ON DELETE CASCADE,
+    action TEXT NOT NULL CHECK (action IN ('suspend', 'reinstate')),
+    reason TEXT CHECK (reason IN ('billing', 'owner_request', 'policy_violation', 'security')),
+    note TEXT NOT NULL DEFAULT '',
+    actor_user_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX IF NOT EXISTS idx_agencies_suspended ON agencies(id) WHERE status = 'suspended';
+CREATE INDEX IF NOT EXISTS idx_suspension_events_tenant_id ON suspension_events(tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_suspension_events_client_id ON suspension_events(client_id) WHERE client_id IS NOT NULL;
+
+-- Grant appropriate permissions
+GRANT SELECT, INSERT ON suspension_events TO PUBLIC;
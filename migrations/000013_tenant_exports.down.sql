@@ -0,0 +1,6 @@
+-- Rollback tenant data exports
+
+DROP INDEX IF EXISTS idx_tenant_exports_active;
+DROP INDEX IF EXISTS idx_tenant_exports_tenant_id;
+
+DROP TABLE IF EXISTS tenant_exports;
@@ -0,0 +1,27 @@
+-- Tenant data exports
+-- Tracks asynchronous exports of everything held for an agency. The archive itself
+-- lives in object storage; this table records progress, history and the download window.
+
+CREATE TABLE IF NOT EXISTS tenant_exports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES agencies(id) ON DELETE CASCADE,
+    requested_by UUID,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    progress INTEGER NOT NULL DEFAULT 0 CHECK (progress >= 0 AND progress <= 100),
+    current_step TEXT NOT NULL DEFAULT '',
+    object_key TEXT,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ
+);
+
+-- Create indexes for performance
+CREATE INDEX IF NOT EXISTS idx_tenant_exports_tenant_id ON tenant_exports(tenant_id, created_at DESC);
+-- At most one active export per tenant; concurrent starts fail on this index
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tenant_exports_active ON tenant_exports(tenant_id) WHERE status IN ('pending', 'running');
+
+-- Grant appropriate permissions
+GRANT SELECT, INSERT, UPDATE ON tenant_exports TO PUBLIC;
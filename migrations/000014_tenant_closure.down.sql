@@ -0,0 +1,8 @@
+-- Rollback tenant closure with retention window
+
+DROP INDEX IF EXISTS idx_agencies_deleted_at;
+DROP INDEX IF EXISTS idx_agencies_purge_after;
+
+ALTER TABLE agencies DROP COLUMN IF EXISTS purge_after;
+ALTER TABLE agencies DROP COLUMN IF EXISTS closure_requested_by;
+ALTER TABLE agencies DROP COLUMN IF EXISTS closure_requested_at;
@@ -0,0 +1,11 @@
+-- Tenant closure with retention window
+-- An owner-requested closure makes the agency read-only until purge_after, after which
+-- the purge job hard-deletes the agency (cascading to all tenant-owned rows) and its stored objects.
+
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS closure_requested_at TIMESTAMPTZ;
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS closure_requested_by UUID;
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS purge_after TIMESTAMPTZ;
+
+-- Create indexes for the purge job
+CREATE INDEX IF NOT EXISTS idx_agencies_purge_after ON agencies(purge_after) WHERE purge_after IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_agencies_deleted_at ON agencies(deleted_at) WHERE deleted_at IS NOT NULL;
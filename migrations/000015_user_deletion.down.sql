@@ -0,0 +1,6 @@
+-- Rollback user deletion with PII anonymization
+
+DROP INDEX IF EXISTS idx_tenant_invites_created_by;
+DROP INDEX IF EXISTS idx_users_deleted_at;
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
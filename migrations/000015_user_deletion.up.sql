@@ -0,0 +1,11 @@
+-- User deletion with PII anonymization
+-- Deleted users keep their row (and ID) so audit references such as tenant_invites.created_by and
+-- suspension events stay valid; personal fields are cleared and deleted_at records when.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Create index for looking up deleted users
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Create index for revoking invites created by a deleted user
+CREATE INDEX IF NOT EXISTS idx_tenant_invites_created_by ON tenant_invites(created_by) WHERE accepted_at IS NULL AND revoked_at IS NULL;
@@ -0,0 +1,4 @@
+-- Rollback identity provider webhook events
+
+DROP INDEX IF EXISTS idx_webhook_events_retryable;
+DROP TABLE IF EXISTS webhook_events;
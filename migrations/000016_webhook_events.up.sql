@@ -0,0 +1,22 @@
+-- Identity provider webhook events
+-- Each delivery is stored under the provider's event ID (svix-id) so redeliveries are deduplicated
+-- and failed events can be retried by the background job.
+
+CREATE TABLE IF NOT EXISTS webhook_events (
+    id TEXT PRIMARY KEY,
+    source TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'received' CHECK (status IN ('received', 'processed', 'ignored', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ
+);
+
+-- Create index for the retry job
+CREATE INDEX IF NOT EXISTS idx_webhook_events_retryable ON webhook_events(updated_at) WHERE status IN ('received', 'failed');
+
+-- Grant appropriate permissions
+GRANT SELECT, INSERT, UPDATE, DELETE ON webhook_events TO PUBLIC;
@@ -0,0 +1,4 @@
+-- Rollback audited impersonation
+
+DROP TABLE IF EXISTS impersonation_audit_log;
+DROP TABLE IF EXISTS impersonation_sessions;
@@ -0,0 +1,38 @@
+-- Audited impersonation
+-- A session lets an actor (platform support, or an agency owner for their client members) act as
+-- another user within one tenant until expires_at. Every start, end and request is appended to the audit log.
+
+CREATE TABLE IF NOT EXISTS impersonation_sessions (
+    id UUID PRIMARY KEY,
+    actor_user_id UUID NOT NULL REFERENCES users(id),
+    actor_clerk_user_id TEXT NOT NULL,
+    target_user_id UUID NOT NULL REFERENCES users(id),
+    target_clerk_user_id TEXT NOT NULL,
+    tenant_id UUID NOT NULL REFERENCES agencies(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS impersonation_audit_log (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES impersonation_sessions(id) ON DELETE CASCADE,
+    actor_user_id UUID NOT NULL,
+    target_user_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('start', 'end', 'request', 'blocked')),
+    method TEXT,
+    path TEXT,
+    status_code INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_actor ON impersonation_sessions(actor_user_id) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_tenant ON impersonation_sessions(tenant_id, started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_impersonation_audit_log_session ON impersonation_audit_log(session_id, created_at);
+
+-- Grant appropriate permissions
+GRANT SELECT, INSERT, UPDATE ON impersonation_sessions TO PUBLIC;
+GRANT SELECT, INSERT ON impersonation_audit_log TO PUBLIC;
@@ -0,0 +1,3 @@
+-- Rollback per-user tenant preferences
+
+DROP TABLE IF EXISTS user_tenant_preferences;
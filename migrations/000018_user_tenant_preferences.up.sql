@@ -0,0 +1,10 @@
+-- Per-user tenant preferences
+-- Tenant resolution falls back to the default tenant, then the last-used tenant, before picking the oldest membership.
+-- Preferences referencing a tenant the user no longer belongs to are ignored at resolution time.
+
+CREATE TABLE IF NOT EXISTS user_tenant_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    default_tenant_id UUID REFERENCES agencies(id) ON DELETE SET NULL,
+    last_used_tenant_id UUID REFERENCES agencies(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
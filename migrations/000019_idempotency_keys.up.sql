@@ -0,0 +1,20 @@
+-- Idempotency keys for retried POST requests
+-- A key is scoped to the tenant and user that sent it. The first request stores its fingerprint and, once finished,
+-- its response; retries with the same key replay that response, and reusing the key for a different request is rejected.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    tenant_id UUID NOT NULL REFERENCES agencies(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL, -- Clerk user ID of the caller
+    idempotency_key TEXT NOT NULL,
+    fingerprint TEXT NOT NULL, -- SHA-256 of method, path and body
+    status_code INTEGER, -- NULL while the first request is in progress
+    locked_until TIMESTAMPTZ, -- End of the in-progress claim's lease; a later request may take over a lapsed claim
+    response_headers JSONB,
+    response_body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, user_id, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
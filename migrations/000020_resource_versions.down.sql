@@ -0,0 +1,12 @@
+-- Rollback row versions
+
+DROP TRIGGER IF EXISTS bump_branding_version ON branding;
+DROP TRIGGER IF EXISTS bump_locations_version ON locations;
+DROP TRIGGER IF EXISTS bump_clients_version ON clients;
+DROP TRIGGER IF EXISTS bump_agencies_version ON agencies;
+DROP FUNCTION IF EXISTS bump_row_version();
+
+ALTER TABLE branding DROP COLUMN IF EXISTS version;
+ALTER TABLE locations DROP COLUMN IF EXISTS version;
+ALTER TABLE clients DROP COLUMN IF EXISTS version;
+ALTER TABLE agencies DROP COLUMN IF EXISTS version;
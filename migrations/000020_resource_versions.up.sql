@@ -0,0 +1,40 @@
+-- Row versions for optimistic concurrency on tenants, clients, locations and branding
+-- Every UPDATE bumps the version (including internal writes), so the ETag derived from it changes whenever the row does.
+-- Repositories update conditionally on the version they read and report a conflict when it has moved on.
+
+ALTER TABLE agencies ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE branding ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION bump_row_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS bump_agencies_version ON agencies;
+CREATE TRIGGER bump_agencies_version
+    BEFORE UPDATE ON agencies
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_row_version();
+
+DROP TRIGGER IF EXISTS bump_clients_version ON clients;
+CREATE TRIGGER bump_clients_version
+    BEFORE UPDATE ON clients
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_row_version();
+
+DROP TRIGGER IF EXISTS bump_locations_version ON locations;
+CREATE TRIGGER bump_locations_version
+    BEFORE UPDATE ON locations
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_row_version();
+
+DROP TRIGGER IF EXISTS bump_branding_version ON branding;
+CREATE TRIGGER bump_branding_version
+    BEFORE UPDATE ON branding
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_row_version();
@@ -0,0 +1,5 @@
+-- Rollback client branding overrides
+
+DROP TRIGGER IF EXISTS bump_client_branding_version ON client_branding;
+DROP POLICY IF EXISTS client_branding_tenant ON client_branding;
+DROP TABLE IF EXISTS client_branding;
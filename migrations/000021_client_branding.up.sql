@@ -0,0 +1,28 @@
+-- Client-level branding overrides layered on the agency branding
+-- NULL columns (and theme keys that are absent) inherit from the agency's branding row,
+-- so a client portal keeps the agency's domain while showing the client's own logo and colors.
+
+CREATE TABLE IF NOT EXISTS client_branding (
+    client_id UUID PRIMARY KEY REFERENCES clients(id) ON DELETE CASCADE,
+    agency_id UUID NOT NULL REFERENCES agencies(id) ON DELETE CASCADE,
+    logo_url TEXT,
+    favicon_url TEXT,
+    primary_color TEXT CHECK (primary_color ~ '^#[0-9A-Fa-f]{6}$' OR primary_color IS NULL),
+    secondary_color TEXT CHECK (secondary_color ~ '^#[0-9A-Fa-f]{6}$' OR secondary_color IS NULL),
+    theme_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    version BIGINT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_client_branding_agency_id ON client_branding(agency_id);
+
+ALTER TABLE client_branding ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY client_branding_tenant ON client_branding
+    USING (agency_id = current_setting('lv.tenant_id')::uuid);
+
+DROP TRIGGER IF EXISTS bump_client_branding_version ON client_branding;
+CREATE TRIGGER bump_client_branding_version
+    BEFORE UPDATE ON client_branding
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_row_version();
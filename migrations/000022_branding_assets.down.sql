@@ -0,0 +1,3 @@
+-- Rollback processed branding assets
+
+ALTER TABLE branding DROP COLUMN IF EXISTS assets;
@@ -0,0 +1,5 @@
+-- Processed branding assets
+-- URLs of each finalized upload and its derivatives, keyed by asset type and variant:
+-- {"logo": {"original": ..., "webp_256": ..., "email_png": ...}, "favicon": {"original": ..., "ico": ..., "apple_touch_icon": ...}}
+
+ALTER TABLE branding ADD COLUMN IF NOT EXISTS assets JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,3 @@
+-- Rollback background custom domain monitoring
+
+DROP TABLE IF EXISTS custom_domain_checks;
@@ -0,0 +1,26 @@
+-- Background custom domain monitoring
+-- One row per agency with a custom domain: the monitor claims rows whose next_check_at has passed,
+-- asks the domain provider for the domain's routing and certificate state and schedules the next check.
+-- health: 'pending' (never went live), 'live' (routed with an active certificate), 'degraded' (was live, now broken)
+
+CREATE TABLE IF NOT EXISTS custom_domain_checks (
+    agency_id UUID PRIMARY KEY REFERENCES branding(agency_id) ON DELETE CASCADE,
+    domain TEXT NOT NULL,
+    health TEXT NOT NULL DEFAULT 'pending' CHECK (health IN ('pending', 'live', 'degraded')),
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    checked_at TIMESTAMPTZ,
+    next_check_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_custom_domain_checks_next_check_at ON custom_domain_checks(next_check_at);
+
+-- Domains configured before the monitor existed are checked on its first run
+INSERT INTO custom_domain_checks (agency_id, domain, health)
+SELECT agency_id,
+       domain,
+       CASE WHEN verified_at IS NOT NULL AND ssl_status = 'active' THEN 'live' ELSE 'pending' END
+FROM branding
+WHERE domain IS NOT NULL AND domain <> ''
+ON CONFLICT (agency_id) DO NOTHING;
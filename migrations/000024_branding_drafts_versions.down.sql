@@ -0,0 +1,7 @@
+-- Rollback branding drafts and version history
+
+DROP TRIGGER IF EXISTS archive_branding_version ON branding;
+DROP FUNCTION IF EXISTS archive_branding_version();
+DROP TABLE IF EXISTS branding_versions;
+DROP TRIGGER IF EXISTS bump_branding_drafts_version ON branding_drafts;
+DROP TABLE IF EXISTS branding_drafts;
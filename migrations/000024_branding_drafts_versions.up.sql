@@ -0,0 +1,74 @@
+-- Branding drafts and version history
+-- An agency edits a draft of its branding (website, logo, favicon, assets, colors, theme, hide_powered_by) and previews
+-- it with the draft's preview token before publishing it. Whenever the live presentation changes (publish, direct
+-- update, upload finalization or rollback) the previous presentation is archived in branding_versions, numbered per agency.
+-- Domain and verification fields are not versioned: they always apply immediately.
+
+CREATE TABLE IF NOT EXISTS branding_drafts (
+    agency_id UUID PRIMARY KEY REFERENCES branding(agency_id) ON DELETE CASCADE,
+    website TEXT NOT NULL DEFAULT '',
+    logo_url TEXT NOT NULL DEFAULT '',
+    favicon_url TEXT NOT NULL DEFAULT '',
+    assets JSONB NOT NULL DEFAULT '{}'::jsonb,
+    primary_color TEXT NOT NULL DEFAULT '',
+    secondary_color TEXT NOT NULL DEFAULT '',
+    theme_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+    hide_powered_by BOOLEAN NOT NULL DEFAULT false,
+    preview_token TEXT NOT NULL UNIQUE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    version BIGINT NOT NULL DEFAULT 1
+);
+
+DROP TRIGGER IF EXISTS bump_branding_drafts_version ON branding_drafts;
+CREATE TRIGGER bump_branding_drafts_version
+    BEFORE UPDATE ON branding_drafts
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_row_version();
+
+CREATE TABLE IF NOT EXISTS branding_versions (
+    agency_id UUID NOT NULL REFERENCES branding(agency_id) ON DELETE CASCADE,
+    number BIGINT NOT NULL,
+    website TEXT NOT NULL DEFAULT '',
+    logo_url TEXT NOT NULL DEFAULT '',
+    favicon_url TEXT NOT NULL DEFAULT '',
+    assets JSONB NOT NULL DEFAULT '{}'::jsonb,
+    primary_color TEXT NOT NULL DEFAULT '',
+    secondary_color TEXT NOT NULL DEFAULT '',
+    theme_json JSONB NOT NULL DEFAULT '{}'::jsonb,
+    hide_powered_by BOOLEAN NOT NULL DEFAULT false,
+    replaced_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (agency_id, number)
+);
+
+-- Archives the presentation an update replaces; updates of the row lock it, so numbers are assigned in order
+CREATE OR REPLACE FUNCTION archive_branding_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (OLD.website, OLD.logo_url, OLD.favicon_url, OLD.assets, OLD.primary_color, OLD.secondary_color, OLD.theme_json, OLD.hide_powered_by)
+        IS DISTINCT FROM
+       (NEW.website, NEW.logo_url, NEW.favicon_url, NEW.assets, NEW.primary_color, NEW.secondary_color, NEW.theme_json, NEW.hide_powered_by) THEN
+        INSERT INTO branding_versions (agency_id, number, website, logo_url, favicon_url, assets,
+                                       primary_color, secondary_color, theme_json, hide_powered_by, replaced_at)
+        SELECT OLD.agency_id,
+               COALESCE(MAX(number), 0) + 1,
+               COALESCE(OLD.website, ''),
+               COALESCE(OLD.logo_url, ''),
+               COALESCE(OLD.favicon_url, ''),
+               OLD.assets,
+               COALESCE(OLD.primary_color, ''),
+               COALESCE(OLD.secondary_color, ''),
+               OLD.theme_json,
+               COALESCE(OLD.hide_powered_by, false),
+               NOW()
+        FROM branding_versions
+        WHERE agency_id = OLD.agency_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS archive_branding_version ON branding;
+CREATE TRIGGER archive_branding_version
+    AFTER UPDATE ON branding
+    FOR EACH ROW
+    EXECUTE FUNCTION archive_branding_version();
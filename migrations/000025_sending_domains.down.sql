@@ -0,0 +1,3 @@
+-- Rollback white-label sending domains
+
+DROP TABLE IF EXISTS sending_domains;
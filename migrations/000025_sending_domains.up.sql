@@ -0,0 +1,24 @@
+-- White-label sending domains
+-- An agency registers a domain to send its emails from. The email provider issues the DKIM and return-path records
+-- and the platform's SPF include; the agency publishes them and each one is checked by DNS lookups.
+-- Once all records are verified, branding.email_domain is set and agency emails are sent from local_part@domain.
+
+CREATE TABLE IF NOT EXISTS sending_domains (
+    agency_id UUID PRIMARY KEY REFERENCES branding(agency_id) ON DELETE CASCADE,
+    domain TEXT NOT NULL UNIQUE,
+    local_part TEXT NOT NULL,
+    from_name TEXT NOT NULL DEFAULT '',
+    provider_id TEXT NOT NULL DEFAULT '', -- ID of the domain at the email provider
+    dkim_host TEXT NOT NULL,
+    dkim_value TEXT NOT NULL,
+    return_path_host TEXT NOT NULL,
+    return_path_target TEXT NOT NULL,
+    spf_value TEXT NOT NULL,
+    dkim_verified BOOLEAN NOT NULL DEFAULT false,
+    spf_verified BOOLEAN NOT NULL DEFAULT false,
+    return_path_verified BOOLEAN NOT NULL DEFAULT false,
+    verified_at TIMESTAMPTZ, -- Set while all records are verified
+    checked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
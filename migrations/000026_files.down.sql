@@ -0,0 +1,3 @@
+-- Rollback file metadata registry
+
+DROP TABLE IF EXISTS files;
@@ -0,0 +1,26 @@
+-- File metadata registry
+-- Every signed upload is recorded as pending with what the uploader declared. It becomes uploaded once a completion
+-- call or a storage notification confirms the object with a HEAD request, which records the stored size, content type
+-- and checksum. Deleting a file keeps its row with status deleted.
+
+CREATE TABLE IF NOT EXISTS files (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES agencies(id) ON DELETE CASCADE,
+    object_key TEXT NOT NULL UNIQUE,
+    asset TEXT NOT NULL,
+    uploaded_by UUID,
+    content_type TEXT NOT NULL DEFAULT '',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    checksum TEXT NOT NULL DEFAULT '', -- Base64 MD5 of the content (as in Content-MD5)
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'uploaded', 'deleted')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    uploaded_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+-- Create indexes for performance
+CREATE INDEX IF NOT EXISTS idx_files_tenant_id ON files(tenant_id, created_at DESC);
+
+-- Grant appropriate permissions
+GRANT SELECT, INSERT, UPDATE ON files TO PUBLIC;